@@ -33,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/cards"
 	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/spam"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
@@ -62,6 +63,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 )
 
 // Start creates and starts a gotosocial server
@@ -83,8 +85,9 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		// depending on what services were
 		// managed to be started.
 
-		state = new(state.State)
-		route *router.Router
+		state     = new(state.State)
+		route     *router.Router
+		processor *processing.Processor
 	)
 
 	defer func() {
@@ -103,7 +106,8 @@ var Start action.GTSAction = func(ctx context.Context) error {
 
 		// Stop any currently running
 		// worker processes / scheduled
-		// tasks from being executed.
+		// tasks from being executed, and
+		// persist any remaining queued tasks.
 		state.Workers.Stop()
 
 		if state.Timelines.Home != nil {
@@ -256,7 +260,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 
 	// Create the processor using all the
 	// other services we've created so far.
-	processor := processing.NewProcessor(
+	processor = processing.NewProcessor(
 		cleaner,
 		typeConverter,
 		federator,
@@ -264,6 +268,8 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		mediaManager,
 		state,
 		emailSender,
+		webpush.NewSender(client, state),
+		cards.New(state, client, mediaManager),
 	)
 
 	// Initialize the specialized workers pools.
@@ -273,7 +279,12 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	state.Workers.Client.Process = processor.Workers().ProcessFromClientAPI
 	state.Workers.Federator.Process = processor.Workers().ProcessFromFediAPI
 
-	// Now start workers!
+	// Queued worker tasks are persisted
+	// across restarts by the admin processor.
+	state.Workers.Persister = processor.Admin()
+
+	// Now start workers! This also refills worker
+	// queues from tasks persisted on last shutdown.
 	state.Workers.Start()
 
 	// Schedule notif tasks for all existing poll expiries.
@@ -281,6 +292,31 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error scheduling poll expiries: %w", err)
 	}
 
+	// Schedule publishing of all existing scheduled statuses.
+	if err := processor.Status().ScheduleAllStatusPublishes(ctx); err != nil {
+		return fmt.Errorf("error scheduling status publishes: %w", err)
+	}
+
+	// Resume processing of imports interrupted by shutdown.
+	if err := processor.Account().ImportsResume(ctx); err != nil {
+		return fmt.Errorf("error resuming imports: %w", err)
+	}
+
+	// Schedule fetching + processing of domain permission subscriptions.
+	if err := processor.Admin().ScheduleDomainPermissionSubscriptions(); err != nil {
+		return fmt.Errorf("error scheduling domain permission subscriptions: %w", err)
+	}
+
+	// Schedule sending of email notification digests.
+	if err := processor.User().ScheduleEmailDigests(); err != nil {
+		return fmt.Errorf("error scheduling email digests: %w", err)
+	}
+
+	// Schedule aggregation of trending tags, statuses and links.
+	if err := processor.Trends().ScheduleAggregation(); err != nil {
+		return fmt.Errorf("error scheduling trends aggregation: %w", err)
+	}
+
 	// Initialize metrics.
 	if err := metrics.Initialize(state.DB); err != nil {
 		return fmt.Errorf("error initializing metrics: %w", err)
@@ -146,6 +146,33 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountDisable2FACmd := &cobra.Command{
+		Use:   "disable-2fa",
+		Short: "disable two-factor authentication for the given local account, and remove its recovery codes",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Disable2FA)
+		},
+	}
+	config.AddAdminAccount(adminAccountDisable2FACmd)
+	adminAccountCmd.AddCommand(adminAccountDisable2FACmd)
+
+	adminAccountExportArchiveCmd := &cobra.Command{
+		Use:   "export-archive",
+		Short: "export an archive of the given local account's actor, statuses, likes, bookmarks and media to a zip file at the given path",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.ExportArchive)
+		},
+	}
+	config.AddAdminAccount(adminAccountExportArchiveCmd)
+	config.AddAdminTrans(adminAccountExportArchiveCmd)
+	adminAccountCmd.AddCommand(adminAccountExportArchiveCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
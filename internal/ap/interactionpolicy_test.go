@@ -0,0 +1,159 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+const noteWithPolicy = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/bobby_tables/statuses/123456",
+  "type": "Note",
+  "attributedTo": "https://example.org/users/bobby_tables",
+  "content": "hello world",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "approvedBy": "https://example.org/users/someone/accepts/01J1AKMZ8JE5NW0ZSFTRC1JJNE",
+  "interactionPolicy": {
+    "canLike": {
+      "always": "https://www.w3.org/ns/activitystreams#Public"
+    },
+    "canReply": {
+      "always": [
+        "https://example.org/users/bobby_tables",
+        "https://example.org/users/bobby_tables/followers"
+      ],
+      "approvalRequired": [
+        "https://www.w3.org/ns/activitystreams#Public"
+      ]
+    }
+  }
+}`
+
+type InteractionPolicyTestSuite struct {
+	APTestSuite
+}
+
+func (suite *InteractionPolicyTestSuite) noteFromJSON(in string) vocab.ActivityStreamsNote {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(in), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	note, ok := t.(vocab.ActivityStreamsNote)
+	if !ok {
+		suite.FailNow("", "could not parse %T as Note", t)
+	}
+
+	return note
+}
+
+func (suite *InteractionPolicyTestSuite) TestGetInteractionPolicy() {
+	note := suite.noteFromJSON(noteWithPolicy)
+
+	policy := ap.GetInteractionPolicy(note)
+	if policy == nil {
+		suite.FailNow("expected interaction policy")
+	}
+
+	suite.Len(policy.CanLike.Always, 1)
+	suite.Equal(`https://www.w3.org/ns/activitystreams#Public`, policy.CanLike.Always[0].String())
+	suite.Empty(policy.CanLike.ApprovalRequired)
+
+	suite.Len(policy.CanReply.Always, 2)
+	suite.Equal(`https://example.org/users/bobby_tables/followers`, policy.CanReply.Always[1].String())
+	suite.Len(policy.CanReply.ApprovalRequired, 1)
+
+	suite.Empty(policy.CanAnnounce.Always)
+	suite.Empty(policy.CanAnnounce.ApprovalRequired)
+
+	approvedBy := ap.GetApprovedBy(note)
+	suite.Equal(`https://example.org/users/someone/accepts/01J1AKMZ8JE5NW0ZSFTRC1JJNE`, approvedBy.String())
+}
+
+func (suite *InteractionPolicyTestSuite) TestGetInteractionPolicyUnset() {
+	note := suite.noteFromJSON(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/bobby_tables/statuses/123456",
+  "type": "Note",
+  "content": "hello world"
+}`)
+
+	suite.Nil(ap.GetInteractionPolicy(note))
+	suite.Nil(ap.GetApprovedBy(note))
+}
+
+func (suite *InteractionPolicyTestSuite) TestSetInteractionPolicy() {
+	public, err := url.Parse("https://www.w3.org/ns/activitystreams#Public")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	note := streams.NewActivityStreamsNote()
+	ap.SetInteractionPolicy(note, &ap.InteractionPolicy{
+		CanLike: ap.InteractionPolicyRules{
+			Always: []*url.URL{public},
+		},
+	})
+
+	m, err := ap.Serialize(note)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.JSONEq(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "interactionPolicy": {
+    "canLike": {
+      "always": ["https://www.w3.org/ns/activitystreams#Public"],
+      "approvalRequired": []
+    },
+    "canReply": {
+      "always": [],
+      "approvalRequired": []
+    },
+    "canAnnounce": {
+      "always": [],
+      "approvalRequired": []
+    }
+  }
+}`, string(b))
+}
+
+func TestInteractionPolicyTestSuite(t *testing.T) {
+	suite.Run(t, &InteractionPolicyTestSuite{})
+}
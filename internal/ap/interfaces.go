@@ -178,6 +178,7 @@ type Statusable interface {
 	WithName
 	WithInReplyTo
 	WithPublished
+	WithUpdated
 	WithURL
 	WithAttributedTo
 	WithTo
@@ -470,6 +471,12 @@ type WithFeatured interface {
 	SetTootFeatured(vocab.TootFeaturedProperty)
 }
 
+// WithUnknownProperties represents an Object with properties
+// not known to go-fed, which are serialized as-is.
+type WithUnknownProperties interface {
+	GetUnknownProperties() map[string]interface{}
+}
+
 // WithMovedTo represents an Object with ActivityStreamsMovedToProperty.
 type WithMovedTo interface {
 	GetActivityStreamsMovedTo() vocab.ActivityStreamsMovedToProperty
@@ -352,6 +352,123 @@ func SetFeatured(with WithFeatured, featured *url.URL) {
 	featuredProp.SetIRI(featured)
 }
 
+// SetFeaturedTags sets the given IRI on the featuredTags property of 'with'.
+// As go-fed has no vocab for Mastodon's featuredTags, this is set as an unknown property.
+func SetFeaturedTags(with WithUnknownProperties, featuredTags *url.URL) {
+	with.GetUnknownProperties()["featuredTags"] = featuredTags.String()
+}
+
+// InteractionPolicy is the raw ActivityPub form of an
+// interactionPolicy, containing the IRIs of actors and
+// actor collections permitted to do each interaction.
+type InteractionPolicy struct {
+	CanLike     InteractionPolicyRules
+	CanReply    InteractionPolicyRules
+	CanAnnounce InteractionPolicyRules
+}
+
+// InteractionPolicyRules contains the IRIs permitted to
+// do an interaction either always, or pending approval.
+type InteractionPolicyRules struct {
+	Always           []*url.URL
+	ApprovalRequired []*url.URL
+}
+
+// GetInteractionPolicy returns the interactionPolicy property of 'with', or nil if not set.
+// As go-fed has no vocab for interactionPolicy, this is read from the unknown properties.
+func GetInteractionPolicy(with WithUnknownProperties) *InteractionPolicy {
+	raw, ok := with.GetUnknownProperties()["interactionPolicy"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return &InteractionPolicy{
+		CanLike:     getInteractionPolicyRules(raw["canLike"]),
+		CanReply:    getInteractionPolicyRules(raw["canReply"]),
+		CanAnnounce: getInteractionPolicyRules(raw["canAnnounce"]),
+	}
+}
+
+// SetInteractionPolicy sets the given policy on the interactionPolicy property of 'with'.
+// As go-fed has no vocab for interactionPolicy, this is set as an unknown property.
+func SetInteractionPolicy(with WithUnknownProperties, policy *InteractionPolicy) {
+	with.GetUnknownProperties()["interactionPolicy"] = map[string]interface{}{
+		"canLike":     setInteractionPolicyRules(policy.CanLike),
+		"canReply":    setInteractionPolicyRules(policy.CanReply),
+		"canAnnounce": setInteractionPolicyRules(policy.CanAnnounce),
+	}
+}
+
+// getInteractionPolicyRules parses policy rules from raw JSON data.
+func getInteractionPolicyRules(raw interface{}) InteractionPolicyRules {
+	m, _ := raw.(map[string]interface{})
+	return InteractionPolicyRules{
+		Always:           parseRawIRIs(m["always"]),
+		ApprovalRequired: parseRawIRIs(m["approvalRequired"]),
+	}
+}
+
+// setInteractionPolicyRules converts policy rules to raw JSON data.
+func setInteractionPolicyRules(rules InteractionPolicyRules) map[string]interface{} {
+	return map[string]interface{}{
+		"always":           rawIRIs(rules.Always),
+		"approvalRequired": rawIRIs(rules.ApprovalRequired),
+	}
+}
+
+// GetApprovedBy returns the IRI contained in the approvedBy property of 'with', or nil if not set.
+// As go-fed has no vocab for approvedBy, this is read from the unknown properties.
+func GetApprovedBy(with WithUnknownProperties) *url.URL {
+	iris := parseRawIRIs(with.GetUnknownProperties()["approvedBy"])
+	if len(iris) == 0 {
+		return nil
+	}
+	return iris[0]
+}
+
+// SetApprovedBy sets the given IRI on the approvedBy property of 'with'.
+// As go-fed has no vocab for approvedBy, this is set as an unknown property.
+func SetApprovedBy(with WithUnknownProperties, approvedBy *url.URL) {
+	with.GetUnknownProperties()["approvedBy"] = approvedBy.String()
+}
+
+// parseRawIRIs parses IRIs from raw JSON data, which
+// may either be a single string or array of strings.
+func parseRawIRIs(raw interface{}) []*url.URL {
+	var strs []string
+
+	switch t := raw.(type) {
+	case string:
+		strs = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			if str, ok := v.(string); ok {
+				strs = append(strs, str)
+			}
+		}
+	}
+
+	iris := make([]*url.URL, 0, len(strs))
+	for _, str := range strs {
+		iri, err := url.Parse(str)
+		if err != nil {
+			continue
+		}
+		iris = append(iris, iri)
+	}
+
+	return iris
+}
+
+// rawIRIs converts IRIs to raw JSON data.
+func rawIRIs(iris []*url.URL) []interface{} {
+	strs := make([]interface{}, len(iris))
+	for i, iri := range iris {
+		strs[i] = iri.String()
+	}
+	return strs
+}
+
 // GetMovedTo returns the IRI contained in the movedTo property of 'with'.
 func GetMovedTo(with WithMovedTo) *url.URL {
 	movedToProp := with.GetActivityStreamsMovedTo()
@@ -408,6 +525,25 @@ func SetPublished(with WithPublished, published time.Time) {
 	publishProp.Set(published)
 }
 
+// GetUpdated returns the time contained in the Updated property of 'with'.
+func GetUpdated(with WithUpdated) time.Time {
+	updateProp := with.GetActivityStreamsUpdated()
+	if updateProp == nil || !updateProp.IsXMLSchemaDateTime() {
+		return time.Time{}
+	}
+	return updateProp.Get()
+}
+
+// SetUpdated sets the given time on the Updated property of 'with'.
+func SetUpdated(with WithUpdated, updated time.Time) {
+	updateProp := with.GetActivityStreamsUpdated()
+	if updateProp == nil {
+		updateProp = streams.NewActivityStreamsUpdatedProperty()
+		with.SetActivityStreamsUpdated(updateProp)
+	}
+	updateProp.Set(updated)
+}
+
 // GetEndTime returns the time contained in the EndTime property of 'with'.
 func GetEndTime(with WithEndTime) time.Time {
 	endTimeProp := with.GetActivityStreamsEndTime()
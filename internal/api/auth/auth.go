@@ -43,6 +43,8 @@ const (
 	AuthAccountDisabledPath = "/account_disabled"
 	// AuthCallbackPath is the API path for receiving callback tokens from external OIDC providers
 	AuthCallbackPath = "/callback"
+	// AuthTwoFactorPath is the API path for users to enter a two-factor authentication code after signing in
+	AuthTwoFactorPath = "/2fa"
 
 	/*
 		paths prefixed with 'oauth'
@@ -73,6 +75,15 @@ const (
 	sessionClientState   = "client_state"
 	sessionClaims        = "claims"
 	sessionAppID         = "app_id"
+
+	sessionTwoFactorVerified = "two_factor_verified"
+	sessionTwoFactorAttempts = "two_factor_attempts"
+
+	// twoFactorMaxAttempts is the number of wrong
+	// two-factor authentication codes that may be
+	// entered for one sign in, before the user has
+	// to start over by giving their password again.
+	twoFactorMaxAttempts = 5
 )
 
 type Module struct {
@@ -97,6 +108,8 @@ func (m *Module) RouteAuth(attachHandler func(method string, path string, f ...g
 	attachHandler(http.MethodGet, AuthSignInPath, m.SignInGETHandler)
 	attachHandler(http.MethodPost, AuthSignInPath, m.SignInPOSTHandler)
 	attachHandler(http.MethodGet, AuthCallbackPath, m.CallbackGETHandler)
+	attachHandler(http.MethodGet, AuthTwoFactorPath, m.TwoFactorGETHandler)
+	attachHandler(http.MethodPost, AuthTwoFactorPath, m.TwoFactorPOSTHandler)
 }
 
 // RouteOauth routes all paths that should have an 'oauth' prefix
@@ -121,6 +121,10 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 		return
 	}
 
+	if ensureTwoFactorVerifiedOrRedirect(c, s, user) {
+		return
+	}
+
 	// Finally we should also get the redirect and scope of this particular request, as stored in the session.
 	redirect, ok := s.Get(sessionRedirectURI).(string)
 	if !ok || redirect == "" {
@@ -248,6 +252,14 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if ensureTwoFactorVerifiedOrRedirect(c, s, user) {
+		return
+	}
+
+	// Pass on whether the second factor was verified
+	// in this session, for the oauth server to check.
+	twoFactorVerified, _ := s.Get(sessionTwoFactorVerified).(string)
+
 	if redirectURI != oauth.OOBURI {
 		// we're done with the session now, so just clear it out
 		m.clearSession(s)
@@ -262,6 +274,8 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 		sessionRedirectURI:  {redirectURI},
 		sessionScope:        {scope},
 		sessionUserID:       {userID},
+
+		sessionTwoFactorVerified: {twoFactorVerified},
 	}
 
 	if clientState != "" {
@@ -339,3 +353,20 @@ func ensureUserIsAuthorizedOrRedirect(ctx *gin.Context, user *gtsmodel.User, acc
 
 	return
 }
+
+// ensureTwoFactorVerifiedOrRedirect redirects to the two-factor
+// authentication page if the given user has two-factor authentication
+// enabled, but hasn't yet entered a valid code in this session.
+func ensureTwoFactorVerifiedOrRedirect(ctx *gin.Context, s sessions.Session, user *gtsmodel.User) (redirected bool) {
+	if !user.TwoFactorEnabled() {
+		return
+	}
+
+	if verified, _ := s.Get(sessionTwoFactorVerified).(string); verified == "true" {
+		return
+	}
+
+	ctx.Redirect(http.StatusSeeOther, "/auth"+AuthTwoFactorPath)
+	redirected = true
+	return
+}
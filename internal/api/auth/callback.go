@@ -166,6 +166,7 @@ func (m *Module) CallbackGETHandler(c *gin.Context) {
 	}
 
 	s.Set(sessionUserID, user.ID)
+	s.Delete(sessionTwoFactorVerified)
 	if err := s.Save(); err != nil {
 		m.clearSession(s)
 		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
@@ -249,6 +250,7 @@ func (m *Module) FinalizePOSTHandler(c *gin.Context) {
 	s.Delete(sessionClaims)
 	s.Delete(sessionAppID)
 	s.Set(sessionUserID, user.ID)
+	s.Delete(sessionTwoFactorVerified)
 	if err := s.Save(); err != nil {
 		m.clearSession(s)
 		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
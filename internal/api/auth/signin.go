@@ -100,7 +100,11 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	// Any second factor must be
+	// given again for this sign in.
 	s.Set(sessionUserID, userid)
+	s.Delete(sessionTwoFactorVerified)
+	s.Delete(sessionTwoFactorAttempts)
 	if err := s.Save(); err != nil {
 		err := fmt.Errorf("error saving user id onto session: %s", err)
 		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
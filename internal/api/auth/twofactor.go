@@ -0,0 +1,182 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// twoFactor just wraps a form-submitted two-factor authentication code,
+// which may be a code from an authenticator app, or a recovery code.
+type twoFactor struct {
+	Code string `form:"code"`
+}
+
+// TwoFactorGETHandler should be served at https://example.org/auth/2fa.
+// Users who have two-factor authentication enabled are redirected here after
+// signing in, to be presented with a page where they can enter their code.
+// The form will then POST to the same path, handled by TwoFactorPOSTHandler.
+func (m *Module) TwoFactorGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	s := sessions.Default(c)
+
+	user, errWithCode := m.twoFactorUser(c, s)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	page := apiutil.WebPage{
+		Template: "2fa.tmpl",
+		Instance: instance,
+		Extra: map[string]any{
+			"user": user.Account.Username,
+		},
+	}
+
+	apiutil.TemplateWebPage(c, page)
+}
+
+// TwoFactorPOSTHandler should be served at https://example.org/auth/2fa.
+// It checks the submitted code for the user who has just signed in, and,
+// if it's valid, marks the session as verified and redirects back to the
+// authorize handler served at /oauth/authorize.
+func (m *Module) TwoFactorPOSTHandler(c *gin.Context) {
+	s := sessions.Default(c)
+
+	form := &twoFactor{}
+	if err := c.ShouldBind(form); err != nil {
+		m.clearSession(s)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	user, errWithCode := m.twoFactorUser(c, s)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := m.processor.User().TwoFactorCodeCheck(c.Request.Context(), user, form.Code); errWithCode != nil {
+		if errWithCode.Code() != http.StatusUnauthorized {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		// Wrong code was given, count it against this sign in.
+		attempts, _ := s.Get(sessionTwoFactorAttempts).(int)
+		attempts++
+
+		if attempts >= twoFactorMaxAttempts {
+			// Too many wrong codes, so forget who signed in
+			// (but keep the oauth request they were signing in
+			// for) and send them back to give their password again.
+			s.Delete(sessionUserID)
+			s.Delete(sessionTwoFactorAttempts)
+			if err := s.Save(); err != nil {
+				m.clearSession(s)
+				err := fmt.Errorf("error saving session: %s", err)
+				apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+				return
+			}
+
+			c.Redirect(http.StatusSeeOther, "/auth"+AuthSignInPath)
+			return
+		}
+
+		// don't clear session here, so the user can just press back and try again
+		// if they accidentally gave the wrong code or something
+		s.Set(sessionTwoFactorAttempts, attempts)
+		if err := s.Save(); err != nil {
+			err := fmt.Errorf("error saving two-factor attempts onto session: %s", err)
+			apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+			return
+		}
+
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	s.Delete(sessionTwoFactorAttempts)
+	s.Set(sessionTwoFactorVerified, "true")
+	if err := s.Save(); err != nil {
+		err := fmt.Errorf("error saving two-factor verification onto session: %s", err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
+}
+
+// twoFactorUser gets the user who has signed in with the given session,
+// and is expected to enter a two-factor authentication code, along with
+// their account.
+func (m *Module) twoFactorUser(c *gin.Context, s sessions.Session) (*gtsmodel.User, gtserror.WithCode) {
+	userID, ok := s.Get(sessionUserID).(string)
+	if !ok || userID == "" {
+		m.clearSession(s)
+		err := fmt.Errorf("key %s was not found in session", sessionUserID)
+		return nil, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice)
+	}
+
+	user, err := m.db.GetUserByID(c.Request.Context(), userID)
+	if err != nil {
+		m.clearSession(s)
+		safe := fmt.Sprintf("user with id %s could not be retrieved", userID)
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorBadRequest(err, safe, oauth.HelpfulAdvice)
+		}
+		return nil, gtserror.NewErrorInternalError(err, safe, oauth.HelpfulAdvice)
+	}
+
+	if !user.TwoFactorEnabled() {
+		err := fmt.Errorf("user %s does not have two-factor authentication enabled", userID)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+	}
+
+	if user.Account == nil {
+		user.Account, err = m.db.GetAccountByID(c.Request.Context(), user.AccountID)
+		if err != nil {
+			m.clearSession(s)
+			safe := fmt.Sprintf("account with id %s could not be retrieved", user.AccountID)
+			return nil, gtserror.NewErrorInternalError(err, safe, oauth.HelpfulAdvice)
+		}
+	}
+
+	return user, nil
+}
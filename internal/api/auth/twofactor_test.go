@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/memstore"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/auth"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AuthTwoFactorTestSuite struct {
+	AuthStandardTestSuite
+}
+
+// postCode posts the given code to the two-factor handler,
+// using the session with the given cookies from the store,
+// and returns the recorder along with updated cookies.
+func (suite *AuthTwoFactorTestSuite) postCode(
+	store sessions.Store,
+	cookies []*http.Cookie,
+	code string,
+) (*httptest.ResponseRecorder, []*http.Cookie) {
+	recorder := httptest.NewRecorder()
+	ctx, engine := testrig.CreateGinTestContext(recorder, nil)
+	testrig.ConfigureTemplatesWithGin(engine, "../../../web/template")
+
+	requestURI := fmt.Sprintf("%s://%s/auth%s", config.GetProtocol(), config.GetHost(), auth.AuthTwoFactorPath)
+	body := url.Values{"code": {code}}.Encode()
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, strings.NewReader(body))
+	ctx.Request.Header.Set("accept", "text/html")
+	ctx.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, cookie := range cookies {
+		ctx.Request.AddCookie(cookie)
+	}
+
+	sessions.Sessions("gotosocial-localhost", store)(ctx)
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	// Redirects from POST have no body, so
+	// make sure the status code is written.
+	ctx.Writer.WriteHeaderNow()
+
+	if newCookies := recorder.Result().Cookies(); len(newCookies) != 0 {
+		cookies = newCookies
+	}
+
+	return recorder, cookies
+}
+
+func (suite *AuthTwoFactorTestSuite) TestTooManyWrongCodes() {
+	// Enable two-factor authentication for zork.
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+	user.TwoFactorSecret = "JBSWY3DPEHPK3PXP"
+	user.TwoFactorEnabledAt = time.Now()
+	if err := suite.db.UpdateUser(context.Background(), user,
+		"two_factor_secret",
+		"two_factor_enabled_at",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	store := memstore.NewStore(make([]byte, 32), make([]byte, 32))
+	store.Options(middleware.SessionOptions())
+
+	// Sign in with password was done, so
+	// user ID is set on the session already.
+	ctx, recorder := suite.newContext(http.MethodGet, "", nil, "")
+	sessions.Sessions("gotosocial-localhost", store)(ctx)
+	s := sessions.Default(ctx)
+	s.Set(sessionUserID, user.ID)
+	s.Set(sessionClientID, suite.testApplications["application_1"].ClientID)
+	if err := s.Save(); err != nil {
+		suite.FailNow(err.Error())
+	}
+	cookies := recorder.Result().Cookies()
+
+	// The first few wrong codes should
+	// just let the user try again.
+	for i := 1; i < 5; i++ {
+		recorder, cookies = suite.postCode(store, cookies, "000000")
+		suite.Equal(http.StatusUnauthorized, recorder.Code, "attempt %d", i)
+	}
+
+	// The last should make the
+	// user sign in again instead.
+	recorder, cookies = suite.postCode(store, cookies, "000000")
+	suite.Equal(http.StatusSeeOther, recorder.Code)
+	suite.Equal("/auth"+auth.AuthSignInPath, recorder.Header().Get("Location"))
+
+	// There's no signed in user to try
+	// codes for any more, even a valid one.
+	recorder, _ = suite.postCode(store, cookies, "123456")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestAuthTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthTwoFactorTestSuite))
+}
@@ -108,6 +108,23 @@ import (
 //		description: Default content type to use for authored statuses (text/plain or text/markdown).
 //		type: string
 //	-
+//		name: source[email_notifications][]
+//		in: formData
+//		description: >-
+//			Notification types for which to send an email to the account's email address:
+//			mention, direct, follow, follow_request. Provide a single empty string to turn
+//			off email notifications.
+//		type: array
+//		items:
+//			type: string
+//	-
+//		name: source[email_digest]
+//		in: formData
+//		description: >-
+//			Send email notifications as a daily or weekly digest (daily, weekly),
+//			rather than one email per notification. Empty string unsets digest.
+//		type: string
+//	-
 //		name: theme
 //		in: formData
 //		description: >-
@@ -215,9 +232,12 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) AccountUpdateCredentialsPATCHHandler(c *gin.Context) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		oauth.ScopeWriteAccounts,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -321,6 +341,8 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 			form.Source.Sensitive == nil &&
 			form.Source.Language == nil &&
 			form.Source.StatusContentType == nil &&
+			form.Source.EmailNotifications == nil &&
+			form.Source.EmailDigest == nil &&
 			form.FieldsAttributes == nil &&
 			form.Theme == nil &&
 			form.CustomCSS == nil &&
@@ -53,7 +53,13 @@ import (
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken, currently only supports `suspend`.
+//		description: |-
+//			Type of action to be taken. One of:
+//			- `none`: record a warning against the account, without taking any other action.
+//			- `sensitive` / `unsensitive`: force / stop forcing the account's media to be marked sensitive.
+//			- `disable` / `reenable`: disable / reenable login for a local account.
+//			- `silence` / `unsilence`: hide / stop hiding the account's posts from non-followers.
+//			- `suspend`: suspend the account and remove all its data. Cannot be reversed.
 //		type: string
 //		required: true
 //	-
@@ -64,7 +70,7 @@ import (
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:write:accounts
 //
 //	responses:
 //		'200':
@@ -87,9 +93,12 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) AccountActionPOSTHandler(c *gin.Context) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		oauth.ScopeAdminWriteAccounts,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
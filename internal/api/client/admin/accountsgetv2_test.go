@@ -76,6 +76,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -133,6 +134,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -179,6 +181,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01AY6P665V14JJR0AFVRT7311Y",
@@ -220,6 +223,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH1H7YV1Z7D2C8K2730QBF",
@@ -266,6 +270,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH0BBE4FHXPH513MBVFHB0",
@@ -311,6 +316,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01FHMQX3GAABWSM0S2VZEC2SWC",
@@ -352,6 +358,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -393,6 +400,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "062G5WYKY35KKD12EMSM3F8PJ8",
@@ -434,6 +442,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "07GZRBAEMBNKGZ8Z9VSKSXKR98",
@@ -514,6 +523,7 @@ func (suite *AccountsGetTestSuite) TestAccountsMinID() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01AY6P665V14JJR0AFVRT7311Y",
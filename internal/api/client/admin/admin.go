@@ -28,37 +28,55 @@ import (
 )
 
 const (
-	BasePath                = "/v1/admin"
-	EmojiPath               = BasePath + "/custom_emojis"
-	EmojiPathWithID         = EmojiPath + "/:" + apiutil.IDKey
-	EmojiCategoriesPath     = EmojiPath + "/categories"
-	DomainBlocksPath        = BasePath + "/domain_blocks"
-	DomainBlocksPathWithID  = DomainBlocksPath + "/:" + apiutil.IDKey
-	DomainAllowsPath        = BasePath + "/domain_allows"
-	DomainAllowsPathWithID  = DomainAllowsPath + "/:" + apiutil.IDKey
-	DomainKeysExpirePath    = BasePath + "/domain_keys_expire"
-	HeaderAllowsPath        = BasePath + "/header_allows"
-	HeaderAllowsPathWithID  = HeaderAllowsPath + "/:" + apiutil.IDKey
-	HeaderBlocksPath        = BasePath + "/header_blocks"
-	HeaderBlocksPathWithID  = HeaderBlocksPath + "/:" + apiutil.IDKey
-	AccountsV1Path          = BasePath + "/accounts"
-	AccountsV2Path          = "/v2/admin/accounts"
-	AccountsPathWithID      = AccountsV1Path + "/:" + apiutil.IDKey
-	AccountsActionPath      = AccountsPathWithID + "/action"
-	AccountsApprovePath     = AccountsPathWithID + "/approve"
-	AccountsRejectPath      = AccountsPathWithID + "/reject"
-	MediaCleanupPath        = BasePath + "/media_cleanup"
-	MediaRefetchPath        = BasePath + "/media_refetch"
-	ReportsPath             = BasePath + "/reports"
-	ReportsPathWithID       = ReportsPath + "/:" + apiutil.IDKey
-	ReportsResolvePath      = ReportsPathWithID + "/resolve"
-	EmailPath               = BasePath + "/email"
-	EmailTestPath           = EmailPath + "/test"
-	InstanceRulesPath       = BasePath + "/instance/rules"
-	InstanceRulesPathWithID = InstanceRulesPath + "/:" + apiutil.IDKey
-	DebugPath               = BasePath + "/debug"
-	DebugAPUrlPath          = DebugPath + "/apurl"
-	DebugClearCachesPath    = DebugPath + "/caches/clear"
+	BasePath                 = "/v1/admin"
+	EmojiPath                = BasePath + "/custom_emojis"
+	EmojiPathWithID          = EmojiPath + "/:" + apiutil.IDKey
+	EmojiCategoriesPath      = EmojiPath + "/categories"
+	DomainBlocksPath         = BasePath + "/domain_blocks"
+	DomainBlocksPathWithID   = DomainBlocksPath + "/:" + apiutil.IDKey
+	DomainAllowsPath         = BasePath + "/domain_allows"
+	DomainAllowsPathWithID   = DomainAllowsPath + "/:" + apiutil.IDKey
+	DomainLimitsPath         = BasePath + "/domain_limits"
+	DomainLimitsPathWithID   = DomainLimitsPath + "/:" + apiutil.IDKey
+	DomainKeysExpirePath     = BasePath + "/domain_keys_expire"
+	DomainPermSubsPath       = BasePath + "/domain_permission_subscriptions"
+	DomainPermSubsPathWithID = DomainPermSubsPath + "/:" + apiutil.IDKey
+	HeaderAllowsPath         = BasePath + "/header_allows"
+	HeaderAllowsPathWithID   = HeaderAllowsPath + "/:" + apiutil.IDKey
+	HeaderBlocksPath         = BasePath + "/header_blocks"
+	HeaderBlocksPathWithID   = HeaderBlocksPath + "/:" + apiutil.IDKey
+	AccountsV1Path           = BasePath + "/accounts"
+	AccountsV2Path           = "/v2/admin/accounts"
+	AccountsPathWithID       = AccountsV1Path + "/:" + apiutil.IDKey
+	AccountsActionPath       = AccountsPathWithID + "/action"
+	AccountsApprovePath      = AccountsPathWithID + "/approve"
+	AccountsRejectPath       = AccountsPathWithID + "/reject"
+	MediaCleanupPath         = BasePath + "/media_cleanup"
+	MediaRefetchPath         = BasePath + "/media_refetch"
+	RelaysPath               = BasePath + "/relays"
+	RelaysPathWithID         = RelaysPath + "/:" + apiutil.IDKey
+	ReportsPath              = BasePath + "/reports"
+	ReportsPathWithID        = ReportsPath + "/:" + apiutil.IDKey
+	ReportsResolvePath       = ReportsPathWithID + "/resolve"
+	EmailPath                = BasePath + "/email"
+	EmailTestPath            = EmailPath + "/test"
+	InstanceRulesPath        = BasePath + "/instance/rules"
+	InstanceRulesPathWithID  = InstanceRulesPath + "/:" + apiutil.IDKey
+	AnnouncementsPath        = BasePath + "/announcements"
+	AnnouncementsPathWithID  = AnnouncementsPath + "/:" + apiutil.IDKey
+	TrendsPath               = BasePath + "/trends"
+	TrendsTagsPath           = TrendsPath + "/tags"
+	TrendTagApprovePath      = TrendsTagsPath + "/:" + apiutil.IDKey + "/approve"
+	TrendTagRejectPath       = TrendsTagsPath + "/:" + apiutil.IDKey + "/reject"
+	TrendsStatusesPath       = TrendsPath + "/statuses"
+	TrendStatusApprovePath   = TrendsStatusesPath + "/:" + apiutil.IDKey + "/approve"
+	TrendStatusRejectPath    = TrendsStatusesPath + "/:" + apiutil.IDKey + "/reject"
+	TrendsLinksPath          = TrendsPath + "/links"
+	TrendLinkApprovePath     = TrendsLinksPath + "/:" + apiutil.IDKey + "/approve"
+	TrendLinkRejectPath      = TrendsLinksPath + "/:" + apiutil.IDKey + "/reject"
+	DebugPath                = BasePath + "/debug"
+	DebugAPUrlPath           = DebugPath + "/apurl"
+	DebugClearCachesPath     = DebugPath + "/caches/clear"
 
 	FilterQueryKey        = "filter"
 	MaxShortcodeDomainKey = "max_shortcode_domain"
@@ -99,6 +117,19 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, DomainAllowsPathWithID, m.DomainAllowGETHandler)
 	attachHandler(http.MethodDelete, DomainAllowsPathWithID, m.DomainAllowDELETEHandler)
 
+	// domain limit stuff
+	attachHandler(http.MethodPost, DomainLimitsPath, m.DomainLimitsPOSTHandler)
+	attachHandler(http.MethodGet, DomainLimitsPath, m.DomainLimitsGETHandler)
+	attachHandler(http.MethodGet, DomainLimitsPathWithID, m.DomainLimitGETHandler)
+	attachHandler(http.MethodDelete, DomainLimitsPathWithID, m.DomainLimitDELETEHandler)
+
+	// domain permission subscription stuff
+	attachHandler(http.MethodPost, DomainPermSubsPath, m.DomainPermissionSubscriptionPOSTHandler)
+	attachHandler(http.MethodGet, DomainPermSubsPath, m.DomainPermissionSubscriptionsGETHandler)
+	attachHandler(http.MethodGet, DomainPermSubsPathWithID, m.DomainPermissionSubscriptionGETHandler)
+	attachHandler(http.MethodPatch, DomainPermSubsPathWithID, m.DomainPermissionSubscriptionPATCHHandler)
+	attachHandler(http.MethodDelete, DomainPermSubsPathWithID, m.DomainPermissionSubscriptionDELETEHandler)
+
 	// header filtering administration routes
 	attachHandler(http.MethodGet, HeaderAllowsPathWithID, m.HeaderFilterAllowGET)
 	attachHandler(http.MethodGet, HeaderBlocksPathWithID, m.HeaderFilterBlockGET)
@@ -124,6 +155,13 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
 	attachHandler(http.MethodPost, MediaRefetchPath, m.MediaRefetchPOSTHandler)
 
+	// relay stuff
+	attachHandler(http.MethodPost, RelaysPath, m.RelayPOSTHandler)
+	attachHandler(http.MethodGet, RelaysPath, m.RelaysGETHandler)
+	attachHandler(http.MethodGet, RelaysPathWithID, m.RelayGETHandler)
+	attachHandler(http.MethodPatch, RelaysPathWithID, m.RelayPATCHHandler)
+	attachHandler(http.MethodDelete, RelaysPathWithID, m.RelayDELETEHandler)
+
 	// reports stuff
 	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
 	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
@@ -139,6 +177,24 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPatch, InstanceRulesPathWithID, m.RulePATCHHandler)
 	attachHandler(http.MethodDelete, InstanceRulesPathWithID, m.RuleDELETEHandler)
 
+	// announcements stuff
+	attachHandler(http.MethodGet, AnnouncementsPath, m.AnnouncementsGETHandler)
+	attachHandler(http.MethodGet, AnnouncementsPathWithID, m.AnnouncementGETHandler)
+	attachHandler(http.MethodPost, AnnouncementsPath, m.AnnouncementPOSTHandler)
+	attachHandler(http.MethodPatch, AnnouncementsPathWithID, m.AnnouncementPATCHHandler)
+	attachHandler(http.MethodDelete, AnnouncementsPathWithID, m.AnnouncementDELETEHandler)
+
+	// trends stuff
+	attachHandler(http.MethodGet, TrendsTagsPath, m.TrendsTagsGETHandler)
+	attachHandler(http.MethodPost, TrendTagApprovePath, m.TrendTagApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendTagRejectPath, m.TrendTagRejectPOSTHandler)
+	attachHandler(http.MethodGet, TrendsStatusesPath, m.TrendsStatusesGETHandler)
+	attachHandler(http.MethodPost, TrendStatusApprovePath, m.TrendStatusApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendStatusRejectPath, m.TrendStatusRejectPOSTHandler)
+	attachHandler(http.MethodGet, TrendsLinksPath, m.TrendsLinksGETHandler)
+	attachHandler(http.MethodPost, TrendLinkApprovePath, m.TrendLinkApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendLinkRejectPath, m.TrendLinkRejectPOSTHandler)
+
 	// debug stuff
 	if debug.DEBUG {
 		attachHandler(http.MethodGet, DebugAPUrlPath, m.DebugAPUrlHandler)
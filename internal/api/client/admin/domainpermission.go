@@ -34,18 +34,19 @@ import (
 
 type singleDomainPermCreate func(
 	context.Context,
-	gtsmodel.DomainPermissionType, // block/allow
+	gtsmodel.DomainPermissionType, // block/allow/limit
 	*gtsmodel.Account, // admin account
 	string, // domain
 	bool, // obfuscate
 	string, // publicComment
 	string, // privateComment
 	string, // subscriptionID
+	*apimodel.DomainLimitOptions, // limitOptions
 ) (*apimodel.DomainPermission, string, gtserror.WithCode)
 
 type multiDomainPermCreate func(
 	context.Context,
-	gtsmodel.DomainPermissionType, // block/allow
+	gtsmodel.DomainPermissionType, // block/allow/limit
 	*gtsmodel.Account, // admin account
 	*multipart.FileHeader, // domains
 ) (*apimodel.MultiStatus, gtserror.WithCode)
@@ -63,9 +64,12 @@ func (m *Module) createDomainPermissions(
 	single singleDomainPermCreate,
 	multi multiDomainPermCreate,
 ) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		domainPermWriteScope(permType),
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -98,6 +102,7 @@ func (m *Module) createDomainPermissions(
 		return
 	}
 
+	var err error
 	if importing && form.Domains.Size == 0 {
 		err = errors.New("import was specified but list of domains is empty")
 	} else if !importing && form.Domain == "" {
@@ -120,6 +125,7 @@ func (m *Module) createDomainPermissions(
 			form.PublicComment,
 			form.PrivateComment,
 			"", // No sub ID for single perm creation.
+			&form.DomainLimitOptions,
 		)
 
 		if errWithCode != nil {
@@ -171,9 +177,12 @@ func (m *Module) deleteDomainPermission(
 	c *gin.Context,
 	permType gtsmodel.DomainPermissionType, // block/allow
 ) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		domainPermWriteScope(permType),
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -218,9 +227,12 @@ func (m *Module) getDomainPermission(
 	c *gin.Context,
 	permType gtsmodel.DomainPermissionType,
 ) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		domainPermReadScope(permType),
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -266,9 +278,12 @@ func (m *Module) getDomainPermissions(
 	c *gin.Context,
 	permType gtsmodel.DomainPermissionType,
 ) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		domainPermReadScope(permType),
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -302,3 +317,31 @@ func (m *Module) getDomainPermissions(
 
 	apiutil.JSON(c, http.StatusOK, domainPerm)
 }
+
+// domainPermReadScope returns the scope
+// needed to view domain permissions of
+// the given type (block/allow/limit).
+func domainPermReadScope(permType gtsmodel.DomainPermissionType) oauth.Scope {
+	switch permType {
+	case gtsmodel.DomainPermissionAllow:
+		return oauth.ScopeAdminReadDomainAllows
+	case gtsmodel.DomainPermissionLimit:
+		return oauth.ScopeAdminReadDomainLimits
+	default:
+		return oauth.ScopeAdminReadDomainBlocks
+	}
+}
+
+// domainPermWriteScope returns the scope
+// needed to create or delete domain permissions
+// of the given type (block/allow/limit).
+func domainPermWriteScope(permType gtsmodel.DomainPermissionType) oauth.Scope {
+	switch permType {
+	case gtsmodel.DomainPermissionAllow:
+		return oauth.ScopeAdminWriteDomainAllows
+	case gtsmodel.DomainPermissionLimit:
+		return oauth.ScopeAdminWriteDomainLimits
+	default:
+		return oauth.ScopeAdminWriteDomainBlocks
+	}
+}
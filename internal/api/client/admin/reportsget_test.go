@@ -163,6 +163,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -204,6 +205,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -261,6 +263,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -307,6 +310,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -366,6 +370,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -423,6 +428,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -453,6 +459,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -528,7 +535,27 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "tags": [],
         "emojis": [],
         "card": null,
-        "poll": null
+        "poll": null,
+        "interaction_policy": {
+          "can_favourite": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          },
+          "can_reply": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          },
+          "can_reblog": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          }
+        }
       }
     ],
     "rules": [
@@ -588,6 +615,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -645,6 +673,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -675,6 +704,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -750,7 +780,27 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
         "tags": [],
         "emojis": [],
         "card": null,
-        "poll": null
+        "poll": null,
+        "interaction_policy": {
+          "can_favourite": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          },
+          "can_reply": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          },
+          "can_reblog": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          }
+        }
       }
     ],
     "rules": [
@@ -810,6 +860,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -867,6 +918,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -897,6 +949,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -972,7 +1025,27 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
         "tags": [],
         "emojis": [],
         "card": null,
-        "poll": null
+        "poll": null,
+        "interaction_policy": {
+          "can_favourite": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          },
+          "can_reply": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          },
+          "can_reblog": {
+            "always": [
+              "public"
+            ],
+            "with_approval": []
+          }
+        }
       }
     ],
     "rules": [
@@ -1015,7 +1088,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetNotAdmin() {
 	testToken := suite.testTokens["local_account_1"]
 	testUser := suite.testUsers["local_account_1"]
 
-	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: user 01F8MGVGPHQ2D3P3X0454H54Z5 not an admin"}`, nil, "", "", "", "", "", 20)
+	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: token has insufficient scope permission: admin:read:reports required"}`, nil, "", "", "", "", "", 20)
 	suite.NoError(err)
 	suite.Empty(reports)
 }
@@ -0,0 +1,292 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// TrendsTagsGETHandler swagger:operation GET /api/v1/admin/trends/tags adminTrendsTags
+//
+// View currently trending hashtags, highest score first, including those not yet reviewed.
+//
+// Trends are aggregated from the database periodically, and only
+// trends approved by an admin are shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: review
+//		type: string
+//		description: >-
+//			If set, only trends with the given review will be returned.
+//			If unset, trends will not be filtered on their review.
+//		enum:
+//		- pending
+//		- approved
+//		- rejected
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of trends to return.
+//		default: 20
+//		minimum: 1
+//		maximum: 100
+//		in: query
+//	-
+//		name: offset
+//		type: integer
+//		description: Number of trends to skip before the first returned trend.
+//		default: 0
+//		minimum: 0
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			name: trends
+//			description: Array of trends.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsTagsGETHandler(c *gin.Context) {
+	m.trendsGET(c, gtsmodel.TrendTypeTag)
+}
+
+// TrendsStatusesGETHandler swagger:operation GET /api/v1/admin/trends/statuses adminTrendsStatuses
+//
+// View currently trending statuses, highest score first, including those not yet reviewed.
+//
+// Trends are aggregated from the database periodically, and only
+// trends approved by an admin are shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: review
+//		type: string
+//		description: >-
+//			If set, only trends with the given review will be returned.
+//			If unset, trends will not be filtered on their review.
+//		enum:
+//		- pending
+//		- approved
+//		- rejected
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of trends to return.
+//		default: 20
+//		minimum: 1
+//		maximum: 100
+//		in: query
+//	-
+//		name: offset
+//		type: integer
+//		description: Number of trends to skip before the first returned trend.
+//		default: 0
+//		minimum: 0
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			name: trends
+//			description: Array of trends.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsStatusesGETHandler(c *gin.Context) {
+	m.trendsGET(c, gtsmodel.TrendTypeStatus)
+}
+
+// TrendsLinksGETHandler swagger:operation GET /api/v1/admin/trends/links adminTrendsLinks
+//
+// View currently trending links, highest score first, including those not yet reviewed.
+//
+// Trends are aggregated from the database periodically, and only
+// trends approved by an admin are shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: review
+//		type: string
+//		description: >-
+//			If set, only trends with the given review will be returned.
+//			If unset, trends will not be filtered on their review.
+//		enum:
+//		- pending
+//		- approved
+//		- rejected
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of trends to return.
+//		default: 20
+//		minimum: 1
+//		maximum: 100
+//		in: query
+//	-
+//		name: offset
+//		type: integer
+//		description: Number of trends to skip before the first returned trend.
+//		default: 0
+//		minimum: 0
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			name: trends
+//			description: Array of trends.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsLinksGETHandler(c *gin.Context) {
+	m.trendsGET(c, gtsmodel.TrendTypeLink)
+}
+
+func (m *Module) trendsGET(c *gin.Context, trendType gtsmodel.TrendType) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		oauth.ScopeAdminRead,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	review := gtsmodel.TrendReviewUnknown
+	if reviewStr := c.Query(apiutil.TrendsReviewKey); reviewStr != "" {
+		review = gtsmodel.NewTrendReview(reviewStr)
+		if review == gtsmodel.TrendReviewUnknown {
+			err := fmt.Errorf("unrecognized review %s", reviewStr)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	limit, errWithCode := apiutil.ParseLimit(c.Query(apiutil.LimitKey), 20, 100, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	offset, errWithCode := apiutil.ParseTrendsOffset(c.Query(apiutil.TrendsOffsetKey), 0, 10000, 0)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	trends, errWithCode := m.processor.Admin().TrendsGet(
+		c.Request.Context(),
+		authed.Account,
+		trendType,
+		review,
+		limit,
+		offset,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, trends)
+}
@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/announcements"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+type AnnouncementsGetTestSuite struct {
+	AnnouncementsStandardTestSuite
+}
+
+func (suite *AnnouncementsGetTestSuite) getAnnouncements(requester string, withDismissed bool) (string, error) {
+	return suite.call(
+		suite.announcementsModule.AnnouncementsGETHandler,
+		http.MethodGet,
+		announcements.BasePath+"?"+apiutil.AnnouncementWithDismissedKey+"="+fmt.Sprint(withDismissed),
+		nil,
+		requester,
+		http.StatusOK,
+	)
+}
+
+func (suite *AnnouncementsGetTestSuite) TestGetAnnouncements() {
+	resp, err := suite.getAnnouncements("local_account_1", false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dst := new(bytes.Buffer)
+	if err := json.Indent(dst, []byte(resp), "", "  "); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(`[
+  {
+    "id": "01J5QVB9VC76NPPRQ207GG4DRZ",
+    "content": "<p>The instance will be down for maintenance tonight, sorry! :rainbow:</p>",
+    "starts_at": "2024-08-20T20:00:00.000Z",
+    "all_day": false,
+    "published_at": "2024-08-20T08:12:24.000Z",
+    "updated_at": "2024-08-20T08:12:24.000Z",
+    "published": true,
+    "read": false,
+    "mentions": [],
+    "statuses": [],
+    "tags": [],
+    "emoji": [
+      {
+        "shortcode": "rainbow",
+        "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png",
+        "static_url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/static/01F8MH9H8E4VG3KDYJR9EGPXCQ.png",
+        "visible_in_picker": true,
+        "category": "reactions"
+      }
+    ],
+    "reactions": [
+      {
+        "name": "🦥",
+        "count": 1,
+        "me": false
+      },
+      {
+        "name": "rainbow",
+        "count": 1,
+        "me": true,
+        "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png",
+        "static_url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/static/01F8MH9H8E4VG3KDYJR9EGPXCQ.png"
+      }
+    ]
+  }
+]`, dst.String())
+}
+
+func (suite *AnnouncementsGetTestSuite) TestGetAnnouncementsDismissed() {
+	announcementID := suite.testAnnouncements["published"].ID
+
+	if _, err := suite.call(
+		suite.announcementsModule.AnnouncementDismissPOSTHandler,
+		http.MethodPost,
+		strings.Replace(announcements.DismissPath, ":"+apiutil.IDKey, announcementID, 1),
+		gin.Params{{Key: apiutil.IDKey, Value: announcementID}},
+		"local_account_1",
+		http.StatusOK,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Dismissed announcement is left out by default.
+	resp, err := suite.getAnnouncements("local_account_1", false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(`[]`, resp)
+
+	// But still there when asked for, marked read.
+	resp, err = suite.getAnnouncements("local_account_1", true)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiAnnouncements := []*apimodel.Announcement{}
+	if err := json.Unmarshal([]byte(resp), &apiAnnouncements); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(apiAnnouncements, 1)
+	suite.True(apiAnnouncements[0].Read)
+
+	// Other accounts still see it.
+	resp, err = suite.getAnnouncements("admin_account", false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEqual(`[]`, resp)
+}
+
+func (suite *AnnouncementsGetTestSuite) TestDismissDraft() {
+	announcementID := suite.testAnnouncements["draft"].ID
+
+	resp, err := suite.call(
+		suite.announcementsModule.AnnouncementDismissPOSTHandler,
+		http.MethodPost,
+		strings.Replace(announcements.DismissPath, ":"+apiutil.IDKey, announcementID, 1),
+		gin.Params{{Key: apiutil.IDKey, Value: announcementID}},
+		"local_account_1",
+		http.StatusNotFound,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(`{"error":"Not Found"}`, resp)
+}
+
+func TestAnnouncementsGetTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementsGetTestSuite))
+}
@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/announcements"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AnnouncementReactionTestSuite struct {
+	AnnouncementsStandardTestSuite
+}
+
+func (suite *AnnouncementReactionTestSuite) react(
+	method string,
+	name string,
+	requester string,
+	expectedHTTPStatus int,
+) (string, error) {
+	var (
+		announcementID = suite.testAnnouncements["published"].ID
+		handler        = suite.announcementsModule.AnnouncementReactionPUTHandler
+	)
+
+	if method == http.MethodDelete {
+		handler = suite.announcementsModule.AnnouncementReactionDELETEHandler
+	}
+
+	path := strings.Replace(announcements.ReactionsPathWithName, ":"+apiutil.IDKey, announcementID, 1)
+	path = strings.Replace(path, ":"+apiutil.AnnouncementReactionNameKey, url.PathEscape(name), 1)
+
+	return suite.call(
+		handler,
+		method,
+		path,
+		gin.Params{
+			{Key: apiutil.IDKey, Value: announcementID},
+			{Key: apiutil.AnnouncementReactionNameKey, Value: name},
+		},
+		requester,
+		expectedHTTPStatus,
+	)
+}
+
+func (suite *AnnouncementReactionTestSuite) getReactions() []*gtsmodel.AnnouncementReaction {
+	reactions, err := suite.db.GetAnnouncementReactions(
+		context.Background(),
+		suite.testAnnouncements["published"].ID,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return reactions
+}
+
+func (suite *AnnouncementReactionTestSuite) TestAddUnicodeReaction() {
+	resp, err := suite.react(http.MethodPut, "🦥", "local_account_1", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(`{}`, resp)
+
+	// Adding it again is a no-op.
+	if _, err := suite.react(http.MethodPut, "🦥", "local_account_1", http.StatusOK); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(suite.getReactions(), len(suite.testAnnouncementReactions)+1)
+}
+
+func (suite *AnnouncementReactionTestSuite) TestAddCustomEmojiReaction() {
+	if _, err := suite.react(http.MethodPut, "rainbow", "admin_account", http.StatusOK); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reactions := suite.getReactions()
+	suite.Len(reactions, len(suite.testAnnouncementReactions)+1)
+
+	latest := reactions[len(reactions)-1]
+	suite.Equal("rainbow", latest.Name)
+	suite.Equal(suite.testEmojis["rainbow"].ID, latest.EmojiID)
+}
+
+func (suite *AnnouncementReactionTestSuite) TestAddInvalidReaction() {
+	for _, name := range []string{
+		"not_an_emoji",
+		"yell", // remote emoji
+		"hello 🦥",
+	} {
+		resp, err := suite.react(http.MethodPut, name, "local_account_1", http.StatusUnprocessableEntity)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Contains(resp, "Unprocessable Entity")
+	}
+
+	suite.Len(suite.getReactions(), len(suite.testAnnouncementReactions))
+}
+
+func (suite *AnnouncementReactionTestSuite) TestRemoveReaction() {
+	if _, err := suite.react(http.MethodDelete, "rainbow", "local_account_1", http.StatusOK); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Removing a reaction that isn't there is a no-op.
+	if _, err := suite.react(http.MethodDelete, "rainbow", "local_account_1", http.StatusOK); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reactions := suite.getReactions()
+	suite.Len(reactions, len(suite.testAnnouncementReactions)-1)
+	suite.Equal("🦥", reactions[0].Name)
+}
+
+func TestAnnouncementReactionTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementReactionTestSuite))
+}
@@ -0,0 +1,87 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/conversations"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+func (suite *ConversationsTestSuite) deleteConversation(
+	conversationID string,
+	accountKey string,
+	expectedHTTPStatus int,
+) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(
+		recorder,
+		http.MethodDelete,
+		strings.Replace(conversations.BasePathWithID, ":id", conversationID, 1),
+		conversationID,
+		accountKey,
+	)
+
+	// trigger the handler
+	suite.conversationsModule.ConversationDELETEHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+	if expectedHTTPStatus == http.StatusOK {
+		suite.Equal("{}", string(b))
+	}
+}
+
+func (suite *ConversationsTestSuite) TestDeleteConversation() {
+	conversation := suite.newConversation()
+
+	suite.deleteConversation(conversation.ID, "local_account_1", http.StatusOK)
+
+	// Conversation should be gone, but not its status.
+	_, err := suite.db.GetConversationByID(context.Background(), conversation.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetStatusByID(context.Background(), conversation.LastStatusID)
+	suite.NoError(err)
+}
+
+func (suite *ConversationsTestSuite) TestDeleteConversationNotOwned() {
+	conversation := suite.newConversation()
+
+	// Another account shouldn't be able to delete the conversation.
+	suite.deleteConversation(conversation.ID, "local_account_2", http.StatusNotFound)
+
+	_, err := suite.db.GetConversationByID(context.Background(), conversation.ID)
+	suite.NoError(err)
+}
+
+func (suite *ConversationsTestSuite) TestDeleteConversationNotFound() {
+	suite.deleteConversation("01J0QBS34SNSQNDM80XKGEWMPG", "local_account_1", http.StatusNotFound)
+}
@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/conversations"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+func (suite *ConversationsTestSuite) readConversation(
+	conversationID string,
+	accountKey string,
+	expectedHTTPStatus int,
+) *apimodel.Conversation {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(
+		recorder,
+		http.MethodPost,
+		strings.Replace(conversations.ReadPathWithID, ":id", conversationID, 1),
+		conversationID,
+		accountKey,
+	)
+
+	// trigger the handler
+	suite.conversationsModule.ConversationReadPOSTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Equal(expectedHTTPStatus, recorder.Code, string(b)) ||
+		expectedHTTPStatus != http.StatusOK {
+		return nil
+	}
+
+	apiConversation := new(apimodel.Conversation)
+	if err := json.Unmarshal(b, apiConversation); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiConversation
+}
+
+func (suite *ConversationsTestSuite) TestReadConversation() {
+	conversation := suite.newConversation()
+
+	apiConversation := suite.readConversation(conversation.ID, "local_account_1", http.StatusOK)
+	if apiConversation == nil {
+		suite.FailNow("no conversation returned")
+	}
+	suite.Equal(conversation.ID, apiConversation.ID)
+	suite.False(apiConversation.Unread)
+
+	// Should be stored as read.
+	dbConversation, err := suite.db.GetConversationByID(context.Background(), conversation.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbConversation.Read)
+}
+
+func (suite *ConversationsTestSuite) TestReadConversationNotOwned() {
+	conversation := suite.newConversation()
+
+	// Another account shouldn't be able to see the conversation.
+	suite.readConversation(conversation.ID, "local_account_2", http.StatusNotFound)
+
+	// Should still be stored as unread.
+	dbConversation, err := suite.db.GetConversationByID(context.Background(), conversation.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*dbConversation.Read)
+}
+
+func (suite *ConversationsTestSuite) TestReadConversationNotFound() {
+	suite.readConversation("01J0QBS34SNSQNDM80XKGEWMPG", "local_account_1", http.StatusNotFound)
+}
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package exports
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// ExportArchiveGETHandler swagger:operation GET /api/v1/exports/archive.zip exportArchive
+//
+// Export a zip archive of all your data.
+//
+// The archive contains your actor as `actor.json`, all of your statuses and boosts
+// as an ActivityStreams OrderedCollection in `outbox.json`, the URIs of statuses you
+// liked and bookmarked in `likes.json` and `bookmarks.json`, and the original files
+// of your avatar, header and status attachments under `media/`.
+//
+// The archive is streamed as it's created, so this may take a while for large accounts.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- application/zip
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read
+//
+//	responses:
+//		'200':
+//			description: Zip archive.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportArchiveGETHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		oauth.ScopeRead,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.ZipAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header("Content-Type", apiutil.AppZip)
+	c.Header("Content-Disposition", `attachment; filename="archive.zip"`)
+	c.Status(http.StatusOK)
+
+	ctx := c.Request.Context()
+	err := m.processor.Account().ExportArchive(ctx, authed.Account, c.Writer)
+	if err == nil {
+		return
+	}
+
+	if c.Writer.Written() {
+		// Too late to send an error
+		// response, the most we can
+		// do now is log the error.
+		log.Errorf(ctx, "error writing archive: %v", err)
+		return
+	}
+
+	// Nothing written yet, drop our
+	// headers and send a proper error.
+	c.Writer.Header().Del("Content-Type")
+	c.Writer.Header().Del("Content-Disposition")
+	apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+}
@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package exports
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// ExportFollowingGETHandler swagger:operation GET /api/v1/exports/following.csv exportFollowing
+//
+// Export accounts you follow, in the CSV format of Mastodon's following_accounts.csv.
+//
+// Columns are account address, whether to show boosts, whether to
+// notify on new posts, and languages (always empty), with a header row.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:follows
+//
+//	responses:
+//		'200':
+//			description: CSV file.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportFollowingGETHandler(c *gin.Context) {
+	m.exportCSV(c, "following.csv", oauth.ScopeReadFollows, m.processor.Account().ExportFollowing)
+}
+
+// ExportBlocksGETHandler swagger:operation GET /api/v1/exports/blocks.csv exportBlocks
+//
+// Export accounts you block, in the CSV format of Mastodon's blocked_accounts.csv.
+//
+// One account address per row, without a header row.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:blocks
+//
+//	responses:
+//		'200':
+//			description: CSV file.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportBlocksGETHandler(c *gin.Context) {
+	m.exportCSV(c, "blocks.csv", oauth.ScopeReadBlocks, m.processor.Account().ExportBlocks)
+}
+
+// ExportMutesGETHandler swagger:operation GET /api/v1/exports/mutes.csv exportMutes
+//
+// Export accounts you mute, in the CSV format of Mastodon's muted_accounts.csv.
+//
+// Columns are account address and whether notifications
+// from the account are hidden, with a header row.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:mutes
+//
+//	responses:
+//		'200':
+//			description: CSV file.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportMutesGETHandler(c *gin.Context) {
+	m.exportCSV(c, "mutes.csv", oauth.ScopeReadMutes, m.processor.Account().ExportMutes)
+}
+
+// ExportListsGETHandler swagger:operation GET /api/v1/exports/lists.csv exportLists
+//
+// Export members of your lists, in the CSV format of Mastodon's lists.csv.
+//
+// Columns are list name and account address, without a header row.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:lists
+//
+//	responses:
+//		'200':
+//			description: CSV file.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportListsGETHandler(c *gin.Context) {
+	m.exportCSV(c, "lists.csv", oauth.ScopeReadLists, m.processor.Account().ExportLists)
+}
+
+// ExportBookmarksGETHandler swagger:operation GET /api/v1/exports/bookmarks.csv exportBookmarks
+//
+// Export statuses you bookmarked, in the CSV format of Mastodon's bookmarks.csv.
+//
+// One status URI per row, without a header row.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:bookmarks
+//
+//	responses:
+//		'200':
+//			description: CSV file.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportBookmarksGETHandler(c *gin.Context) {
+	m.exportCSV(c, "bookmarks.csv", oauth.ScopeReadBookmarks, m.processor.Account().ExportBookmarks)
+}
+
+// exportCSV serves the records returned by the
+// given export function as a csv file attachment.
+func (m *Module) exportCSV(
+	c *gin.Context,
+	filename string,
+	scope oauth.Scope,
+	export func(context.Context, *gtsmodel.Account) ([][]string, gtserror.WithCode),
+) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		scope,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.CSVAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	records, errWithCode := export(c.Request.Context(), authed.Account)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := csv.NewWriter(&buf).WriteAll(records); err != nil {
+		err = gtserror.Newf("error writing csv: %w", err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	apiutil.Data(c, http.StatusOK, apiutil.TextCSV+"; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,169 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package imports
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// ImportPOSTHandler swagger:operation POST /api/v1/imports importCreate
+//
+// Upload a CSV file or account archive to import.
+//
+// Accepts the CSV formats exported by Mastodon (and by GoToSocial's
+// /api/v1/exports endpoints): following_accounts.csv, blocked_accounts.csv,
+// muted_accounts.csv, lists.csv, and bookmarks.csv.
+//
+// Also accepts a zip archive of a Mastodon or GoToSocial account, from which
+// the statuses in outbox.json are imported as statuses of your account, keeping
+// their original publish dates, along with their media attachments. Boosts, direct
+// messages, and replies to other accounts' statuses are not imported.
+//
+// The import is processed in the background. Remote accounts and
+// statuses are resolved as necessary, so this may take a while; use
+// the returned import's ID to check on its progress. Items that cannot
+// be imported (eg., accounts that no longer exist) are counted as failed.
+//
+// When importing lists, the accounts to add to lists must already be followed.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	consumes:
+//	- multipart/form-data
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: data
+//		in: formData
+//		description: The CSV file to import, max size 5MiB, or the zip archive to import, max size 1GiB.
+//		type: file
+//		required: true
+//	-
+//		name: type
+//		in: formData
+//		description: Type of data contained in the file.
+//		type: string
+//		enum:
+//			- following
+//			- blocks
+//			- mutes
+//			- lists
+//			- bookmarks
+//			- archive
+//		required: true
+//	-
+//		name: mode
+//		in: formData
+//		description: >-
+//			"merge" to add the imported data to existing follows/blocks/etc, or
+//			"overwrite" to also remove existing entries that are not in the file.
+//			When overwriting lists, only entries of lists present in the file are removed.
+//		type: string
+//		enum:
+//			- merge
+//			- overwrite
+//		default: merge
+//	-
+//		name: dry_run
+//		in: formData
+//		description: >-
+//			Archive imports only. Check how many statuses can be imported,
+//			without actually importing anything.
+//		type: boolean
+//		default: false
+//	-
+//		name: federate
+//		in: formData
+//		description: >-
+//			Archive imports only. Send imported statuses to your followers on other
+//			instances. By default, imported statuses are only visible on this instance
+//			until they're fetched by someone else.
+//		type: boolean
+//		default: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'202':
+//			description: The newly-created import, queued for processing.
+//			schema:
+//				"$ref": "#/definitions/import"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ImportPOSTHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		oauth.ScopeWriteAccounts,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.ImportRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.Data == nil || form.Data.Size == 0 {
+		const text = "no import file given"
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	imp, errWithCode := m.processor.Account().ImportCreate(c.Request.Context(), authed.Account, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusAccepted, imp)
+}
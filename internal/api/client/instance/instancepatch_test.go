@@ -107,7 +107,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -228,7 +234,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -349,7 +361,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -455,8 +473,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch5() {
 	recorder := httptest.NewRecorder()
 	ctx := suite.newContext(recorder, http.MethodPatch, instance.InstanceInformationPathV1, bodyBytes, w.FormDataContentType(), true)
 
+	// Give the token admin scope, so that
+	// it's the user's role that's checked.
+	token := *suite.testTokens["local_account_1"]
+	token.Scope = "read write admin"
+
 	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
-	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(&token))
 	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
 	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
 
@@ -521,7 +544,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -664,7 +693,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -822,7 +857,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -93,6 +93,10 @@ import (
 //				- poll
 //				- status
 //				- admin.sign_up
+//				- update
+//				- pending.favourite
+//				- pending.reply
+//				- pending.reblog
 //		description: Types of notifications to include. If not provided, all notification types will be included.
 //		in: query
 //		required: false
@@ -110,6 +114,10 @@ import (
 //				- poll
 //				- status
 //				- admin.sign_up
+//				- update
+//				- pending.favourite
+//				- pending.reply
+//				- pending.reblog
 //		description: Types of notifications to exclude.
 //		in: query
 //		required: false
@@ -141,9 +149,12 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) NotificationsGETHandler(c *gin.Context) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		oauth.ScopeReadNotifications,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
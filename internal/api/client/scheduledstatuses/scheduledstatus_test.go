@@ -0,0 +1,191 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package scheduledstatuses_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/scheduledstatuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type ScheduledStatusTestSuite struct {
+	ScheduledStatusesStandardTestSuite
+}
+
+func (suite *ScheduledStatusTestSuite) params(id string) gin.Params {
+	return gin.Params{{Key: apiutil.IDKey, Value: id}}
+}
+
+func (suite *ScheduledStatusTestSuite) path(id string) string {
+	return strings.ReplaceAll(scheduledstatuses.BasePathWithID, ":"+apiutil.IDKey, id)
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatus() {
+	scheduled := suite.schedule("local_account_1", "hello later", time.Hour)
+
+	b, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusGETHandler,
+		http.MethodGet,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		nil,
+		"local_account_1",
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiScheduledStatus := &apimodel.ScheduledStatus{}
+	if err := json.Unmarshal([]byte(b), apiScheduledStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(scheduled.ID, apiScheduledStatus.ID)
+	suite.Equal(scheduled.ScheduledAt, apiScheduledStatus.ScheduledAt)
+	suite.Equal("hello later", apiScheduledStatus.Params.Text)
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatusNotOwned() {
+	scheduled := suite.schedule("local_account_1", "hello later", time.Hour)
+
+	_, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusGETHandler,
+		http.MethodGet,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		nil,
+		"local_account_2",
+		http.StatusNotFound,
+	)
+	suite.NoError(err)
+}
+
+func (suite *ScheduledStatusTestSuite) TestUpdateScheduledStatus() {
+	scheduled := suite.schedule("local_account_1", "hello later", time.Hour)
+	scheduledAt := util.FormatISO8601(time.Now().Add(3 * time.Hour))
+
+	b, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusPUTHandler,
+		http.MethodPut,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		url.Values{"scheduled_at": {scheduledAt}},
+		"local_account_1",
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiScheduledStatus := &apimodel.ScheduledStatus{}
+	if err := json.Unmarshal([]byte(b), apiScheduledStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(scheduled.ID, apiScheduledStatus.ID)
+	suite.Equal(scheduledAt, apiScheduledStatus.ScheduledAt)
+}
+
+func (suite *ScheduledStatusTestSuite) TestUpdateScheduledStatusTooSoon() {
+	scheduled := suite.schedule("local_account_1", "hello later", time.Hour)
+
+	b, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusPUTHandler,
+		http.MethodPut,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		url.Values{"scheduled_at": {util.FormatISO8601(time.Now().Add(time.Minute))}},
+		"local_account_1",
+		http.StatusUnprocessableEntity,
+	)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unprocessable Entity: scheduled_at must be at least 5 minutes in the future"}`, b)
+}
+
+func (suite *ScheduledStatusTestSuite) TestUpdateScheduledStatusNoScheduledAt() {
+	scheduled := suite.schedule("local_account_1", "hello later", time.Hour)
+
+	b, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusPUTHandler,
+		http.MethodPut,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		url.Values{},
+		"local_account_1",
+		http.StatusBadRequest,
+	)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: scheduled_at must be set"}`, b)
+}
+
+func (suite *ScheduledStatusTestSuite) TestDeleteScheduledStatus() {
+	scheduled := suite.schedule("local_account_1", "hello later", time.Hour)
+
+	// Someone else can't delete it.
+	_, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusDELETEHandler,
+		http.MethodDelete,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		nil,
+		"local_account_2",
+		http.StatusNotFound,
+	)
+	suite.NoError(err)
+
+	// Owner can.
+	b, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusDELETEHandler,
+		http.MethodDelete,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		nil,
+		"local_account_1",
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("{}", b)
+
+	// It should be gone now.
+	_, _, err = suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusGETHandler,
+		http.MethodGet,
+		suite.path(scheduled.ID),
+		suite.params(scheduled.ID),
+		nil,
+		"local_account_1",
+		http.StatusNotFound,
+	)
+	suite.NoError(err)
+}
+
+func TestScheduledStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledStatusTestSuite))
+}
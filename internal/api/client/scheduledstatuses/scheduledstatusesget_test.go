@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package scheduledstatuses_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/scheduledstatuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type ScheduledStatusesGetTestSuite struct {
+	ScheduledStatusesStandardTestSuite
+}
+
+func (suite *ScheduledStatusesGetTestSuite) TestGetScheduledStatuses() {
+	first := suite.schedule("local_account_1", "first!", time.Hour)
+	second := suite.schedule("local_account_1", "second!", 2*time.Hour)
+
+	// Scheduled status belonging to someone
+	// else, which shouldn't be returned.
+	suite.schedule("local_account_2", "not mine", time.Hour)
+
+	b, recorder, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusesGETHandler,
+		http.MethodGet,
+		scheduledstatuses.BasePath,
+		nil, nil,
+		"local_account_1",
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiScheduledStatuses := []*apimodel.ScheduledStatus{}
+	if err := json.Unmarshal([]byte(b), &apiScheduledStatuses); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Newest first.
+	if suite.Len(apiScheduledStatuses, 2) {
+		suite.Equal(second.ID, apiScheduledStatuses[0].ID)
+		suite.Equal(first.ID, apiScheduledStatuses[1].ID)
+	}
+	suite.NotEmpty(recorder.Header().Get("link"))
+}
+
+func (suite *ScheduledStatusesGetTestSuite) TestGetScheduledStatusesEmpty() {
+	b, _, err := suite.call(
+		suite.scheduledStatusesModule.ScheduledStatusesGETHandler,
+		http.MethodGet,
+		scheduledstatuses.BasePath,
+		nil, nil,
+		"local_account_1",
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("[]", b)
+}
+
+func TestScheduledStatusesGetTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledStatusesGetTestSuite))
+}
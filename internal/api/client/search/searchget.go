@@ -81,11 +81,11 @@ import (
 //		name: offset
 //		type: integer
 //		description: >-
-//			Page number of results to return (starts at 0).
-//			This parameter is currently not used, page by selecting
-//			a specific query type and using maxID and minID instead.
+//			Number of results to skip, for paging through results.
+//			Only used if max_id and min_id are not set, in which
+//			case results are returned in order of relevance.
 //		default: 0
-//		maximum: 10
+//		maximum: 1000
 //		minimum: 0
 //		in: query
 //		required: false
@@ -102,6 +102,14 @@ import (
 //
 //			Arbitrary string queries may include the following operators:
 //			- `from:localuser`, `from:remoteuser@instance.tld`: restrict results to statuses created by the specified account.
+//			- `has:media`, `has:poll`: restrict results to statuses with media attachments, or with a poll.
+//			- `is:reply`, `is:sensitive`: restrict results to statuses replying to another status, or marked as sensitive.
+//			- `language:xx`: restrict results to statuses in the given language.
+//			- `before:YYYY-MM-DD`, `after:YYYY-MM-DD`, `during:YYYY-MM-DD`: restrict results to statuses created before, after, or on the given date (UTC).
+//			- `in:library`: search statuses created, faved, or bookmarked by the requester.
+//			- `"quoted phrase"`: restrict results to statuses containing the given words in that order.
+//
+//			Queries with operators only return statuses. A bad operator argument returns a 400 error.
 //		in: query
 //		required: true
 //	-
@@ -178,9 +186,12 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		return
 	}
 
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		oauth.ScopeReadSearch,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -207,7 +218,7 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		return
 	}
 
-	offset, errWithCode := apiutil.ParseSearchOffset(c.Query(apiutil.SearchOffsetKey), 0, 10, 0)
+	offset, errWithCode := apiutil.ParseSearchOffset(c.Query(apiutil.SearchOffsetKey), 0, 1000, 0)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -915,8 +915,10 @@ func (suite *SearchGetTestSuite) TestSearchAAny() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Len(searchResult.Accounts, 5)
-	suite.Len(searchResult.Statuses, 6)
+	// Accounts and statuses match words
+	// starting with "a", not "a" anywhere.
+	suite.Len(searchResult.Accounts, 1)
+	suite.Len(searchResult.Statuses, 4)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
@@ -958,8 +960,10 @@ func (suite *SearchGetTestSuite) TestSearchAAnyFollowingOnly() {
 		suite.FailNow(err.Error())
 	}
 
+	// Accounts and statuses match words
+	// starting with "a", not "a" anywhere.
 	suite.Len(searchResult.Accounts, 2)
-	suite.Len(searchResult.Statuses, 6)
+	suite.Len(searchResult.Statuses, 4)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
@@ -1002,7 +1006,9 @@ func (suite *SearchGetTestSuite) TestSearchAStatuses() {
 	}
 
 	suite.Len(searchResult.Accounts, 0)
-	suite.Len(searchResult.Statuses, 6)
+	// Statuses match words starting
+	// with "a", not "a" anywhere.
+	suite.Len(searchResult.Statuses, 4)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
@@ -1092,6 +1098,182 @@ func (suite *SearchGetTestSuite) TestSearchHiStatusesWithAccountIDInQueryText()
 	suite.Len(searchResult.Hashtags, 0)
 }
 
+func (suite *SearchGetTestSuite) TestSearchOperatorOnly() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = nil
+		query                      = "has:poll"
+		queryType          *string = nil
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Operators don't apply to accounts, so only
+	// the status with a poll should be returned.
+	suite.Len(searchResult.Accounts, 0)
+	if suite.Len(searchResult.Statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_6"].ID, searchResult.Statuses[0].ID)
+	}
+	suite.Len(searchResult.Hashtags, 0)
+}
+
+func (suite *SearchGetTestSuite) TestSearchOperatorsCombined() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = nil
+		query                      = "post is:sensitive before:2022-01-01"
+		queryType          *string = nil
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(searchResult.Accounts, 0)
+	if suite.Len(searchResult.Statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_1"].ID, searchResult.Statuses[0].ID)
+	}
+	suite.Len(searchResult.Hashtags, 0)
+}
+
+func (suite *SearchGetTestSuite) TestSearchQuotedPhraseStatuses() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = nil
+		query                      = `"very personal"`
+		queryType          *string = func() *string { i := "statuses"; return &i }() // Only statuses.
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(searchResult.Accounts, 0)
+	if suite.Len(searchResult.Statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_3"].ID, searchResult.Statuses[0].ID)
+	}
+	suite.Len(searchResult.Hashtags, 0)
+}
+
+func (suite *SearchGetTestSuite) TestSearchBadOperatorArg() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = nil
+		query                      = "post has:cake"
+		queryType          *string = nil
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusBadRequest
+		expectedBody               = `{"error":"Bad Request: the 'has:' search operator argument cake was not recognized, valid options are ['media', 'poll']"}`
+	)
+
+	_, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
 func (suite *SearchGetTestSuite) TestSearchAAccounts() {
 	var (
 		requestingAccount          = suite.testAccounts["local_account_1"]
@@ -1130,7 +1312,9 @@ func (suite *SearchGetTestSuite) TestSearchAAccounts() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Len(searchResult.Accounts, 5)
+	// Only names with a word starting
+	// with "a" match, not "a" anywhere.
+	suite.Len(searchResult.Accounts, 1)
 	suite.Len(searchResult.Statuses, 0)
 	suite.Len(searchResult.Hashtags, 0)
 }
@@ -28,6 +28,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
@@ -35,6 +36,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -392,6 +395,10 @@ func (suite *StatusCreateTestSuite) TestAttachNewMediaSuccess() {
 	gtsAttachmentAsapi, err := suite.tc.AttachmentToAPIAttachment(context.Background(), gtsAttachment)
 	suite.NoError(err)
 
+	// MIME type isn't serialized to
+	// JSON, so it won't be in the response.
+	gtsAttachmentAsapi.MIMEType = ""
+
 	// compare it with what we have now
 	suite.EqualValues(*statusResponse.MediaAttachments[0], gtsAttachmentAsapi)
 
@@ -503,6 +510,76 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithPollJSON() {
 	})
 }
 
+// Post a new status with scheduled_at set, which
+// should return a scheduled status instead.
+func (suite *StatusCreateTestSuite) testPostNewScheduledStatus(scheduledAt time.Time, expectedHTTPStatus int) []byte {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status":       {"this one's for later"},
+		"visibility":   {string(apimodel.VisibilityPublic)},
+		"scheduled_at": {util.FormatISO8601(scheduledAt)},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	suite.EqualValues(expectedHTTPStatus, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	return b
+}
+
+func (suite *StatusCreateTestSuite) TestPostNewScheduledStatus() {
+	b := suite.testPostNewScheduledStatus(time.Now().Add(time.Hour), http.StatusOK)
+
+	scheduledStatusReply := &apimodel.ScheduledStatus{}
+	if err := json.Unmarshal(b, scheduledStatusReply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(scheduledStatusReply.ID)
+	suite.NotEmpty(scheduledStatusReply.ScheduledAt)
+	suite.Equal("this one's for later", scheduledStatusReply.Params.Text)
+	suite.Equal(apimodel.VisibilityPublic, scheduledStatusReply.Params.Visibility)
+	suite.Equal(suite.testApplications["application_1"].ID, scheduledStatusReply.Params.ApplicationID)
+	suite.Nil(scheduledStatusReply.Params.ScheduledAt)
+
+	// The scheduled status should be stored...
+	scheduledStatuses, err := suite.db.GetScheduledStatusesForAccount(
+		context.Background(),
+		suite.testAccounts["local_account_1"].ID,
+		&paging.Page{Limit: 10},
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(scheduledStatuses, 1) {
+		suite.Equal(scheduledStatusReply.ID, scheduledStatuses[0].ID)
+	}
+
+	// ...but no status should have been created yet.
+	_, err = suite.db.GetStatusByID(context.Background(), scheduledStatusReply.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *StatusCreateTestSuite) TestPostNewScheduledStatusTooSoon() {
+	b := suite.testPostNewScheduledStatus(time.Now().Add(time.Minute), http.StatusUnprocessableEntity)
+	suite.Equal(`{"error":"Unprocessable Entity: scheduled_at must be at least 5 minutes in the future"}`, string(b))
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
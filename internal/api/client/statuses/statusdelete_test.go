@@ -86,6 +86,46 @@ func (suite *StatusDeleteTestSuite) TestPostDelete() {
 
 }
 
+func (suite *StatusDeleteTestSuite) TestPostDeleteReadOnlyToken() {
+	// Take a copy of the token and
+	// limit it to read-only scope.
+	t := *suite.testTokens["local_account_1"]
+	t.Scope = "read"
+	oauthToken := oauth.DBTokenToToken(&t)
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("http://localhost:8080%s", strings.Replace(statuses.BasePathWithID, ":id", targetStatus.ID, 1)), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	suite.statusModule.StatusDELETEHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusForbidden, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Forbidden: token has insufficient scope permission: write:statuses required"}`, string(b))
+
+	// Status should still be there.
+	_, err = suite.db.GetStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+}
+
 func TestStatusDeleteTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusDeleteTestSuite))
 }
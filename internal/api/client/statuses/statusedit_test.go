@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusEditTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) editStatus(
+	targetStatusID string,
+	form url.Values,
+	expectedHTTPStatus int,
+) (*apimodel.Status, error) {
+	var (
+		testApplication = suite.testApplications["application_1"]
+		testAccount     = suite.testAccounts["local_account_1"]
+		testUser        = suite.testUsers["local_account_1"]
+		testToken       = oauth.DBTokenToToken(suite.testTokens["local_account_1"])
+		target          = fmt.Sprintf("http://localhost:8080%s", strings.ReplaceAll(statuses.BasePathWithID, ":id", targetStatusID))
+	)
+
+	// Setup request.
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, testApplication)
+	ctx.Set(oauth.SessionAuthorizedToken, testToken)
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+	ctx.Request = httptest.NewRequest(http.MethodPut, target, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = form
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatusID,
+		},
+	}
+
+	// Call the handler.
+	suite.statusModule.StatusEditPUTHandler(ctx)
+
+	// Check code.
+	if code := recorder.Code; code != expectedHTTPStatus {
+		return nil, fmt.Errorf("unexpected http code: %d", code)
+	}
+
+	// Read body.
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedHTTPStatus != http.StatusOK {
+		return nil, nil
+	}
+
+	apiStatus := new(apimodel.Status)
+	if err := json.Unmarshal(b, apiStatus); err != nil {
+		return nil, err
+	}
+
+	return apiStatus, nil
+}
+
+func (suite *StatusEditTestSuite) TestEditStatus() {
+	var (
+		ctx            = context.Background()
+		testAccount    = suite.testAccounts["local_account_1"]
+		targetStatusID = suite.testStatuses["local_account_1_status_1"].ID
+	)
+
+	apiStatus, err := suite.editStatus(targetStatusID, url.Values{
+		"status":       {"hello everyone! (edited to say hi to @1happyturtle)"},
+		"spoiler_text": {"introduction post"},
+		"sensitive":    {"true"},
+	}, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(targetStatusID, apiStatus.ID)
+	suite.Equal("introduction post", apiStatus.SpoilerText)
+	suite.NotNil(apiStatus.EditedAt)
+	suite.Len(apiStatus.Mentions, 1)
+	suite.Contains(apiStatus.Content, "edited to say hi to")
+
+	// Check edit history contains previous + current revisions.
+	history, errWithCode := suite.processor.Status().HistoryGet(ctx, testAccount, targetStatusID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(history, 2)
+	suite.Equal("hello everyone!", history[0].Content)
+	suite.Equal(apiStatus.Content, history[1].Content)
+	suite.Equal(*apiStatus.EditedAt, history[1].CreatedAt)
+
+	// Source should now give the edited text.
+	source, errWithCode := suite.processor.Status().SourceGet(ctx, testAccount, targetStatusID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("hello everyone! (edited to say hi to @1happyturtle)", source.Text)
+}
+
+func (suite *StatusEditTestSuite) TestEditStatusNotOwned() {
+	targetStatusID := suite.testStatuses["local_account_2_status_1"].ID
+
+	// Statuses of other accounts should not be found.
+	if _, err := suite.editStatus(targetStatusID, url.Values{
+		"status": {"this isn't my status"},
+	}, http.StatusNotFound); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *StatusEditTestSuite) TestEditStatusEmpty() {
+	targetStatusID := suite.testStatuses["local_account_1_status_1"].ID
+
+	// An edit must contain something.
+	if _, err := suite.editStatus(targetStatusID, url.Values{
+		"spoiler_text": {"just a content warning"},
+	}, http.StatusBadRequest); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
@@ -126,12 +126,18 @@ import (
 //							`notification`: a new notification has been received.
 //							`delete`: a status has been deleted.
 //							`filters_changed`: filters (including keywords and statuses) have changed.
+//							`announcement`: an announcement has been published or updated.
+//							`announcement.reaction`: reactions to an announcement have changed.
+//							`announcement.delete`: an announcement has been deleted or unpublished.
 //						type: string
 //						enum:
 //						- update
 //						- notification
 //						- delete
 //						- filters_changed
+//						- announcement
+//						- announcement.reaction
+//						- announcement.delete
 //					payload:
 //						description: |-
 //							The payload of the streamed message.
@@ -143,6 +149,9 @@ import (
 //							If `event` = `notification`, then the payload will be a JSON string of a notification.
 //							If `event` = `delete`, then the payload will be a status ID.
 //							If `event` = `filters_changed`, then there is no payload.
+//							If `event` = `announcement`, then the payload will be a JSON string of an announcement.
+//							If `event` = `announcement.reaction`, then the payload will be a JSON string with the `name` and `count` of the reaction, and the `announcement_id`.
+//							If `event` = `announcement.delete`, then the payload will be an announcement ID.
 //						type: string
 //						example: "{\"id\":\"01FC3TZ5CFG6H65GCKCJRKA669\",\"created_at\":\"2021-08-02T16:25:52Z\",\"sensitive\":false,\"spoiler_text\":\"\",\"visibility\":\"public\",\"language\":\"en\",\"uri\":\"https://gts.superseriousbusiness.org/users/dumpsterqueer/statuses/01FC3TZ5CFG6H65GCKCJRKA669\",\"url\":\"https://gts.superseriousbusiness.org/@dumpsterqueer/statuses/01FC3TZ5CFG6H65GCKCJRKA669\",\"replies_count\":0,\"reblogs_count\":0,\"favourites_count\":0,\"favourited\":false,\"reblogged\":false,\"muted\":false,\"bookmarked\":fals…//gts.superseriousbusiness.org/fileserver/01JNN207W98SGG3CBJ76R5MVDN/header/original/019036W043D8FXPJKSKCX7G965.png\",\"header_static\":\"https://gts.superseriousbusiness.org/fileserver/01JNN207W98SGG3CBJ76R5MVDN/header/small/019036W043D8FXPJKSKCX7G965.png\",\"followers_count\":33,\"following_count\":28,\"statuses_count\":126,\"last_status_at\":\"2021-08-02T16:25:52Z\",\"emojis\":[],\"fields\":[]},\"media_attachments\":[],\"mentions\":[],\"tags\":[],\"emojis\":[],\"card\":null,\"poll\":null,\"text\":\"a\"}"
 //		'401':
@@ -175,9 +184,11 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 
 		// No explicit token was provided:
 		// try regular oauth as a last resort.
-		authed, err := oauth.Authed(c, true, true, true, true)
-		if err != nil {
-			errWithCode := gtserror.NewErrorUnauthorized(err, err.Error())
+		authed, errWithCode := apiutil.TokenAuth(c,
+			true, true, true, true,
+			oauth.ScopeReadStreaming,
+		)
+		if errWithCode != nil {
 			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 			return
 		}
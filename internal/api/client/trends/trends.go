@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base path for serving the trends API, minus the 'api' prefix
+	BasePath = "/v1/trends"
+	// TagsPath is for serving trending tags
+	TagsPath = BasePath + "/tags"
+	// StatusesPath is for serving trending statuses
+	StatusesPath = BasePath + "/statuses"
+	// LinksPath is for serving trending links
+	LinksPath = BasePath + "/links"
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// Serve trending tags at the base
+	// path too, as a deprecated alias.
+	attachHandler(http.MethodGet, BasePath, m.TrendsTagsGETHandler)
+	attachHandler(http.MethodGet, TagsPath, m.TrendsTagsGETHandler)
+	attachHandler(http.MethodGet, StatusesPath, m.TrendsStatusesGETHandler)
+	attachHandler(http.MethodGet, LinksPath, m.TrendsLinksGETHandler)
+}
+
+// authTrends authenticates a request for trends, which
+// like the public timeline may be accessible without
+// authentication, depending on instance configuration.
+func authTrends(c *gin.Context, scopes ...oauth.Scope) (*oauth.Auth, gtserror.WithCode) {
+	if config.GetInstanceExposePublicTimeline() {
+		// If the public timeline is allowed to be exposed, still check if we
+		// can extract various authentication properties, but don't require them.
+		return apiutil.TokenAuth(c,
+			false, false, false, false,
+			scopes...,
+		)
+	}
+
+	return apiutil.TokenAuth(c,
+		true, true, true, true,
+		scopes...,
+	)
+}
@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type TwoFactorTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorQRCodeURIPOST() {
+	// Starting enrolment should generate a secret.
+	response, code := suite.POST(user.TwoFactorQRCodeURIPath, nil, suite.userModule.TwoFactorQRCodeURIPOSTHandler)
+	defer response.Body.Close()
+	suite.EqualValues(http.StatusOK, code)
+
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	qrCodeURI := &apimodel.TwoFactorQRCodeURI{}
+	if err := json.Unmarshal(b, qrCodeURI); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(qrCodeURI.Secret)
+	suite.Contains(qrCodeURI.URI, "secret="+qrCodeURI.Secret)
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), suite.testUsers["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(qrCodeURI.Secret, dbUser.TwoFactorSecret)
+	suite.False(dbUser.TwoFactorEnabled())
+
+	// Doing it again shouldn't replace the pending secret.
+	response, code = suite.POST(user.TwoFactorQRCodeURIPath, nil, suite.userModule.TwoFactorQRCodeURIPOSTHandler)
+	defer response.Body.Close()
+	suite.EqualValues(http.StatusOK, code)
+
+	b, err = io.ReadAll(response.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	again := &apimodel.TwoFactorQRCodeURI{}
+	if err := json.Unmarshal(b, again); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(qrCodeURI.Secret, again.Secret)
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorTestSuite))
+}
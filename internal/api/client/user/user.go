@@ -31,6 +31,14 @@ const (
 	PasswordChangePath = BasePath + "/password_change"
 	// EmailChangePath is the path for POSTing an email address change request.
 	EmailChangePath = BasePath + "/email_change"
+	// TwoFactorPath is the base path for two-factor authentication enrolment.
+	TwoFactorPath = BasePath + "/2fa"
+	// TwoFactorQRCodeURIPath is the path for POSTing to start enrolment and get the key URI to enrol with.
+	TwoFactorQRCodeURIPath = TwoFactorPath + "/qruri"
+	// TwoFactorEnablePath is the path for POSTing a code to complete enrolment.
+	TwoFactorEnablePath = TwoFactorPath + "/enable"
+	// TwoFactorDisablePath is the path for POSTing a two-factor disable request.
+	TwoFactorDisablePath = TwoFactorPath + "/disable"
 )
 
 type Module struct {
@@ -47,4 +55,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.UserGETHandler)
 	attachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
 	attachHandler(http.MethodPost, EmailChangePath, m.EmailChangePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorQRCodeURIPath, m.TwoFactorQRCodeURIPOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorEnablePath, m.TwoFactorEnablePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorDisablePath, m.TwoFactorDisablePOSTHandler)
 }
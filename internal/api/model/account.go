@@ -202,6 +202,12 @@ type UpdateSource struct {
 	Language *string `form:"language" json:"language"`
 	// Default format for authored statuses (text/plain or text/markdown).
 	StatusContentType *string `form:"status_content_type" json:"status_content_type"`
+	// Notification types for which to send emails (mention, direct, follow, follow_request).
+	// Provide an empty list, or a single empty string, to turn off email notifications.
+	EmailNotifications *[]string `form:"email_notifications" json:"email_notifications"`
+	// Send email notifications as a digest (daily, weekly).
+	// Use empty string to send one email per notification.
+	EmailDigest *string `form:"email_digest" json:"email_digest"`
 }
 
 // UpdateField is to be used specifically in an UpdateCredentialsRequest.
@@ -64,6 +64,8 @@ type AdminAccountInfo struct {
 	Disabled bool `json:"disabled"`
 	// Whether the account is currently silenced
 	Silenced bool `json:"silenced"`
+	// Whether the account's media is currently forced sensitive.
+	Sensitized bool `json:"sensitized"`
 	// Whether the account is currently suspended.
 	Suspended bool `json:"suspended"`
 	// User-level information about the account.
@@ -170,7 +172,8 @@ type AdminEmoji struct {
 type AdminActionRequest struct {
 	// Category of the target entity.
 	Category string `form:"-" json:"-" xml:"-"`
-	// Type of admin action to take. One of disable, silence, suspend.
+	// Type of admin action to take. One of none, sensitive,
+	// unsensitive, disable, reenable, silence, unsilence, suspend.
 	Type string `form:"type" json:"type" xml:"type"`
 	// Text describing why an action was taken.
 	Text string `form:"text" json:"text" xml:"text"`
@@ -19,7 +19,7 @@ package model
 
 // Announcement models an admin announcement for the instance.
 //
-// TODO: swagger:model announcement once announcement API is supported
+// swagger:model announcement
 type Announcement struct {
 	// The ID of the announcement.
 	// example: 01FC30T7X4TNCZK0TH90QYF3M4
@@ -31,11 +31,11 @@ type Announcement struct {
 	// When the announcement should begin to be displayed (ISO 8601 Datetime).
 	// If the announcement has no start time, this will be omitted or empty.
 	// example: 2021-07-30T09:20:25+00:00
-	StartsAt string `json:"starts_at"`
+	StartsAt string `json:"starts_at,omitempty"`
 	// When the announcement should stop being displayed (ISO 8601 Datetime).
 	// If the announcement has no end time, this will be omitted or empty.
 	// example: 2021-07-30T09:20:25+00:00
-	EndsAt string `json:"ends_at"`
+	EndsAt string `json:"ends_at,omitempty"`
 	// Announcement doesn't have begin time and end time, but begin day and end day.
 	AllDay bool `json:"all_day"`
 	// When the announcement was first published (ISO 8601 Datetime).
@@ -60,3 +60,42 @@ type Announcement struct {
 	// Reactions to this announcement.
 	Reactions []AnnouncementReaction `json:"reactions"`
 }
+
+// AdminAnnouncement models an admin announcement
+// for the instance, with extra admin information.
+//
+// swagger:model adminAnnouncement
+type AdminAnnouncement struct {
+	*Announcement
+	// The markdown text of the announcement,
+	// from which its content was formatted.
+	// example: This is an announcement. No malarky.
+	Text string `json:"text"`
+}
+
+// AnnouncementRequest is the form submitted as a POST or PATCH
+// to create or update an announcement through the admin API.
+//
+// swagger:ignore
+type AnnouncementRequest struct {
+	// Markdown text of the announcement.
+	// Required when creating an announcement.
+	// example: This is an announcement. No malarky.
+	Text *string `form:"text" json:"text" xml:"text"`
+	// Start of the period the announcement is about (ISO 8601 Datetime).
+	// Empty string to remove an existing start time.
+	// example: 2021-07-30T09:20:25+00:00
+	StartsAt *string `form:"starts_at" json:"starts_at" xml:"starts_at"`
+	// End of the period the announcement is about (ISO 8601 Datetime),
+	// after which it is no longer shown. Empty string to remove an
+	// existing end time.
+	// example: 2021-07-30T09:20:25+00:00
+	EndsAt *string `form:"ends_at" json:"ends_at" xml:"ends_at"`
+	// Start and end times are whole days, rather than times.
+	// example: false
+	AllDay *bool `form:"all_day" json:"all_day" xml:"all_day"`
+	// Publish the announcement to users.
+	// Defaults to true when creating an announcement.
+	// example: true
+	Published *bool `form:"published" json:"published" xml:"published"`
+}
@@ -59,7 +59,8 @@ type ApplicationCreateRequest struct {
 	RedirectURIs string `form:"redirect_uris" json:"redirect_uris" xml:"redirect_uris" binding:"required"`
 	// Space separated list of scopes.
 	//
-	// If no scopes are provided, defaults to `read`.
+	// Scopes that aren't recognized are ignored.
+	// If no (recognized) scopes are provided, defaults to `read`.
 	//
 	// in: formData
 	Scopes string `form:"scopes" json:"scopes" xml:"scopes"`
@@ -96,6 +96,9 @@ type Attachment struct {
 
 	// Parent status of this media is sensitive.
 	Sensitive bool `json:"-"`
+
+	// MIME type of the original attachment file.
+	MIMEType string `json:"-"`
 }
 
 // MediaMeta models media metadata.
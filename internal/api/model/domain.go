@@ -37,7 +37,7 @@ type Domain struct {
 	PublicComment string `form:"public_comment" json:"public_comment,omitempty"`
 }
 
-// DomainPermission represents a permission applied to one domain (explicit block/allow).
+// DomainPermission represents a permission applied to one domain (explicit block/allow/limit).
 //
 // swagger:model domainPermission
 type DomainPermission struct {
@@ -61,9 +61,32 @@ type DomainPermission struct {
 	// Time at which the permission entry was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at,omitempty"`
+	// Moderation options applied to content from this domain.
+	// Only set for domain limits.
+	Limit *DomainLimitOptions `json:"limit,omitempty"`
 }
 
-// DomainPermissionRequest is the form submitted as a POST to create a new domain permission entry (allow/block).
+// DomainLimitOptions represents the moderation
+// measures applied to a limited domain's content.
+//
+// swagger:model domainLimitOptions
+type DomainLimitOptions struct {
+	// Content warning to apply to statuses from
+	// this domain that don't already have one.
+	// example: from a limited instance
+	ContentWarning string `form:"content_warning" json:"content_warning" xml:"content_warning"`
+	// Mark all media attachments from this domain as sensitive.
+	// example: true
+	MediaSensitive bool `form:"media_sensitive" json:"media_sensitive" xml:"media_sensitive"`
+	// Hide statuses from this domain from public timelines.
+	// example: true
+	HidePublic bool `form:"hide_public" json:"hide_public" xml:"hide_public"`
+	// Don't download or cache media attachments from this domain.
+	// example: false
+	RejectMedia bool `form:"reject_media" json:"reject_media" xml:"reject_media"`
+}
+
+// DomainPermissionRequest is the form submitted as a POST to create a new domain permission entry (allow/block/limit).
 //
 // swagger:ignore
 type DomainPermissionRequest struct {
@@ -85,6 +108,9 @@ type DomainPermissionRequest struct {
 	// Will be visible to requesters at /api/v1/instance/peers if this endpoint is exposed.
 	// example: foss dorks 😫
 	PublicComment string `form:"public_comment" json:"public_comment" xml:"public_comment"`
+	// Moderation options to apply to content from the domain.
+	// Only used when creating a domain limit.
+	DomainLimitOptions
 }
 
 // DomainKeysExpireRequest is the form submitted as a POST to /api/v1/admin/domain_keys_expire to expire a domain's public keys.
@@ -94,3 +120,97 @@ type DomainKeysExpireRequest struct {
 	// hostname/domain to expire keys for.
 	Domain string `form:"domain" json:"domain" xml:"domain"`
 }
+
+// DomainPermissionSubscription represents an auto-refreshing subscription to a list of domain permissions (allows, blocks).
+//
+// swagger:model domainPermissionSubscription
+type DomainPermissionSubscription struct {
+	// The ID of the domain permission subscription.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// Priority of this subscription compared to others of the same permission type. 0-255 (higher = higher priority).
+	// example: 100
+	Priority uint8 `json:"priority"`
+	// Title of this subscription, as set by admin who created or updated it.
+	// example: really cool list of neato pals
+	Title string `json:"title"`
+	// The type of domain permission subscription (allow, block).
+	// example: block
+	PermissionType string `json:"permission_type"`
+	// If true, domain permissions present on the list but already on this instance and not owned by any subscription will be adopted by this subscription.
+	// example: false
+	AdoptOrphans bool `json:"adopt_orphans"`
+	// If true, domain permissions created by this subscription will be removed when they no longer appear on the list; if false, they will be orphaned instead.
+	// example: true
+	RemoveRetracted bool `json:"remove_retracted"`
+	// Time at which the subscription was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// ID of the account that created this subscription.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+	// URI to call in order to fetch the permissions list.
+	// example: https://www.example.org/blocklists/list1.csv
+	URI string `json:"uri"`
+	// MIME content type to expect from the URI.
+	// example: text/csv
+	ContentType string `json:"content_type"`
+	// (Optional) username to set for basic auth when doing a fetch of URI.
+	// example: admin123
+	FetchUsername string `json:"fetch_username,omitempty"`
+	// (Optional) password to set for basic auth when doing a fetch of URI.
+	// example: admin123
+	FetchPassword string `json:"fetch_password,omitempty"`
+	// Time of the most recent fetch attempt (successful or otherwise) (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	FetchedAt string `json:"fetched_at,omitempty"`
+	// Time of the most recent successful fetch (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	SuccessfullyFetchedAt string `json:"successfully_fetched_at,omitempty"`
+	// If most recent fetch attempt failed, this field will contain an error message related to the fetch attempt.
+	// example: Oopsie doopsie, we made a fucky wucky.
+	// readonly: true
+	Error string `json:"error,omitempty"`
+	// Count of domain permission entries currently owned by this subscription.
+	// example: 53
+	// readonly: true
+	Count uint64 `json:"count"`
+}
+
+// DomainPermissionSubscriptionRequest is the form submitted as a POST or PATCH to create or update a domain permission subscription.
+//
+// swagger:ignore
+type DomainPermissionSubscriptionRequest struct {
+	// Priority of this subscription compared to others of the same permission type. 0-255 (higher = higher priority).
+	// example: 100
+	Priority *int `form:"priority" json:"priority" xml:"priority"`
+	// Title of this subscription, as set by admin who created or updated it.
+	// example: really cool list of neato pals
+	Title *string `form:"title" json:"title" xml:"title"`
+	// The type of domain permission subscription (allow, block).
+	// Only used when creating a subscription.
+	// example: block
+	PermissionType *string `form:"permission_type" json:"permission_type" xml:"permission_type"`
+	// If true, domain permissions present on the list but already on this instance and not owned by any subscription will be adopted by this subscription.
+	// example: false
+	AdoptOrphans *bool `form:"adopt_orphans" json:"adopt_orphans" xml:"adopt_orphans"`
+	// If true, domain permissions created by this subscription will be removed when they no longer appear on the list.
+	// example: true
+	RemoveRetracted *bool `form:"remove_retracted" json:"remove_retracted" xml:"remove_retracted"`
+	// URI to call in order to fetch the permissions list.
+	// example: https://www.example.org/blocklists/list1.csv
+	URI *string `form:"uri" json:"uri" xml:"uri"`
+	// MIME content type to expect from the URI.
+	// example: text/csv
+	ContentType *string `form:"content_type" json:"content_type" xml:"content_type"`
+	// (Optional) username to set for basic auth when doing a fetch of URI.
+	// example: admin123
+	FetchUsername *string `form:"fetch_username" json:"fetch_username" xml:"fetch_username"`
+	// (Optional) password to set for basic auth when doing a fetch of URI.
+	// example: admin123
+	FetchPassword *string `form:"fetch_password" json:"fetch_password" xml:"fetch_password"`
+}
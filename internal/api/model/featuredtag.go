@@ -18,15 +18,29 @@
 package model
 
 // FeaturedTag represents a hashtag that is featured on a profile.
+//
+// swagger:model featuredTag
 type FeaturedTag struct {
 	// The internal ID of the featured tag in the database.
+	// example: 01HZZ9ZGDGMMQ3ZJ1XH2KTB3AD
 	ID string `json:"id"`
 	// The name of the hashtag being featured.
+	// example: helloworld
 	Name string `json:"name"`
 	// A link to all statuses by a user that contain this hashtag.
+	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
-	// The number of authored statuses containing this hashtag.
+	// The number of authored, public or unlisted statuses containing this hashtag.
 	StatusesCount int `json:"statuses_count"`
-	// The timestamp of the last authored status containing this hashtag. (ISO 8601 Datetime)
-	LastStatusAt string `json:"last_status_at"`
+	// The timestamp of the last authored, public or unlisted status containing this hashtag. (ISO 8601 Datetime)
+	// Null if no statuses have been authored with this hashtag.
+	LastStatusAt *string `json:"last_status_at"`
+}
+
+// FeaturedTagCreateRequest models a request to feature a hashtag on the requester's profile.
+//
+// swagger:ignore
+type FeaturedTagCreateRequest struct {
+	// The name of the hashtag to feature, with or without leading '#'.
+	Name string `form:"name" json:"name"`
 }
@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+import "mime/multipart"
+
+// Import represents an upload of account data
+// (follows, blocks, mutes, lists, bookmarks, or
+// an account archive), along with the progress
+// of its processing.
+//
+// swagger:model import
+type Import struct {
+	// ID of the import in the database.
+	ID string `json:"id"`
+	// Type of data being imported.
+	// enum:
+	//	- following
+	//	- blocks
+	//	- mutes
+	//	- lists
+	//	- bookmarks
+	//	- archive
+	Type string `json:"type"`
+	// How the imported data is applied.
+	// "merge" adds to existing entries, "overwrite"
+	// also removes existing entries not in the import.
+	// enum:
+	//	- merge
+	//	- overwrite
+	Mode string `json:"mode"`
+	// Only check what would be imported, without importing
+	// anything. Only applies to archive imports.
+	DryRun bool `json:"dry_run"`
+	// Send imported statuses to followers on other
+	// instances. Only applies to archive imports.
+	Federate bool `json:"federate"`
+	// Processing state of the import.
+	// enum:
+	//	- pending
+	//	- processing
+	//	- finished
+	//	- failed
+	State string `json:"state"`
+	// Total number of items in the import.
+	TotalItems int `json:"total_items"`
+	// Number of items processed so far, including failed items.
+	ProcessedItems int `json:"processed_items"`
+	// Number of items that could not be imported.
+	FailedItems int `json:"failed_items"`
+	// ISO 8601 Datetime at which the import was uploaded.
+	CreatedAt string `json:"created_at"`
+	// ISO 8601 Datetime at which processing of the import
+	// finished or failed. Null if still pending or processing.
+	FinishedAt *string `json:"finished_at"`
+}
+
+// ImportRequest models a request
+// to upload a new account data import.
+//
+// swagger:ignore
+type ImportRequest struct {
+	// CSV file or zip archive to import.
+	Data *multipart.FileHeader `form:"data"`
+	// Type of data contained in the file.
+	Type string `form:"type"`
+	// Mode of the import, "merge" or "overwrite".
+	Mode string `form:"mode"`
+	// Only check what would be imported (archives only).
+	DryRun bool `form:"dry_run"`
+	// Federate imported statuses (archives only).
+	Federate bool `form:"federate"`
+}
@@ -145,6 +145,16 @@ type InstanceV2ConfigurationTranslation struct {
 	Enabled bool `json:"enabled"`
 }
 
+// Hints related to Web Push.
+//
+// swagger:model instanceV2ConfigurationVAPID
+type InstanceV2ConfigurationVAPID struct {
+	// The instance's VAPID public key, used by clients
+	// when subscribing to push notifications.
+	// Base64url encoded uncompressed P-256 point.
+	PublicKey string `json:"public_key"`
+}
+
 // Configured values and limits for this instance.
 //
 // swagger:model instanceV2Configuration
@@ -163,6 +173,8 @@ type InstanceV2Configuration struct {
 	Translation InstanceV2ConfigurationTranslation `json:"translation"`
 	// Instance configuration pertaining to emojis.
 	Emojis InstanceConfigurationEmojis `json:"emojis"`
+	// Hints related to Web Push.
+	VAPID InstanceV2ConfigurationVAPID `json:"vapid"`
 	// True if instance is running with OIDC as auth/identity backend, else omitted.
 	OIDCEnabled bool `json:"oidc_enabled,omitempty"`
 }
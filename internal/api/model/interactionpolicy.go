@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// PolicyValue represents a single value
+// in an interaction policy rule list.
+//
+// swagger:enum interactionPolicyValue
+// swagger:type string
+type PolicyValue string
+
+const (
+	PolicyValuePublic    PolicyValue = "public"    // Public, aka anyone who can see the status.
+	PolicyValueFollowers PolicyValue = "followers" // Followers of the status author.
+	PolicyValueFollowing PolicyValue = "following" // Accounts followed by the status author.
+	PolicyValueMentioned PolicyValue = "mentioned" // Accounts mentioned in the status.
+	PolicyValueAuthor    PolicyValue = "author"    // The status author themself.
+)
+
+// InteractionPolicy models the interaction policy of a
+// status, or a default interaction policy of an account.
+//
+// swagger:model interactionPolicy
+type InteractionPolicy struct {
+	// Rules for who can favourite this status.
+	CanFavourite PolicyRules `form:"can_favourite" json:"can_favourite"`
+	// Rules for who can reply to this status.
+	CanReply PolicyRules `form:"can_reply" json:"can_reply"`
+	// Rules for who can reblog this status.
+	CanReblog PolicyRules `form:"can_reblog" json:"can_reblog"`
+}
+
+// PolicyRules models a rule list for a single type of interaction.
+//
+// swagger:model interactionPolicyRules
+type PolicyRules struct {
+	// Policy entries for accounts that can always do this type of interaction.
+	Always []PolicyValue `form:"always" json:"always"`
+	// Policy entries for accounts that require approval to do this type of interaction.
+	WithApproval []PolicyValue `form:"with_approval" json:"with_approval"`
+}
+
+// DefaultPolicies models the default interaction
+// policies of an account, one per visibility level.
+//
+// swagger:model defaultPolicies
+type DefaultPolicies struct {
+	// TopLevel/default policy for "direct" visibility.
+	Direct InteractionPolicy `json:"direct"`
+	// TopLevel/default policy for "mutuals_only" visibility.
+	MutualsOnly InteractionPolicy `json:"mutuals_only"`
+	// TopLevel/default policy for "private" visibility.
+	Private InteractionPolicy `json:"private"`
+	// TopLevel/default policy for "unlisted" visibility.
+	Unlisted InteractionPolicy `json:"unlisted"`
+	// TopLevel/default policy for "public" visibility.
+	Public InteractionPolicy `json:"public"`
+}
+
+// UpdateInteractionPoliciesRequest models a request to update
+// the default interaction policies of the requesting account.
+// Any nil policy will be reset to the instance default.
+//
+// swagger:ignore
+type UpdateInteractionPoliciesRequest struct {
+	// Default policy for "direct" visibility.
+	Direct *InteractionPolicy `json:"direct"`
+	// Default policy for "mutuals_only" visibility.
+	MutualsOnly *InteractionPolicy `json:"mutuals_only"`
+	// Default policy for "private" visibility.
+	Private *InteractionPolicy `json:"private"`
+	// Default policy for "unlisted" visibility.
+	Unlisted *InteractionPolicy `json:"unlisted"`
+	// Default policy for "public" visibility.
+	Public *InteractionPolicy `json:"public"`
+}
+
+// InteractionRequest models an interaction with one of
+// the requesting account's statuses that is pending approval.
+//
+// swagger:model interactionRequest
+type InteractionRequest struct {
+	// The ID of the interaction request,
+	// ie., the ID of the pending fave or status.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The type of interaction that this interaction request pertains to.
+	//
+	// `favourite` - Someone favourited a status.
+	// `reply` - Someone replied to a status.
+	// `reblog` - Someone reblogged / boosted a status.
+	// example: reply
+	Type string `json:"type"`
+	// The timestamp of the interaction request (ISO 8601 Datetime)
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The account that performed the interaction.
+	Account *Account `json:"account"`
+	// Status targeted by the requested interaction.
+	Status *Status `json:"status"`
+	// If type=reply, this field will be set to the reply
+	// that is awaiting approval. Otherwise it will be null.
+	// nullable: true
+	Reply *Status `json:"reply"`
+}
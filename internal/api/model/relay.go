@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Relay represents a subscription of this instance to a fediverse relay.
+//
+// swagger:model relay
+type Relay struct {
+	// The ID of the relay subscription.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the subscription was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+	// ID of the account that created this subscription.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+	// URI of the relay, as given when subscribing. Either the actor of a LitePub relay, or the inbox of a Mastodon relay.
+	// example: https://relay.example.org/actor
+	URI string `json:"uri"`
+	// Inbox of the relay, to which activities are delivered.
+	// example: https://relay.example.org/inbox
+	// readonly: true
+	InboxURI string `json:"inbox_uri"`
+	// URI of the relay actor. Empty for Mastodon relays that have not accepted the subscription yet.
+	// example: https://relay.example.org/actor
+	// readonly: true
+	ActorURI string `json:"actor_uri,omitempty"`
+	// State of the subscription.
+	// enum:
+	//	- pending
+	//	- accepted
+	//	- rejected
+	// example: accepted
+	// readonly: true
+	State string `json:"state"`
+	// If true, public statuses of local accounts are delivered to the relay.
+	// example: false
+	Forward bool `json:"forward"`
+}
+
+// RelayRequest is the form submitted as a POST or PATCH to create or update a relay subscription.
+//
+// swagger:ignore
+type RelayRequest struct {
+	// URI of the relay. Either the actor of a LitePub relay, or the inbox of a Mastodon relay.
+	// Only used when creating a subscription.
+	// example: https://relay.example.org/actor
+	URI *string `form:"uri" json:"uri" xml:"uri"`
+	// If true, public statuses of local accounts are delivered to the relay.
+	// example: false
+	Forward *bool `form:"forward" json:"forward" xml:"forward"`
+}
@@ -18,21 +18,66 @@
 package model
 
 // ScheduledStatus represents a status that will be published at a future scheduled date.
+//
+// swagger:model scheduledStatus
 type ScheduledStatus struct {
-	ID               string        `json:"id"`
-	ScheduledAt      string        `json:"scheduled_at"`
-	Params           *StatusParams `json:"params"`
-	MediaAttachments []Attachment  `json:"media_attachments"`
+	// ID of the scheduled status in the database.
+	ID string `json:"id"`
+	// ISO 8601 Datetime at which the status will be published.
+	ScheduledAt string `json:"scheduled_at"`
+	// Parameters that will be used to create the status.
+	Params *StatusParams `json:"params"`
+	// Media that will be attached when the status is posted.
+	MediaAttachments []*Attachment `json:"media_attachments"`
 }
 
 // StatusParams represents parameters for a scheduled status.
+//
+// swagger:model scheduledStatusParams
 type StatusParams struct {
-	Text          string   `json:"text"`
-	InReplyToID   string   `json:"in_reply_to_id,omitempty"`
-	MediaIDs      []string `json:"media_ids,omitempty"`
-	Sensitive     bool     `json:"sensitive,omitempty"`
-	SpoilerText   string   `json:"spoiler_text,omitempty"`
-	Visibility    string   `json:"visibility"`
-	ScheduledAt   string   `json:"scheduled_at,omitempty"`
-	ApplicationID string   `json:"application_id"`
+	// Text content of the status.
+	Text string `json:"text"`
+	// Poll to be attached to the status.
+	Poll *StatusParamsPoll `json:"poll"`
+	// IDs of the media attachments to be attached to the status.
+	MediaIDs []string `json:"media_ids"`
+	// Whether the status will be marked as sensitive.
+	Sensitive bool `json:"sensitive"`
+	// Text to be shown as a warning or subject before the actual content.
+	SpoilerText string `json:"spoiler_text"`
+	// Visibility of the status.
+	Visibility Visibility `json:"visibility"`
+	// ID of the status being replied to, if any.
+	InReplyToID string `json:"in_reply_to_id"`
+	// ISO 639 language code for the status.
+	Language string `json:"language"`
+	// ID of the application that scheduled the status.
+	ApplicationID string `json:"application_id"`
+	// Always null; set on the scheduled status itself.
+	ScheduledAt *string `json:"scheduled_at"`
+}
+
+// StatusParamsPoll represents the parameters
+// of a poll attached to a scheduled status.
+//
+// swagger:model scheduledStatusParamsPoll
+type StatusParamsPoll struct {
+	// Possible answers to the poll.
+	Options []string `json:"options"`
+	// Duration the poll should be open, in seconds.
+	ExpiresIn int `json:"expires_in"`
+	// Whether multiple choices are allowed.
+	Multiple bool `json:"multiple"`
+	// Whether poll vote counts are hidden until the poll ends.
+	HideTotals bool `json:"hide_totals"`
+}
+
+// ScheduledStatusUpdateRequest models a request
+// to update the publish time of a scheduled status.
+//
+// swagger:ignore
+type ScheduledStatusUpdateRequest struct {
+	// ISO 8601 Datetime at which to publish the status.
+	// Must be at least 5 minutes in the future.
+	ScheduledAt string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
 }
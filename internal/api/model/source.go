@@ -43,4 +43,14 @@ type Source struct {
 	//
 	// Omitted from json if empty / not set.
 	AlsoKnownAsURIs []string `json:"also_known_as_uris,omitempty"`
+	// Notification types for which an email is sent to the
+	// account's email address: mention, direct, follow, follow_request.
+	//
+	// Omitted from json if empty / not set.
+	EmailNotifications []string `json:"email_notifications,omitempty"`
+	// Send email notifications as a daily or weekly
+	// digest, rather than one email per notification.
+	//
+	// Omitted from json if not set.
+	EmailDigest string `json:"email_digest,omitempty"`
 }
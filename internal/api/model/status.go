@@ -29,6 +29,11 @@ type Status struct {
 	// The date when this status was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at"`
+	// The date when this status was last edited (ISO 8601 Datetime).
+	// Will be null if the status has never been edited.
+	// example: 2021-07-30T09:20:25+00:00
+	// nullable: true
+	EditedAt *string `json:"edited_at"`
 	// ID of the status being replied to.
 	// example: 01FBVD42CQ3ZEEVMW180SBX03B
 	// nullable: true
@@ -102,6 +107,8 @@ type Status struct {
 	Text string `json:"text,omitempty"`
 	// A list of filters that matched this status and why they matched, if there are any such filters.
 	Filtered []FilterResult `json:"filtered,omitempty"`
+	// The interaction policy for this status, as set by the status author.
+	InteractionPolicy InteractionPolicy `json:"interaction_policy"`
 
 	// Additional fields not exposed via JSON
 	// (used only internally for templating etc).
@@ -193,6 +200,50 @@ type StatusCreateRequest struct {
 	Language string `form:"language" json:"language" xml:"language"`
 	// Content type to use when parsing this status.
 	ContentType StatusContentType `form:"content_type" json:"content_type" xml:"content_type"`
+	// Interaction policy to use for this status. Only
+	// settable via JSON; if not set, the account's default
+	// policy for the status visibility is used.
+	InteractionPolicy *InteractionPolicy `form:"-" json:"interaction_policy" xml:"-"`
+}
+
+// StatusEditRequest models status edit parameters.
+//
+// swagger:ignore
+type StatusEditRequest struct {
+	// Text content of the status.
+	// If media_ids is provided, this becomes optional.
+	// Attaching a poll is optional while status is provided.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Text to be shown as a warning or subject before the actual content.
+	// Statuses are generally collapsed behind this field.
+	SpoilerText string `form:"spoiler_text" json:"spoiler_text" xml:"spoiler_text"`
+	// Status and attached media should be marked as sensitive.
+	Sensitive bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
+	// ISO 639 language code for this status.
+	Language string `form:"language" json:"language" xml:"language"`
+	// Content type to use when parsing this status.
+	ContentType StatusContentType `form:"content_type" json:"content_type" xml:"content_type"`
+	// Array of Attachment ids to be attached as media.
+	// If provided, status becomes optional, and poll cannot be used.
+	MediaIDs []string `form:"media_ids[]" json:"media_ids" xml:"media_ids"`
+	// Array of Attachment attributes to be updated in attached media.
+	MediaAttributes []AttachmentAttributesRequest `form:"-" json:"media_attributes" xml:"media_attributes"`
+	// Poll to include with this status.
+	Poll *PollRequest `form:"poll" json:"poll" xml:"poll"`
+}
+
+// AttachmentAttributesRequest models an edit request for attachment attributes.
+//
+// swagger:ignore
+type AttachmentAttributesRequest struct {
+	// ID of the attachment to update.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Image or media description to use as alt-text on the attachment.
+	// Left unchanged if not set.
+	Description *string `form:"description" json:"description" xml:"description"`
+	// Focus of the media file, as "x,y" where x and y are between -1 and 1.
+	// Left unchanged if not set.
+	Focus *string `form:"focus" json:"focus" xml:"focus"`
 }
 
 // Visibility models the visibility of a status.
@@ -231,10 +282,16 @@ type AdvancedVisibilityFlagsForm struct {
 	// This status will be federated beyond the local timeline(s).
 	Federated *bool `form:"federated" json:"federated" xml:"federated"`
 	// This status can be boosted/reblogged.
+	//
+	// Deprecated: use interaction_policy instead.
 	Boostable *bool `form:"boostable" json:"boostable" xml:"boostable"`
 	// This status can be replied to.
+	//
+	// Deprecated: use interaction_policy instead.
 	Replyable *bool `form:"replyable" json:"replyable" xml:"replyable"`
 	// This status can be liked/faved.
+	//
+	// Deprecated: use interaction_policy instead.
 	Likeable *bool `form:"likeable" json:"likeable" xml:"likeable"`
 }
 
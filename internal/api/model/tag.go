@@ -27,8 +27,11 @@ type Tag struct {
 	// Web link to the hashtag.
 	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
-	// History of this hashtag's usage.
-	// Currently just a stub, if provided will always be an empty array.
+	// History of this hashtag's usage, most recent day first.
+	// Only populated for trending hashtags, otherwise if provided will be an empty array.
 	// example: []
 	History *[]any `json:"history,omitempty"`
+	// Following is true if the authorized user follows this tag,
+	// false if they don't, and omitted if there is no authorized user.
+	Following *bool `json:"following,omitempty"`
 }
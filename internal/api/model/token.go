@@ -33,3 +33,43 @@ type Token struct {
 	// example: 1627644520
 	CreatedAt int64 `json:"created_at"`
 }
+
+// TokenInfo represents metadata about one
+// OAuth access token issued to the user,
+// without revealing the access token itself.
+//
+// swagger:model tokenInfo
+type TokenInfo struct {
+	// Database ID of this token.
+	// example: 01JMW7QBAZYZ8T8H73PCEX12F3
+	ID string `json:"id"`
+	// When the token was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Approximate time (accurate to within a few minutes)
+	// when the token was last used (ISO 8601 Datetime).
+	// Omitted if token has never been used.
+	// example: 2021-07-30T09:20:25+00:00
+	LastUsed string `json:"last_used,omitempty"`
+	// OAuth scopes granted by the token, space-separated.
+	// example: read write admin
+	Scope string `json:"scope"`
+	// Application used to create this token.
+	// Omitted when the token is listed as
+	// part of an authorized application.
+	Application *Application `json:"application,omitempty"`
+}
+
+// AuthorizedApplication represents one application
+// that the user has authorized to access their account,
+// along with the access tokens issued to it.
+//
+// swagger:model authorizedApplication
+type AuthorizedApplication struct {
+	// The authorized application.
+	// Its ID can be used to revoke
+	// all of its access tokens.
+	Application *Application `json:"application"`
+	// Access tokens issued to the application, newest first.
+	Tokens []*TokenInfo `json:"tokens"`
+}
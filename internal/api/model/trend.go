@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// TrendsLink represents a link which is
+// trending on this instance, shared as
+// a preview card with its usage history.
+//
+// swagger:model trendsLink
+type TrendsLink struct {
+	Card
+	// History of this link's usage, most recent day first.
+	History []History `json:"history"`
+}
+
+// AdminTrend models the admin view of a
+// trending hashtag, status or link,
+// including its review status.
+//
+// swagger:model adminTrend
+type AdminTrend struct {
+	// ID of the trend.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Type of the trend.
+	// enum:
+	// - tag
+	// - status
+	// - link
+	// example: tag
+	Type string `json:"type"`
+	// Score of the trend; higher scores trend more.
+	// example: 4.5
+	Score float64 `json:"score"`
+	// Admin review of the trend.
+	// Only approved trends are shown to users.
+	// enum:
+	// - pending
+	// - approved
+	// - rejected
+	// example: pending
+	Review string `json:"review"`
+	// When was this trend last reviewed (ISO 8601 Datetime).
+	// Will be null if not yet reviewed.
+	// example: 2021-07-30T09:20:25+00:00
+	ReviewedAt *string `json:"reviewed_at"`
+	// The trending hashtag, if type is tag.
+	Tag *Tag `json:"tag,omitempty"`
+	// The trending status, if type is status.
+	Status *Status `json:"status,omitempty"`
+	// The trending link, if type is link.
+	Link *TrendsLink `json:"link,omitempty"`
+}
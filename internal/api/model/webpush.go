@@ -0,0 +1,191 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// WebPushSubscription represents a subscription
+// to the Web Push server.
+//
+// swagger:model webPushSubscription
+type WebPushSubscription struct {
+	// The id of the push subscription in the database.
+	ID string `json:"id"`
+	// Where push alerts will be sent to.
+	Endpoint string `json:"endpoint"`
+	// Which alerts should be delivered to the endpoint.
+	Alerts WebPushSubscriptionAlerts `json:"alerts"`
+	// The streaming server's VAPID key.
+	ServerKey string `json:"server_key"`
+	// Which accounts to receive push notifications from.
+	// enum:
+	//	- all
+	//	- followed
+	//	- follower
+	//	- none
+	Policy string `json:"policy"`
+}
+
+// WebPushSubscriptionAlerts represents the specific
+// alerts that a Web Push subscription will receive.
+//
+// swagger:model webPushSubscriptionAlerts
+type WebPushSubscriptionAlerts struct {
+	// Receive a push notification when someone has followed you?
+	Follow bool `json:"follow"`
+	// Receive a push notification when someone has requested to follow you?
+	FollowRequest bool `json:"follow_request"`
+	// Receive a push notification when a status you created has been favourited by someone else?
+	Favourite bool `json:"favourite"`
+	// Receive a push notification when someone else has mentioned you in a status?
+	Mention bool `json:"mention"`
+	// Receive a push notification when a status you created has been boosted by someone else?
+	Reblog bool `json:"reblog"`
+	// Receive a push notification when a poll you voted in or created has ended?
+	Poll bool `json:"poll"`
+	// Receive a push notification when a subscribed account posts a status?
+	Status bool `json:"status"`
+	// Receive a push notification when a status you interacted with has been edited?
+	Update bool `json:"update"`
+	// Receive a push notification when a new user has signed up?
+	AdminSignup bool `json:"admin.sign_up"`
+	// Receive a push notification when a fave is pending your approval?
+	PendingFave bool `json:"pending.favourite"`
+	// Receive a push notification when a reply is pending your approval?
+	PendingReply bool `json:"pending.reply"`
+	// Receive a push notification when a boost is pending your approval?
+	PendingReblog bool `json:"pending.reblog"`
+}
+
+// WebPushSubscriptionCreateRequest models a request to create a Web Push subscription.
+// This has two sets of fields to support a goofy nested map structure in both form data and JSON bodies.
+//
+// swagger:ignore
+type WebPushSubscriptionCreateRequest struct {
+	Subscription               *WebPushSubscriptionRequestSubscription `json:"subscription"`
+	FormSubscriptionEndpoint   string                                  `form:"subscription[endpoint]"`
+	FormSubscriptionKeysAuth   string                                  `form:"subscription[keys][auth]"`
+	FormSubscriptionKeysP256dh string                                  `form:"subscription[keys][p256dh]"`
+
+	WebPushSubscriptionUpdateRequest
+}
+
+// WebPushSubscriptionRequestSubscription is the
+// JSON representation of the subscription to create.
+//
+// swagger:ignore
+type WebPushSubscriptionRequestSubscription struct {
+	// Where push alerts will be sent to.
+	Endpoint string `json:"endpoint"`
+	// Keys with which to encrypt push alerts.
+	Keys WebPushSubscriptionRequestKeys `json:"keys"`
+}
+
+// WebPushSubscriptionRequestKeys contains the
+// client's keys for encrypting push alerts.
+//
+// swagger:ignore
+type WebPushSubscriptionRequestKeys struct {
+	// Base64url encoded auth secret.
+	Auth string `json:"auth"`
+	// Base64url encoded P-256 ECDH public key.
+	P256dh string `json:"p256dh"`
+}
+
+// SubscriptionEndpoint should be used instead of Subscription or FormSubscriptionEndpoint.
+func (r *WebPushSubscriptionCreateRequest) SubscriptionEndpoint() string {
+	if r.Subscription != nil {
+		return r.Subscription.Endpoint
+	}
+	return r.FormSubscriptionEndpoint
+}
+
+// SubscriptionKeysAuth should be used instead of Subscription or FormSubscriptionKeysAuth.
+func (r *WebPushSubscriptionCreateRequest) SubscriptionKeysAuth() string {
+	if r.Subscription != nil {
+		return r.Subscription.Keys.Auth
+	}
+	return r.FormSubscriptionKeysAuth
+}
+
+// SubscriptionKeysP256dh should be used instead of Subscription or FormSubscriptionKeysP256dh.
+func (r *WebPushSubscriptionCreateRequest) SubscriptionKeysP256dh() string {
+	if r.Subscription != nil {
+		return r.Subscription.Keys.P256dh
+	}
+	return r.FormSubscriptionKeysP256dh
+}
+
+// WebPushSubscriptionUpdateRequest models a request to update the alerts and policy of a Web Push subscription.
+// This has two sets of fields to support a goofy nested map structure in both form data and JSON bodies.
+//
+// swagger:ignore
+type WebPushSubscriptionUpdateRequest struct {
+	Data                        *WebPushSubscriptionRequestData `json:"data"`
+	FormDataPolicy              *string                         `form:"data[policy]"`
+	FormDataAlertsFollow        bool                            `form:"data[alerts][follow]"`
+	FormDataAlertsFollowRequest bool                            `form:"data[alerts][follow_request]"`
+	FormDataAlertsFavourite     bool                            `form:"data[alerts][favourite]"`
+	FormDataAlertsMention       bool                            `form:"data[alerts][mention]"`
+	FormDataAlertsReblog        bool                            `form:"data[alerts][reblog]"`
+	FormDataAlertsPoll          bool                            `form:"data[alerts][poll]"`
+	FormDataAlertsStatus        bool                            `form:"data[alerts][status]"`
+	FormDataAlertsUpdate        bool                            `form:"data[alerts][update]"`
+	FormDataAlertsAdminSignup   bool                            `form:"data[alerts][admin.sign_up]"`
+	FormDataAlertsPendingFave   bool                            `form:"data[alerts][pending.favourite]"`
+	FormDataAlertsPendingReply  bool                            `form:"data[alerts][pending.reply]"`
+	FormDataAlertsPendingReblog bool                            `form:"data[alerts][pending.reblog]"`
+}
+
+// WebPushSubscriptionRequestData is the JSON
+// representation of the alerts and policy to set.
+//
+// swagger:ignore
+type WebPushSubscriptionRequestData struct {
+	// Which alerts should be delivered to the endpoint.
+	Alerts WebPushSubscriptionAlerts `json:"alerts"`
+	// Which accounts to receive push notifications from.
+	Policy *string `json:"policy"`
+}
+
+// DataAlerts should be used instead of Data or the FormDataAlerts fields.
+func (r *WebPushSubscriptionUpdateRequest) DataAlerts() WebPushSubscriptionAlerts {
+	if r.Data != nil {
+		return r.Data.Alerts
+	}
+	return WebPushSubscriptionAlerts{
+		Follow:        r.FormDataAlertsFollow,
+		FollowRequest: r.FormDataAlertsFollowRequest,
+		Favourite:     r.FormDataAlertsFavourite,
+		Mention:       r.FormDataAlertsMention,
+		Reblog:        r.FormDataAlertsReblog,
+		Poll:          r.FormDataAlertsPoll,
+		Status:        r.FormDataAlertsStatus,
+		Update:        r.FormDataAlertsUpdate,
+		AdminSignup:   r.FormDataAlertsAdminSignup,
+		PendingFave:   r.FormDataAlertsPendingFave,
+		PendingReply:  r.FormDataAlertsPendingReply,
+		PendingReblog: r.FormDataAlertsPendingReblog,
+	}
+}
+
+// DataPolicy should be used instead of Data or FormDataPolicy.
+func (r *WebPushSubscriptionUpdateRequest) DataPolicy() *string {
+	if r.Data != nil {
+		return r.Data.Policy
+	}
+	return r.FormDataPolicy
+}
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// TokenAuth wraps oauth.Authed, extracting the authorized token,
+// application, user and account from the given gin context, and
+// checking that those required are present (else 401 Unauthorized).
+//
+// If a token was provided, it is also checked that the token was
+// granted each of the given scopes (else 403 Forbidden). Scopes
+// are checked whenever a token is present, even if not required,
+// so that a token can never be used beyond its granted scopes.
+func TokenAuth(
+	c *gin.Context,
+	requireToken bool,
+	requireApp bool,
+	requireUser bool,
+	requireAccount bool,
+	scopes ...oauth.Scope,
+) (*oauth.Auth, gtserror.WithCode) {
+	authed, err := oauth.Authed(c,
+		requireToken,
+		requireApp,
+		requireUser,
+		requireAccount,
+	)
+	if err != nil {
+		return nil, gtserror.NewErrorUnauthorized(err, err.Error())
+	}
+
+	if authed.Token == nil {
+		// No token to check
+		// scopes against.
+		return authed, nil
+	}
+
+	granted := authed.Token.GetScope()
+	for _, scope := range scopes {
+		if !oauth.ScopesPermit(granted, scope) {
+			text := fmt.Sprintf("token has insufficient scope permission: %s required", scope)
+			return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+		}
+	}
+
+	return authed, nil
+}
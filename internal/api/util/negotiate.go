@@ -52,6 +52,16 @@ var HTMLAcceptHeaders = []string{
 	TextHTML,
 }
 
+// CSVAcceptHeaders is a slice of offers that just contains text/csv types.
+var CSVAcceptHeaders = []string{
+	TextCSV,
+}
+
+// ZipAcceptHeaders is a slice of offers that just contains application/zip types.
+var ZipAcceptHeaders = []string{
+	AppZip,
+}
+
 // HTMLOrActivityPubHeaders matches text/html first, then activitypub types.
 // This is useful for user URLs that a user might go to in their browser,
 // but which should also be able to serve ActivityPub as a fallback.
@@ -60,10 +60,13 @@ func (c *Caches) Init() {
 	c.initBlockIDs()
 	c.initBoostOfIDs()
 	c.initClient()
+	c.initConversation()
 	c.initDomainAllow()
 	c.initDomainBlock()
+	c.initDomainLimit()
 	c.initEmoji()
 	c.initEmojiCategory()
+	c.initFeaturedTag()
 	c.initFilter()
 	c.initFilterKeyword()
 	c.initFilterStatus()
@@ -71,6 +74,7 @@ func (c *Caches) Init() {
 	c.initFollowIDs()
 	c.initFollowRequest()
 	c.initFollowRequestIDs()
+	c.initFollowedTagIDs()
 	c.initInReplyToIDs()
 	c.initInstance()
 	c.initList()
@@ -83,10 +87,13 @@ func (c *Caches) Init() {
 	c.initPoll()
 	c.initPollVote()
 	c.initPollVoteIDs()
+	c.initPreviewCard()
 	c.initReport()
+	c.initScheduledStatus()
 	c.initStatus()
 	c.initStatusBookmark()
 	c.initStatusBookmarkIDs()
+	c.initStatusEdit()
 	c.initStatusFave()
 	c.initStatusFaveIDs()
 	c.initTag()
@@ -96,6 +103,7 @@ func (c *Caches) Init() {
 	c.initUser()
 	c.initUserMute()
 	c.initUserMuteIDs()
+	c.initWebPushSubscription()
 	c.initWebfinger()
 	c.initVisibility()
 }
@@ -134,8 +142,10 @@ func (c *Caches) Sweep(threshold float64) {
 	c.GTS.BlockIDs.Trim(threshold)
 	c.GTS.BoostOfIDs.Trim(threshold)
 	c.GTS.Client.Trim(threshold)
+	c.GTS.Conversation.Trim(threshold)
 	c.GTS.Emoji.Trim(threshold)
 	c.GTS.EmojiCategory.Trim(threshold)
+	c.GTS.FeaturedTag.Trim(threshold)
 	c.GTS.Filter.Trim(threshold)
 	c.GTS.FilterKeyword.Trim(threshold)
 	c.GTS.FilterStatus.Trim(threshold)
@@ -143,6 +153,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.GTS.FollowIDs.Trim(threshold)
 	c.GTS.FollowRequest.Trim(threshold)
 	c.GTS.FollowRequestIDs.Trim(threshold)
+	c.GTS.FollowedTagIDs.Trim(threshold)
 	c.GTS.InReplyToIDs.Trim(threshold)
 	c.GTS.Instance.Trim(threshold)
 	c.GTS.List.Trim(threshold)
@@ -155,10 +166,13 @@ func (c *Caches) Sweep(threshold float64) {
 	c.GTS.Poll.Trim(threshold)
 	c.GTS.PollVote.Trim(threshold)
 	c.GTS.PollVoteIDs.Trim(threshold)
+	c.GTS.PreviewCard.Trim(threshold)
 	c.GTS.Report.Trim(threshold)
+	c.GTS.ScheduledStatus.Trim(threshold)
 	c.GTS.Status.Trim(threshold)
 	c.GTS.StatusBookmark.Trim(threshold)
 	c.GTS.StatusBookmarkIDs.Trim(threshold)
+	c.GTS.StatusEdit.Trim(threshold)
 	c.GTS.StatusFave.Trim(threshold)
 	c.GTS.StatusFaveIDs.Trim(threshold)
 	c.GTS.Tag.Trim(threshold)
@@ -167,6 +181,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.GTS.Tombstone.Trim(threshold)
 	c.GTS.User.Trim(threshold)
 	c.GTS.UserMute.Trim(threshold)
+	c.GTS.WebPushSubscription.Trim(threshold)
 	c.GTS.UserMuteIDs.Trim(threshold)
 	c.Visibility.Trim(threshold)
 }
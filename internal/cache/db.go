@@ -56,18 +56,27 @@ type GTSCaches struct {
 	// Client provides access to the gtsmodel Client database cache.
 	Client StructCache[*gtsmodel.Client]
 
+	// Conversation provides access to the gtsmodel Conversation database cache.
+	Conversation StructCache[*gtsmodel.Conversation]
+
 	// DomainAllow provides access to the domain allow database cache.
 	DomainAllow *domain.Cache
 
 	// DomainBlock provides access to the domain block database cache.
 	DomainBlock *domain.Cache
 
+	// DomainLimit provides access to the domain limit database cache.
+	DomainLimit *domain.Cache
+
 	// Emoji provides access to the gtsmodel Emoji database cache.
 	Emoji StructCache[*gtsmodel.Emoji]
 
 	// EmojiCategory provides access to the gtsmodel EmojiCategory database cache.
 	EmojiCategory StructCache[*gtsmodel.EmojiCategory]
 
+	// FeaturedTag provides access to the gtsmodel FeaturedTag database cache.
+	FeaturedTag StructCache[*gtsmodel.FeaturedTag]
+
 	// Filter provides access to the gtsmodel Filter database cache.
 	Filter StructCache[*gtsmodel.Filter]
 
@@ -97,6 +106,10 @@ type GTSCaches struct {
 	// - '<'  for follower IDs
 	FollowRequestIDs SliceCache[string]
 
+	// FollowedTagIDs provides access to the followed
+	// tag IDs database cache, keyed by account ID.
+	FollowedTagIDs SliceCache[string]
+
 	// Instance provides access to the gtsmodel Instance database cache.
 	Instance StructCache[*gtsmodel.Instance]
 
@@ -133,9 +146,15 @@ type GTSCaches struct {
 	// PollVoteIDs provides access to the poll vote IDs list database cache.
 	PollVoteIDs SliceCache[string]
 
+	// PreviewCard provides access to the gtsmodel PreviewCard database cache.
+	PreviewCard StructCache[*gtsmodel.PreviewCard]
+
 	// Report provides access to the gtsmodel Report database cache.
 	Report StructCache[*gtsmodel.Report]
 
+	// ScheduledStatus provides access to the gtsmodel ScheduledStatus database cache.
+	ScheduledStatus StructCache[*gtsmodel.ScheduledStatus]
+
 	// Status provides access to the gtsmodel Status database cache.
 	Status StructCache[*gtsmodel.Status]
 
@@ -145,6 +164,9 @@ type GTSCaches struct {
 	// StatusBookmarkIDs ...
 	StatusBookmarkIDs SliceCache[string]
 
+	// StatusEdit provides access to the gtsmodel StatusEdit database cache.
+	StatusEdit StructCache[*gtsmodel.StatusEdit]
+
 	// StatusFave provides access to the gtsmodel StatusFave database cache.
 	StatusFave StructCache[*gtsmodel.StatusFave]
 
@@ -172,6 +194,9 @@ type GTSCaches struct {
 	// UserMuteIDs provides access to the user mute IDs database cache.
 	UserMuteIDs SliceCache[string]
 
+	// WebPushSubscription provides access to the gtsmodel WebPushSubscription database cache.
+	WebPushSubscription StructCache[*gtsmodel.WebPushSubscription]
+
 	// Webfinger provides access to the webfinger URL cache.
 	// TODO: move out of GTS caches since unrelated to DB.
 	Webfinger *ttl.Cache[string, string] // TTL=24hr, sweep=5min
@@ -423,6 +448,42 @@ func (c *Caches) initClient() {
 	})
 }
 
+func (c *Caches) initConversation() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofConversation(), // model in-mem size.
+		config.GetCacheConversationMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(c1 *gtsmodel.Conversation) *gtsmodel.Conversation {
+		c2 := new(gtsmodel.Conversation)
+		*c2 = *c1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/conversation.go.
+		c2.Account = nil
+		c2.OtherAccounts = nil
+		c2.LastStatus = nil
+
+		return c2
+	}
+
+	c.GTS.Conversation.Init(structr.CacheConfig[*gtsmodel.Conversation]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "ThreadID,AccountID,OtherAccountsKey"},
+			{Fields: "AccountID,LastStatusID"},
+			{Fields: "AccountID", Multiple: true},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initDomainAllow() {
 	c.GTS.DomainAllow = new(domain.Cache)
 }
@@ -431,6 +492,10 @@ func (c *Caches) initDomainBlock() {
 	c.GTS.DomainBlock = new(domain.Cache)
 }
 
+func (c *Caches) initDomainLimit() {
+	c.GTS.DomainLimit = new(domain.Cache)
+}
+
 func (c *Caches) initEmoji() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -493,6 +558,40 @@ func (c *Caches) initEmojiCategory() {
 	})
 }
 
+func (c *Caches) initFeaturedTag() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofFeaturedTag(), // model in-mem size.
+		config.GetCacheFeaturedTagMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(t1 *gtsmodel.FeaturedTag) *gtsmodel.FeaturedTag {
+		t2 := new(gtsmodel.FeaturedTag)
+		*t2 = *t1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/featuredtag.go.
+		t2.Account = nil
+		t2.Tag = nil
+
+		return t2
+	}
+
+	c.GTS.FeaturedTag.Init(structr.CacheConfig[*gtsmodel.FeaturedTag]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "AccountID,TagID"},
+			{Fields: "AccountID", Multiple: true},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initFilter() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -693,6 +792,17 @@ func (c *Caches) initFollowRequestIDs() {
 	c.GTS.FollowRequestIDs.Init(0, cap)
 }
 
+func (c *Caches) initFollowedTagIDs() {
+	// Calculate maximum cache size.
+	cap := calculateSliceCacheMax(
+		config.GetCacheFollowedTagIDsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.GTS.FollowedTagIDs.Init(0, cap)
+}
+
 func (c *Caches) initInReplyToIDs() {
 	// Calculate maximum cache size.
 	cap := calculateSliceCacheMax(
@@ -1032,6 +1142,39 @@ func (c *Caches) initPollVoteIDs() {
 	c.GTS.PollVoteIDs.Init(0, cap)
 }
 
+func (c *Caches) initPreviewCard() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofPreviewCard(), // model in-mem size.
+		config.GetCachePreviewCardMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(p1 *gtsmodel.PreviewCard) *gtsmodel.PreviewCard {
+		p2 := new(gtsmodel.PreviewCard)
+		*p2 = *p1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/previewcard.go.
+		p2.Image = nil
+
+		return p2
+	}
+
+	c.GTS.PreviewCard.Init(structr.CacheConfig[*gtsmodel.PreviewCard]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "URL"},
+			{Fields: "ImageAttachmentID"},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initReport() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1067,6 +1210,40 @@ func (c *Caches) initReport() {
 	})
 }
 
+func (c *Caches) initScheduledStatus() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofScheduledStatus(), // model in-mem size.
+		config.GetCacheScheduledStatusMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(s1 *gtsmodel.ScheduledStatus) *gtsmodel.ScheduledStatus {
+		s2 := new(gtsmodel.ScheduledStatus)
+		*s2 = *s1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/scheduledstatus.go.
+		s2.Account = nil
+		s2.MediaAttachments = nil
+		s2.Application = nil
+
+		return s2
+	}
+
+	c.GTS.ScheduledStatus.Init(structr.CacheConfig[*gtsmodel.ScheduledStatus]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "AccountID", Multiple: true},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initStatus() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1089,11 +1266,13 @@ func (c *Caches) initStatus() {
 		s2.BoostOf = nil
 		s2.BoostOfAccount = nil
 		s2.Poll = nil
+		s2.PreviewCard = nil
 		s2.Attachments = nil
 		s2.Tags = nil
 		s2.Mentions = nil
 		s2.Emojis = nil
 		s2.CreatedWithApplication = nil
+		s2.Edits = nil
 
 		return s2
 	}
@@ -1162,6 +1341,38 @@ func (c *Caches) initStatusBookmarkIDs() {
 	c.GTS.StatusBookmarkIDs.Init(0, cap)
 }
 
+func (c *Caches) initStatusEdit() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofStatusEdit(), // model in-mem size.
+		config.GetCacheStatusEditMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(s1 *gtsmodel.StatusEdit) *gtsmodel.StatusEdit {
+		s2 := new(gtsmodel.StatusEdit)
+		*s2 = *s1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/statusedit.go.
+		s2.Attachments = nil
+
+		return s2
+	}
+
+	c.GTS.StatusEdit.Init(structr.CacheConfig[*gtsmodel.StatusEdit]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "StatusID", Multiple: true},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initStatusFave() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1188,6 +1399,7 @@ func (c *Caches) initStatusFave() {
 	c.GTS.StatusFave.Init(structr.CacheConfig[*gtsmodel.StatusFave]{
 		Indices: []structr.IndexConfig{
 			{Fields: "ID"},
+			{Fields: "URI"},
 			{Fields: "AccountID,StatusID"},
 			{Fields: "StatusID", Multiple: true},
 		},
@@ -1398,6 +1610,33 @@ func (c *Caches) initUserMuteIDs() {
 	c.GTS.UserMuteIDs.Init(0, cap)
 }
 
+func (c *Caches) initWebPushSubscription() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofWebPushSubscription(), // model in-mem size.
+		config.GetCacheWebPushSubscriptionMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(s1 *gtsmodel.WebPushSubscription) *gtsmodel.WebPushSubscription {
+		s2 := new(gtsmodel.WebPushSubscription)
+		*s2 = *s1
+		return s2
+	}
+
+	c.GTS.WebPushSubscription.Init(structr.CacheConfig[*gtsmodel.WebPushSubscription]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "TokenID"},
+			{Fields: "AccountID", Multiple: true},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initWebfinger() {
 	// Calculate maximum cache size.
 	cap := calculateCacheMax(
@@ -19,6 +19,7 @@ package cache
 
 import (
 	"crypto/rsa"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -179,8 +180,10 @@ func totalOfRatios() float64 {
 		config.GetCacheBlockIDsMemRatio() +
 		config.GetCacheBoostOfIDsMemRatio() +
 		config.GetCacheClientMemRatio() +
+		config.GetCacheConversationMemRatio() +
 		config.GetCacheEmojiMemRatio() +
 		config.GetCacheEmojiCategoryMemRatio() +
+		config.GetCacheFeaturedTagMemRatio() +
 		config.GetCacheFilterMemRatio() +
 		config.GetCacheFilterKeywordMemRatio() +
 		config.GetCacheFilterStatusMemRatio() +
@@ -188,6 +191,7 @@ func totalOfRatios() float64 {
 		config.GetCacheFollowIDsMemRatio() +
 		config.GetCacheFollowRequestMemRatio() +
 		config.GetCacheFollowRequestIDsMemRatio() +
+		config.GetCacheFollowedTagIDsMemRatio() +
 		config.GetCacheInstanceMemRatio() +
 		config.GetCacheInReplyToIDsMemRatio() +
 		config.GetCacheListMemRatio() +
@@ -199,10 +203,13 @@ func totalOfRatios() float64 {
 		config.GetCacheNotificationMemRatio() +
 		config.GetCachePollMemRatio() +
 		config.GetCachePollVoteMemRatio() +
+		config.GetCachePreviewCardMemRatio() +
 		config.GetCacheReportMemRatio() +
+		config.GetCacheScheduledStatusMemRatio() +
 		config.GetCacheStatusMemRatio() +
 		config.GetCacheStatusBookmarkMemRatio() +
 		config.GetCacheStatusBookmarkIDsMemRatio() +
+		config.GetCacheStatusEditMemRatio() +
 		config.GetCacheStatusFaveMemRatio() +
 		config.GetCacheStatusFaveIDsMemRatio() +
 		config.GetCacheTagMemRatio() +
@@ -210,6 +217,7 @@ func totalOfRatios() float64 {
 		config.GetCacheTokenMemRatio() +
 		config.GetCacheTombstoneMemRatio() +
 		config.GetCacheUserMemRatio() +
+		config.GetCacheWebPushSubscriptionMemRatio() +
 		config.GetCacheWebfingerMemRatio() +
 		config.GetCacheVisibilityMemRatio()
 }
@@ -259,16 +267,22 @@ func sizeofAccountNote() uintptr {
 
 func sizeofAccountSettings() uintptr {
 	return uintptr(size.Of(&gtsmodel.AccountSettings{
-		AccountID:         exampleID,
-		CreatedAt:         exampleTime,
-		UpdatedAt:         exampleTime,
-		Privacy:           gtsmodel.VisibilityFollowersOnly,
-		Sensitive:         util.Ptr(true),
-		Language:          "fr",
-		StatusContentType: "text/plain",
-		CustomCSS:         exampleText,
-		EnableRSS:         util.Ptr(true),
-		HideCollections:   util.Ptr(false),
+		AccountID:                exampleID,
+		CreatedAt:                exampleTime,
+		UpdatedAt:                exampleTime,
+		Privacy:                  gtsmodel.VisibilityFollowersOnly,
+		Sensitive:                util.Ptr(true),
+		Language:                 "fr",
+		StatusContentType:        "text/plain",
+		CustomCSS:                exampleText,
+		EnableRSS:                util.Ptr(true),
+		HideCollections:          util.Ptr(false),
+		EmailNotifyMention:       util.Ptr(true),
+		EmailNotifyDirect:        util.Ptr(true),
+		EmailNotifyFollow:        util.Ptr(false),
+		EmailNotifyFollowRequest: util.Ptr(false),
+		EmailDigest:              gtsmodel.EmailDigestDaily,
+		EmailDigestAt:            exampleTime,
 	}))
 }
 
@@ -319,6 +333,20 @@ func sizeofClient() uintptr {
 	}))
 }
 
+func sizeofConversation() uintptr {
+	return uintptr(size.Of(&gtsmodel.Conversation{
+		ID:               exampleID,
+		CreatedAt:        exampleTime,
+		UpdatedAt:        exampleTime,
+		AccountID:        exampleID,
+		OtherAccountIDs:  []string{exampleID, exampleID, exampleID},
+		OtherAccountsKey: strings.Join([]string{exampleID, exampleID, exampleID}, ","),
+		ThreadID:         exampleID,
+		LastStatusID:     exampleID,
+		Read:             util.Ptr(true),
+	}))
+}
+
 func sizeofEmoji() uintptr {
 	return uintptr(size.Of(&gtsmodel.Emoji{
 		ID:                     exampleID,
@@ -352,6 +380,15 @@ func sizeofEmojiCategory() uintptr {
 	}))
 }
 
+func sizeofFeaturedTag() uintptr {
+	return uintptr(size.Of(&gtsmodel.FeaturedTag{
+		ID:        exampleID,
+		CreatedAt: exampleTime,
+		AccountID: exampleID,
+		TagID:     exampleID,
+	}))
+}
+
 func sizeofFilter() uintptr {
 	return uintptr(size.Of(&gtsmodel.Filter{
 		ID:        exampleID,
@@ -549,6 +586,25 @@ func sizeofPollVote() uintptr {
 	}))
 }
 
+func sizeofPreviewCard() uintptr {
+	return uintptr(size.Of(&gtsmodel.PreviewCard{
+		ID:                exampleID,
+		CreatedAt:         exampleTime,
+		UpdatedAt:         exampleTime,
+		FetchedAt:         exampleTime,
+		URL:               exampleURI,
+		Title:             exampleTextSmall,
+		Description:       exampleText,
+		Type:              gtsmodel.PreviewCardTypeLink,
+		ProviderName:      exampleUsername,
+		ProviderURL:       exampleURI,
+		Width:             640,
+		Height:            480,
+		ImageRemoteURL:    exampleURI,
+		ImageAttachmentID: exampleID,
+	}))
+}
+
 func sizeofReport() uintptr {
 	return uintptr(size.Of(&gtsmodel.Report{
 		ID:                     exampleID,
@@ -566,6 +622,25 @@ func sizeofReport() uintptr {
 	}))
 }
 
+func sizeofScheduledStatus() uintptr {
+	return uintptr(size.Of(&gtsmodel.ScheduledStatus{
+		ID:            exampleID,
+		CreatedAt:     exampleTime,
+		UpdatedAt:     exampleTime,
+		AccountID:     exampleID,
+		ScheduledAt:   exampleTime,
+		Text:          exampleText,
+		MediaIDs:      []string{exampleID, exampleID},
+		Sensitive:     func() *bool { ok := false; return &ok }(),
+		SpoilerText:   exampleText,
+		Visibility:    gtsmodel.VisibilityPublic,
+		Federated:     func() *bool { ok := true; return &ok }(),
+		InReplyToID:   exampleID,
+		Language:      "en",
+		ApplicationID: exampleID,
+	}))
+}
+
 func sizeofStatus() uintptr {
 	return uintptr(size.Of(&gtsmodel.Status{
 		ID:                       exampleID,
@@ -577,6 +652,7 @@ func sizeofStatus() uintptr {
 		TagIDs:                   []string{exampleID, exampleID, exampleID},
 		MentionIDs:               []string{},
 		EmojiIDs:                 []string{exampleID, exampleID, exampleID},
+		EditIDs:                  []string{exampleID, exampleID, exampleID},
 		CreatedAt:                exampleTime,
 		UpdatedAt:                exampleTime,
 		FetchedAt:                exampleTime,
@@ -594,9 +670,9 @@ func sizeofStatus() uintptr {
 		Language:                 "en",
 		CreatedWithApplicationID: exampleID,
 		Federated:                func() *bool { ok := true; return &ok }(),
-		Boostable:                func() *bool { ok := true; return &ok }(),
-		Replyable:                func() *bool { ok := true; return &ok }(),
-		Likeable:                 func() *bool { ok := true; return &ok }(),
+		InteractionPolicy:        gtsmodel.DefaultInteractionPolicyPublic(),
+		PendingApproval:          func() *bool { ok := false; return &ok }(),
+		ApprovedByURI:            exampleURI,
 		ActivityStreamsType:      ap.ObjectNote,
 	}))
 }
@@ -615,6 +691,23 @@ func sizeofStatusBookmark() uintptr {
 	}))
 }
 
+func sizeofStatusEdit() uintptr {
+	return uintptr(size.Of(&gtsmodel.StatusEdit{
+		ID:                     exampleID,
+		Content:                exampleText,
+		ContentWarning:         exampleUsername, // similar length
+		Text:                   exampleText,
+		Language:               "en",
+		Sensitive:              func() *bool { ok := false; return &ok }(),
+		AttachmentIDs:          []string{exampleID, exampleID, exampleID},
+		AttachmentDescriptions: []string{exampleText, exampleText, exampleText},
+		PollOptions:            []string{exampleTextSmall, exampleTextSmall, exampleTextSmall, exampleTextSmall},
+		PollVotes:              []int{69, 420, 1337, 1969},
+		StatusID:               exampleID,
+		CreatedAt:              exampleTime,
+	}))
+}
+
 func sizeofStatusFave() uintptr {
 	return uintptr(size.Of(&gtsmodel.StatusFave{
 		ID:              exampleID,
@@ -623,6 +716,8 @@ func sizeofStatusFave() uintptr {
 		TargetAccountID: exampleID,
 		StatusID:        exampleID,
 		URI:             exampleURI,
+		PendingApproval: func() *bool { ok := false; return &ok }(),
+		ApprovedByURI:   exampleURI,
 	}))
 }
 
@@ -667,6 +762,7 @@ func sizeofToken() uintptr {
 		Refresh:             "", // TODO: clients don't really support this very well yet
 		RefreshCreateAt:     exampleTime,
 		RefreshExpiresAt:    exampleTime,
+		LastUsed:            exampleTime,
 	}))
 }
 
@@ -727,3 +823,23 @@ func sizeofUserMute() uintptr {
 		Notifications:   util.Ptr(false),
 	}))
 }
+
+func sizeofWebPushSubscription() uintptr {
+	return uintptr(size.Of(&gtsmodel.WebPushSubscription{
+		ID:        exampleID,
+		CreatedAt: exampleTime,
+		UpdatedAt: exampleTime,
+		AccountID: exampleID,
+		TokenID:   exampleID,
+		Endpoint:  exampleURI,
+		Auth:      "BTBZMqHH6r4Tts7J_aSIgg",
+		P256dh:    "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		Alerts: gtsmodel.WebPushSubscriptionAlerts{
+			Follow:    true,
+			Favourite: true,
+			Mention:   true,
+			Reblog:    true,
+		},
+		Policy: gtsmodel.WebPushSubscriptionPolicyAll,
+	}))
+}
@@ -0,0 +1,223 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cards
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"codeberg.org/gruf/go-bytesize"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// maxPageSize is the most of a linked page that's
+	// read while looking for its metadata, which is
+	// expected to be in the <head> near its start.
+	maxPageSize = int64(1 * bytesize.MiB)
+
+	// maxOEmbedSize is the most of a
+	// linked page's oEmbed data read.
+	maxOEmbedSize = int64(64 * bytesize.KiB)
+
+	// maxTitleLen and maxDescriptionLen are
+	// the lengths in runes, beyond which card
+	// titles and descriptions are truncated.
+	maxTitleLen       = 256
+	maxDescriptionLen = 1024
+)
+
+// fetchCard fetches the page at link, returning a
+// new preview card made from its metadata, and the
+// absolute url of the card image, if there is one.
+func (f *Fetcher) fetchCard(ctx context.Context, link string) (*gtsmodel.PreviewCard, *url.URL, error) {
+	rsp, err := f.get(ctx, link, "text/html")
+	if err != nil {
+		return nil, nil, gtserror.Newf("error fetching %s: %w", link, err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, nil, gtserror.NewFromResponse(rsp)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(rsp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, nil, gtserror.Newf("unsupported content type %q at %s", mediaType, link)
+	}
+
+	// Relative urls in the page are
+	// resolved against its final url,
+	// after following any redirects.
+	base := rsp.Request.URL
+
+	// Parse page metadata from the start of body.
+	meta := parsePage(io.LimitReader(rsp.Body, maxPageSize), base)
+
+	card := &gtsmodel.PreviewCard{
+		FetchedAt:    time.Now(),
+		URL:          link,
+		Type:         gtsmodel.PreviewCardTypeLink,
+		Title:        meta.title,
+		Description:  meta.description,
+		AuthorName:   meta.author,
+		ProviderName: meta.siteName,
+		ProviderURL:  base.Scheme + "://" + base.Host,
+	}
+
+	imageURL := meta.image
+
+	if meta.oEmbed != nil {
+		// Page provides oEmbed data, this describes
+		// the page better than the page itself does.
+		oembed, err := f.fetchOEmbed(ctx, meta.oEmbed)
+		if err != nil {
+			log.Warnf(ctx, "error fetching oembed for %s: %v", link, err)
+		} else {
+			imageURL = oembed.apply(card, imageURL)
+		}
+	}
+
+	// Tidy up text fields, which may contain
+	// html (or escaped html) from the page.
+	card.Title = truncate(text.SanitizeToPlaintext(card.Title), maxTitleLen)
+	card.Description = truncate(text.SanitizeToPlaintext(card.Description), maxDescriptionLen)
+	card.AuthorName = truncate(text.SanitizeToPlaintext(card.AuthorName), maxTitleLen)
+	card.ProviderName = truncate(text.SanitizeToPlaintext(card.ProviderName), maxTitleLen)
+
+	if card.Title == "" {
+		return nil, nil, gtserror.Newf("no title found at %s", link)
+	}
+
+	if imageURL != nil {
+		card.ImageRemoteURL = imageURL.String()
+	}
+
+	return card, imageURL, nil
+}
+
+// fetchOEmbed fetches and decodes the oEmbed data at the given url.
+func (f *Fetcher) fetchOEmbed(ctx context.Context, u *url.URL) (*oEmbed, error) {
+	rsp, err := f.get(ctx, u.String(), "application/json")
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+
+	var oembed oEmbed
+	dec := json.NewDecoder(io.LimitReader(rsp.Body, maxOEmbedSize))
+	if err := dec.Decode(&oembed); err != nil {
+		return nil, gtserror.Newf("error decoding oembed: %w", err)
+	}
+
+	return &oembed, nil
+}
+
+// storeImage fetches the image at the given url, and stores
+// and thumbnails it as a media attachment owned by the instance
+// account, setting it as the given card's image.
+func (f *Fetcher) storeImage(ctx context.Context, card *gtsmodel.PreviewCard, imageURL *url.URL) error {
+	instanceAcct, err := f.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("db error getting instance account: %w", err)
+	}
+
+	processing := f.media.PreProcessMedia(
+		func(ctx context.Context) (io.ReadCloser, int64, error) {
+			rsp, err := f.get(ctx, imageURL.String(), "image/*")
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if rsp.StatusCode != http.StatusOK {
+				_ = rsp.Body.Close()
+				return nil, 0, gtserror.NewFromResponse(rsp)
+			}
+
+			return rsp.Body, rsp.ContentLength, nil
+		},
+		instanceAcct.ID,
+		&media.AdditionalMediaInfo{
+			Description: util.Ptr(card.Title),
+		},
+	)
+
+	// Force image loading *right now*.
+	image, err := processing.LoadAttachment(ctx)
+	if err != nil {
+		// Any partially stored attachment
+		// is unused, so will be pruned.
+		return err
+	}
+
+	if image.Type != gtsmodel.FileTypeImage {
+		// Not an image, leave this
+		// unused attachment to be
+		// pruned by the cleaner.
+		return gtserror.Newf("card image %s was %s", imageURL, image.Type)
+	}
+
+	if card.Width == 0 && card.Height == 0 {
+		// Cards without embed dimensions
+		// get those of their image.
+		card.Width = image.FileMeta.Original.Width
+		card.Height = image.FileMeta.Original.Height
+	}
+
+	card.ImageAttachmentID = image.ID
+	card.Image = image
+	return nil
+}
+
+// get performs a GET request to the given url
+// with the given accept header, using the client.
+func (f *Fetcher) get(ctx context.Context, rawURL string, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", f.userAgent)
+	return f.client.Do(req)
+}
+
+// truncate truncates the given
+// string to at most n runes.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(r[:n-1])) + "…"
+}
@@ -0,0 +1,228 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// refetchAfter is how long a stored preview card
+// is reused for statuses linking to the same page,
+// before the page is fetched again to refresh it.
+const refetchAfter = 7 * 24 * time.Hour
+
+// Client is the HTTP client used to fetch linked
+// pages, their oEmbed data, and card images. In
+// production this should be an *httpclient.Client,
+// so that outgoing requests are protected by the
+// configured SSRF IP allow and block ranges.
+type Client interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Fetcher fetches, parses and stores link preview
+// cards from the OpenGraph and oEmbed metadata of
+// pages linked to in statuses.
+type Fetcher struct {
+	state     *state.State
+	client    Client
+	media     *media.Manager
+	userAgent string
+}
+
+// New returns a new preview card Fetcher,
+// using the given client for all requests,
+// and storing card images with mediaManager.
+func New(state *state.State, client Client, mediaManager *media.Manager) *Fetcher {
+	var (
+		host    = config.GetHost()
+		proto   = config.GetProtocol()
+		version = config.GetSoftwareVersion()
+	)
+
+	return &Fetcher{
+		state:     state,
+		client:    client,
+		media:     mediaManager,
+		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)", version, proto, host),
+	}
+}
+
+// FetchForStatus gets a preview card for the first link in the
+// content of the given status, ignoring mentions and hashtags,
+// and attaches it to the status, updating it in the database.
+//
+// If the status no longer has any link that a card can be
+// fetched for, any card previously attached is detached.
+// Boosts, and statuses with media attachments, never get cards.
+//
+// Only public and unlisted statuses get cards. Fetching a card
+// for a direct or followers / mutuals-only status would reveal
+// to the linked site that it was shared privately, and when.
+func (f *Fetcher) FetchForStatus(ctx context.Context, status *gtsmodel.Status) error {
+	var card *gtsmodel.PreviewCard
+
+	if status.BoostOfID == "" &&
+		len(status.AttachmentIDs) == 0 &&
+		(status.Visibility == gtsmodel.VisibilityPublic ||
+			status.Visibility == gtsmodel.VisibilityUnlocked) {
+		link, err := f.cardLink(ctx, status)
+		if err != nil {
+			return err
+		}
+
+		if link != "" {
+			card, err = f.GetCard(ctx, link)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var cardID string
+	if card != nil {
+		cardID = card.ID
+	}
+
+	// Set the card on the status.
+	status.PreviewCard = card
+
+	if status.PreviewCardID == cardID {
+		// Card unchanged, no
+		// need to update status.
+		return nil
+	}
+
+	status.PreviewCardID = cardID
+	if err := f.state.DB.UpdateStatus(ctx, status, "preview_card_id"); err != nil {
+		return gtserror.Newf("db error updating status %s: %w", status.ID, err)
+	}
+
+	return nil
+}
+
+// cardLink returns the first link in the content of the given
+// status that a preview card may be fetched for, else "". Links
+// to this instance, and to blocked domains, are never fetched.
+func (f *Fetcher) cardLink(ctx context.Context, status *gtsmodel.Status) (string, error) {
+	for _, link := range text.ExtractLinks(status.Content) {
+		u, err := url.Parse(link)
+		if err != nil {
+			continue
+		}
+
+		host := u.Hostname()
+		if host == config.GetHost() ||
+			host == config.GetAccountDomain() {
+			// Don't fetch cards
+			// for our own pages.
+			continue
+		}
+
+		blocked, err := f.state.DB.IsDomainBlocked(ctx, host)
+		if err != nil {
+			return "", gtserror.Newf("db error checking domain block for %s: %w", host, err)
+		}
+
+		if blocked {
+			continue
+		}
+
+		return link, nil
+	}
+
+	return "", nil
+}
+
+// GetCard returns the preview card for the page at the given
+// link, reusing a stored card if it was fetched recently enough,
+// otherwise fetching the page and storing or updating its card.
+func (f *Fetcher) GetCard(ctx context.Context, link string) (*gtsmodel.PreviewCard, error) {
+	existing, err := f.state.DB.GetPreviewCardByURL(ctx, link)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting preview card for %s: %w", link, err)
+	}
+
+	if existing != nil && time.Since(existing.FetchedAt) < refetchAfter {
+		// Recent enough, reuse it.
+		return existing, nil
+	}
+
+	// Fetch (possibly fresh) card metadata from page.
+	card, imageURL, err := f.fetchCard(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil &&
+		existing.Image != nil && *existing.Image.Cached &&
+		existing.ImageRemoteURL == card.ImageRemoteURL {
+		// Image is unchanged and
+		// cached, keep stored one.
+		card.ImageAttachmentID = existing.ImageAttachmentID
+		card.Image = existing.Image
+		imageURL = nil
+	}
+
+	if imageURL != nil {
+		// Store the (new) card image. Any previous image no
+		// longer used by the card will be pruned by cleaner.
+		if err := f.storeImage(ctx, card, imageURL); err != nil {
+			// Cards are still useful
+			// without an image, so just
+			// drop it and carry on.
+			card.ImageRemoteURL = ""
+			log.Warnf(ctx, "error storing preview card image for %s: %v", link, err)
+		}
+	}
+
+	if existing == nil {
+		card.ID = id.NewULID()
+		err := f.state.DB.PutPreviewCard(ctx, card)
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Another status linking the same page
+			// beat us to it, just use their card.
+			return f.state.DB.GetPreviewCardByURL(ctx, link)
+		} else if err != nil {
+			return nil, gtserror.Newf("db error putting preview card for %s: %w", link, err)
+		}
+	} else {
+		card.ID = existing.ID
+		card.CreatedAt = existing.CreatedAt
+		if err := f.state.DB.UpdatePreviewCard(ctx, card); err != nil {
+			return nil, gtserror.Newf("db error updating preview card for %s: %w", link, err)
+		}
+	}
+
+	return card, nil
+}
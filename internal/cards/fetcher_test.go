@@ -0,0 +1,290 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cards_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cards"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const testPage = `<!DOCTYPE html>
+<html>
+<head>
+	<title>Plain Title</title>
+	<meta property="og:title" content="Sloths &amp; You">
+	<meta property="og:description" content="Everything you ever wanted to know about sloths.">
+	<meta property="og:site_name" content="Sloth Facts">
+	<meta property="og:image" content="/images/sloth.jpg">
+	<meta name="author" content="A. Sloth">
+</head>
+<body>
+	<p>Sloths are slow.</p>
+</body>
+</html>`
+
+type FetcherTestSuite struct {
+	suite.Suite
+
+	db        db.DB
+	storage   *storage.Driver
+	state     state.State
+	fetcher   *cards.Fetcher
+	requested map[string]int
+
+	testStatuses map[string]*gtsmodel.Status
+}
+
+func (suite *FetcherTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.storage = testrig.NewInMemoryStorage()
+	suite.state.DB = suite.db
+	suite.state.Storage = suite.storage
+
+	testrig.StandardStorageSetup(suite.storage, "../../testrig/media")
+	testrig.StandardDBSetup(suite.db, nil)
+
+	suite.testStatuses = testrig.NewTestStatuses()
+	suite.requested = make(map[string]int)
+
+	sloth, err := os.ReadFile("../../testrig/media/sloth-original.jpg")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	client := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		suite.requested[req.URL.String()]++
+
+		var (
+			status      = http.StatusOK
+			contentType string
+			body        []byte
+		)
+
+		switch req.URL.String() {
+		case "https://example.org/sloths":
+			contentType = "text/html; charset=utf-8"
+			body = []byte(testPage)
+		case "https://example.org/images/sloth.jpg":
+			contentType = "image/jpeg"
+			body = sloth
+		default:
+			status = http.StatusNotFound
+			body = []byte("not found")
+		}
+
+		return &http.Response{
+			StatusCode:    status,
+			Request:       req,
+			Header:        http.Header{"Content-Type": {contentType}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+		}, nil
+	}, "")
+
+	suite.fetcher = cards.New(&suite.state, client, testrig.NewTestMediaManager(&suite.state))
+}
+
+func (suite *FetcherTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StandardStorageTeardown(suite.storage)
+	testrig.StopWorkers(&suite.state)
+}
+
+// linkStatus returns a copy of the given test status,
+// with the given html content, as a status without
+// any attachments that a card may be fetched for.
+func (suite *FetcherTestSuite) linkStatus(key string, content string) *gtsmodel.Status {
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses[key]
+	status.Content = content
+	status.AttachmentIDs = nil
+	status.Attachments = nil
+	return status
+}
+
+func (suite *FetcherTestSuite) TestFetchForStatus() {
+	ctx := context.Background()
+	status := suite.linkStatus(
+		"local_account_1_status_1",
+		`<p>check this out: <a href="https://example.org/sloths" rel="nofollow noreferrer noopener" target="_blank">https://example.org/sloths</a></p>`,
+	)
+
+	if err := suite.fetcher.FetchForStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	card := status.PreviewCard
+	if card == nil {
+		suite.FailNow("expected status to have a preview card")
+	}
+
+	suite.Equal(card.ID, status.PreviewCardID)
+	suite.Equal("https://example.org/sloths", card.URL)
+	suite.Equal(gtsmodel.PreviewCardTypeLink, card.Type)
+	suite.Equal("Sloths & You", card.Title)
+	suite.Equal("Everything you ever wanted to know about sloths.", card.Description)
+	suite.Equal("A. Sloth", card.AuthorName)
+	suite.Equal("Sloth Facts", card.ProviderName)
+	suite.Equal("https://example.org", card.ProviderURL)
+	suite.Equal("https://example.org/images/sloth.jpg", card.ImageRemoteURL)
+
+	// Card image should be stored.
+	image := card.Image
+	if image == nil {
+		suite.FailNow("expected preview card to have an image")
+	}
+	suite.Equal(card.ImageAttachmentID, image.ID)
+	suite.Equal(gtsmodel.FileTypeImage, image.Type)
+	suite.True(*image.Cached)
+	suite.Equal(image.FileMeta.Original.Width, card.Width)
+	suite.Equal(image.FileMeta.Original.Height, card.Height)
+
+	// Status should be updated in the db.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(card.ID, dbStatus.PreviewCardID)
+	suite.NotNil(dbStatus.PreviewCard)
+}
+
+func (suite *FetcherTestSuite) TestFetchForStatusReusesCard() {
+	ctx := context.Background()
+	content := `<p><a href="https://example.org/sloths" rel="nofollow noreferrer noopener" target="_blank">https://example.org/sloths</a></p>`
+
+	status1 := suite.linkStatus("local_account_1_status_1", content)
+	if err := suite.fetcher.FetchForStatus(ctx, status1); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status2 := suite.linkStatus("local_account_2_status_1", content)
+	if err := suite.fetcher.FetchForStatus(ctx, status2); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Both statuses should share one card,
+	// with the page only fetched the once.
+	suite.NotEmpty(status1.PreviewCardID)
+	suite.Equal(status1.PreviewCardID, status2.PreviewCardID)
+	suite.Equal(1, suite.requested["https://example.org/sloths"])
+}
+
+func (suite *FetcherTestSuite) TestFetchForStatusIgnoresMentionsAndHashtags() {
+	ctx := context.Background()
+	status := suite.linkStatus(
+		"local_account_1_status_1",
+		`<p><span class="h-card"><a href="https://example.org/@someone" class="u-url mention">@<span>someone</span></a></span> <a href="https://example.org/tags/sloths" class="mention hashtag" rel="tag">#<span>sloths</span></a></p>`,
+	)
+
+	if err := suite.fetcher.FetchForStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Nil(status.PreviewCard)
+	suite.Empty(status.PreviewCardID)
+	suite.Empty(suite.requested)
+}
+
+func (suite *FetcherTestSuite) TestFetchForStatusNotPublic() {
+	ctx := context.Background()
+
+	for _, visibility := range []gtsmodel.Visibility{
+		gtsmodel.VisibilityDirect,
+		gtsmodel.VisibilityMutualsOnly,
+		gtsmodel.VisibilityFollowersOnly,
+	} {
+		status := suite.linkStatus(
+			"local_account_1_status_1",
+			`<p><a href="https://example.org/sloths" rel="nofollow noreferrer noopener" target="_blank">https://example.org/sloths</a></p>`,
+		)
+		status.Visibility = visibility
+
+		if err := suite.fetcher.FetchForStatus(ctx, status); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Nil(status.PreviewCard, visibility)
+		suite.Empty(status.PreviewCardID, visibility)
+	}
+
+	// Linked page should never
+	// have been requested.
+	suite.Empty(suite.requested)
+}
+
+func (suite *FetcherTestSuite) TestFetchForStatusDetachesCard() {
+	ctx := context.Background()
+	status := suite.linkStatus(
+		"local_account_1_status_1",
+		`<p><a href="https://example.org/sloths" rel="nofollow noreferrer noopener" target="_blank">https://example.org/sloths</a></p>`,
+	)
+
+	if err := suite.fetcher.FetchForStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(status.PreviewCardID)
+
+	// Edit the link out of the status.
+	status.Content = "<p>never mind</p>"
+	if err := suite.fetcher.FetchForStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Nil(status.PreviewCard)
+	suite.Empty(status.PreviewCardID)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbStatus.PreviewCardID)
+}
+
+func (suite *FetcherTestSuite) TestGetCardNotFound() {
+	ctx := context.Background()
+
+	card, err := suite.fetcher.GetCard(ctx, "https://example.org/nothing-here")
+	suite.Nil(card)
+	suite.Error(err)
+
+	// Nothing should be stored.
+	_, err = suite.db.GetPreviewCardByURL(ctx, "https://example.org/nothing-here")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestFetcherTestSuite(t *testing.T) {
+	suite.Run(t, new(FetcherTestSuite))
+}
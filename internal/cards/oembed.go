@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cards
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// oEmbed models the json oEmbed response of
+// a linked page. See: https://oembed.com/
+type oEmbed struct {
+	Type         string     `json:"type"`
+	Title        string     `json:"title"`
+	AuthorName   string     `json:"author_name"`
+	AuthorURL    string     `json:"author_url"`
+	ProviderName string     `json:"provider_name"`
+	ProviderURL  string     `json:"provider_url"`
+	HTML         string     `json:"html"`
+	URL          string     `json:"url"`
+	Width        oEmbedSize `json:"width"`
+	Height       oEmbedSize `json:"height"`
+	ThumbnailURL string     `json:"thumbnail_url"`
+}
+
+// apply sets the oEmbed data on the given card, returning
+// the url of the card image to use, given the image url
+// found in the page itself. Embedded html is sanitized
+// so that only https iframes remain.
+func (o *oEmbed) apply(card *gtsmodel.PreviewCard, image *url.URL) *url.URL {
+	base, _ := url.Parse(card.URL)
+
+	switch o.Type {
+	case "photo":
+		if u := resolve(base, o.URL); u != nil {
+			card.Type = gtsmodel.PreviewCardTypePhoto
+			card.EmbedURL = u.String()
+			if image == nil {
+				// Photo is the best
+				// image we've got.
+				image = u
+			}
+		}
+
+	case "video", "rich":
+		if html := text.SanitizeEmbed(o.HTML); html != "" {
+			card.Type = gtsmodel.PreviewCardType(o.Type)
+			card.HTML = html
+		}
+	}
+
+	if card.Type != gtsmodel.PreviewCardTypeLink {
+		// Dimensions are of
+		// the embedded media.
+		card.Width = int(o.Width)
+		card.Height = int(o.Height)
+	}
+
+	if o.Title != "" {
+		card.Title = o.Title
+	}
+
+	if o.AuthorName != "" {
+		card.AuthorName = o.AuthorName
+	}
+
+	if u := resolve(base, o.AuthorURL); u != nil {
+		card.AuthorURL = u.String()
+	}
+
+	if o.ProviderName != "" {
+		card.ProviderName = o.ProviderName
+	}
+
+	if u := resolve(base, o.ProviderURL); u != nil {
+		card.ProviderURL = u.String()
+	}
+
+	if u := resolve(base, o.ThumbnailURL); u != nil {
+		// oEmbed thumbnails are
+		// made for previewing.
+		image = u
+	}
+
+	return image
+}
+
+// oEmbedSize is an oEmbed width or height, which some
+// providers give as a string rather than as a number.
+// Any value that isn't a whole number of pixels is
+// treated as unknown, i.e. zero.
+type oEmbedSize int
+
+func (s *oEmbedSize) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case float64:
+		*s = oEmbedSize(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		*s = oEmbedSize(i)
+	}
+
+	if *s < 0 {
+		*s = 0
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cards
+
+import (
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// pageMeta contains the preview
+// card metadata of a linked page.
+type pageMeta struct {
+	title       string
+	description string
+	author      string
+	siteName    string
+	image       *url.URL // absolute url of page image
+	oEmbed      *url.URL // absolute url of json oEmbed
+}
+
+// parsePage parses the preview card metadata from the
+// <head> of the given html page, preferring OpenGraph
+// tags, then Twitter card tags, then plain html tags.
+// Relative urls in the page are resolved against base.
+func parsePage(r io.Reader, base *url.URL) *pageMeta {
+	var (
+		z       = html.NewTokenizer(r)
+		props   = make(map[string]string)
+		title   string
+		inTitle bool
+		meta    = new(pageMeta)
+	)
+
+	// setProp sets the given metadata
+	// property, keeping the first value
+	// found in the page for each.
+	setProp := func(key, val string) {
+		key = strings.ToLower(strings.TrimSpace(key))
+		val = strings.TrimSpace(val)
+		if key == "" || val == "" {
+			return
+		}
+		if _, ok := props[key]; !ok {
+			props[key] = val
+		}
+	}
+
+loop:
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// End of page,
+			// or invalid HTML.
+			break loop
+
+		case html.TextToken:
+			if inTitle {
+				title += string(z.Text())
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch string(name) {
+			case "title":
+				inTitle = false
+			case "head":
+				// All metadata
+				// should be in
+				// the head.
+				break loop
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			switch t.Data {
+			case "body":
+				// Head is over.
+				break loop
+
+			case "title":
+				inTitle = (tt == html.StartTagToken)
+
+			case "meta":
+				// OpenGraph uses "property",
+				// but "name" is also common.
+				key := attr(t, "property")
+				if key == "" {
+					key = attr(t, "name")
+				}
+				setProp(key, attr(t, "content"))
+
+			case "link":
+				rel := strings.Fields(strings.ToLower(attr(t, "rel")))
+				if meta.oEmbed == nil && slices.Contains(rel, "alternate") &&
+					strings.EqualFold(attr(t, "type"), "application/json+oembed") {
+					meta.oEmbed = resolve(base, attr(t, "href"))
+				}
+			}
+		}
+	}
+
+	meta.title = first(props["og:title"], props["twitter:title"], strings.TrimSpace(title))
+	meta.description = first(props["og:description"], props["twitter:description"], props["description"])
+	meta.author = first(props["author"], props["twitter:creator"])
+	meta.siteName = first(props["og:site_name"], props["application-name"])
+
+	imageStr := first(
+		props["og:image:secure_url"],
+		props["og:image:url"],
+		props["og:image"],
+		props["twitter:image"],
+		props["twitter:image:src"],
+	)
+	if imageStr != "" {
+		meta.image = resolve(base, imageStr)
+	}
+
+	return meta
+}
+
+// attr returns the value of
+// the given attribute of t.
+func attr(t html.Token, key string) string {
+	for _, a := range t.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// resolve returns ref resolved against base, if
+// the result is an http(s) url, otherwise nil.
+func resolve(base *url.URL, ref string) *url.URL {
+	u, err := base.Parse(strings.TrimSpace(ref))
+	if err != nil || u.Host == "" ||
+		(u.Scheme != "http" && u.Scheme != "https") {
+		return nil
+	}
+	return u
+}
+
+// first returns the first non-empty string.
+func first(strs ...string) string {
+	for _, s := range strs {
+		if s != "" {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cards
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func TestParsePageTwitterFallback(t *testing.T) {
+	const page = `<html><head>
+<title>  Page Title  </title>
+<meta name="twitter:title" content="Twitter Title">
+<meta name="description" content="Plain description">
+<meta name="twitter:image" content="https://cdn.example.org/image.png">
+<link rel="alternate" type="application/json+oembed" href="/oembed?url=x">
+</head><body><meta property="og:title" content="Too Late"></body></html>`
+
+	base, _ := url.Parse("https://example.org/some/page")
+	meta := parsePage(strings.NewReader(page), base)
+
+	if meta.title != "Twitter Title" {
+		t.Errorf("unexpected title %q", meta.title)
+	}
+
+	if meta.description != "Plain description" {
+		t.Errorf("unexpected description %q", meta.description)
+	}
+
+	if meta.image == nil || meta.image.String() != "https://cdn.example.org/image.png" {
+		t.Errorf("unexpected image %v", meta.image)
+	}
+
+	if meta.oEmbed == nil || meta.oEmbed.String() != "https://example.org/oembed?url=x" {
+		t.Errorf("unexpected oembed %v", meta.oEmbed)
+	}
+}
+
+func TestParsePageTitleOnly(t *testing.T) {
+	const page = `<html><head><title>Just a Title</title>
+<meta property="og:image" content="javascript:alert(1)">
+</head></html>`
+
+	base, _ := url.Parse("https://example.org/")
+	meta := parsePage(strings.NewReader(page), base)
+
+	if meta.title != "Just a Title" {
+		t.Errorf("unexpected title %q", meta.title)
+	}
+
+	if meta.image != nil {
+		t.Errorf("expected no image, got %v", meta.image)
+	}
+}
+
+func TestOEmbedApplyVideo(t *testing.T) {
+	const data = `{
+	"type": "video",
+	"title": "A Video",
+	"author_name": "Someone",
+	"author_url": "/@someone",
+	"provider_name": "Videos",
+	"html": "<iframe src=\"https://videos.example.org/embed/1\" width=\"560\" height=\"315\" onload=\"alert(1)\"></iframe><script>alert(1)</script>",
+	"width": "560",
+	"height": 315,
+	"thumbnail_url": "https://videos.example.org/thumb/1.jpg"
+}`
+
+	var o oEmbed
+	if err := json.Unmarshal([]byte(data), &o); err != nil {
+		t.Fatal(err)
+	}
+
+	card := &gtsmodel.PreviewCard{
+		URL:   "https://videos.example.org/watch/1",
+		Type:  gtsmodel.PreviewCardTypeLink,
+		Title: "Page Title",
+	}
+
+	image := o.apply(card, nil)
+
+	if card.Type != gtsmodel.PreviewCardTypeVideo {
+		t.Errorf("unexpected type %q", card.Type)
+	}
+
+	if card.HTML != `<iframe src="https://videos.example.org/embed/1" width="560" height="315"></iframe>` {
+		t.Errorf("unexpected html %q", card.HTML)
+	}
+
+	if card.Width != 560 || card.Height != 315 {
+		t.Errorf("unexpected dimensions %dx%d", card.Width, card.Height)
+	}
+
+	if card.Title != "A Video" {
+		t.Errorf("unexpected title %q", card.Title)
+	}
+
+	if card.AuthorURL != "https://videos.example.org/@someone" {
+		t.Errorf("unexpected author url %q", card.AuthorURL)
+	}
+
+	if image == nil || image.String() != "https://videos.example.org/thumb/1.jpg" {
+		t.Errorf("unexpected image %v", image)
+	}
+}
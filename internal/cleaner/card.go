@@ -0,0 +1,186 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// Card encompasses a set of
+// preview card cleanup utils.
+type Card struct{ *Cleaner }
+
+// All will execute all cleaner.Card utilities synchronously, including output logging.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (c *Card) All(ctx context.Context, maxRemoteDays int) {
+	t := time.Now().Add(-24 * time.Hour * time.Duration(maxRemoteDays))
+	c.LogPruneUnused(ctx)
+	c.LogUncacheImages(ctx, t)
+}
+
+// LogPruneUnused performs Card.PruneUnused(...), logging the start and outcome.
+func (c *Card) LogPruneUnused(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := c.PruneUnused(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "pruned: %d", n)
+	}
+}
+
+// LogUncacheImages performs Card.UncacheImages(...), logging the start and outcome.
+func (c *Card) LogUncacheImages(ctx context.Context, olderThan time.Time) {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	if n, err := c.UncacheImages(ctx, olderThan); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "uncached: %d", n)
+	}
+}
+
+// PruneUnused will delete all preview cards not attached to any status,
+// along with their images, from the database and storage driver.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (c *Card) PruneUnused(ctx context.Context) (int, error) {
+	return c.forEach(ctx, c.pruneUnused)
+}
+
+// UncacheImages will uncache the images of all preview cards last fetched before given
+// input time. Images are stored again if the card is refetched for a newly linking status.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (c *Card) UncacheImages(ctx context.Context, olderThan time.Time) (int, error) {
+	return c.forEach(ctx, func(ctx context.Context, card *gtsmodel.PreviewCard) (bool, error) {
+		return c.uncacheImage(ctx, olderThan, card)
+	})
+}
+
+// forEach calls fn for every preview card in the database,
+// returning the number of cards that fn acted upon.
+func (c *Card) forEach(
+	ctx context.Context,
+	fn func(context.Context, *gtsmodel.PreviewCard) (bool, error),
+) (int, error) {
+	var (
+		total int
+		page  paging.Page
+	)
+
+	// Set page select limit.
+	page.Limit = selectLimit
+
+	for {
+		// Fetch the next batch of preview cards to next maxID.
+		cards, err := c.state.DB.GetPreviewCards(ctx, &page)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting preview cards: %w", err)
+		}
+
+		// Get current max ID.
+		maxID := page.Max.Value
+
+		// If no cards or the same group is returned, we reached the end.
+		if len(cards) == 0 || maxID == cards[len(cards)-1].ID {
+			break
+		}
+
+		// Use last ID as the next 'maxID' value.
+		maxID = cards[len(cards)-1].ID
+		page.Max = paging.MaxID(maxID)
+
+		for _, card := range cards {
+			// Check / act on each card.
+			done, err := fn(ctx, card)
+			if err != nil {
+				return total, err
+			}
+
+			if done {
+				// Update
+				// count.
+				total++
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func (c *Card) pruneUnused(ctx context.Context, card *gtsmodel.PreviewCard) (bool, error) {
+	// Start a log entry for card.
+	l := log.WithContext(ctx).
+		WithField("card", card.ID)
+
+	// Check whether any status still uses this card.
+	used, err := c.state.DB.IsPreviewCardUsed(ctx, card.ID)
+	if err != nil {
+		return false, gtserror.Newf("error checking preview card use: %w", err)
+	}
+
+	if used {
+		l.Debug("skipping as attached to status")
+		return false, nil
+	}
+
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return true, nil
+	}
+
+	// Delete the card entirely from the database.
+	l.Debug("deleting unused preview card")
+	if err := c.state.DB.DeletePreviewCardByID(ctx, card.ID); err != nil {
+		return false, gtserror.Newf("error deleting preview card: %w", err)
+	}
+
+	if card.Image != nil {
+		// Then delete its
+		// now unused image.
+		if err := c.media.delete(ctx, card.Image); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (c *Card) uncacheImage(ctx context.Context, olderThan time.Time, card *gtsmodel.PreviewCard) (bool, error) {
+	if card.Image == nil || !*card.Image.Cached {
+		// No image to uncache.
+		return false, nil
+	}
+
+	if card.FetchedAt.After(olderThan) {
+		// Recently fetched card.
+		return false, nil
+	}
+
+	// This card is too old, uncache its image.
+	log.WithContext(ctx).
+		WithField("card", card.ID).
+		Debug("uncaching old preview card image")
+	return true, c.media.uncache(ctx, card.Image)
+}
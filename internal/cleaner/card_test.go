@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// putCard puts a new preview card for the given url in
+// the database, with the given test attachment as image.
+func (suite *CleanerTestSuite) putCard(ctx context.Context, id string, url string, image *gtsmodel.MediaAttachment) *gtsmodel.PreviewCard {
+	card := &gtsmodel.PreviewCard{
+		ID:                id,
+		FetchedAt:         time.Now().Add(-30 * 24 * time.Hour),
+		URL:               url,
+		Title:             "Some Page",
+		Type:              gtsmodel.PreviewCardTypeLink,
+		ImageRemoteURL:    "https://example.org/image.jpg",
+		ImageAttachmentID: image.ID,
+		Image:             image,
+	}
+
+	if err := suite.state.DB.PutPreviewCard(ctx, card); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return card
+}
+
+// useCard attaches the given preview card to the given test status.
+func (suite *CleanerTestSuite) useCard(ctx context.Context, status *gtsmodel.Status, card *gtsmodel.PreviewCard) {
+	status.PreviewCardID = card.ID
+	if err := suite.state.DB.UpdateStatus(ctx, status, "preview_card_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *CleanerTestSuite) TestCardPruneUnused() {
+	ctx := context.Background()
+	attachments := testrig.NewTestAttachments()
+	statuses := testrig.NewTestStatuses()
+
+	usedImage := attachments["local_account_1_unattached_1"]
+	unusedImage := attachments["local_account_1_status_4_attachment_2"]
+
+	used := suite.putCard(ctx, "01J5FPJ6Y41QF0FC2GWQ8YVQNK", "https://example.org/used", usedImage)
+	unused := suite.putCard(ctx, "01J5FPJDHD9R9XC41EGH1DX8X6", "https://example.org/unused", unusedImage)
+	suite.useCard(ctx, statuses["local_account_2_status_1"], used)
+
+	pruned, err := suite.cleaner.Card().PruneUnused(ctx)
+	suite.NoError(err)
+	suite.Equal(1, pruned)
+
+	// Used card and its image should remain.
+	_, err = suite.state.DB.GetPreviewCardByID(ctx, used.ID)
+	suite.NoError(err)
+	_, err = suite.state.DB.GetAttachmentByID(ctx, usedImage.ID)
+	suite.NoError(err)
+
+	// Unused card and its image should be gone.
+	_, err = suite.state.DB.GetPreviewCardByID(ctx, unused.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	_, err = suite.state.DB.GetAttachmentByID(ctx, unusedImage.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *CleanerTestSuite) TestCardPruneUnusedDryRun() {
+	ctx := context.Background()
+	attachments := testrig.NewTestAttachments()
+
+	unused := suite.putCard(ctx, "01J5FPJDHD9R9XC41EGH1DX8X6", "https://example.org/unused", attachments["local_account_1_unattached_1"])
+
+	pruned, err := suite.cleaner.Card().PruneUnused(gtscontext.SetDryRun(ctx))
+	suite.NoError(err)
+	suite.Equal(1, pruned)
+
+	// Card should remain.
+	_, err = suite.state.DB.GetPreviewCardByID(ctx, unused.ID)
+	suite.NoError(err)
+}
+
+func (suite *CleanerTestSuite) TestCardUncacheImages() {
+	ctx := context.Background()
+	attachments := testrig.NewTestAttachments()
+
+	card := suite.putCard(ctx, "01J5FPJ6Y41QF0FC2GWQ8YVQNK", "https://example.org/page", attachments["local_account_1_unattached_1"])
+
+	// Card was fetched 30 days
+	// ago, so is uncached for 7.
+	uncached, err := suite.cleaner.Card().UncacheImages(ctx, time.Now().Add(-7*24*time.Hour))
+	suite.NoError(err)
+	suite.Equal(1, uncached)
+
+	image, err := suite.state.DB.GetAttachmentByID(ctx, card.ImageAttachmentID)
+	suite.NoError(err)
+	suite.False(*image.Cached)
+
+	// But not for 60 days.
+	uncached, err = suite.cleaner.Card().UncacheImages(ctx, time.Now().Add(-60*24*time.Hour))
+	suite.NoError(err)
+	suite.Zero(uncached)
+}
+
+func (suite *CleanerTestSuite) TestMediaPruneUnusedSkipsCardImage() {
+	ctx := context.Background()
+	attachments := testrig.NewTestAttachments()
+	statuses := testrig.NewTestStatuses()
+
+	// Unattached media would usually be pruned,
+	// but not when it's the image of a used card.
+	image := attachments["local_account_1_unattached_1"]
+	card := suite.putCard(ctx, "01J5FPJ6Y41QF0FC2GWQ8YVQNK", "https://example.org/page", image)
+	suite.useCard(ctx, statuses["local_account_2_status_1"], card)
+
+	_, err := suite.cleaner.Media().PruneUnused(ctx)
+	suite.NoError(err)
+
+	_, err = suite.state.DB.GetAttachmentByID(ctx, image.ID)
+	suite.NoError(err)
+}
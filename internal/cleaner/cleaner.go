@@ -35,6 +35,7 @@ const (
 
 type Cleaner struct {
 	state *state.State
+	card  Card
 	emoji Emoji
 	media Media
 }
@@ -42,11 +43,17 @@ type Cleaner struct {
 func New(state *state.State) *Cleaner {
 	c := new(Cleaner)
 	c.state = state
+	c.card.Cleaner = c
 	c.emoji.Cleaner = c
 	c.media.Cleaner = c
 	return c
 }
 
+// Card returns the preview card set of cleaner utilities.
+func (c *Cleaner) Card() *Card {
+	return &c.card
+}
+
 // Emoji returns the emoji set of cleaner utilities.
 func (c *Cleaner) Emoji() *Emoji {
 	return &c.emoji
@@ -151,6 +158,7 @@ func (c *Cleaner) ScheduleJobs() error {
 		log.Info(ctx, "starting media clean")
 		c.Media().All(ctx, config.GetMediaRemoteCacheDays())
 		c.Emoji().All(ctx, config.GetMediaRemoteCacheDays())
+		c.Card().All(ctx, config.GetMediaRemoteCacheDays())
 		log.Infof(ctx, "finished media clean after %s", time.Since(start))
 	}
 
@@ -20,6 +20,7 @@ package cleaner
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -372,6 +373,49 @@ func (m *Media) pruneUnused(ctx context.Context, media *gtsmodel.MediaAttachment
 				return false, nil
 			}
 		}
+
+		// Check whether attached to a previous revision of status.
+		edits, err := m.state.DB.GetStatusEditsByIDs(
+			gtscontext.SetBarebones(ctx),
+			status.EditIDs,
+		)
+		if err != nil {
+			return false, gtserror.Newf("error fetching status edits: %w", err)
+		}
+
+		for _, edit := range edits {
+			if slices.Contains(edit.AttachmentIDs, media.ID) {
+				l.Debug("skippping as attached to status edit")
+				return false, nil
+			}
+		}
+	}
+
+	if media.ScheduledStatusID != "" {
+		// Check whether the scheduled status media is attached to still exists.
+		scheduledStatus, err := m.state.DB.GetScheduledStatusByID(
+			gtscontext.SetBarebones(ctx),
+			media.ScheduledStatusID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return false, gtserror.Newf("error fetching scheduled status by id %s: %w", media.ScheduledStatusID, err)
+		}
+
+		if scheduledStatus != nil && slices.Contains(scheduledStatus.MediaIDs, media.ID) {
+			l.Debug("skippping as attached to scheduled status")
+			return false, nil
+		}
+	}
+
+	// Check whether media is the image of a preview card.
+	if _, err := m.state.DB.GetPreviewCardByImageAttachmentID(
+		gtscontext.SetBarebones(ctx),
+		media.ID,
+	); err == nil {
+		l.Debug("skippping as preview card image")
+		return false, nil
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error fetching preview card by image id %s: %w", media.ID, err)
 	}
 
 	// Media totally unused, delete it.
@@ -58,13 +58,15 @@ var Defaults = Configuration{
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
 
-	InstanceFederationMode:         InstanceFederationModeDefault,
-	InstanceFederationSpamFilter:   false,
-	InstanceExposePeers:            false,
-	InstanceExposeSuspended:        false,
-	InstanceExposeSuspendedWeb:     false,
-	InstanceDeliverToSharedInboxes: true,
-	InstanceLanguages:              make(language.Languages, 0),
+	InstanceFederationMode:            InstanceFederationModeDefault,
+	InstanceFederationSpamFilter:      false,
+	InstanceExposePeers:               false,
+	InstanceExposeSuspended:           false,
+	InstanceExposeSuspendedWeb:        false,
+	InstanceDeliverToSharedInboxes:    true,
+	InstanceLanguages:                 make(language.Languages, 0),
+	InstanceSubscriptionsProcessFrom:  "23:00",        // 11pm,
+	InstanceSubscriptionsProcessEvery: 24 * time.Hour, // 1/day.
 
 	AccountsRegistrationOpen: false,
 	AccountsReasonRequired:   true,
@@ -90,6 +92,8 @@ var Defaults = Configuration{
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesScheduledMaxTotal:  300,
+	StatusesScheduledMaxDaily:  25,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
@@ -156,51 +160,58 @@ var Defaults = Configuration{
 		// when TODO items in the size.go source
 		// file have been addressed, these should
 		// be able to make some more sense :D
-		AccountMemRatio:           5,
-		AccountNoteMemRatio:       1,
-		AccountSettingsMemRatio:   0.1,
-		AccountStatsMemRatio:      2,
-		ApplicationMemRatio:       0.1,
-		BlockMemRatio:             2,
-		BlockIDsMemRatio:          3,
-		BoostOfIDsMemRatio:        3,
-		ClientMemRatio:            0.1,
-		EmojiMemRatio:             3,
-		EmojiCategoryMemRatio:     0.1,
-		FilterMemRatio:            0.5,
-		FilterKeywordMemRatio:     0.5,
-		FilterStatusMemRatio:      0.5,
-		FollowMemRatio:            2,
-		FollowIDsMemRatio:         4,
-		FollowRequestMemRatio:     2,
-		FollowRequestIDsMemRatio:  2,
-		InReplyToIDsMemRatio:      3,
-		InstanceMemRatio:          1,
-		ListMemRatio:              1,
-		ListEntryMemRatio:         2,
-		MarkerMemRatio:            0.5,
-		MediaMemRatio:             4,
-		MentionMemRatio:           2,
-		MoveMemRatio:              0.1,
-		NotificationMemRatio:      2,
-		PollMemRatio:              1,
-		PollVoteMemRatio:          2,
-		PollVoteIDsMemRatio:       2,
-		ReportMemRatio:            1,
-		StatusMemRatio:            5,
-		StatusBookmarkMemRatio:    0.5,
-		StatusBookmarkIDsMemRatio: 2,
-		StatusFaveMemRatio:        2,
-		StatusFaveIDsMemRatio:     3,
-		TagMemRatio:               2,
-		ThreadMuteMemRatio:        0.2,
-		TokenMemRatio:             0.75,
-		TombstoneMemRatio:         0.5,
-		UserMemRatio:              0.25,
-		UserMuteMemRatio:          2,
-		UserMuteIDsMemRatio:       3,
-		WebfingerMemRatio:         0.1,
-		VisibilityMemRatio:        2,
+		AccountMemRatio:             5,
+		AccountNoteMemRatio:         1,
+		AccountSettingsMemRatio:     0.1,
+		AccountStatsMemRatio:        2,
+		ApplicationMemRatio:         0.1,
+		BlockMemRatio:               2,
+		BlockIDsMemRatio:            3,
+		BoostOfIDsMemRatio:          3,
+		ClientMemRatio:              0.1,
+		ConversationMemRatio:        1,
+		EmojiMemRatio:               3,
+		EmojiCategoryMemRatio:       0.1,
+		FeaturedTagMemRatio:         0.5,
+		FilterMemRatio:              0.5,
+		FilterKeywordMemRatio:       0.5,
+		FilterStatusMemRatio:        0.5,
+		FollowMemRatio:              2,
+		FollowIDsMemRatio:           4,
+		FollowRequestMemRatio:       2,
+		FollowRequestIDsMemRatio:    2,
+		FollowedTagIDsMemRatio:      1,
+		InReplyToIDsMemRatio:        3,
+		InstanceMemRatio:            1,
+		ListMemRatio:                1,
+		ListEntryMemRatio:           2,
+		MarkerMemRatio:              0.5,
+		MediaMemRatio:               4,
+		MentionMemRatio:             2,
+		MoveMemRatio:                0.1,
+		NotificationMemRatio:        2,
+		PollMemRatio:                1,
+		PollVoteMemRatio:            2,
+		PollVoteIDsMemRatio:         2,
+		PreviewCardMemRatio:         0.5,
+		ReportMemRatio:              1,
+		ScheduledStatusMemRatio:     0.5,
+		StatusMemRatio:              5,
+		StatusBookmarkMemRatio:      0.5,
+		StatusBookmarkIDsMemRatio:   2,
+		StatusEditMemRatio:          2,
+		StatusFaveMemRatio:          2,
+		StatusFaveIDsMemRatio:       3,
+		TagMemRatio:                 2,
+		ThreadMuteMemRatio:          0.2,
+		TokenMemRatio:               0.75,
+		TombstoneMemRatio:           0.5,
+		UserMemRatio:                0.25,
+		UserMuteMemRatio:            2,
+		UserMuteIDsMemRatio:         3,
+		WebPushSubscriptionMemRatio: 0.5,
+		WebfingerMemRatio:           0.1,
+		VisibilityMemRatio:          2,
 	},
 
 	HTTPClient: HTTPClientConfiguration{
@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Announcement handles getting/creation/deletion/updating of instance
+// announcements, and of the reactions and read state of accounts.
+type Announcement interface {
+	// GetAnnouncementByID gets one announcement by its db id.
+	GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error)
+
+	// GetAnnouncements gets all announcements,
+	// published or not, newest created first.
+	GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// GetPublishedAnnouncements gets all published
+	// announcements, oldest published first.
+	GetPublishedAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// PutAnnouncement puts the given announcement in the database.
+	PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error
+
+	// UpdateAnnouncement updates the given announcement by its db id.
+	// If no columns are given, all columns are updated.
+	UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error
+
+	// DeleteAnnouncementByID deletes one announcement by
+	// its db id, along with all its reactions and reads.
+	DeleteAnnouncementByID(ctx context.Context, id string) error
+
+	// GetAnnouncementReactions gets all reactions to
+	// the given announcement, oldest reaction first.
+	GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error)
+
+	// PutAnnouncementReaction puts the given announcement reaction in the database.
+	// Returns ErrAlreadyExists if the account has already reacted with the same name.
+	PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error
+
+	// DeleteAnnouncementReaction deletes the reaction with the given
+	// name by the given account to the given announcement, if any.
+	DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) error
+
+	// DeleteAnnouncementReactionsByAccountID deletes
+	// all announcement reactions by the given account.
+	DeleteAnnouncementReactionsByAccountID(ctx context.Context, accountID string) error
+
+	// IsAnnouncementRead returns whether the given
+	// account has read the given announcement.
+	IsAnnouncementRead(ctx context.Context, announcementID string, accountID string) (bool, error)
+
+	// PutAnnouncementRead marks the given announcement as read by
+	// the given account. Marking it read again is not an error.
+	PutAnnouncementRead(ctx context.Context, announcementID string, accountID string) error
+
+	// DeleteAnnouncementReadsByAccountID deletes the
+	// announcement read state of the given account.
+	DeleteAnnouncementReadsByAccountID(ctx context.Context, accountID string) error
+}
@@ -739,8 +739,12 @@ func (a *accountDB) PutAccount(ctx context.Context, account *gtsmodel.Account) e
 			}
 
 			// insert the account
-			_, err := tx.NewInsert().Model(account).Exec(ctx)
-			return err
+			if _, err := tx.NewInsert().Model(account).Exec(ctx); err != nil {
+				return err
+			}
+
+			// add the account to the full-text index
+			return indexAccount(ctx, tx, account)
 		})
 	})
 }
@@ -780,12 +784,20 @@ func (a *accountDB) UpdateAccount(ctx context.Context, account *gtsmodel.Account
 			}
 
 			// update the account
-			_, err := tx.NewUpdate().
+			if _, err := tx.NewUpdate().
 				Model(account).
 				Where("? = ?", bun.Ident("account.id"), account.ID).
 				Column(columns...).
-				Exec(ctx)
-			return err
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			if !needsReindex(columns, accountTextColumns) {
+				return nil
+			}
+
+			// update the account in the full-text index
+			return indexAccount(ctx, tx, account)
 		})
 	})
 }
@@ -814,6 +826,11 @@ func (a *accountDB) DeleteAccount(ctx context.Context, id string) error {
 			return err
 		}
 
+		// remove the account from the full-text index
+		if err := deindexAccount(ctx, tx, id); err != nil {
+			return err
+		}
+
 		// delete the account
 		_, err := tx.
 			NewDelete().
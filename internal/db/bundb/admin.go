@@ -21,6 +21,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/mail"
@@ -38,6 +39,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/uptrace/bun"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -295,18 +297,41 @@ func (a *adminDB) CreateInstanceInstance(ctx context.Context) error {
 	host := config.GetHost()
 
 	// check if instance entry already exists
-	q := a.db.
+	existing := new(gtsmodel.Instance)
+	err := a.db.
 		NewSelect().
-		Column("instance.id").
-		TableExpr("? AS ?", bun.Ident("instances"), bun.Ident("instance")).
-		Where("? = ?", bun.Ident("instance.domain"), host)
-
-	exists, err := exists(ctx, q)
-	if err != nil {
+		Model(existing).
+		Column("id", "vapid_public_key", "vapid_private_key").
+		Where("? = ?", bun.Ident("instance.domain"), host).
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return err
 	}
-	if exists {
+
+	if existing.ID != "" {
 		log.Infof(ctx, "instance entry already exists")
+
+		if existing.VAPIDPublicKey != "" && existing.VAPIDPrivateKey != "" {
+			return nil
+		}
+
+		// Instance entry predates Web
+		// Push support, generate its keys.
+		existing.VAPIDPublicKey, existing.VAPIDPrivateKey, err = webpush.GenerateVAPIDKeyPair()
+		if err != nil {
+			return err
+		}
+
+		if _, err := a.db.
+			NewUpdate().
+			Model(existing).
+			Column("vapid_public_key", "vapid_private_key").
+			Where("? = ?", bun.Ident("instance.id"), existing.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		log.Infof(ctx, "generated VAPID key pair for instance %s", host)
 		return nil
 	}
 
@@ -322,6 +347,11 @@ func (a *adminDB) CreateInstanceInstance(ctx context.Context) error {
 		URI:    fmt.Sprintf("%s://%s", protocol, host),
 	}
 
+	i.VAPIDPublicKey, i.VAPIDPrivateKey, err = webpush.GenerateVAPIDKeyPair()
+	if err != nil {
+		return err
+	}
+
 	insertQ := a.db.
 		NewInsert().
 		Model(i)
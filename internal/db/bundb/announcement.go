@@ -0,0 +1,196 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type announcementDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *announcementDB) GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error) {
+	var announcement gtsmodel.Announcement
+
+	if err := a.db.NewSelect().
+		Model(&announcement).
+		Where("? = ?", bun.Ident("announcement.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &announcement, nil
+}
+
+func (a *announcementDB) GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	announcements := make([]*gtsmodel.Announcement, 0)
+
+	if err := a.db.NewSelect().
+		Model(&announcements).
+		Order("announcement.id DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) GetPublishedAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	announcements := make([]*gtsmodel.Announcement, 0)
+
+	if err := a.db.NewSelect().
+		Model(&announcements).
+		Where("? IS NOT NULL", bun.Ident("announcement.published_at")).
+		Order("announcement.published_at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	_, err := a.db.NewInsert().
+		Model(announcement).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error {
+	announcement.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.NewUpdate().
+		Model(announcement).
+		Where("? = ?", bun.Ident("announcement.id"), announcement.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementByID(ctx context.Context, id string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete reactions to and reads of
+		// the announcement, then the thing.
+		for _, table := range []string{
+			"announcement_reactions",
+			"announcement_reads",
+		} {
+			if _, err := tx.NewDelete().
+				Table(table).
+				Where("? = ?", bun.Ident("announcement_id"), id).
+				Exec(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return err
+			}
+		}
+
+		if _, err := tx.NewDelete().
+			Table("announcements").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (a *announcementDB) GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error) {
+	reactions := make([]*gtsmodel.AnnouncementReaction, 0)
+
+	if err := a.db.NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), announcementID).
+		Order("announcement_reaction.id ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reactions, nil
+}
+
+func (a *announcementDB) PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error {
+	_, err := a.db.NewInsert().
+		Model(reaction).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) error {
+	if _, err := a.db.NewDelete().
+		Table("announcement_reactions").
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? = ?", bun.Ident("name"), name).
+		Exec(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
+
+func (a *announcementDB) DeleteAnnouncementReactionsByAccountID(ctx context.Context, accountID string) error {
+	if _, err := a.db.NewDelete().
+		Table("announcement_reactions").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
+
+func (a *announcementDB) IsAnnouncementRead(ctx context.Context, announcementID string, accountID string) (bool, error) {
+	return exists(ctx, a.db.NewSelect().
+		Table("announcement_reads").
+		Column("announcement_id").
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("account_id"), accountID),
+	)
+}
+
+func (a *announcementDB) PutAnnouncementRead(ctx context.Context, announcementID string, accountID string) error {
+	_, err := a.db.NewInsert().
+		Model(&gtsmodel.AnnouncementRead{
+			AccountID:      accountID,
+			AnnouncementID: announcementID,
+		}).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("announcement_id")).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementReadsByAccountID(ctx context.Context, accountID string) error {
+	if _, err := a.db.NewDelete().
+		Table("announcement_reads").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
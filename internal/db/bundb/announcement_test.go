@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type AnnouncementTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) TestGetAnnouncements() {
+	announcements, err := suite.state.DB.GetAnnouncements(context.Background())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Newest created first.
+	suite.Len(announcements, len(suite.testAnnouncements))
+	suite.Equal(suite.testAnnouncements["draft"].ID, announcements[0].ID)
+	suite.Equal(suite.testAnnouncements["published"].ID, announcements[1].ID)
+}
+
+func (suite *AnnouncementTestSuite) TestGetPublishedAnnouncements() {
+	announcements, err := suite.state.DB.GetPublishedAnnouncements(context.Background())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(announcements, 1)
+	suite.Equal(suite.testAnnouncements["published"].ID, announcements[0].ID)
+}
+
+func (suite *AnnouncementTestSuite) TestUpdateAnnouncement() {
+	ctx := context.Background()
+
+	announcement, err := suite.state.DB.GetAnnouncementByID(ctx, suite.testAnnouncements["draft"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	updatedAt := announcement.UpdatedAt
+
+	announcement.Text = "We're moving to a bigger server next week!"
+	if err := suite.state.DB.UpdateAnnouncement(ctx, announcement, "text"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbAnnouncement, err := suite.state.DB.GetAnnouncementByID(ctx, announcement.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(announcement.Text, dbAnnouncement.Text)
+	suite.True(dbAnnouncement.UpdatedAt.After(updatedAt))
+}
+
+func (suite *AnnouncementTestSuite) TestDeleteAnnouncement() {
+	var (
+		ctx          = context.Background()
+		announcement = suite.testAnnouncements["published"]
+		accountID    = suite.testAccounts["local_account_1"].ID
+	)
+
+	if err := suite.state.DB.PutAnnouncementRead(ctx, announcement.ID, accountID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.state.DB.DeleteAnnouncementByID(ctx, announcement.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.state.DB.GetAnnouncementByID(ctx, announcement.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Reactions and reads should be gone too.
+	reactions, err := suite.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(reactions)
+
+	read, err := suite.state.DB.IsAnnouncementRead(ctx, announcement.ID, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(read)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementReactions() {
+	var (
+		ctx          = context.Background()
+		announcement = suite.testAnnouncements["published"]
+		existing     = suite.testAnnouncementReactions["local_account_2_sloth"]
+	)
+
+	reactions, err := suite.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reactions, len(suite.testAnnouncementReactions))
+
+	// Reacting again with the same name is rejected.
+	err = suite.state.DB.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AnnouncementID: existing.AnnouncementID,
+		AccountID:      existing.AccountID,
+		Name:           existing.Name,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	if err := suite.state.DB.DeleteAnnouncementReaction(
+		ctx,
+		existing.AnnouncementID,
+		existing.AccountID,
+		existing.Name,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reactions, err = suite.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reactions, len(suite.testAnnouncementReactions)-1)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementRead() {
+	var (
+		ctx            = context.Background()
+		announcementID = suite.testAnnouncements["published"].ID
+		accountID      = suite.testAccounts["local_account_1"].ID
+	)
+
+	read, err := suite.state.DB.IsAnnouncementRead(ctx, announcementID, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(read)
+
+	// Marking read twice is fine.
+	for i := 0; i < 2; i++ {
+		if err := suite.state.DB.PutAnnouncementRead(ctx, announcementID, accountID); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	read, err = suite.state.DB.IsAnnouncementRead(ctx, announcementID, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(read)
+
+	if err := suite.state.DB.DeleteAnnouncementReadsByAccountID(ctx, accountID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	read, err = suite.state.DB.IsAnnouncementRead(ctx, announcementID, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(read)
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
@@ -54,11 +54,15 @@ import (
 type DBService struct {
 	db.Account
 	db.Admin
+	db.Announcement
 	db.Application
 	db.Basic
+	db.Conversation
 	db.Domain
 	db.Emoji
+	db.FeaturedTag
 	db.HeaderFilter
+	db.Import
 	db.Instance
 	db.Filter
 	db.List
@@ -68,19 +72,26 @@ type DBService struct {
 	db.Move
 	db.Notification
 	db.Poll
+	db.PreviewCard
 	db.Relationship
+	db.Relay
 	db.Report
 	db.Rule
+	db.ScheduledStatus
 	db.Search
 	db.Session
 	db.Status
 	db.StatusBookmark
+	db.StatusEdit
 	db.StatusFave
 	db.Tag
 	db.Thread
 	db.Timeline
+	db.Trend
 	db.User
 	db.Tombstone
+	db.WebPush
+	db.WorkerTask
 	db *bun.DB
 }
 
@@ -180,6 +191,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Announcement: &announcementDB{
+			db:    db,
+			state: state,
+		},
 		Application: &applicationDB{
 			db:    db,
 			state: state,
@@ -187,6 +202,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		Basic: &basicDB{
 			db: db,
 		},
+		Conversation: &conversationDB{
+			db:    db,
+			state: state,
+		},
 		Domain: &domainDB{
 			db:    db,
 			state: state,
@@ -195,10 +214,18 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		FeaturedTag: &featuredTagDB{
+			db:    db,
+			state: state,
+		},
 		HeaderFilter: &headerFilterDB{
 			db:    db,
 			state: state,
 		},
+		Import: &importDB{
+			db:    db,
+			state: state,
+		},
 		Instance: &instanceDB{
 			db:    db,
 			state: state,
@@ -235,10 +262,18 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		PreviewCard: &previewCardDB{
+			db:    db,
+			state: state,
+		},
 		Relationship: &relationshipDB{
 			db:    db,
 			state: state,
 		},
+		Relay: &relayDB{
+			db:    db,
+			state: state,
+		},
 		Report: &reportDB{
 			db:    db,
 			state: state,
@@ -247,6 +282,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		ScheduledStatus: &scheduledStatusDB{
+			db:    db,
+			state: state,
+		},
 		Search: &searchDB{
 			db:    db,
 			state: state,
@@ -262,6 +301,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusEdit: &statusEditDB{
+			db:    db,
+			state: state,
+		},
 		StatusFave: &statusFaveDB{
 			db:    db,
 			state: state,
@@ -278,6 +321,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Trend: &trendDB{
+			db:    db,
+			state: state,
+		},
 		User: &userDB{
 			db:    db,
 			state: state,
@@ -286,6 +333,13 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		WebPush: &webPushDB{
+			db:    db,
+			state: state,
+		},
+		WorkerTask: &workerTaskDB{
+			db: db,
+		},
 		db: db,
 	}
 
@@ -56,6 +56,9 @@ type BunDBStandardTestSuite struct {
 	testThreads      map[string]*gtsmodel.Thread
 	testPolls        map[string]*gtsmodel.Poll
 	testPollVotes    map[string]*gtsmodel.PollVote
+
+	testAnnouncements         map[string]*gtsmodel.Announcement
+	testAnnouncementReactions map[string]*gtsmodel.AnnouncementReaction
 }
 
 func (suite *BunDBStandardTestSuite) SetupSuite() {
@@ -81,6 +84,8 @@ func (suite *BunDBStandardTestSuite) SetupSuite() {
 	suite.testThreads = testrig.NewTestThreads()
 	suite.testPolls = testrig.NewTestPolls()
 	suite.testPollVotes = testrig.NewTestPollVotes()
+	suite.testAnnouncements = testrig.NewTestAnnouncements()
+	suite.testAnnouncementReactions = testrig.NewTestAnnouncementReactions()
 }
 
 func (suite *BunDBStandardTestSuite) SetupTest() {
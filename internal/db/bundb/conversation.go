@@ -0,0 +1,441 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type conversationDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (c *conversationDB) GetConversationByID(ctx context.Context, id string) (*gtsmodel.Conversation, error) {
+	return c.getConversation(
+		ctx,
+		"ID",
+		func(conversation *gtsmodel.Conversation) error {
+			return c.db.
+				NewSelect().
+				Model(conversation).
+				Where("? = ?", bun.Ident("id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (c *conversationDB) GetConversationByThreadAndAccountIDs(ctx context.Context, threadID string, accountID string, otherAccountIDs []string) (*gtsmodel.Conversation, error) {
+	otherAccountsKey := gtsmodel.ConversationOtherAccountsKey(otherAccountIDs)
+	return c.getConversation(
+		ctx,
+		"ThreadID,AccountID,OtherAccountsKey",
+		func(conversation *gtsmodel.Conversation) error {
+			return c.db.
+				NewSelect().
+				Model(conversation).
+				Where("? = ?", bun.Ident("thread_id"), threadID).
+				Where("? = ?", bun.Ident("account_id"), accountID).
+				Where("? = ?", bun.Ident("other_accounts_key"), otherAccountsKey).
+				Scan(ctx)
+		},
+		threadID,
+		accountID,
+		otherAccountsKey,
+	)
+}
+
+func (c *conversationDB) getConversation(
+	ctx context.Context,
+	lookup string,
+	dbQuery func(*gtsmodel.Conversation) error,
+	keyParts ...any,
+) (*gtsmodel.Conversation, error) {
+	// Fetch conversation from cache with loader callback
+	conversation, err := c.state.Caches.GTS.Conversation.LoadOne(lookup, func() (*gtsmodel.Conversation, error) {
+		var conversation gtsmodel.Conversation
+
+		// Not cached! Perform database query
+		if err := dbQuery(&conversation); err != nil {
+			return nil, err
+		}
+
+		return &conversation, nil
+	}, keyParts...)
+	if err != nil {
+		// already processed
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return conversation, nil
+	}
+
+	if err := c.PopulateConversation(ctx, conversation); err != nil {
+		log.Errorf(ctx, "error populating conversation: %v", err)
+	}
+
+	return conversation, nil
+}
+
+func (c *conversationDB) PopulateConversation(ctx context.Context, conversation *gtsmodel.Conversation) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	if conversation.Account == nil {
+		conversation.Account, err = c.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			conversation.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating conversation owner account: %w", err)
+		}
+	}
+
+	if !conversation.OtherAccountsPopulated() {
+		conversation.OtherAccounts, err = c.state.DB.GetAccountsByIDs(
+			gtscontext.SetBarebones(ctx),
+			conversation.OtherAccountIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating other conversation accounts: %w", err)
+		}
+	}
+
+	if conversation.LastStatus == nil && conversation.LastStatusID != "" {
+		conversation.LastStatus, err = c.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			conversation.LastStatusID,
+		)
+		if err != nil {
+			errs.Appendf("error populating conversation last status: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (c *conversationDB) GetConversationsByOwnerAccountID(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Conversation, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		conversationIDs = make([]string, 0, limit)
+	)
+
+	q := c.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+		// Select only IDs from table.
+		Column("conversation.id").
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID)
+
+	// Conversations are paged by their last status ID,
+	// such that the most recently active come first.
+
+	// Return only conversations with last
+	// status id lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("conversation.last_status_id"), maxID)
+	}
+
+	// Return only conversations with last
+	// status id greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("conversation.last_status_id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// conversations returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("conversation.last_status_id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("conversation.last_status_id"))
+	}
+
+	if err := q.Scan(ctx, &conversationIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no conversations early.
+	if len(conversationIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want conversations
+	// to be sorted by last status ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(conversationIDs)
+	}
+
+	return c.getConversationsByIDs(ctx, conversationIDs)
+}
+
+func (c *conversationDB) getConversationsByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Conversation, error) {
+	// Load all conversation IDs via cache loader callbacks.
+	conversations, err := c.state.Caches.GTS.Conversation.LoadIDs("ID",
+		ids,
+		func(uncached []string) ([]*gtsmodel.Conversation, error) {
+			// Preallocate expected length of uncached conversations.
+			conversations := make([]*gtsmodel.Conversation, 0, len(uncached))
+
+			// Perform database query scanning the
+			// remaining (uncached) conversation IDs.
+			if err := c.db.NewSelect().
+				Model(&conversations).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return conversations, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the conversations by their
+	// IDs to ensure in correct order.
+	getID := func(c *gtsmodel.Conversation) string { return c.ID }
+	util.OrderBy(conversations, ids, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return conversations, nil
+	}
+
+	// Populate all loaded conversations, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	conversations = slices.DeleteFunc(conversations, func(conversation *gtsmodel.Conversation) bool {
+		if err := c.PopulateConversation(ctx, conversation); err != nil {
+			log.Errorf(ctx, "error populating conversation %s: %v", conversation.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return conversations, nil
+}
+
+func (c *conversationDB) UpsertConversation(ctx context.Context, conversation *gtsmodel.Conversation, columns ...string) error {
+	conversation.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return c.state.Caches.GTS.Conversation.Store(conversation, func() error {
+		_, err := NewUpsert(c.db).
+			Model(conversation).
+			Constraint("id").
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (c *conversationDB) LinkConversationToStatus(ctx context.Context, conversationID string, statusID string) error {
+	conversationToStatus := &gtsmodel.ConversationToStatus{
+		ConversationID: conversationID,
+		StatusID:       statusID,
+	}
+
+	if _, err := c.db.NewInsert().
+		Model(conversationToStatus).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("conversation_id"), bun.Ident("status_id")).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *conversationDB) DeleteConversationByID(ctx context.Context, id string) error {
+	// Load conversation into cache before attempting a delete,
+	// as we need it cached in order to trigger the invalidate
+	// callback. This in turn invalidates others.
+	_, err := c.GetConversationByID(gtscontext.SetBarebones(ctx), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not an issue.
+			err = nil
+		}
+		return err
+	}
+
+	// Drop this now-cached conversation on return after delete.
+	defer c.state.Caches.GTS.Conversation.Invalidate("ID", id)
+
+	// Finally delete conversation (and its status links) from DB.
+	return c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Table("conversation_to_statuses").
+			Where("? = ?", bun.Ident("conversation_id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting conversation-status links: %w", err)
+		}
+
+		if _, err := tx.NewDelete().
+			Table("conversations").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting conversation: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (c *conversationDB) DeleteConversationsByOwnerAccountID(ctx context.Context, accountID string) error {
+	defer func() {
+		// Invalidate any cached conversations
+		// owned by this account on return.
+		c.state.Caches.GTS.Conversation.Invalidate("AccountID", accountID)
+	}()
+
+	return c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete links between conversations owned
+		// by this account and their statuses.
+		if _, err := tx.NewDelete().
+			Table("conversation_to_statuses").
+			Where("? IN (?)",
+				bun.Ident("conversation_id"),
+				tx.NewSelect().
+					Table("conversations").
+					Column("id").
+					Where("? = ?", bun.Ident("account_id"), accountID),
+			).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting conversation-status links: %w", err)
+		}
+
+		// Delete the conversations themselves.
+		if _, err := tx.NewDelete().
+			Table("conversations").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting conversations: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (c *conversationDB) DeleteStatusFromConversations(ctx context.Context, statusID string) error {
+	// Keep track of which conversations
+	// were touched so they can be invalidated.
+	var conversationIDs []string
+
+	defer func() {
+		// Invalidate any cached conversations
+		// touched by this operation on return.
+		c.state.Caches.GTS.Conversation.InvalidateIDs("ID", conversationIDs)
+	}()
+
+	return c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Select the IDs of all conversations this status is linked to.
+		if err := tx.NewSelect().
+			Table("conversation_to_statuses").
+			Column("conversation_id").
+			Where("? = ?", bun.Ident("status_id"), statusID).
+			Scan(ctx, &conversationIDs); err != nil {
+			return gtserror.Newf("error selecting conversation-status links: %w", err)
+		}
+
+		if len(conversationIDs) == 0 {
+			// Status isn't part
+			// of a conversation.
+			return nil
+		}
+
+		// Delete this status from conversation-status links.
+		if _, err := tx.NewDelete().
+			Table("conversation_to_statuses").
+			Where("? = ?", bun.Ident("status_id"), statusID).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting conversation-status links: %w", err)
+		}
+
+		for _, conversationID := range conversationIDs {
+			// Find the latest status ID
+			// remaining in this conversation.
+			var lastStatusIDs []string
+			if err := tx.NewSelect().
+				Table("conversation_to_statuses").
+				Column("status_id").
+				Where("? = ?", bun.Ident("conversation_id"), conversationID).
+				OrderExpr("? DESC", bun.Ident("status_id")).
+				Limit(1).
+				Scan(ctx, &lastStatusIDs); err != nil {
+				return gtserror.Newf("error selecting last status for conversation %s: %w", conversationID, err)
+			}
+
+			if len(lastStatusIDs) == 0 {
+				// No statuses left in this
+				// conversation, so delete it.
+				if _, err := tx.NewDelete().
+					Table("conversations").
+					Where("? = ?", bun.Ident("id"), conversationID).
+					Exec(ctx); err != nil {
+					return gtserror.Newf("error deleting conversation %s: %w", conversationID, err)
+				}
+				continue
+			}
+
+			// Point the conversation at the
+			// latest remaining status in it.
+			if _, err := tx.NewUpdate().
+				Table("conversations").
+				Set("? = ?", bun.Ident("last_status_id"), lastStatusIDs[0]).
+				Where("? = ?", bun.Ident("id"), conversationID).
+				Exec(ctx); err != nil {
+				return gtserror.Newf("error updating last status for conversation %s: %w", conversationID, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,174 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type ConversationTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// newConversation creates and links a conversation
+// for the given account from the given DM status.
+func (suite *ConversationTestSuite) newConversation(
+	ctx context.Context,
+	owner *gtsmodel.Account,
+	status *gtsmodel.Status,
+	otherAccountIDs []string,
+) *gtsmodel.Conversation {
+	conversation := &gtsmodel.Conversation{
+		ID:               id.NewULID(),
+		AccountID:        owner.ID,
+		OtherAccountIDs:  otherAccountIDs,
+		OtherAccountsKey: gtsmodel.ConversationOtherAccountsKey(otherAccountIDs),
+		ThreadID:         status.ThreadID,
+		LastStatusID:     status.ID,
+		Read:             util.Ptr(false),
+	}
+
+	if err := suite.db.UpsertConversation(ctx, conversation); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.LinkConversationToStatus(ctx, conversation.ID, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return conversation
+}
+
+func (suite *ConversationTestSuite) TestGetConversation() {
+	ctx := context.Background()
+
+	var (
+		owner  = suite.testAccounts["local_account_1"]
+		other  = suite.testAccounts["local_account_2"]
+		status = suite.testStatuses["local_account_2_status_6"]
+	)
+
+	conversation := suite.newConversation(ctx, owner, status, []string{other.ID})
+
+	// Fetch the conversation by ID.
+	got, err := suite.db.GetConversationByID(ctx, conversation.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(owner.ID, got.Account.ID)
+	suite.Equal(status.ID, got.LastStatus.ID)
+	suite.Len(got.OtherAccounts, 1)
+	suite.False(*got.Read)
+
+	// Fetch the conversation by its thread and participants.
+	got, err = suite.db.GetConversationByThreadAndAccountIDs(ctx, status.ThreadID, owner.ID, []string{other.ID})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(conversation.ID, got.ID)
+
+	// Mark the conversation as read.
+	got.Read = util.Ptr(true)
+	if err := suite.db.UpsertConversation(ctx, got, "read"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	got, err = suite.db.GetConversationByID(ctx, conversation.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*got.Read)
+}
+
+func (suite *ConversationTestSuite) TestGetConversationsByOwnerAccountID() {
+	ctx := context.Background()
+
+	var (
+		owner  = suite.testAccounts["local_account_1"]
+		other  = suite.testAccounts["local_account_2"]
+		status = suite.testStatuses["local_account_2_status_6"]
+	)
+
+	conversation := suite.newConversation(ctx, owner, status, []string{other.ID})
+
+	conversations, err := suite.db.GetConversationsByOwnerAccountID(ctx, owner.ID, &paging.Page{
+		Max:   paging.MaxID(id.Highest),
+		Limit: 20,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(conversations, 1)
+	suite.Equal(conversation.ID, conversations[0].ID)
+
+	// The other account doesn't own any conversations.
+	_, err = suite.db.GetConversationsByOwnerAccountID(ctx, other.ID, nil)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ConversationTestSuite) TestDeleteStatusFromConversations() {
+	ctx := context.Background()
+
+	var (
+		owner  = suite.testAccounts["local_account_1"]
+		other  = suite.testAccounts["local_account_2"]
+		status = suite.testStatuses["local_account_2_status_6"]
+	)
+
+	conversation := suite.newConversation(ctx, owner, status, []string{other.ID})
+
+	// Removing the only status from the
+	// conversation should delete it entirely.
+	if err := suite.db.DeleteStatusFromConversations(ctx, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetConversationByID(ctx, conversation.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ConversationTestSuite) TestDeleteConversationsByOwnerAccountID() {
+	ctx := context.Background()
+
+	var (
+		owner  = suite.testAccounts["local_account_1"]
+		other  = suite.testAccounts["local_account_2"]
+		status = suite.testStatuses["local_account_2_status_6"]
+	)
+
+	conversation := suite.newConversation(ctx, owner, status, []string{other.ID})
+
+	if err := suite.db.DeleteConversationsByOwnerAccountID(ctx, owner.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetConversationByID(ctx, conversation.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestConversationTestSuite(t *testing.T) {
+	suite.Run(t, new(ConversationTestSuite))
+}
@@ -19,7 +19,10 @@ package bundb
 
 import (
 	"context"
+	"errors"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -110,6 +113,26 @@ func (d *domainDB) GetDomainAllowByID(ctx context.Context, id string) (*gtsmodel
 	return &allow, nil
 }
 
+func (d *domainDB) UpdateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow, columns ...string) error {
+	// Update the allow's last-updated
+	allow.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	// Domain cannot be changed
+	// through an update, so no
+	// need to clear the cache.
+	_, err := d.db.
+		NewUpdate().
+		Model(allow).
+		Where("? = ?", bun.Ident("domain_allow.id"), allow.ID).
+		Column(columns...).
+		Exec(ctx)
+
+	return err
+}
+
 func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) error {
 	// Normalize the domain as punycode
 	domain, err := util.Punify(domain)
@@ -206,6 +229,26 @@ func (d *domainDB) GetDomainBlockByID(ctx context.Context, id string) (*gtsmodel
 	return &block, nil
 }
 
+func (d *domainDB) UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) error {
+	// Update the block's last-updated
+	block.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	// Domain cannot be changed
+	// through an update, so no
+	// need to clear the cache.
+	_, err := d.db.
+		NewUpdate().
+		Model(block).
+		Where("? = ?", bun.Ident("domain_block.id"), block.ID).
+		Column(columns...).
+		Exec(ctx)
+
+	return err
+}
+
 func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) error {
 	// Normalize the domain as punycode
 	domain, err := util.Punify(domain)
@@ -227,6 +270,122 @@ func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) error {
 	return nil
 }
 
+func (d *domainDB) CreateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit) error {
+	// Normalize the domain as punycode
+	var err error
+	limit.Domain, err = util.Punify(limit.Domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to store domain limit in DB
+	if _, err := d.db.NewInsert().
+		Model(limit).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain limit cache (for later reload)
+	d.state.Caches.GTS.DomainLimit.Clear()
+
+	return nil
+}
+
+func (d *domainDB) GetDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return nil, db.ErrNoEntries
+	}
+
+	var limit gtsmodel.DomainLimit
+
+	// Look for limit matching domain in DB
+	q := d.db.
+		NewSelect().
+		Model(&limit).
+		Where("? = ?", bun.Ident("domain_limit.domain"), domain)
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &limit, nil
+}
+
+func (d *domainDB) GetDomainLimits(ctx context.Context) ([]*gtsmodel.DomainLimit, error) {
+	limits := []*gtsmodel.DomainLimit{}
+
+	if err := d.db.
+		NewSelect().
+		Model(&limits).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+func (d *domainDB) GetDomainLimitByID(ctx context.Context, id string) (*gtsmodel.DomainLimit, error) {
+	var limit gtsmodel.DomainLimit
+
+	q := d.db.
+		NewSelect().
+		Model(&limit).
+		Where("? = ?", bun.Ident("domain_limit.id"), id)
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &limit, nil
+}
+
+func (d *domainDB) UpdateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit, columns ...string) error {
+	// Update the limit's last-updated
+	limit.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	// Domain cannot be changed
+	// through an update, so no
+	// need to clear the cache.
+	_, err := d.db.
+		NewUpdate().
+		Model(limit).
+		Where("? = ?", bun.Ident("domain_limit.id"), limit.ID).
+		Column(columns...).
+		Exec(ctx)
+
+	return err
+}
+
+func (d *domainDB) DeleteDomainLimit(ctx context.Context, domain string) error {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to delete domain limit
+	if _, err := d.db.NewDelete().
+		Model((*gtsmodel.DomainLimit)(nil)).
+		Where("? = ?", bun.Ident("domain_limit.domain"), domain).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain limit cache (for later reload)
+	d.state.Caches.GTS.DomainLimit.Clear()
+
+	return nil
+}
+
 func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, error) {
 	// Normalize the domain as punycode
 	domain, err := util.Punify(domain)
@@ -328,3 +487,61 @@ func (d *domainDB) AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, e
 	}
 	return false, nil
 }
+
+func (d *domainDB) MatchDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Domain referencing *us* cannot be limited.
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return nil, nil
+	}
+
+	// Check the cache for a domain limit (hydrating the cache with callback if necessary).
+	limited, err := d.state.Caches.GTS.DomainLimit.Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all limited domains from DB
+		q := d.db.NewSelect().
+			Table("domain_limits").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !limited {
+		// Nothing to do.
+		return nil, nil
+	}
+
+	// Domain (or one of its parents) is limited,
+	// walk up the domain parts looking for the
+	// most specific limit entry that applies.
+	for {
+		limit, err := d.GetDomainLimit(ctx, domain)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		if limit != nil {
+			return limit, nil
+		}
+
+		// Strip the leftmost domain part.
+		i := strings.IndexByte(domain, '.')
+		if i == -1 {
+			return nil, nil
+		}
+		domain = domain[i+1:]
+	}
+}
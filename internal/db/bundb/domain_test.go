@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type DomainTestSuite struct {
@@ -274,6 +275,67 @@ func (suite *DomainTestSuite) TestIsOtherDomainBlockedWildcardAndExplicit() {
 	}
 }
 
+func (suite *DomainTestSuite) TestMatchDomainLimit() {
+	ctx := context.Background()
+
+	domainLimit := &gtsmodel.DomainLimit{
+		ID:                 "01G204214Y9TNJEBX39C7G88SW",
+		Domain:             "bad.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		ContentWarning:     "from a bad apple",
+		MediaSensitive:     util.Ptr(true),
+		HidePublic:         util.Ptr(false),
+		RejectMedia:        util.Ptr(false),
+	}
+
+	// no domain limit exists for the given domain yet
+	limit, err := suite.db.MatchDomainLimit(ctx, "some.bad.apples")
+	suite.NoError(err)
+	suite.Nil(limit)
+
+	err = suite.db.CreateDomainLimit(ctx, domainLimit)
+	suite.NoError(err)
+
+	// limit on parent domain now applies to subdomain
+	limit, err = suite.db.MatchDomainLimit(ctx, "some.bad.apples")
+	suite.NoError(err)
+	suite.NotNil(limit)
+	suite.Equal(domainLimit.ID, limit.ID)
+	suite.Equal("from a bad apple", limit.ContentWarning)
+
+	// more specific limit on subdomain takes precedence
+	subLimit := &gtsmodel.DomainLimit{
+		ID:                 "01G20497XN3T45JSXZRBWB3SCB",
+		Domain:             "some.bad.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		MediaSensitive:     util.Ptr(false),
+		HidePublic:         util.Ptr(true),
+		RejectMedia:        util.Ptr(false),
+	}
+
+	err = suite.db.CreateDomainLimit(ctx, subLimit)
+	suite.NoError(err)
+
+	limit, err = suite.db.MatchDomainLimit(ctx, "some.bad.apples")
+	suite.NoError(err)
+	suite.NotNil(limit)
+	suite.Equal(subLimit.ID, limit.ID)
+
+	// other domains are still not limited
+	limit, err = suite.db.MatchDomainLimit(ctx, "good.apples")
+	suite.NoError(err)
+	suite.Nil(limit)
+
+	// deleting the subdomain limit falls back to parent limit
+	err = suite.db.DeleteDomainLimit(ctx, subLimit.Domain)
+	suite.NoError(err)
+
+	limit, err = suite.db.MatchDomainLimit(ctx, "some.bad.apples")
+	suite.NoError(err)
+	suite.NotNil(limit)
+	suite.Equal(domainLimit.ID, limit.ID)
+}
+
 func TestDomainTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainTestSuite))
 }
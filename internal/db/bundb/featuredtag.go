@@ -0,0 +1,318 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type featuredTagDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (f *featuredTagDB) GetFeaturedTagByID(ctx context.Context, id string) (*gtsmodel.FeaturedTag, error) {
+	return f.getFeaturedTag(
+		ctx,
+		"ID",
+		func(featuredTag *gtsmodel.FeaturedTag) error {
+			return f.db.
+				NewSelect().
+				Model(featuredTag).
+				Where("? = ?", bun.Ident("id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (f *featuredTagDB) GetFeaturedTagByAccountIDAndTagID(ctx context.Context, accountID string, tagID string) (*gtsmodel.FeaturedTag, error) {
+	return f.getFeaturedTag(
+		ctx,
+		"AccountID,TagID",
+		func(featuredTag *gtsmodel.FeaturedTag) error {
+			return f.db.
+				NewSelect().
+				Model(featuredTag).
+				Where("? = ?", bun.Ident("account_id"), accountID).
+				Where("? = ?", bun.Ident("tag_id"), tagID).
+				Scan(ctx)
+		},
+		accountID,
+		tagID,
+	)
+}
+
+func (f *featuredTagDB) getFeaturedTag(
+	ctx context.Context,
+	lookup string,
+	dbQuery func(*gtsmodel.FeaturedTag) error,
+	keyParts ...any,
+) (*gtsmodel.FeaturedTag, error) {
+	// Fetch featured tag from cache with loader callback
+	featuredTag, err := f.state.Caches.GTS.FeaturedTag.LoadOne(lookup, func() (*gtsmodel.FeaturedTag, error) {
+		var featuredTag gtsmodel.FeaturedTag
+
+		// Not cached! Perform database query
+		if err := dbQuery(&featuredTag); err != nil {
+			return nil, err
+		}
+
+		return &featuredTag, nil
+	}, keyParts...)
+	if err != nil {
+		// already processed
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return featuredTag, nil
+	}
+
+	if err := f.PopulateFeaturedTag(ctx, featuredTag); err != nil {
+		return nil, err
+	}
+
+	return featuredTag, nil
+}
+
+func (f *featuredTagDB) GetFeaturedTagsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, error) {
+	var featuredTagIDs []string
+
+	if err := f.db.
+		NewSelect().
+		Table("featured_tags").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx, &featuredTagIDs); err != nil {
+		return nil, err
+	}
+
+	if len(featuredTagIDs) == 0 {
+		return nil, nil
+	}
+
+	// Load all featured tag IDs via cache loader callbacks.
+	featuredTags, err := f.state.Caches.GTS.FeaturedTag.LoadIDs("ID",
+		featuredTagIDs,
+		func(uncached []string) ([]*gtsmodel.FeaturedTag, error) {
+			// Preallocate expected length of uncached featured tags.
+			featuredTags := make([]*gtsmodel.FeaturedTag, 0, len(uncached))
+
+			// Perform database query scanning the
+			// remaining (uncached) featured tag IDs.
+			if err := f.db.NewSelect().
+				Model(&featuredTags).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return featuredTags, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the featured tags by their
+	// IDs to ensure in correct order.
+	getID := func(t *gtsmodel.FeaturedTag) string { return t.ID }
+	util.OrderBy(featuredTags, featuredTagIDs, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return featuredTags, nil
+	}
+
+	// Populate all loaded featured tags, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	featuredTags = slices.DeleteFunc(featuredTags, func(featuredTag *gtsmodel.FeaturedTag) bool {
+		if err := f.PopulateFeaturedTag(ctx, featuredTag); err != nil {
+			log.Errorf(ctx, "error populating featured tag %s: %v", featuredTag.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return featuredTags, nil
+}
+
+func (f *featuredTagDB) PopulateFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	if featuredTag.Account == nil {
+		featuredTag.Account, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			featuredTag.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating featured tag account: %w", err)
+		}
+	}
+
+	if featuredTag.Tag == nil {
+		featuredTag.Tag, err = f.state.DB.GetTag(ctx, featuredTag.TagID)
+		if err != nil {
+			errs.Appendf("error populating featured tag tag: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (f *featuredTagDB) GetFeaturedTagStats(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) (int, time.Time, error) {
+	var (
+		count        int
+		lastStatusID sql.NullString
+	)
+
+	if err := f.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		ColumnExpr("COUNT(*)").
+		ColumnExpr("MAX(?)", bun.Ident("status.id")).
+		// Join with statuses for filtering.
+		Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), featuredTag.TagID).
+		Where("? = ?", bun.Ident("status.account_id"), featuredTag.AccountID).
+		// Only statuses shown on profiles.
+		Where("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+			gtsmodel.VisibilityPublic,
+			gtsmodel.VisibilityUnlocked,
+		})).
+		Scan(ctx, &count, &lastStatusID); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	if !lastStatusID.Valid {
+		// No statuses.
+		return count, time.Time{}, nil
+	}
+
+	lastStatusAt, err := id.TimeFromULID(lastStatusID.String)
+	if err != nil {
+		return 0, time.Time{}, gtserror.Newf("error parsing last status id %s: %w", lastStatusID.String, err)
+	}
+
+	return count, lastStatusAt, nil
+}
+
+func (f *featuredTagDB) GetFeaturedTagSuggestions(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Tag, error) {
+	var tagIDs []string
+
+	// Tags already featured
+	// by the account.
+	featuredQ := f.db.
+		NewSelect().
+		Table("featured_tags").
+		Column("tag_id").
+		Where("? = ?", bun.Ident("account_id"), accountID)
+
+	if err := f.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Column("status_to_tag.tag_id").
+		// Join with statuses for filtering.
+		Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? NOT IN (?)", bun.Ident("status_to_tag.tag_id"), featuredQ).
+		Group("status_to_tag.tag_id").
+		OrderExpr("COUNT(*) DESC").
+		Limit(limit).
+		Scan(ctx, &tagIDs); err != nil {
+		return nil, err
+	}
+
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+
+	return f.state.DB.GetTags(ctx, tagIDs)
+}
+
+func (f *featuredTagDB) PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error {
+	return f.state.Caches.GTS.FeaturedTag.Store(featuredTag, func() error {
+		_, err := f.db.
+			NewInsert().
+			Model(featuredTag).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (f *featuredTagDB) DeleteFeaturedTagByID(ctx context.Context, id string) error {
+	// Load featured tag into cache before attempting a delete,
+	// as we need it cached in order to trigger the invalidate
+	// callback. This in turn invalidates others.
+	_, err := f.GetFeaturedTagByID(gtscontext.SetBarebones(ctx), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not an issue.
+			err = nil
+		}
+		return err
+	}
+
+	// Drop this now-cached featured tag on return after delete.
+	defer f.state.Caches.GTS.FeaturedTag.Invalidate("ID", id)
+
+	// Finally delete featured tag from DB.
+	_, err = f.db.NewDelete().
+		Table("featured_tags").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (f *featuredTagDB) DeleteFeaturedTagsByAccountID(ctx context.Context, accountID string) error {
+	defer f.state.Caches.GTS.FeaturedTag.Invalidate("AccountID", accountID)
+
+	_, err := f.db.NewDelete().
+		Table("featured_tags").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,209 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type importDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (i *importDB) GetImportByID(ctx context.Context, id string) (*gtsmodel.Import, error) {
+	imp := new(gtsmodel.Import)
+
+	// Imports are rarely accessed
+	// outside of their own processing,
+	// so don't bother caching them.
+	if err := i.db.
+		NewSelect().
+		Model(imp).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return imp, nil
+	}
+
+	if err := i.PopulateImport(ctx, imp); err != nil {
+		return nil, err
+	}
+
+	return imp, nil
+}
+
+func (i *importDB) GetImportsForAccount(ctx context.Context, accountID string) ([]*gtsmodel.Import, error) {
+	var imports []*gtsmodel.Import
+
+	// Select all imports of account, newest first,
+	// leaving out the (potentially large) data blobs.
+	if err := i.db.
+		NewSelect().
+		Model(&imports).
+		ExcludeColumn("data").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(imports) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return imports, nil
+}
+
+func (i *importDB) GetUnfinishedImports(ctx context.Context) ([]*gtsmodel.Import, error) {
+	var imports []*gtsmodel.Import
+
+	// Select all pending or processing imports, oldest
+	// first, leaving out the (potentially large) data blobs.
+	if err := i.db.
+		NewSelect().
+		Model(&imports).
+		ExcludeColumn("data").
+		Where("? IN (?)", bun.Ident("state"), bun.In([]gtsmodel.ImportState{
+			gtsmodel.ImportStatePending,
+			gtsmodel.ImportStateProcessing,
+		})).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(imports) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return imports, nil
+}
+
+func (i *importDB) PopulateImport(ctx context.Context, imp *gtsmodel.Import) error {
+	var err error
+
+	if imp.Account == nil {
+		imp.Account, err = i.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			imp.AccountID,
+		)
+		if err != nil {
+			return gtserror.Newf("error populating import account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (i *importDB) PutImport(ctx context.Context, imp *gtsmodel.Import) error {
+	_, err := i.db.
+		NewInsert().
+		Model(imp).
+		Exec(ctx)
+	return err
+}
+
+func (i *importDB) UpdateImport(ctx context.Context, imp *gtsmodel.Import, columns ...string) error {
+	imp.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.db.
+		NewUpdate().
+		Model(imp).
+		Where("? = ?", bun.Ident("import.id"), imp.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (i *importDB) DeleteImportsByAccountID(ctx context.Context, accountID string) error {
+	return i.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete statuses imported by the account's imports.
+		if _, err := tx.
+			NewDelete().
+			Table("import_statuses").
+			Where("? IN (?)",
+				bun.Ident("import_id"),
+				tx.NewSelect().
+					Table("imports").
+					Column("id").
+					Where("? = ?", bun.Ident("account_id"), accountID),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			Table("imports").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (i *importDB) GetImportStatuses(ctx context.Context, importID string) ([]*gtsmodel.ImportStatus, error) {
+	var importStatuses []*gtsmodel.ImportStatus
+
+	if err := i.db.
+		NewSelect().
+		Model(&importStatuses).
+		Where("? = ?", bun.Ident("import_id"), importID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(importStatuses) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return importStatuses, nil
+}
+
+func (i *importDB) PutImportStatus(ctx context.Context, importStatus *gtsmodel.ImportStatus) error {
+	_, err := i.db.
+		NewInsert().
+		Model(importStatus).
+		Exec(ctx)
+	return err
+}
+
+func (i *importDB) DeleteImportStatuses(ctx context.Context, importID string) error {
+	_, err := i.db.
+		NewDelete().
+		Table("import_statuses").
+		Where("? = ?", bun.Ident("import_id"), importID).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,216 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+
+	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	// columnExists returns whether the given table already has the
+	// given column. Tables recreated by earlier migrations using the
+	// current gtsmodel, like status_faves, may have the new columns.
+	columnExists := func(ctx context.Context, tx bun.Tx, table string, column string) (bool, error) {
+		var q *bun.RawQuery
+		switch tx.Dialect().Name() {
+		case dialect.SQLite:
+			q = tx.NewRaw(
+				"SELECT COUNT(*) FROM pragma_table_info(?) WHERE ? = ?",
+				table, bun.Ident("name"), column,
+			)
+		case dialect.PG:
+			q = tx.NewRaw(
+				"SELECT COUNT(*) FROM ? WHERE ? = ? AND ? = ?",
+				bun.Ident("information_schema.columns"),
+				bun.Ident("table_name"), table,
+				bun.Ident("column_name"), column,
+			)
+		default:
+			panic("db conn was neither pg not sqlite")
+		}
+
+		var count int
+		if err := q.Scan(ctx, &count); err != nil {
+			return false, err
+		}
+		return count > 0, nil
+	}
+
+	up := func(ctx context.Context, db *bun.DB) error {
+		log.Info(ctx, "migrating statuses to interaction policies, please wait...")
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Interaction policies are stored as
+			// JSON, type is dependent on dialect.
+			var policyColumnType string
+			switch tx.Dialect().Name() {
+			case dialect.SQLite:
+				policyColumnType = "VARCHAR"
+			case dialect.PG:
+				policyColumnType = "JSONB"
+			default:
+				panic("db conn was neither pg not sqlite")
+			}
+
+			// Add the new interaction policy columns.
+			for table, columns := range map[string][]string{
+				"statuses": {
+					"interaction_policy",
+				},
+				"account_settings": {
+					"interaction_policy_direct",
+					"interaction_policy_mutuals_only",
+					"interaction_policy_followers_only",
+					"interaction_policy_unlocked",
+					"interaction_policy_public",
+				},
+			} {
+				for _, column := range columns {
+					if _, err := tx.
+						NewAddColumn().
+						Table(table).
+						ColumnExpr("? "+policyColumnType, bun.Ident(column)).
+						Exec(ctx); err != nil {
+						return err
+					}
+				}
+			}
+
+			// Add pending approval columns
+			// to statuses and status faves.
+			for _, table := range []string{
+				"statuses",
+				"status_faves",
+			} {
+				for column, columnType := range map[string]string{
+					"pending_approval": "BOOLEAN NOT NULL DEFAULT false",
+					"approved_by_uri":  "VARCHAR",
+				} {
+					exists, err := columnExists(ctx, tx, table, column)
+					if err != nil {
+						return err
+					}
+
+					if exists {
+						continue
+					}
+
+					if _, err := tx.
+						NewAddColumn().
+						Table(table).
+						ColumnExpr("? "+columnType, bun.Ident(column)).
+						Exec(ctx); err != nil {
+						return err
+					}
+				}
+			}
+
+			// Select all non-boost statuses that had
+			// any of the old interaction booleans unset.
+			var statuses []struct {
+				ID         string
+				Visibility gtsmodel.Visibility
+				Boostable  bool
+				Replyable  bool
+				Likeable   bool
+			}
+			if err := tx.
+				NewSelect().
+				Table("statuses").
+				Column("id", "visibility", "boostable", "replyable", "likeable").
+				Where("? IS NULL", bun.Ident("boost_of_id")).
+				WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.
+						WhereOr("NOT ?", bun.Ident("boostable")).
+						WhereOr("NOT ?", bun.Ident("replyable")).
+						WhereOr("NOT ?", bun.Ident("likeable"))
+				}).
+				Scan(ctx, &statuses); err != nil {
+				return err
+			}
+
+			// Convert the old booleans into an equivalent
+			// interaction policy, where false means only
+			// the author can do the interaction.
+			for _, status := range statuses {
+				policy := gtsmodel.DefaultInteractionPolicyFor(status.Visibility)
+				author := gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				}
+
+				if !status.Boostable {
+					policy.CanAnnounce = author
+				}
+
+				if !status.Replyable {
+					policy.CanReply = author
+				}
+
+				if !status.Likeable {
+					policy.CanLike = author
+				}
+
+				b, err := json.Marshal(policy)
+				if err != nil {
+					return err
+				}
+
+				if _, err := tx.
+					NewUpdate().
+					Table("statuses").
+					Set("? = ?", bun.Ident("interaction_policy"), string(b)).
+					Where("? = ?", bun.Ident("id"), status.ID).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// Drop now unused columns from statuses table.
+			for _, column := range []string{
+				"boostable",
+				"replyable",
+				"likeable",
+			} {
+				if _, err := tx.
+					NewDropColumn().
+					Table("statuses").
+					Column(column).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
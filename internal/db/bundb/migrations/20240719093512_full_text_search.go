@@ -0,0 +1,235 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"html"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	const batchSize = 500
+
+	// ftsText converts the given HTML parts
+	// into one plaintext string for indexing.
+	ftsText := func(parts ...string) string {
+		texts := make([]string, 0, len(parts))
+		for _, part := range parts {
+			if part == "" {
+				continue
+			}
+			part = html.UnescapeString(part)
+			part = strings.ReplaceAll(part, "<", " <")
+			texts = append(texts, text.SanitizeToPlaintext(part))
+		}
+		return strings.Join(texts, " ")
+	}
+
+	// ftsIndexStatuses indexes text of all non-boost statuses.
+	ftsIndexStatuses := func(ctx context.Context, tx bun.Tx) error {
+		var (
+			lastID string
+			total  int
+		)
+
+		for {
+			var statuses []struct {
+				ID             string `bun:"id"`
+				Content        string `bun:"content"`
+				ContentWarning string `bun:"content_warning"`
+			}
+
+			q := tx.
+				NewSelect().
+				Table("statuses").
+				Column("id", "content", "content_warning").
+				Where("? IS NULL", bun.Ident("boost_of_id")).
+				Order("id ASC").
+				Limit(batchSize)
+			if lastID != "" {
+				q = q.Where("? > ?", bun.Ident("id"), lastID)
+			}
+
+			if err := q.Scan(ctx, &statuses); err != nil {
+				return err
+			}
+
+			if len(statuses) == 0 {
+				break
+			}
+
+			for _, status := range statuses {
+				statusText := ftsText(status.ContentWarning, status.Content)
+				if statusText == "" {
+					continue
+				}
+
+				var q *bun.RawQuery
+				if tx.Dialect().Name() == dialect.PG {
+					q = tx.NewRaw(
+						`INSERT INTO "status_texts" ("status_id", "text_vector") VALUES (?, to_tsvector('english', ?)) ON CONFLICT DO NOTHING`,
+						status.ID, statusText,
+					)
+				} else {
+					q = tx.NewRaw(
+						`INSERT INTO "status_texts" ("status_id", "text") VALUES (?, ?) ON CONFLICT DO NOTHING`,
+						status.ID, statusText,
+					)
+				}
+
+				if _, err := q.Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			lastID = statuses[len(statuses)-1].ID
+			total += len(statuses)
+			log.Infof(ctx, "indexed %d statuses so far", total)
+		}
+
+		return nil
+	}
+
+	// ftsIndexAccounts indexes names and notes of all accounts.
+	ftsIndexAccounts := func(ctx context.Context, tx bun.Tx) error {
+		var (
+			lastID string
+			total  int
+		)
+
+		for {
+			var accounts []struct {
+				ID          string `bun:"id"`
+				Username    string `bun:"username"`
+				DisplayName string `bun:"display_name"`
+				Note        string `bun:"note"`
+			}
+
+			q := tx.
+				NewSelect().
+				Table("accounts").
+				Column("id", "username", "display_name", "note").
+				Order("id ASC").
+				Limit(batchSize)
+			if lastID != "" {
+				q = q.Where("? > ?", bun.Ident("id"), lastID)
+			}
+
+			if err := q.Scan(ctx, &accounts); err != nil {
+				return err
+			}
+
+			if len(accounts) == 0 {
+				break
+			}
+
+			for _, account := range accounts {
+				var (
+					names = ftsText(account.Username, account.DisplayName)
+					note  = ftsText(account.Note)
+					q     *bun.RawQuery
+				)
+
+				if tx.Dialect().Name() == dialect.PG {
+					q = tx.NewRaw(
+						`INSERT INTO "account_texts" ("account_id", "text_vector") VALUES (?, setweight(to_tsvector('simple', ?), 'A') || setweight(to_tsvector('simple', ?), 'B')) ON CONFLICT DO NOTHING`,
+						account.ID, names, note,
+					)
+				} else {
+					q = tx.NewRaw(
+						`INSERT INTO "account_texts" ("account_id", "names", "note") VALUES (?, ?, ?) ON CONFLICT DO NOTHING`,
+						account.ID, names, note,
+					)
+				}
+
+				if _, err := q.Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			lastID = accounts[len(accounts)-1].ID
+			total += len(accounts)
+			log.Infof(ctx, "indexed %d accounts so far", total)
+		}
+
+		return nil
+	}
+
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Create full-text search index tables.
+			var stmts []string
+			switch tx.Dialect().Name() {
+			case dialect.SQLite:
+				// Each index table is the external content
+				// table of an FTS5 table, kept in sync by
+				// triggers. See: https://sqlite.org/fts5.html
+				stmts = []string{
+					`CREATE TABLE IF NOT EXISTS "status_texts" ("id" INTEGER PRIMARY KEY, "status_id" CHAR(26) NOT NULL UNIQUE, "text" TEXT NOT NULL)`,
+					`CREATE VIRTUAL TABLE IF NOT EXISTS "status_texts_fts" USING fts5("text", content='status_texts', content_rowid='id', tokenize='porter unicode61 remove_diacritics 2')`,
+					`CREATE TRIGGER IF NOT EXISTS "status_texts_ai" AFTER INSERT ON "status_texts" BEGIN INSERT INTO "status_texts_fts" ("rowid", "text") VALUES (new."id", new."text"); END`,
+					`CREATE TRIGGER IF NOT EXISTS "status_texts_ad" AFTER DELETE ON "status_texts" BEGIN INSERT INTO "status_texts_fts" ("status_texts_fts", "rowid", "text") VALUES ('delete', old."id", old."text"); END`,
+					`CREATE TRIGGER IF NOT EXISTS "status_texts_au" AFTER UPDATE ON "status_texts" BEGIN INSERT INTO "status_texts_fts" ("status_texts_fts", "rowid", "text") VALUES ('delete', old."id", old."text"); INSERT INTO "status_texts_fts" ("rowid", "text") VALUES (new."id", new."text"); END`,
+					`CREATE TABLE IF NOT EXISTS "account_texts" ("id" INTEGER PRIMARY KEY, "account_id" CHAR(26) NOT NULL UNIQUE, "names" TEXT NOT NULL, "note" TEXT NOT NULL)`,
+					`CREATE VIRTUAL TABLE IF NOT EXISTS "account_texts_fts" USING fts5("names", "note", content='account_texts', content_rowid='id', tokenize='unicode61 remove_diacritics 2')`,
+					`CREATE TRIGGER IF NOT EXISTS "account_texts_ai" AFTER INSERT ON "account_texts" BEGIN INSERT INTO "account_texts_fts" ("rowid", "names", "note") VALUES (new."id", new."names", new."note"); END`,
+					`CREATE TRIGGER IF NOT EXISTS "account_texts_ad" AFTER DELETE ON "account_texts" BEGIN INSERT INTO "account_texts_fts" ("account_texts_fts", "rowid", "names", "note") VALUES ('delete', old."id", old."names", old."note"); END`,
+					`CREATE TRIGGER IF NOT EXISTS "account_texts_au" AFTER UPDATE ON "account_texts" BEGIN INSERT INTO "account_texts_fts" ("account_texts_fts", "rowid", "names", "note") VALUES ('delete', old."id", old."names", old."note"); INSERT INTO "account_texts_fts" ("rowid", "names", "note") VALUES (new."id", new."names", new."note"); END`,
+				}
+
+			case dialect.PG:
+				stmts = []string{
+					`CREATE TABLE IF NOT EXISTS "status_texts" ("status_id" CHAR(26) NOT NULL PRIMARY KEY, "text_vector" TSVECTOR NOT NULL)`,
+					`CREATE INDEX IF NOT EXISTS "status_texts_text_vector_idx" ON "status_texts" USING GIN ("text_vector")`,
+					`CREATE TABLE IF NOT EXISTS "account_texts" ("account_id" CHAR(26) NOT NULL PRIMARY KEY, "text_vector" TSVECTOR NOT NULL)`,
+					`CREATE INDEX IF NOT EXISTS "account_texts_text_vector_idx" ON "account_texts" USING GIN ("text_vector")`,
+				}
+			}
+
+			for _, stmt := range stmts {
+				if _, err := tx.ExecContext(ctx, stmt); err != nil {
+					return err
+				}
+			}
+
+			log.Info(ctx, "indexing existing statuses and accounts for full-text search, please wait and don't interrupt it (this may take a while)")
+
+			if err := ftsIndexStatuses(ctx, tx); err != nil {
+				return err
+			}
+
+			return ftsIndexAccounts(ctx, tx)
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
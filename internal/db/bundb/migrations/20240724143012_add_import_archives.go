@@ -0,0 +1,106 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	// columnExists returns whether the given table already has the
+	// given column, as the imports table is created from the current
+	// gtsmodel, which may already include the new columns.
+	columnExists := func(ctx context.Context, tx bun.Tx, table string, column string) (bool, error) {
+		var q *bun.RawQuery
+		switch tx.Dialect().Name() {
+		case dialect.SQLite:
+			q = tx.NewRaw(
+				"SELECT COUNT(*) FROM pragma_table_info(?) WHERE ? = ?",
+				table, bun.Ident("name"), column,
+			)
+		case dialect.PG:
+			q = tx.NewRaw(
+				"SELECT COUNT(*) FROM ? WHERE ? = ? AND ? = ?",
+				bun.Ident("information_schema.columns"),
+				bun.Ident("table_name"), table,
+				bun.Ident("column_name"), column,
+			)
+		default:
+			panic("db conn was neither pg not sqlite")
+		}
+
+		var count int
+		if err := q.Scan(ctx, &count); err != nil {
+			return false, err
+		}
+		return count > 0, nil
+	}
+
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Add the new import `dry_run`
+			// and `federate` columns.
+			for _, column := range []string{
+				"dry_run",
+				"federate",
+			} {
+				exists, err := columnExists(ctx, tx, "imports", column)
+				if err != nil {
+					return err
+				}
+
+				if exists {
+					continue
+				}
+
+				if _, err := tx.
+					NewAddColumn().
+					Table("imports").
+					ColumnExpr("? BOOLEAN NOT NULL DEFAULT false", bun.Ident(column)).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// Create the new table mapping original
+			// URIs of imported statuses to their IDs.
+			if _, err := tx.NewCreateTable().
+				Model((*gtsmodel.ImportStatus)(nil)).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,87 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Create preview cards table. Lookups
+			// by url are covered by the unique
+			// constraint on that column.
+			if _, err := tx.
+				NewCreateTable().
+				Model(&gtsmodel.PreviewCard{}).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index preview cards by image attachment,
+			// as these are checked when pruning media.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("preview_cards").
+				Index("preview_cards_image_attachment_id_idx").
+				Column("image_attachment_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Add the new status `preview_card_id` column.
+			if _, err := tx.
+				NewAddColumn().
+				Table("statuses").
+				ColumnExpr("? CHAR(26)", bun.Ident("preview_card_id")).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index statuses by preview card, as
+			// these are checked when pruning cards.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("statuses").
+				Index("statuses_preview_card_id_idx").
+				Column("preview_card_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
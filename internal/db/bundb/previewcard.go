@@ -0,0 +1,220 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type previewCardDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (p *previewCardDB) GetPreviewCardByID(ctx context.Context, id string) (*gtsmodel.PreviewCard, error) {
+	return p.getPreviewCard(
+		ctx,
+		"ID",
+		func(card *gtsmodel.PreviewCard) error {
+			return p.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("preview_card.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (p *previewCardDB) GetPreviewCardByURL(ctx context.Context, url string) (*gtsmodel.PreviewCard, error) {
+	return p.getPreviewCard(
+		ctx,
+		"URL",
+		func(card *gtsmodel.PreviewCard) error {
+			return p.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("preview_card.url"), url).
+				Scan(ctx)
+		},
+		url,
+	)
+}
+
+func (p *previewCardDB) GetPreviewCardByImageAttachmentID(ctx context.Context, id string) (*gtsmodel.PreviewCard, error) {
+	return p.getPreviewCard(
+		ctx,
+		"ImageAttachmentID",
+		func(card *gtsmodel.PreviewCard) error {
+			return p.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("preview_card.image_attachment_id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (p *previewCardDB) getPreviewCard(
+	ctx context.Context,
+	lookup string,
+	dbQuery func(*gtsmodel.PreviewCard) error,
+	keyParts ...any,
+) (*gtsmodel.PreviewCard, error) {
+	// Fetch card from database cache with loader callback
+	card, err := p.state.Caches.GTS.PreviewCard.LoadOne(lookup, func() (*gtsmodel.PreviewCard, error) {
+		var card gtsmodel.PreviewCard
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&card); err != nil {
+			return nil, err
+		}
+
+		return &card, nil
+	}, keyParts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return card, nil
+	}
+
+	// Further populate the card fields where applicable.
+	if err := p.PopulatePreviewCard(ctx, card); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (p *previewCardDB) GetPreviewCards(ctx context.Context, page *paging.Page) ([]*gtsmodel.PreviewCard, error) {
+	maxID := page.GetMax()
+	limit := page.GetLimit()
+
+	cardIDs := make([]string, 0, limit)
+
+	q := p.db.NewSelect().
+		Table("preview_cards").
+		Column("id").
+		Order("id DESC")
+
+	if maxID != "" {
+		q = q.Where("id < ?", maxID)
+	}
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &cardIDs); err != nil {
+		return nil, err
+	}
+
+	cards := make([]*gtsmodel.PreviewCard, 0, len(cardIDs))
+	for _, id := range cardIDs {
+		card, err := p.GetPreviewCardByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting preview card %s: %v", id, err)
+			continue
+		}
+		cards = append(cards, card)
+	}
+
+	return cards, nil
+}
+
+func (p *previewCardDB) PopulatePreviewCard(ctx context.Context, card *gtsmodel.PreviewCard) error {
+	var err error
+
+	if card.ImageAttachmentID != "" && card.Image == nil {
+		// Card image is not set, fetch from database.
+		card.Image, err = p.state.DB.GetAttachmentByID(
+			gtscontext.SetBarebones(ctx),
+			card.ImageAttachmentID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			// A missing image is not an error, the
+			// card is still usable without it.
+			return gtserror.Newf("error populating preview card image: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *previewCardDB) PutPreviewCard(ctx context.Context, card *gtsmodel.PreviewCard) error {
+	return p.state.Caches.GTS.PreviewCard.Store(card, func() error {
+		_, err := p.db.NewInsert().Model(card).Exec(ctx)
+		return err
+	})
+}
+
+func (p *previewCardDB) UpdatePreviewCard(ctx context.Context, card *gtsmodel.PreviewCard, columns ...string) error {
+	card.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Drop the currently cached card first, as it
+	// may still be keyed by an old image attachment.
+	p.state.Caches.GTS.PreviewCard.Invalidate("ID", card.ID)
+
+	return p.state.Caches.GTS.PreviewCard.Store(card, func() error {
+		_, err := p.db.NewUpdate().
+			Model(card).
+			Where("? = ?", bun.Ident("preview_card.id"), card.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (p *previewCardDB) DeletePreviewCardByID(ctx context.Context, id string) error {
+	// Delete card by ID from database.
+	if _, err := p.db.NewDelete().
+		Table("preview_cards").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate card by ID from cache.
+	p.state.Caches.GTS.PreviewCard.Invalidate("ID", id)
+
+	return nil
+}
+
+func (p *previewCardDB) IsPreviewCardUsed(ctx context.Context, id string) (bool, error) {
+	return exists(ctx, p.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		Where("? = ?", bun.Ident("preview_card_id"), id),
+	)
+}
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type RelayTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *RelayTestSuite) TestPutGetUpdateDeleteRelay() {
+	ctx := context.Background()
+
+	relay := &gtsmodel.Relay{
+		ID:                 id.NewULID(),
+		URI:                "https://relay.example.org/actor",
+		InboxURI:           "https://relay.example.org/inbox",
+		ActorURI:           "https://relay.example.org/actor",
+		FollowURI:          "http://localhost:8080/users/localhost:8080/follow/" + id.NewULID(),
+		State:              gtsmodel.RelayStatePending,
+		Forward:            util.Ptr(false),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.state.DB.PutRelay(ctx, relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Same URI again should be refused.
+	dupe := *relay
+	dupe.ID = id.NewULID()
+	dupe.ActorURI = ""
+	dupe.FollowURI += "_dupe"
+	err := suite.state.DB.PutRelay(ctx, &dupe)
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	// Fetch by each of the unique columns.
+	for _, get := range []func() (*gtsmodel.Relay, error){
+		func() (*gtsmodel.Relay, error) { return suite.state.DB.GetRelayByID(ctx, relay.ID) },
+		func() (*gtsmodel.Relay, error) { return suite.state.DB.GetRelayByURI(ctx, relay.URI) },
+		func() (*gtsmodel.Relay, error) { return suite.state.DB.GetRelayByActorURI(ctx, relay.ActorURI) },
+		func() (*gtsmodel.Relay, error) { return suite.state.DB.GetRelayByFollowURI(ctx, relay.FollowURI) },
+	} {
+		dbRelay, err := get()
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(relay.ID, dbRelay.ID)
+		suite.NotNil(dbRelay.CreatedByAccount)
+	}
+
+	relay.State = gtsmodel.RelayStateAccepted
+	if err := suite.state.DB.UpdateRelay(ctx, relay, "state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	relays, err := suite.state.DB.GetRelays(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(relays, 1) {
+		suite.Equal(gtsmodel.RelayStateAccepted, relays[0].State)
+	}
+
+	if err := suite.state.DB.DeleteRelayByID(ctx, relay.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetRelayByID(ctx, relay.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
@@ -0,0 +1,305 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type scheduledStatusDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, error) {
+	// Fetch scheduled status from cache with loader callback
+	scheduledStatus, err := s.state.Caches.GTS.ScheduledStatus.LoadOne("ID", func() (*gtsmodel.ScheduledStatus, error) {
+		var scheduledStatus gtsmodel.ScheduledStatus
+
+		// Not cached! Perform database query
+		if err := s.db.
+			NewSelect().
+			Model(&scheduledStatus).
+			Where("? = ?", bun.Ident("id"), id).
+			Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &scheduledStatus, nil
+	}, id)
+	if err != nil {
+		// already processed
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return scheduledStatus, nil
+	}
+
+	if err := s.PopulateScheduledStatus(ctx, scheduledStatus); err != nil {
+		return nil, err
+	}
+
+	return scheduledStatus, nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusesForAccount(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.ScheduledStatus, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		scheduledStatusIDs = make([]string, 0, limit)
+	)
+
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("scheduled_statuses"), bun.Ident("scheduled_status")).
+		// Select only IDs from table.
+		Column("scheduled_status.id").
+		Where("? = ?", bun.Ident("scheduled_status.account_id"), accountID)
+
+	// Return only scheduled statuses LOWER (ie., older) than maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("scheduled_status.id"), maxID)
+	}
+
+	// Return only scheduled statuses HIGHER (ie., newer) than minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("scheduled_status.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of scheduled statuses returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("scheduled_status.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("scheduled_status.id"))
+	}
+
+	if err := q.Scan(ctx, &scheduledStatusIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no scheduled statuses early.
+	if len(scheduledStatusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want scheduled statuses
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(scheduledStatusIDs)
+	}
+
+	return s.getScheduledStatusesByIDs(ctx, scheduledStatusIDs)
+}
+
+func (s *scheduledStatusDB) GetAllScheduledStatuses(ctx context.Context) ([]*gtsmodel.ScheduledStatus, error) {
+	var scheduledStatusIDs []string
+
+	if err := s.db.
+		NewSelect().
+		Table("scheduled_statuses").
+		Column("id").
+		OrderExpr("? ASC", bun.Ident("scheduled_at")).
+		Scan(ctx, &scheduledStatusIDs); err != nil {
+		return nil, err
+	}
+
+	if len(scheduledStatusIDs) == 0 {
+		return nil, nil
+	}
+
+	return s.getScheduledStatusesByIDs(ctx, scheduledStatusIDs)
+}
+
+func (s *scheduledStatusDB) getScheduledStatusesByIDs(ctx context.Context, scheduledStatusIDs []string) ([]*gtsmodel.ScheduledStatus, error) {
+	// Load all scheduled status IDs via cache loader callbacks.
+	scheduledStatuses, err := s.state.Caches.GTS.ScheduledStatus.LoadIDs("ID",
+		scheduledStatusIDs,
+		func(uncached []string) ([]*gtsmodel.ScheduledStatus, error) {
+			// Preallocate expected length of uncached scheduled statuses.
+			scheduledStatuses := make([]*gtsmodel.ScheduledStatus, 0, len(uncached))
+
+			// Perform database query scanning the
+			// remaining (uncached) scheduled status IDs.
+			if err := s.db.NewSelect().
+				Model(&scheduledStatuses).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return scheduledStatuses, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the scheduled statuses by their
+	// IDs to ensure in correct order.
+	getID := func(s *gtsmodel.ScheduledStatus) string { return s.ID }
+	util.OrderBy(scheduledStatuses, scheduledStatusIDs, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return scheduledStatuses, nil
+	}
+
+	// Populate all loaded scheduled statuses, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	scheduledStatuses = slices.DeleteFunc(scheduledStatuses, func(scheduledStatus *gtsmodel.ScheduledStatus) bool {
+		if err := s.PopulateScheduledStatus(ctx, scheduledStatus); err != nil {
+			log.Errorf(ctx, "error populating scheduled status %s: %v", scheduledStatus.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return scheduledStatuses, nil
+}
+
+func (s *scheduledStatusDB) CountScheduledStatusesForAccount(ctx context.Context, accountID string, start time.Time, end time.Time) (int, error) {
+	q := s.db.
+		NewSelect().
+		Table("scheduled_statuses").
+		Where("? = ?", bun.Ident("account_id"), accountID)
+
+	if !start.IsZero() {
+		q = q.Where("? >= ?", bun.Ident("scheduled_at"), start)
+	}
+
+	if !end.IsZero() {
+		q = q.Where("? < ?", bun.Ident("scheduled_at"), end)
+	}
+
+	return q.Count(ctx)
+}
+
+func (s *scheduledStatusDB) PopulateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	if scheduledStatus.Account == nil {
+		scheduledStatus.Account, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			scheduledStatus.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating scheduled status account: %w", err)
+		}
+	}
+
+	if scheduledStatus.ApplicationID != "" && scheduledStatus.Application == nil {
+		scheduledStatus.Application, err = s.state.DB.GetApplicationByID(
+			ctx,
+			scheduledStatus.ApplicationID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating scheduled status application: %w", err)
+		}
+	}
+
+	if len(scheduledStatus.MediaIDs) != len(scheduledStatus.MediaAttachments) {
+		scheduledStatus.MediaAttachments, err = s.state.DB.GetAttachmentsByIDs(
+			ctx,
+			scheduledStatus.MediaIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating scheduled status attachments: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (s *scheduledStatusDB) PutScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	return s.state.Caches.GTS.ScheduledStatus.Store(scheduledStatus, func() error {
+		_, err := s.db.
+			NewInsert().
+			Model(scheduledStatus).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (s *scheduledStatusDB) UpdateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, columns ...string) error {
+	scheduledStatus.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return s.state.Caches.GTS.ScheduledStatus.Store(scheduledStatus, func() error {
+		_, err := s.db.
+			NewUpdate().
+			Model(scheduledStatus).
+			Where("? = ?", bun.Ident("scheduled_status.id"), scheduledStatus.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (s *scheduledStatusDB) DeleteScheduledStatusByID(ctx context.Context, id string) error {
+	// Drop this scheduled status from cache on return after delete.
+	defer s.state.Caches.GTS.ScheduledStatus.Invalidate("ID", id)
+
+	// Finally delete scheduled status from DB.
+	_, err := s.db.NewDelete().
+		Table("scheduled_statuses").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (s *scheduledStatusDB) DeleteScheduledStatusesByAccountID(ctx context.Context, accountID string) error {
+	defer s.state.Caches.GTS.ScheduledStatus.Invalidate("AccountID", accountID)
+
+	_, err := s.db.NewDelete().
+		Table("scheduled_statuses").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
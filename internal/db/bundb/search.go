@@ -21,6 +21,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -29,46 +30,40 @@ import (
 	"github.com/uptrace/bun/dialect"
 )
 
-// todo: currently we pass an 'offset' parameter into functions owned by this struct,
-// which is ignored.
+// searchDB searches for statuses and accounts using the full-text
+// search indexes kept in the status_texts and account_texts tables
+// (see searchindex.go), and searches for tags using LIKE.
 //
-// The idea of 'offset' is to allow callers to page through results without supplying
-// maxID or minID params; they simply use the offset as more or less a 'page number'.
-// This works fine when you're dealing with something like Elasticsearch, but for
-// SQLite or Postgres 'LIKE' queries it doesn't really, because for each higher offset
-// you have to calculate the value of all the previous offsets as well *within the
-// execution time of the query*. It's MUCH more efficient to page using maxID and
-// minID for queries like this. For now, then, we just ignore the offset and hope that
-// the caller will page using maxID and minID instead.
-//
-// In future, however, it would be good to support offset in a way that doesn't totally
-// destroy database queries. One option would be to cache previous offsets when paging
-// down (which is the most common use case).
-//
-// For example, say a caller makes a call with offset 0: we run the query as normal,
-// and in a 10 minute cache or something, store the next maxID value as it would be for
-// offset 1, for the supplied query, limit, following, etc. Then when they call for
-// offset 1, instead of supplying 'offset' in the query and causing slowdown, we check
-// the cache to see if we have the next maxID value stored for that query, and use that
-// instead. If a caller out of the blue requests offset 4 or something, on an empty cache,
-// we could run the previous 4 queries and store the offsets for those before making the
-// 5th call for page 4.
-//
-// This isn't ideal, of course, but at least we could cover the most common use case of
-// a caller paging down through results.
+// Callers can page through results in one of two ways. If maxID
+// or minID is given, results are returned in descending ID order,
+// and offset is ignored. Otherwise, results are returned in order
+// of relevance (or ID order, where relevance doesn't apply), and
+// offset is used as the number of results to skip. Full-text
+// indexes make the latter cheap enough to do, and it's what most
+// clients expect from search results.
 type searchDB struct {
 	db    *bun.DB
 	state *state.State
 }
 
+func (s *searchDB) IndexStatus(ctx context.Context, status *gtsmodel.Status) error {
+	return indexStatus(ctx, s.db, status)
+}
+
+func (s *searchDB) IndexAccount(ctx context.Context, account *gtsmodel.Account) error {
+	return indexAccount(ctx, s.db, account)
+}
+
 // Query example (SQLite):
 //
 //	SELECT "account"."id" FROM "accounts" AS "account"
+//	JOIN "account_texts" AS "account_text" ON "account_text"."account_id" = "account"."id"
+//	JOIN "account_texts_fts" ON "account_texts_fts"."rowid" = "account_text"."id"
 //	WHERE (("account"."domain" IS NULL) OR ("account"."domain" != "account"."username"))
 //	AND ("account"."id" < 'ZZZZZZZZZZZZZZZZZZZZZZZZZZ')
-//	AND ("account"."id" IN (SELECT "target_account_id" FROM "follows" WHERE ("account_id" = '016T5Q3SQKBT337DAKVSKNXXW1')))
-//	AND ((SELECT "account"."username" || COALESCE("account"."display_name", '') || COALESCE("account"."note", '') AS "account_text") LIKE '%turtle%' ESCAPE '\')
-//	ORDER BY "account"."id" DESC LIMIT 10
+//	AND ("account"."id" IN (SELECT "follow"."target_account_id" FROM "follows" AS "follow" WHERE ("follow"."account_id" = '016T5Q3SQKBT337DAKVSKNXXW1')))
+//	AND ("account_texts_fts" MATCH '"turtle"*')
+//	ORDER BY bm25("account_texts_fts"), "account"."id" DESC LIMIT 10
 func (s *searchDB) SearchForAccounts(
 	ctx context.Context,
 	accountID string,
@@ -88,6 +83,7 @@ func (s *searchDB) SearchForAccounts(
 	var (
 		accountIDs  = make([]string, 0, limit)
 		frontToBack = true
+		byRelevance = (maxID == "" && minID == "")
 	)
 
 	q := s.db.
@@ -136,10 +132,14 @@ func (s *searchDB) SearchForAccounts(
 		q = whereStartsLike(q, bun.Ident("account.username"), query)
 	} else {
 		// Query looks like arbitrary string.
-		// Search using LIKE for matches of query
-		// string within accountText subquery.
-		subQ := s.accountText(following)
-		q = whereLike(q, subQ, query)
+		// Search the full-text index for
+		// words that start with query terms.
+		var ok bool
+		q, ok = s.accountTextMatch(q, query, following, byRelevance)
+		if !ok {
+			// Nothing to search for.
+			return nil, nil
+		}
 	}
 
 	if limit > 0 {
@@ -147,7 +147,11 @@ func (s *searchDB) SearchForAccounts(
 		q = q.Limit(limit)
 	}
 
-	if frontToBack {
+	if byRelevance {
+		// Page by offset, with most relevant
+		// first (if applicable), then newest.
+		q = q.Order("account.id DESC").Offset(offset)
+	} else if frontToBack {
 		// Page down.
 		q = q.Order("account.id DESC")
 	} else {
@@ -198,77 +202,108 @@ func (s *searchDB) followedAccounts(accountID string) *bun.SelectQuery {
 		Where("? = ?", bun.Ident("follow.account_id"), accountID)
 }
 
-// accountText returns a subquery that selects a concatenation
-// of account username and display name as "account_text". If
-// `following` is true, then account note will also be included
-// in the concatenation.
-func (s *searchDB) accountText(following bool) *bun.SelectQuery {
-	var (
-		accountText = s.db.NewSelect()
-		query       string
-		args        []interface{}
+// accountTextMatch joins the given query on the account full-text
+// index, and matches accounts with words starting with each of the
+// terms of the given search query in their username or display name.
+// If `following` is true, then account note will also be searched.
+//
+// If byRelevance is true, the query will be ordered by relevance.
+// If the search query contains no terms, false is returned.
+func (s *searchDB) accountTextMatch(
+	q *bun.SelectQuery,
+	query string,
+	following bool,
+	byRelevance bool,
+) (*bun.SelectQuery, bool) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return q, false
+	}
+
+	q = q.Join(
+		"JOIN ? AS ? ON ? = ?",
+		bun.Ident("account_texts"), bun.Ident("account_text"),
+		bun.Ident("account_text.account_id"), bun.Ident("account.id"),
 	)
 
-	if following {
-		// If querying for accounts we follow,
-		// include note in text search params.
-		args = []interface{}{
-			bun.Ident("account.username"),
-			bun.Ident("account.display_name"), "",
-			bun.Ident("account.note"), "",
-			bun.Ident("account_text"),
-		}
-	} else {
-		// If querying for accounts we're not following,
-		// don't include note in text search params.
-		args = []interface{}{
-			bun.Ident("account.username"),
-			bun.Ident("account.display_name"), "",
-			bun.Ident("account_text"),
-		}
-	}
+	switch d := s.db.Dialect().Name(); d {
 
-	// SQLite and Postgres use different syntaxes for
-	// concatenation, and we also need to use a
-	// different number of placeholders depending on
-	// following/not following. COALESCE calls ensure
-	// that we're not trying to concatenate null values.
+	case dialect.SQLite:
+		// Build an FTS5 query of prefix
+		// terms, restricted to the names
+		// column if not following.
+		match := make([]string, len(terms))
+		for i, term := range terms {
+			match[i] = `"` + term + `"*`
+			if !following {
+				match[i] = "names : " + match[i]
+			}
+		}
 
-	switch d := s.db.Dialect().Name(); {
+		q = q.
+			Join(
+				"JOIN ? ON ? = ?",
+				bun.Ident("account_texts_fts"),
+				bun.Ident("account_texts_fts.rowid"), bun.Ident("account_text.id"),
+			).
+			Where("? MATCH ?", bun.Ident("account_texts_fts"), strings.Join(match, " AND "))
+
+		if byRelevance {
+			// Lower bm25 is more relevant.
+			q = q.OrderExpr("bm25(?)", bun.Ident("account_texts_fts"))
+		}
 
-	case d == dialect.SQLite && following:
-		query = "? || COALESCE(?, ?) || COALESCE(?, ?) AS ?"
+	case dialect.PG:
+		// Build a tsquery of prefix
+		// terms, restricted to names
+		// (weight A) if not following.
+		weight := ""
+		if !following {
+			weight = "A"
+		}
 
-	case d == dialect.SQLite && !following:
-		query = "? || COALESCE(?, ?) AS ?"
+		tsquery := make([]string, len(terms))
+		for i, term := range terms {
+			tsquery[i] = term + ":*" + weight
+		}
 
-	case d == dialect.PG && following:
-		query = "CONCAT(?, COALESCE(?, ?), COALESCE(?, ?)) AS ?"
+		match := strings.Join(tsquery, " & ")
+		q = q.Where(
+			"? @@ to_tsquery(?, ?)",
+			bun.Ident("account_text.text_vector"), accountSearchConfig, match,
+		)
 
-	case d == dialect.PG && !following:
-		query = "CONCAT(?, COALESCE(?, ?)) AS ?"
+		if byRelevance {
+			// Higher ts_rank is more relevant.
+			q = q.OrderExpr(
+				"ts_rank(?, to_tsquery(?, ?)) DESC",
+				bun.Ident("account_text.text_vector"), accountSearchConfig, match,
+			)
+		}
 
 	default:
 		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
 	}
 
-	return accountText.ColumnExpr(query, args...)
+	return q, true
 }
 
 // Query example (SQLite):
 //
 //	SELECT "status"."id"
 //	FROM "statuses" AS "status"
+//	JOIN "status_texts" AS "status_text" ON "status_text"."status_id" = "status"."id"
+//	JOIN "status_texts_fts" ON "status_texts_fts"."rowid" = "status_text"."id"
 //	WHERE ("status"."boost_of_id" IS NULL)
 //	AND (("status"."account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF') OR ("status"."in_reply_to_account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF'))
 //	AND ("status"."id" < 'ZZZZZZZZZZZZZZZZZZZZZZZZZZ')
-//	AND ((SELECT "status"."content" || COALESCE("status"."content_warning", '') AS "status_text") LIKE '%hello%' ESCAPE '\')
-//	ORDER BY "status"."id" DESC LIMIT 10
+//	AND ("status_texts_fts" MATCH '"hello"*')
+//	ORDER BY bm25("status_texts_fts"), "status"."id" DESC LIMIT 10
 func (s *searchDB) SearchForStatuses(
 	ctx context.Context,
 	requestingAccountID string,
 	query string,
-	fromAccountID string,
+	filter *db.StatusSearchFilter,
 	maxID string,
 	minID string,
 	limit int,
@@ -283,6 +318,7 @@ func (s *searchDB) SearchForStatuses(
 	var (
 		statusIDs   = make([]string, 0, limit)
 		frontToBack = true
+		byRelevance = (maxID == "" && minID == "")
 	)
 
 	q := s.db.
@@ -291,18 +327,45 @@ func (s *searchDB) SearchForStatuses(
 		// Select only IDs from table
 		Column("status.id").
 		// Ignore boosts.
-		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? IS NULL", bun.Ident("status.boost_of_id"))
+
+	if filter == nil {
+		filter = new(db.StatusSearchFilter)
+	}
+
+	if filter.InLibrary {
+		// Select only statuses created,
+		// faved, or bookmarked by accountID.
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("status.account_id"), requestingAccountID).
+				WhereOr("? IN (?)", bun.Ident("status.id"), s.db.
+					NewSelect().
+					TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+					Column("status_fave.status_id").
+					Where("? = ?", bun.Ident("status_fave.account_id"), requestingAccountID),
+				).
+				WhereOr("? IN (?)", bun.Ident("status.id"), s.db.
+					NewSelect().
+					TableExpr("? AS ?", bun.Ident("status_bookmarks"), bun.Ident("status_bookmark")).
+					Column("status_bookmark.status_id").
+					Where("? = ?", bun.Ident("status_bookmark.account_id"), requestingAccountID),
+				)
+		})
+	} else {
 		// Select only statuses created by
 		// accountID or replying to accountID.
-		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
 			return q.
 				Where("? = ?", bun.Ident("status.account_id"), requestingAccountID).
 				WhereOr("? = ?", bun.Ident("status.in_reply_to_account_id"), requestingAccountID)
 		})
-	if fromAccountID != "" {
-		q = q.Where("? = ?", bun.Ident("status.account_id"), fromAccountID)
 	}
 
+	// Apply any other restrictions from the
+	// filter, noting whether there were any.
+	q, filtered := s.statusSearchFilter(q, filter)
+
 	// Return only items with a LOWER id than maxID.
 	if maxID == "" {
 		maxID = id.Highest
@@ -317,19 +380,24 @@ func (s *searchDB) SearchForStatuses(
 		frontToBack = false
 	}
 
-	// Select status text as subquery.
-	statusTextSubq := s.statusText()
-
-	// Search using LIKE for matches of query
-	// string within statusText subquery.
-	q = whereLike(q, statusTextSubq, query)
+	// Search the full-text index for statuses
+	// containing query terms and phrases.
+	q, ok := s.statusTextMatch(q, query, filter.Phrases, byRelevance)
+	if !ok && !filtered {
+		// Nothing to search for.
+		return nil, nil
+	}
 
 	if limit > 0 {
 		// Limit amount of statuses returned.
 		q = q.Limit(limit)
 	}
 
-	if frontToBack {
+	if byRelevance {
+		// Page by offset, with most
+		// relevant first, then newest.
+		q = q.Order("status.id DESC").Offset(offset)
+	} else if frontToBack {
 		// Page down.
 		q = q.Order("status.id DESC")
 	} else {
@@ -370,32 +438,163 @@ func (s *searchDB) SearchForStatuses(
 	return statuses, nil
 }
 
-// statusText returns a subquery that selects a concatenation
-// of status content and content warning as "status_text".
-func (s *searchDB) statusText() *bun.SelectQuery {
-	statusText := s.db.NewSelect()
+// statusTextMatch joins the given query on the status full-text
+// index, and matches statuses containing all the terms of the given
+// search query in their content or content warning. Words are
+// stemmed, so eg., a search for "cats" will also match "cat".
+//
+// If byRelevance is true, the query will be ordered by relevance.
+// If the search query contains no terms, false is returned.
+// statusSearchFilter restricts the given query
+// according to filter, other than FromAccountID,
+// Phrases, and InLibrary. It returns false if the
+// filter didn't restrict the query at all.
+func (s *searchDB) statusSearchFilter(
+	q *bun.SelectQuery,
+	filter *db.StatusSearchFilter,
+) (*bun.SelectQuery, bool) {
+	filtered := false
+
+	if filter.FromAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("status.account_id"), filter.FromAccountID)
+		filtered = true
+	}
+
+	if filter.HasMedia {
+		q = q.Where("? IN (?)", bun.Ident("status.id"), s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+			Column("media_attachment.status_id").
+			Where("? IS NOT NULL", bun.Ident("media_attachment.status_id")),
+		)
+		filtered = true
+	}
+
+	if filter.HasPoll {
+		q = q.Where("? IS NOT NULL", bun.Ident("status.poll_id"))
+		filtered = true
+	}
+
+	if filter.IsReply {
+		q = q.Where("? IS NOT NULL", bun.Ident("status.in_reply_to_uri"))
+		filtered = true
+	}
+
+	if filter.IsSensitive {
+		q = q.Where("? = ?", bun.Ident("status.sensitive"), true)
+		filtered = true
+	}
+
+	if filter.Language != "" {
+		q = q.Where("? = ?", bun.Ident("status.language"), filter.Language)
+		filtered = true
+	}
+
+	if !filter.Since.IsZero() {
+		q = q.Where("? >= ?", bun.Ident("status.created_at"), filter.Since)
+		filtered = true
+	}
+
+	if !filter.Until.IsZero() {
+		q = q.Where("? < ?", bun.Ident("status.created_at"), filter.Until)
+		filtered = true
+	}
+
+	return q, filtered
+}
+
+// statusTextMatch restricts the given query to statuses
+// whose text contains words starting with all of the terms
+// in query, and all of the given phrases. It returns false if there was
+// nothing to search for, leaving the query unchanged.
+func (s *searchDB) statusTextMatch(
+	q *bun.SelectQuery,
+	query string,
+	phrases []string,
+	byRelevance bool,
+) (*bun.SelectQuery, bool) {
+	terms := searchTerms(query)
+
+	// Reduce each phrase to its
+	// terms, dropping empty ones.
+	phraseTerms := make([][]string, 0, len(phrases))
+	for _, phrase := range phrases {
+		if t := searchTerms(phrase); len(t) > 0 {
+			phraseTerms = append(phraseTerms, t)
+		}
+	}
+
+	if len(terms) == 0 && len(phraseTerms) == 0 {
+		return q, false
+	}
+
+	q = q.Join(
+		"JOIN ? AS ? ON ? = ?",
+		bun.Ident("status_texts"), bun.Ident("status_text"),
+		bun.Ident("status_text.status_id"), bun.Ident("status.id"),
+	)
 
-	// SQLite and Postgres use different
-	// syntaxes for concatenation.
 	switch d := s.db.Dialect().Name(); d {
 
 	case dialect.SQLite:
-		statusText = statusText.ColumnExpr(
-			"? || COALESCE(?, ?) AS ?",
-			bun.Ident("status.content"), bun.Ident("status.content_warning"), "",
-			bun.Ident("status_text"))
+		// Build an FTS5 query matching all
+		// prefix terms and phrases. Within
+		// quotes, multiple terms form a phrase.
+		match := make([]string, 0, len(terms)+len(phraseTerms))
+		for _, term := range terms {
+			match = append(match, `"`+term+`"*`)
+		}
+		for _, t := range phraseTerms {
+			match = append(match, `"`+strings.Join(t, " ")+`"`)
+		}
+
+		q = q.
+			Join(
+				"JOIN ? ON ? = ?",
+				bun.Ident("status_texts_fts"),
+				bun.Ident("status_texts_fts.rowid"), bun.Ident("status_text.id"),
+			).
+			Where("? MATCH ?", bun.Ident("status_texts_fts"), strings.Join(match, " "))
+
+		if byRelevance {
+			// Lower bm25 is more relevant.
+			q = q.OrderExpr("bm25(?)", bun.Ident("status_texts_fts"))
+		}
 
 	case dialect.PG:
-		statusText = statusText.ColumnExpr(
-			"CONCAT(?, COALESCE(?, ?)) AS ?",
-			bun.Ident("status.content"), bun.Ident("status.content_warning"), "",
-			bun.Ident("status_text"))
+		// to_tsquery matches all prefix terms, and
+		// phraseto_tsquery matches terms in order.
+		var (
+			exprs = make([]string, 0, 1+len(phraseTerms))
+			args  = make([]any, 0, 1+2*cap(exprs))
+		)
+		if len(terms) > 0 {
+			tsquery := make([]string, len(terms))
+			for i, term := range terms {
+				tsquery[i] = term + ":*"
+			}
+			exprs = append(exprs, "to_tsquery(?, ?)")
+			args = append(args, statusSearchConfig, strings.Join(tsquery, " & "))
+		}
+		for _, t := range phraseTerms {
+			exprs = append(exprs, "phraseto_tsquery(?, ?)")
+			args = append(args, statusSearchConfig, strings.Join(t, " "))
+		}
+		match := strings.Join(exprs, " && ")
+		args = append([]any{bun.Ident("status_text.text_vector")}, args...)
+
+		q = q.Where("? @@ ("+match+")", args...)
+
+		if byRelevance {
+			// Higher ts_rank is more relevant.
+			q = q.OrderExpr("ts_rank(?, "+match+") DESC", args...)
+		}
 
 	default:
 		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
 	}
 
-	return statusText
+	return q, true
 }
 
 // Query example (SQLite):
@@ -421,6 +620,7 @@ func (s *searchDB) SearchForTags(
 	var (
 		tagIDs      = make([]string, 0, limit)
 		frontToBack = true
+		byRelevance = (maxID == "" && minID == "")
 	)
 
 	q := s.db.
@@ -455,7 +655,10 @@ func (s *searchDB) SearchForTags(
 		q = q.Limit(limit)
 	}
 
-	if frontToBack {
+	if byRelevance {
+		// Page by offset, newest first.
+		q = q.Order("tag.id DESC").Offset(offset)
+	} else if frontToBack {
 		// Page down.
 		q = q.Order("tag.id DESC")
 	} else {
@@ -20,9 +20,11 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type SearchTestSuite struct {
@@ -107,7 +109,7 @@ func (suite *SearchTestSuite) TestSearchAccountsFossAny() {
 func (suite *SearchTestSuite) TestSearchStatuses() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hello", "", "", "", 10, 0)
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hello", nil, "", "", 10, 0)
 	suite.NoError(err)
 	suite.Len(statuses, 1)
 }
@@ -116,13 +118,203 @@ func (suite *SearchTestSuite) TestSearchStatusesFromAccount() {
 	testAccount := suite.testAccounts["local_account_1"]
 	fromAccount := suite.testAccounts["local_account_2"]
 
-	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hi", fromAccount.ID, "", "", 10, 0)
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hi", &db.StatusSearchFilter{FromAccountID: fromAccount.ID}, "", "", 10, 0)
 	suite.NoError(err)
 	if suite.Len(statuses, 1) {
 		suite.Equal(fromAccount.ID, statuses[0].AccountID)
 	}
 }
 
+func (suite *SearchTestSuite) TestSearchStatusesStemmed() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Should match "what do you think of sloths?"
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "sloth", nil, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_6"].ID, statuses[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesWholeWords() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Shouldn't match "hello everyone!"
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "ello", nil, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesOffset() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Page through results one at a time.
+	seen := make(map[string]struct{})
+	for offset := 0; offset < 4; offset++ {
+		statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "post", nil, "", "", 1, offset)
+		suite.NoError(err)
+		if suite.Len(statuses, 1) {
+			seen[statuses[0].ID] = struct{}{}
+		}
+	}
+	suite.Len(seen, 4)
+
+	// Nothing left after the last page.
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "post", nil, "", "", 1, 4)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesUpdatedAndDeleted() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Change the content of a status.
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["local_account_1_status_6"]
+	status.Content = "what do you think of pangolins?"
+	if err := suite.db.UpdateStatus(ctx, status, "content"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Should be found by the new text only.
+	statuses, err := suite.db.SearchForStatuses(ctx, testAccount.ID, "pangolins", nil, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Len(statuses, 1)
+
+	statuses, err = suite.db.SearchForStatuses(ctx, testAccount.ID, "sloths", nil, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+
+	// Delete the status.
+	if err := suite.db.DeleteStatusByID(ctx, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statuses, err = suite.db.SearchForStatuses(ctx, testAccount.ID, "pangolins", nil, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesNothingToSearch() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// No terms and no filter.
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "!!!", nil, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesHasMedia() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Filter alone, with no query terms.
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "", &db.StatusSearchFilter{HasMedia: true}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_4"].ID, statuses[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesHasPoll() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "sloths", &db.StatusSearchFilter{HasPoll: true}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_6"].ID, statuses[0].ID)
+	}
+
+	// "hi!" has no poll.
+	statuses, err = suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hi", &db.StatusSearchFilter{HasPoll: true}, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesIsReply() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Should match replies to zork, but not "hi!".
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hi", &db.StatusSearchFilter{IsReply: true}, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Len(statuses, 2)
+	for _, status := range statuses {
+		suite.NotEmpty(status.InReplyToURI)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesIsSensitive() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "post", &db.StatusSearchFilter{IsSensitive: true}, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Len(statuses, 2)
+	for _, status := range statuses {
+		suite.True(*status.Sensitive)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesLanguage() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "post", &db.StatusSearchFilter{Language: "en"}, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Len(statuses, 4)
+
+	statuses, err = suite.db.SearchForStatuses(context.Background(), testAccount.ID, "post", &db.StatusSearchFilter{Language: "de"}, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesDates() {
+	testAccount := suite.testAccounts["local_account_1"]
+	newYear2022 := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	newYear2023 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// Posts from 2021.
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "post", &db.StatusSearchFilter{Until: newYear2022}, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Len(statuses, 3)
+
+	// Posts from 2023.
+	statuses, err = suite.db.SearchForStatuses(context.Background(), testAccount.ID, "post", &db.StatusSearchFilter{Since: newYear2023}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_7"].ID, statuses[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesPhrases() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "", &db.StatusSearchFilter{Phrases: []string{"very personal"}}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_1_status_3"].ID, statuses[0].ID)
+	}
+
+	// Same words, wrong order.
+	statuses, err = suite.db.SearchForStatuses(context.Background(), testAccount.ID, "", &db.StatusSearchFilter{Phrases: []string{"personal very"}}, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesInLibrary() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Turtles are only in a status that
+	// local_account_1 faved, not their own.
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "turtles", nil, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+
+	statuses, err = suite.db.SearchForStatuses(context.Background(), testAccount.ID, "turtles", &db.StatusSearchFilter{InLibrary: true}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(suite.testStatuses["local_account_2_status_1"].ID, statuses[0].ID)
+	}
+}
+
 func (suite *SearchTestSuite) TestSearchTags() {
 	// Search with full tag string.
 	tags, err := suite.db.SearchForTags(context.Background(), "welcome", "", "", 10, 0)
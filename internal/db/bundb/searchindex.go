@@ -0,0 +1,229 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// Full-text search indexes for statuses and accounts are
+// stored in their own tables, and kept in sync with the
+// statuses and accounts tables by the put, update, and
+// delete functions of statusDB and accountDB.
+//
+// On Postgres, each index table holds a tsvector column
+// with a GIN index on it. On SQLite, each index table holds
+// plaintext, and is used as the external content table of
+// an FTS5 virtual table, which is kept in sync by triggers.
+//
+// See the 20240719093512_full_text_search migration.
+
+const (
+	// statusSearchConfig is the Postgres text search configuration
+	// used for status text. This stems words, which matches the
+	// porter tokenizer used for the SQLite FTS5 status index.
+	statusSearchConfig = "english"
+
+	// accountSearchConfig is the Postgres text search
+	// configuration used for account names and notes.
+	// Names shouldn't be stemmed or have stop words
+	// removed, so the simple config is used.
+	accountSearchConfig = "simple"
+)
+
+// searchText converts the given HTML parts into
+// one plaintext string suitable for indexing.
+func searchText(parts ...string) string {
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		// Make sure words either side
+		// of an HTML tag (including any
+		// escaped ones) don't get run
+		// together when it's stripped.
+		part = html.UnescapeString(part)
+		part = strings.ReplaceAll(part, "<", " <")
+		part = text.SanitizeToPlaintext(part)
+
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// searchTerms splits the given search query into
+// terms consisting only of letters and numbers,
+// which are safe to use in full-text search syntax.
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) &&
+			!unicode.IsNumber(r) &&
+			!unicode.IsMark(r)
+	})
+}
+
+// indexStatus adds or updates the given
+// status in the full-text search index.
+func indexStatus(ctx context.Context, tx bun.IDB, status *gtsmodel.Status) error {
+	if status.BoostOfID != "" {
+		// Boosts have no
+		// text of their own.
+		return nil
+	}
+
+	statusText := searchText(
+		status.ContentWarning,
+		status.Content,
+	)
+
+	if statusText == "" {
+		// Nothing to index, make sure
+		// any previous text is removed.
+		return deindexStatus(ctx, tx, status.ID)
+	}
+
+	var q *bun.RawQuery
+
+	switch d := tx.Dialect().Name(); d {
+	case dialect.SQLite:
+		q = tx.NewRaw(
+			"INSERT INTO ? (?, ?) VALUES (?, ?) ON CONFLICT (?) DO UPDATE SET ? = EXCLUDED.?",
+			bun.Ident("status_texts"), bun.Ident("status_id"), bun.Ident("text"),
+			status.ID, statusText,
+			bun.Ident("status_id"), bun.Ident("text"), bun.Ident("text"),
+		)
+
+	case dialect.PG:
+		q = tx.NewRaw(
+			"INSERT INTO ? (?, ?) VALUES (?, to_tsvector(?, ?)) ON CONFLICT (?) DO UPDATE SET ? = EXCLUDED.?",
+			bun.Ident("status_texts"), bun.Ident("status_id"), bun.Ident("text_vector"),
+			status.ID, statusSearchConfig, statusText,
+			bun.Ident("status_id"), bun.Ident("text_vector"), bun.Ident("text_vector"),
+		)
+
+	default:
+		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+	}
+
+	_, err := q.Exec(ctx)
+	return err
+}
+
+// deindexStatus removes the status with the
+// given ID from the full-text search index.
+func deindexStatus(ctx context.Context, tx bun.IDB, statusID string) error {
+	_, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_texts"), bun.Ident("status_text")).
+		Where("? = ?", bun.Ident("status_text.status_id"), statusID).
+		Exec(ctx)
+	return err
+}
+
+// indexAccount adds or updates the given
+// account in the full-text search index.
+func indexAccount(ctx context.Context, tx bun.IDB, account *gtsmodel.Account) error {
+	var (
+		names = searchText(account.Username, account.DisplayName)
+		note  = searchText(account.Note)
+		q     *bun.RawQuery
+	)
+
+	switch d := tx.Dialect().Name(); d {
+	case dialect.SQLite:
+		q = tx.NewRaw(
+			"INSERT INTO ? (?, ?, ?) VALUES (?, ?, ?) ON CONFLICT (?) DO UPDATE SET ? = EXCLUDED.?, ? = EXCLUDED.?",
+			bun.Ident("account_texts"), bun.Ident("account_id"), bun.Ident("names"), bun.Ident("note"),
+			account.ID, names, note,
+			bun.Ident("account_id"),
+			bun.Ident("names"), bun.Ident("names"),
+			bun.Ident("note"), bun.Ident("note"),
+		)
+
+	case dialect.PG:
+		// Names and note are given different
+		// weights, so that a search can be
+		// restricted to only names if needed.
+		q = tx.NewRaw(
+			"INSERT INTO ? (?, ?) VALUES (?, setweight(to_tsvector(?, ?), 'A') || setweight(to_tsvector(?, ?), 'B')) ON CONFLICT (?) DO UPDATE SET ? = EXCLUDED.?",
+			bun.Ident("account_texts"), bun.Ident("account_id"), bun.Ident("text_vector"),
+			account.ID, accountSearchConfig, names, accountSearchConfig, note,
+			bun.Ident("account_id"), bun.Ident("text_vector"), bun.Ident("text_vector"),
+		)
+
+	default:
+		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+	}
+
+	_, err := q.Exec(ctx)
+	return err
+}
+
+// deindexAccount removes the account with the
+// given ID from the full-text search index.
+func deindexAccount(ctx context.Context, tx bun.IDB, accountID string) error {
+	_, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("account_texts"), bun.Ident("account_text")).
+		Where("? = ?", bun.Ident("account_text.account_id"), accountID).
+		Exec(ctx)
+	return err
+}
+
+// statusTextColumns are the status columns which,
+// if updated, require the status to be reindexed.
+var statusTextColumns = []string{
+	"content",
+	"content_warning",
+}
+
+// accountTextColumns are the account columns which,
+// if updated, require the account to be reindexed.
+var accountTextColumns = []string{
+	"username",
+	"display_name",
+	"note",
+}
+
+// needsReindex returns whether an update of the given
+// columns (where none means all) touches any of the
+// given text columns, and so requires reindexing.
+func needsReindex(columns []string, textColumns []string) bool {
+	if len(columns) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(columns, func(column string) bool {
+		return slices.Contains(textColumns, column)
+	})
+}
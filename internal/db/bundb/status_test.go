@@ -44,9 +44,7 @@ func (suite *StatusTestSuite) TestGetStatusByID() {
 	suite.Nil(status.InReplyTo)
 	suite.Nil(status.InReplyToAccount)
 	suite.True(*status.Federated)
-	suite.True(*status.Boostable)
-	suite.True(*status.Replyable)
-	suite.True(*status.Likeable)
+	suite.Nil(status.InteractionPolicy)
 }
 
 func (suite *StatusTestSuite) TestGetStatusesByIDs() {
@@ -73,9 +71,7 @@ func (suite *StatusTestSuite) TestGetStatusesByIDs() {
 	suite.Nil(status1.InReplyTo)
 	suite.Nil(status1.InReplyToAccount)
 	suite.True(*status1.Federated)
-	suite.True(*status1.Boostable)
-	suite.True(*status1.Replyable)
-	suite.True(*status1.Likeable)
+	suite.Nil(status1.InteractionPolicy)
 
 	status2 := statuses[1]
 	suite.NotNil(status2)
@@ -86,9 +82,9 @@ func (suite *StatusTestSuite) TestGetStatusesByIDs() {
 	suite.Nil(status2.InReplyTo)
 	suite.Nil(status2.InReplyToAccount)
 	suite.True(*status2.Federated)
-	suite.True(*status2.Boostable)
-	suite.False(*status2.Replyable)
-	suite.False(*status2.Likeable)
+	suite.Equal(gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic}, status2.InteractionPolicy.CanAnnounce.Always)
+	suite.Equal(gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor}, status2.InteractionPolicy.CanReply.Always)
+	suite.Equal(gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor}, status2.InteractionPolicy.CanLike.Always)
 }
 
 func (suite *StatusTestSuite) TestGetStatusByURI() {
@@ -104,9 +100,9 @@ func (suite *StatusTestSuite) TestGetStatusByURI() {
 	suite.Nil(status.InReplyTo)
 	suite.Nil(status.InReplyToAccount)
 	suite.True(*status.Federated)
-	suite.True(*status.Boostable)
-	suite.False(*status.Replyable)
-	suite.False(*status.Likeable)
+	suite.Equal(gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic}, status.InteractionPolicy.CanAnnounce.Always)
+	suite.Equal(gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor}, status.InteractionPolicy.CanReply.Always)
+	suite.Equal(gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor}, status.InteractionPolicy.CanLike.Always)
 }
 
 func (suite *StatusTestSuite) TestGetStatusWithExtras() {
@@ -121,9 +117,7 @@ func (suite *StatusTestSuite) TestGetStatusWithExtras() {
 	suite.NotEmpty(status.Attachments)
 	suite.NotEmpty(status.Emojis)
 	suite.True(*status.Federated)
-	suite.True(*status.Boostable)
-	suite.True(*status.Replyable)
-	suite.True(*status.Likeable)
+	suite.Nil(status.InteractionPolicy)
 }
 
 func (suite *StatusTestSuite) TestGetStatusWithMention() {
@@ -138,9 +132,7 @@ func (suite *StatusTestSuite) TestGetStatusWithMention() {
 	suite.NotEmpty(status.InReplyToID)
 	suite.NotEmpty(status.InReplyToAccountID)
 	suite.True(*status.Federated)
-	suite.True(*status.Boostable)
-	suite.True(*status.Replyable)
-	suite.True(*status.Likeable)
+	suite.Nil(status.InteractionPolicy)
 }
 
 // The below test was originally used to ensure that a second
@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type StatusEditTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) TestPutGetDeleteStatusEdits() {
+	ctx := context.Background()
+
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	// Create two revisions of the status.
+	edits := make([]*gtsmodel.StatusEdit, 2)
+	for i := range edits {
+		edits[i] = &gtsmodel.StatusEdit{
+			ID:             id.NewULID(),
+			Content:        status.Content,
+			ContentWarning: status.ContentWarning,
+			Text:           status.Text,
+			Language:       status.Language,
+			Sensitive:      util.Ptr(false),
+			StatusID:       status.ID,
+			CreatedAt:      time.Now(),
+		}
+
+		if err := suite.db.PutStatusEdit(ctx, edits[i]); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Fetch a single edit by ID.
+	edit, err := suite.db.GetStatusEditByID(ctx, edits[0].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(status.ID, edit.StatusID)
+	suite.Equal(status.Content, edit.Content)
+	suite.False(*edit.Sensitive)
+
+	// Fetch edits by IDs, in given order.
+	ids := []string{edits[1].ID, edits[0].ID}
+	got, err := suite.db.GetStatusEditsByIDs(ctx, ids)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(got, 2)
+	suite.Equal(ids[0], got[0].ID)
+	suite.Equal(ids[1], got[1].ID)
+
+	// Delete the edits, they should be gone.
+	if err := suite.db.DeleteStatusEdits(ctx, ids); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetStatusEditByID(ctx, edits[0].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
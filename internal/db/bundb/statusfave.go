@@ -23,12 +23,14 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -77,6 +79,21 @@ func (s *statusFaveDB) GetStatusFaveByID(ctx context.Context, id string) (*gtsmo
 	)
 }
 
+func (s *statusFaveDB) GetStatusFaveByURI(ctx context.Context, uri string) (*gtsmodel.StatusFave, error) {
+	return s.getStatusFave(
+		ctx,
+		"URI",
+		func(fave *gtsmodel.StatusFave) error {
+			return s.db.
+				NewSelect().
+				Model(fave).
+				Where("? = ?", bun.Ident("uri"), uri).
+				Scan(ctx)
+		},
+		uri,
+	)
+}
+
 func (s *statusFaveDB) getStatusFave(ctx context.Context, lookup string, dbQuery func(*gtsmodel.StatusFave) error, keyParts ...any) (*gtsmodel.StatusFave, error) {
 	// Fetch status fave from database cache with loader callback
 	fave, err := s.state.Caches.GTS.StatusFave.LoadOne(lookup, func() (*gtsmodel.StatusFave, error) {
@@ -113,6 +130,71 @@ func (s *statusFaveDB) GetStatusFaves(ctx context.Context, statusID string) ([]*
 		return nil, err
 	}
 
+	return s.getStatusFavesByIDs(ctx, faveIDs)
+}
+
+func (s *statusFaveDB) GetPendingStatusFavesForAccount(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.StatusFave, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		faveIDs = make([]string, 0, limit)
+	)
+
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		// Select only IDs from table.
+		Column("status_fave.id").
+		Where("? = ?", bun.Ident("status_fave.pending_approval"), true).
+		Where("? = ?", bun.Ident("status_fave.target_account_id"), accountID)
+
+	// Return only faves LOWER (ie., older) than maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status_fave.id"), maxID)
+	}
+
+	// Return only faves HIGHER (ie., newer) than minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status_fave.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of faves returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("status_fave.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("status_fave.id"))
+	}
+
+	if err := q.Scan(ctx, &faveIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no faves early.
+	if len(faveIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want faves
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(faveIDs)
+	}
+
+	return s.getStatusFavesByIDs(ctx, faveIDs)
+}
+
+func (s *statusFaveDB) getStatusFavesByIDs(ctx context.Context, faveIDs []string) ([]*gtsmodel.StatusFave, error) {
 	// Load all fave IDs via cache loader callbacks.
 	faves, err := s.state.Caches.GTS.StatusFave.LoadIDs("ID",
 		faveIDs,
@@ -242,6 +324,24 @@ func (s *statusFaveDB) PutStatusFave(ctx context.Context, fave *gtsmodel.StatusF
 	})
 }
 
+func (s *statusFaveDB) UpdateStatusFave(ctx context.Context, fave *gtsmodel.StatusFave, columns ...string) error {
+	fave.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return s.state.Caches.GTS.StatusFave.Store(fave, func() error {
+		_, err := s.db.
+			NewUpdate().
+			Model(fave).
+			Where("? = ?", bun.Ident("status_fave.id"), fave.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
 func (s *statusFaveDB) DeleteStatusFaveByID(ctx context.Context, id string) error {
 	var statusID string
 
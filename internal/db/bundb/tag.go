@@ -19,9 +19,13 @@ package bundb
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"strings"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -131,3 +135,109 @@ func (t *tagDB) PutTag(ctx context.Context, tag *gtsmodel.Tag) error {
 
 	return nil
 }
+
+func (t *tagDB) GetFollowedTags(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Tag, error) {
+	tagIDs, err := t.GetFollowedTagIDs(ctx, accountID, page)
+	if err != nil {
+		return nil, err
+	}
+	return t.GetTags(ctx, tagIDs)
+}
+
+func (t *tagDB) GetFollowedTagIDs(ctx context.Context, accountID string, page *paging.Page) ([]string, error) {
+	return loadPagedIDs(&t.state.Caches.GTS.FollowedTagIDs, accountID, page, func() ([]string, error) {
+		var tagIDs []string
+
+		// Followed tag IDs not in cache, perform DB query!
+		if err := t.db.
+			NewSelect().
+			TableExpr("?", bun.Ident("followed_tags")).
+			ColumnExpr("?", bun.Ident("tag_id")).
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			OrderExpr("? DESC", bun.Ident("tag_id")).
+			Scan(ctx, &tagIDs); // nocollapse
+		err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		return tagIDs, nil
+	})
+}
+
+func (t *tagDB) IsAccountFollowingTag(ctx context.Context, accountID string, tagID string) (bool, error) {
+	tagIDs, err := t.GetFollowedTagIDs(ctx, accountID, nil)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(tagIDs, tagID), nil
+}
+
+func (t *tagDB) PutFollowedTag(ctx context.Context, accountID string, tagID string) error {
+	if _, err := t.db.
+		NewInsert().
+		Model(&gtsmodel.FollowedTag{
+			AccountID: accountID,
+			TagID:     tagID,
+		}).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("tag_id")).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	t.invalidateFollowedTags(accountID)
+	return nil
+}
+
+func (t *tagDB) DeleteFollowedTag(ctx context.Context, accountID string, tagID string) error {
+	if _, err := t.db.
+		NewDelete().
+		Table("followed_tags").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? = ?", bun.Ident("tag_id"), tagID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	t.invalidateFollowedTags(accountID)
+	return nil
+}
+
+func (t *tagDB) DeleteFollowedTagsByAccountID(ctx context.Context, accountID string) error {
+	if _, err := t.db.
+		NewDelete().
+		Table("followed_tags").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	t.invalidateFollowedTags(accountID)
+	return nil
+}
+
+func (t *tagDB) GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+
+	var accountIDs []string
+	if err := t.db.
+		NewSelect().
+		TableExpr("?", bun.Ident("followed_tags")).
+		ColumnExpr("DISTINCT ?", bun.Ident("account_id")).
+		Where("? IN (?)", bun.Ident("tag_id"), bun.In(tagIDs)).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+// invalidateFollowedTags invalidates cached followed tag IDs
+// of the given account, as well as any cached visibility
+// where it's the requester, since followed tags affect
+// which statuses are visible in its home timeline.
+func (t *tagDB) invalidateFollowedTags(accountID string) {
+	t.state.Caches.GTS.FollowedTagIDs.Invalidate(accountID)
+	t.state.Caches.Visibility.Invalidate("RequesterID", accountID)
+}
@@ -89,6 +89,61 @@ func (suite *TagTestSuite) TestPutTag() {
 	}
 }
 
+func (suite *TagTestSuite) TestFollowedTags() {
+	var (
+		ctx       = context.Background()
+		accountID = suite.testAccounts["local_account_1"].ID
+		welcome   = suite.testTags["welcome"]
+		hashtag   = suite.testTags["Hashtag"]
+	)
+
+	// Follow both tags, following one
+	// twice shouldn't cause an error.
+	for _, tag := range []*gtsmodel.Tag{welcome, hashtag, welcome} {
+		if err := suite.db.PutFollowedTag(ctx, accountID, tag.ID); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	following, err := suite.db.IsAccountFollowingTag(ctx, accountID, welcome.ID)
+	suite.NoError(err)
+	suite.True(following)
+
+	// Followed tags should be returned by tag ID descending.
+	tags, err := suite.db.GetFollowedTags(ctx, accountID, nil)
+	suite.NoError(err)
+	if suite.Len(tags, 2) {
+		suite.Equal(hashtag.ID, tags[0].ID)
+		suite.Equal(welcome.ID, tags[1].ID)
+	}
+
+	accountIDs, err := suite.db.GetAccountIDsFollowingTagIDs(ctx, []string{welcome.ID, hashtag.ID})
+	suite.NoError(err)
+	suite.Equal([]string{accountID}, accountIDs)
+
+	// Unfollow one tag.
+	if err := suite.db.DeleteFollowedTag(ctx, accountID, welcome.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	following, err = suite.db.IsAccountFollowingTag(ctx, accountID, welcome.ID)
+	suite.NoError(err)
+	suite.False(following)
+
+	accountIDs, err = suite.db.GetAccountIDsFollowingTagIDs(ctx, []string{welcome.ID})
+	suite.NoError(err)
+	suite.Empty(accountIDs)
+
+	// Unfollow everything.
+	if err := suite.db.DeleteFollowedTagsByAccountID(ctx, accountID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tags, err = suite.db.GetFollowedTags(ctx, accountID, nil)
+	suite.NoError(err)
+	suite.Empty(tags)
+}
+
 func TestTagTestSuite(t *testing.T) {
 	suite.Run(t, new(TagTestSuite))
 }
@@ -65,9 +65,6 @@ func getFutureStatus() *gtsmodel.Status {
 		Language:                 "en",
 		CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 }
@@ -192,6 +189,33 @@ func (suite *TimelineTestSuite) TestGetHomeTimelineNoFollowing() {
 	suite.checkStatuses(s, id.Highest, id.Lowest, 7)
 }
 
+func (suite *TimelineTestSuite) TestGetHomeTimelineFollowedTag() {
+	var (
+		ctx            = context.Background()
+		viewingAccount = suite.testAccounts["local_account_2"]
+		taggedStatus   = suite.testStatuses["admin_account_status_1"]
+	)
+
+	// viewingAccount doesn't follow the admin
+	// account, so the tagged status isn't there.
+	s, err := suite.db.GetHomeTimeline(ctx, viewingAccount.ID, "", "", "", 20, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotContains(statusIDs(s), taggedStatus.ID)
+
+	// Follow the #welcome tag used by the status.
+	if err := suite.db.PutFollowedTag(ctx, viewingAccount.ID, suite.testTags["welcome"].ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	s, err = suite.db.GetHomeTimeline(ctx, viewingAccount.ID, "", "", "", 20, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Contains(statusIDs(s), taggedStatus.ID)
+}
+
 func (suite *TimelineTestSuite) TestGetHomeTimelineWithFutureStatus() {
 	var (
 		ctx            = context.Background()
@@ -323,6 +347,14 @@ func (suite *TimelineTestSuite) TestGetTagTimelineNoParams() {
 	suite.Equal("01F8MH75CBF9JFX4ZAD54N0W0R", s[0].ID)
 }
 
+func statusIDs(statuses []*gtsmodel.Status) []string {
+	ids := make([]string, len(statuses))
+	for i, status := range statuses {
+		ids[i] = status.ID
+	}
+	return ids
+}
+
 func TestTimelineTestSuite(t *testing.T) {
 	suite.Run(t, new(TimelineTestSuite))
 }
@@ -0,0 +1,257 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type trendDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (t *trendDB) GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error) {
+	var trend gtsmodel.Trend
+
+	if err := t.db.
+		NewSelect().
+		Model(&trend).
+		Where("? = ?", bun.Ident("trend.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &trend, nil
+}
+
+func (t *trendDB) GetTrends(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	review gtsmodel.TrendReview,
+	limit int,
+	offset int,
+) ([]*gtsmodel.Trend, error) {
+	trends := make([]*gtsmodel.Trend, 0, limit)
+
+	q := t.db.
+		NewSelect().
+		Model(&trends).
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Where("? > 0", bun.Ident("trend.score"))
+
+	if review != gtsmodel.TrendReviewUnknown {
+		q = q.Where("? = ?", bun.Ident("trend.review"), review)
+	}
+
+	// Order by score, falling back
+	// to ID for a stable ordering.
+	q = q.
+		OrderExpr("? DESC", bun.Ident("trend.score")).
+		OrderExpr("? DESC", bun.Ident("trend.id"))
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return trends, nil
+}
+
+func (t *trendDB) GetAllTrends(ctx context.Context, trendType gtsmodel.TrendType) ([]*gtsmodel.Trend, error) {
+	trends := make([]*gtsmodel.Trend, 0)
+
+	if err := t.db.
+		NewSelect().
+		Model(&trends).
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return trends, nil
+}
+
+func (t *trendDB) PutTrend(ctx context.Context, trend *gtsmodel.Trend) error {
+	_, err := t.db.
+		NewInsert().
+		Model(trend).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error {
+	trend.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := t.db.
+		NewUpdate().
+		Model(trend).
+		Column(columns...).
+		Where("? = ?", bun.Ident("trend.id"), trend.ID).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) DeleteTrendByID(ctx context.Context, id string) error {
+	_, err := t.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("trends"), bun.Ident("trend")).
+		Where("? = ?", bun.Ident("trend.id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) GetTrendTagUses(ctx context.Context, since time.Time) ([]*db.TrendUse, error) {
+	uses := make([]*db.TrendUse, 0)
+
+	q := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		ColumnExpr("? AS ?", bun.Ident("status_to_tag.tag_id"), bun.Ident("target_id")).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("status.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		)
+	q = whereTrendableStatus(q, since)
+
+	if err := q.Scan(ctx, &uses); err != nil {
+		return nil, err
+	}
+
+	return uses, nil
+}
+
+func (t *trendDB) GetTrendStatusUses(ctx context.Context, since time.Time) ([]*db.TrendUse, error) {
+	faves := make([]*db.TrendUse, 0)
+
+	q := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		ColumnExpr("? AS ?", bun.Ident("status_fave.status_id"), bun.Ident("target_id")).
+		ColumnExpr("? AS ?", bun.Ident("status_fave.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("status_fave.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_fave.status_id"),
+		).
+		Where("? = ?", bun.Ident("status_fave.pending_approval"), false).
+		Where("? != ?", bun.Ident("status_fave.account_id"), bun.Ident("status.account_id"))
+	q = whereTrendableOriginalStatus(q, since)
+
+	if err := q.Scan(ctx, &faves); err != nil {
+		return nil, err
+	}
+
+	boosts := make([]*db.TrendUse, 0)
+
+	q = t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("boost")).
+		ColumnExpr("? AS ?", bun.Ident("boost.boost_of_id"), bun.Ident("target_id")).
+		ColumnExpr("? AS ?", bun.Ident("boost.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("boost.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("boost.boost_of_id"),
+		).
+		Where("? = ?", bun.Ident("boost.pending_approval"), false).
+		Where("? != ?", bun.Ident("boost.account_id"), bun.Ident("status.account_id"))
+	q = whereTrendableOriginalStatus(q, since)
+
+	if err := q.Scan(ctx, &boosts); err != nil {
+		return nil, err
+	}
+
+	return append(faves, boosts...), nil
+}
+
+func (t *trendDB) GetTrendLinkUses(ctx context.Context, since time.Time) ([]*db.TrendUse, error) {
+	uses := make([]*db.TrendUse, 0)
+
+	q := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		ColumnExpr("? AS ?", bun.Ident("status.id"), bun.Ident("target_id")).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("status.created_at"), bun.Ident("created_at")).
+		ColumnExpr("? AS ?", bun.Ident("status.content"), bun.Ident("content")).
+		// Cheaply skip statuses that can't contain
+		// links; the caller does the actual parsing.
+		Where("? LIKE ?", bun.Ident("status.content"), "%href=%")
+	q = whereTrendableStatus(q, since)
+
+	if err := q.Scan(ctx, &uses); err != nil {
+		return nil, err
+	}
+
+	return uses, nil
+}
+
+// whereTrendableStatus joins the author account of the query's
+// "status" table, and restricts it to public, non-boost statuses
+// created since the given time by non-silenced, non-suspended accounts.
+func whereTrendableStatus(q *bun.SelectQuery, since time.Time) *bun.SelectQuery {
+	return q.
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("status.account_id"),
+		).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? = ?", bun.Ident("status.pending_approval"), false).
+		Where("? > ?", bun.Ident("status.created_at"), since).
+		Where("? IS NULL", bun.Ident("account.silenced_at")).
+		Where("? IS NULL", bun.Ident("account.suspended_at"))
+}
+
+// whereTrendableOriginalStatus is like whereTrendableStatus,
+// but additionally excludes replies, and statuses by accounts
+// that haven't opted in to being discoverable.
+func whereTrendableOriginalStatus(q *bun.SelectQuery, since time.Time) *bun.SelectQuery {
+	return whereTrendableStatus(q, since).
+		Where("? IS NULL", bun.Ident("status.in_reply_to_uri")).
+		Where("? = ?", bun.Ident("account.discoverable"), true)
+}
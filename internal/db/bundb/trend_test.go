@@ -0,0 +1,117 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type TrendTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TrendTestSuite) TestGetTrendTagUses() {
+	ctx := context.Background()
+
+	// The only tagged fixture status is admin_account_status_1 with #welcome.
+	uses, err := suite.db.GetTrendTagUses(ctx, time.Time{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(uses, 1) {
+		suite.Equal(suite.testTags["welcome"].ID, uses[0].TargetID)
+		suite.Equal(suite.testAccounts["admin_account"].ID, uses[0].AccountID)
+		suite.Empty(uses[0].Domain)
+		suite.Equal(suite.testStatuses["admin_account_status_1"].CreatedAt.Unix(), uses[0].CreatedAt.Unix())
+	}
+
+	// Nothing is recent enough for a
+	// window starting in the present.
+	uses, err = suite.db.GetTrendTagUses(ctx, time.Now())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(uses)
+}
+
+func (suite *TrendTestSuite) TestGetTrends() {
+	ctx := context.Background()
+
+	for _, trend := range []*gtsmodel.Trend{
+		{Score: 1, Review: gtsmodel.TrendReviewApproved},
+		{Score: 3, Review: gtsmodel.TrendReviewPending},
+		{Score: 2, Review: gtsmodel.TrendReviewApproved},
+		{Score: 0, Review: gtsmodel.TrendReviewApproved},
+	} {
+		trend.ID = id.NewULID()
+		trend.Type = gtsmodel.TrendTypeLink
+		trend.TargetID = "https://example.org/" + trend.ID
+		trend.DayUses = []int{1, 2, 3}
+		if err := suite.db.PutTrend(ctx, trend); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	scores := func(trends []*gtsmodel.Trend) []float64 {
+		s := make([]float64, len(trends))
+		for i, trend := range trends {
+			s[i] = trend.Score
+		}
+		return s
+	}
+
+	// All trending links, highest score first.
+	trends, err := suite.db.GetTrends(ctx, gtsmodel.TrendTypeLink, gtsmodel.TrendReviewUnknown, 10, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]float64{3, 2, 1}, scores(trends))
+	suite.Equal([]int{1, 2, 3}, trends[0].DayUses)
+
+	// Approved trending links only, paged.
+	trends, err = suite.db.GetTrends(ctx, gtsmodel.TrendTypeLink, gtsmodel.TrendReviewApproved, 1, 1)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]float64{1}, scores(trends))
+
+	// No trending tags.
+	trends, err = suite.db.GetTrends(ctx, gtsmodel.TrendTypeTag, gtsmodel.TrendReviewUnknown, 10, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(trends)
+
+	// All links, including the one no longer trending.
+	trends, err = suite.db.GetAllTrends(ctx, gtsmodel.TrendTypeLink)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(trends, 4)
+}
+
+func TestTrendTestSuite(t *testing.T) {
+	suite.Run(t, new(TrendTestSuite))
+}
@@ -0,0 +1,147 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type webPushDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (w *webPushDB) GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, error) {
+	return w.state.Caches.GTS.WebPushSubscription.LoadOne("TokenID", func() (*gtsmodel.WebPushSubscription, error) {
+		var subscription gtsmodel.WebPushSubscription
+
+		// Not cached! Perform database query
+		if err := w.db.
+			NewSelect().
+			Model(&subscription).
+			Where("? = ?", bun.Ident("token_id"), tokenID).
+			Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &subscription, nil
+	}, tokenID)
+}
+
+func (w *webPushDB) GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, error) {
+	var subscriptionIDs []string
+
+	if err := w.db.
+		NewSelect().
+		Table("web_push_subscriptions").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx, &subscriptionIDs); err != nil {
+		return nil, err
+	}
+
+	if len(subscriptionIDs) == 0 {
+		return nil, nil
+	}
+
+	// Load all subscription IDs via cache loader callbacks.
+	subscriptions, err := w.state.Caches.GTS.WebPushSubscription.LoadIDs("ID",
+		subscriptionIDs,
+		func(uncached []string) ([]*gtsmodel.WebPushSubscription, error) {
+			// Preallocate expected length of uncached subscriptions.
+			subscriptions := make([]*gtsmodel.WebPushSubscription, 0, len(uncached))
+
+			// Perform database query scanning the
+			// remaining (uncached) subscription IDs.
+			if err := w.db.NewSelect().
+				Model(&subscriptions).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return subscriptions, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the subscriptions by their
+	// IDs to ensure in correct order.
+	getID := func(s *gtsmodel.WebPushSubscription) string { return s.ID }
+	util.OrderBy(subscriptions, subscriptionIDs, getID)
+
+	return subscriptions, nil
+}
+
+func (w *webPushDB) PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) error {
+	return w.state.Caches.GTS.WebPushSubscription.Store(subscription, func() error {
+		_, err := w.db.
+			NewInsert().
+			Model(subscription).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (w *webPushDB) UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) error {
+	subscription.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return w.state.Caches.GTS.WebPushSubscription.Store(subscription, func() error {
+		_, err := w.db.
+			NewUpdate().
+			Model(subscription).
+			Where("? = ?", bun.Ident("web_push_subscription.id"), subscription.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) error {
+	// Drop this subscription from cache on return after delete.
+	defer w.state.Caches.GTS.WebPushSubscription.Invalidate("TokenID", tokenID)
+
+	_, err := w.db.NewDelete().
+		Table("web_push_subscriptions").
+		Where("? = ?", bun.Ident("token_id"), tokenID).
+		Exec(ctx)
+	return err
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) error {
+	// Drop these subscriptions from cache on return after delete.
+	defer w.state.Caches.GTS.WebPushSubscription.Invalidate("AccountID", accountID)
+
+	_, err := w.db.NewDelete().
+		Table("web_push_subscriptions").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type workerTaskDB struct {
+	db *bun.DB
+}
+
+func (w *workerTaskDB) GetWorkerTasks(ctx context.Context) ([]*gtsmodel.WorkerTask, error) {
+	var tasks []*gtsmodel.WorkerTask
+
+	// Fetch all worker tasks,
+	// oldest (lowest ID) first.
+	if err := w.db.NewSelect().
+		Model(&tasks).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (w *workerTaskDB) PutWorkerTasks(ctx context.Context, tasks []*gtsmodel.WorkerTask) error {
+	if len(tasks) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	_, err := w.db.NewInsert().
+		Model(&tasks).
+		Exec(ctx)
+	return err
+}
+
+func (w *workerTaskDB) DeleteWorkerTaskByID(ctx context.Context, id uint) error {
+	_, err := w.db.NewDelete().
+		Table("worker_tasks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
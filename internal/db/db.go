@@ -26,11 +26,15 @@ const (
 type DB interface {
 	Account
 	Admin
+	Announcement
 	Application
 	Basic
+	Conversation
 	Domain
 	Emoji
+	FeaturedTag
 	HeaderFilter
+	Import
 	Instance
 	Filter
 	List
@@ -40,17 +44,24 @@ type DB interface {
 	Move
 	Notification
 	Poll
+	PreviewCard
 	Relationship
+	Relay
 	Report
 	Rule
+	ScheduledStatus
 	Search
 	Session
 	Status
 	StatusBookmark
+	StatusEdit
 	StatusFave
 	Tag
 	Thread
 	Timeline
+	Trend
 	User
 	Tombstone
+	WebPush
+	WorkerTask
 }
@@ -27,7 +27,7 @@ import (
 // Domain contains DB functions related to domains and domain blocks.
 type Domain interface {
 	/*
-		Block/allow storage + retrieval functions.
+		Block/allow/limit storage + retrieval functions.
 	*/
 
 	// CreateDomainAllow puts the given instance-level domain allow into the database.
@@ -42,6 +42,9 @@ type Domain interface {
 	// GetDomainAllows returns all instance-level domain allows currently enforced by this instance.
 	GetDomainAllows(ctx context.Context) ([]*gtsmodel.DomainAllow, error)
 
+	// UpdateDomainAllow updates the given domain allow, setting the provided columns (empty for all).
+	UpdateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow, columns ...string) error
+
 	// DeleteDomainAllow deletes an instance-level domain allow with the given domain, if it exists.
 	DeleteDomainAllow(ctx context.Context, domain string) error
 
@@ -57,11 +60,57 @@ type Domain interface {
 	// GetDomainBlocks returns all instance-level domain blocks currently enforced by this instance.
 	GetDomainBlocks(ctx context.Context) ([]*gtsmodel.DomainBlock, error)
 
+	// UpdateDomainBlock updates the given domain block, setting the provided columns (empty for all).
+	UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) error
+
 	// DeleteDomainBlock deletes an instance-level domain block with the given domain, if it exists.
 	DeleteDomainBlock(ctx context.Context, domain string) error
 
+	// CreateDomainLimit puts the given instance-level domain limit into the database.
+	CreateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit) error
+
+	// GetDomainLimit returns one instance-level domain limit with the given domain, if it exists.
+	GetDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error)
+
+	// GetDomainLimitByID returns one instance-level domain limit with the given id, if it exists.
+	GetDomainLimitByID(ctx context.Context, id string) (*gtsmodel.DomainLimit, error)
+
+	// GetDomainLimits returns all instance-level domain limits currently enforced by this instance.
+	GetDomainLimits(ctx context.Context) ([]*gtsmodel.DomainLimit, error)
+
+	// UpdateDomainLimit updates the given domain limit, setting the provided columns (empty for all).
+	UpdateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit, columns ...string) error
+
+	// DeleteDomainLimit deletes an instance-level domain limit with the given domain, if it exists.
+	DeleteDomainLimit(ctx context.Context, domain string) error
+
+	/*
+		Domain permission subscription functions.
+	*/
+
+	// GetDomainPermissionSubscriptionByID gets one DomainPermissionSubscription with the given ID.
+	GetDomainPermissionSubscriptionByID(ctx context.Context, id string) (*gtsmodel.DomainPermissionSubscription, error)
+
+	// GetDomainPermissionSubscriptions returns all DomainPermissionSubscriptions of the given
+	// permission type (or all types, if DomainPermissionUnknown), ordered by priority descending.
+	GetDomainPermissionSubscriptions(ctx context.Context, permType gtsmodel.DomainPermissionType) ([]*gtsmodel.DomainPermissionSubscription, error)
+
+	// CountDomainPermissionSubscriptionPerms counts the number of
+	// domain permissions currently owned by the given subscription.
+	CountDomainPermissionSubscriptionPerms(ctx context.Context, permSub *gtsmodel.DomainPermissionSubscription) (int, error)
+
+	// PutDomainPermissionSubscription stores one DomainPermissionSubscription.
+	PutDomainPermissionSubscription(ctx context.Context, permSub *gtsmodel.DomainPermissionSubscription) error
+
+	// UpdateDomainPermissionSubscription updates the provided
+	// columns of one DomainPermissionSubscription (empty for all).
+	UpdateDomainPermissionSubscription(ctx context.Context, permSub *gtsmodel.DomainPermissionSubscription, columns ...string) error
+
+	// DeleteDomainPermissionSubscription deletes one DomainPermissionSubscription with the given ID.
+	DeleteDomainPermissionSubscription(ctx context.Context, id string) error
+
 	/*
-		Block/allow checking functions.
+		Block/allow/limit checking functions.
 	*/
 
 	// IsDomainBlocked checks if domain is blocked, accounting for both explicit allows and blocks.
@@ -78,4 +127,9 @@ type Domain interface {
 	// AreURIsBlocked calls IsURIBlocked for each URI.
 	// Will return true if even one of the given URIs is blocked.
 	AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, error)
+
+	// MatchDomainLimit returns the most specific domain limit that applies
+	// to the given domain, taking account of limits on parent domains.
+	// If no domain limit applies, nil will be returned with no error.
+	MatchDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error)
 }
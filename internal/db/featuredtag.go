@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type FeaturedTag interface {
+	// GetFeaturedTagByID gets one featured tag with the given ID.
+	GetFeaturedTagByID(ctx context.Context, id string) (*gtsmodel.FeaturedTag, error)
+
+	// GetFeaturedTagByAccountIDAndTagID gets the featured tag
+	// of the given account for the given tag, if it exists.
+	GetFeaturedTagByAccountIDAndTagID(ctx context.Context, accountID string, tagID string) (*gtsmodel.FeaturedTag, error)
+
+	// GetFeaturedTagsByAccountID gets all featured tags
+	// of the given account, oldest first.
+	GetFeaturedTagsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, error)
+
+	// PopulateFeaturedTag ensures that the featured tag's struct fields are populated.
+	PopulateFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error
+
+	// GetFeaturedTagStats returns the number of public or unlisted statuses
+	// posted by the featured tag's account using the featured tag, and the
+	// time at which the most recent of those statuses was posted (zero if none).
+	GetFeaturedTagStats(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) (int, time.Time, error)
+
+	// GetFeaturedTagSuggestions returns up to limit tags most used in statuses
+	// by the given account, most used first, excluding already featured tags.
+	GetFeaturedTagSuggestions(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Tag, error)
+
+	// PutFeaturedTag stores one featured tag.
+	PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error
+
+	// DeleteFeaturedTagByID deletes one featured tag with the given ID.
+	DeleteFeaturedTagByID(ctx context.Context, id string) error
+
+	// DeleteFeaturedTagsByAccountID deletes all featured tags of the given account.
+	DeleteFeaturedTagsByAccountID(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Import interface {
+	// GetImportByID gets one account import with the given ID.
+	GetImportByID(ctx context.Context, id string) (*gtsmodel.Import, error)
+
+	// GetImportsForAccount gets all imports
+	// of the given account, newest (by ID) first.
+	GetImportsForAccount(ctx context.Context, accountID string) ([]*gtsmodel.Import, error)
+
+	// GetUnfinishedImports gets all imports, of any account, that
+	// are still pending or processing, oldest (by ID) first.
+	GetUnfinishedImports(ctx context.Context) ([]*gtsmodel.Import, error)
+
+	// PopulateImport ensures that the import's struct fields are populated.
+	PopulateImport(ctx context.Context, imp *gtsmodel.Import) error
+
+	// PutImport stores one account import.
+	PutImport(ctx context.Context, imp *gtsmodel.Import) error
+
+	// UpdateImport updates the given import in the database,
+	// updating only the given columns, or all columns if none are provided.
+	UpdateImport(ctx context.Context, imp *gtsmodel.Import, columns ...string) error
+
+	// DeleteImportsByAccountID deletes all imports of the given account.
+	DeleteImportsByAccountID(ctx context.Context, accountID string) error
+
+	// GetImportStatuses gets all statuses imported so far by the archive import with the given ID.
+	GetImportStatuses(ctx context.Context, importID string) ([]*gtsmodel.ImportStatus, error)
+
+	// PutImportStatus stores one status imported by an archive import.
+	PutImportStatus(ctx context.Context, importStatus *gtsmodel.ImportStatus) error
+
+	// DeleteImportStatuses deletes all statuses imported by the archive import with the given ID.
+	DeleteImportStatuses(ctx context.Context, importID string) error
+}
@@ -0,0 +1,56 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// PreviewCard contains functions for getting/creating/deleting link preview cards in the database.
+type PreviewCard interface {
+	// GetPreviewCardByID gets one preview card by its db id.
+	GetPreviewCardByID(ctx context.Context, id string) (*gtsmodel.PreviewCard, error)
+
+	// GetPreviewCardByURL gets one preview card by the url of the page it previews.
+	GetPreviewCardByURL(ctx context.Context, url string) (*gtsmodel.PreviewCard, error)
+
+	// GetPreviewCardByImageAttachmentID gets one preview card by the id of its image attachment.
+	GetPreviewCardByImageAttachmentID(ctx context.Context, id string) (*gtsmodel.PreviewCard, error)
+
+	// GetPreviewCards fetches preview cards in descending order of ID, paged by the given page.
+	GetPreviewCards(ctx context.Context, page *paging.Page) ([]*gtsmodel.PreviewCard, error)
+
+	// PopulatePreviewCard ensures that the preview card's struct fields are populated.
+	PopulatePreviewCard(ctx context.Context, card *gtsmodel.PreviewCard) error
+
+	// PutPreviewCard puts the given preview card in the database.
+	PutPreviewCard(ctx context.Context, card *gtsmodel.PreviewCard) error
+
+	// UpdatePreviewCard updates the given preview card in the database. If any columns
+	// are specified, these will be updated exclusively; otherwise all columns are updated.
+	UpdatePreviewCard(ctx context.Context, card *gtsmodel.PreviewCard, columns ...string) error
+
+	// DeletePreviewCardByID deletes one preview card by its db id.
+	DeletePreviewCardByID(ctx context.Context, id string) error
+
+	// IsPreviewCardUsed returns whether any status has the given preview card attached.
+	IsPreviewCardUsed(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type ScheduledStatus interface {
+	// GetScheduledStatusByID gets one scheduled status with the given ID.
+	GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, error)
+
+	// GetScheduledStatusesForAccount gets a page of scheduled
+	// statuses of the given account, newest (by ID) first.
+	GetScheduledStatusesForAccount(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.ScheduledStatus, error)
+
+	// GetAllScheduledStatuses gets all scheduled statuses in the database.
+	GetAllScheduledStatuses(ctx context.Context) ([]*gtsmodel.ScheduledStatus, error)
+
+	// CountScheduledStatusesForAccount counts the scheduled statuses of
+	// the given account, scheduled in the given time range (inclusive
+	// of start, exclusive of end). Zero times leave the range unbounded.
+	CountScheduledStatusesForAccount(ctx context.Context, accountID string, start time.Time, end time.Time) (int, error)
+
+	// PopulateScheduledStatus ensures that the scheduled status' struct fields are populated.
+	PopulateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error
+
+	// PutScheduledStatus stores one scheduled status.
+	PutScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error
+
+	// UpdateScheduledStatus updates the given scheduled status in the database,
+	// updating only the given columns, or all columns if none are provided.
+	UpdateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, columns ...string) error
+
+	// DeleteScheduledStatusByID deletes one scheduled status with the given ID.
+	DeleteScheduledStatusByID(ctx context.Context, id string) error
+
+	// DeleteScheduledStatusesByAccountID deletes all scheduled statuses of the given account.
+	DeleteScheduledStatusesByAccountID(ctx context.Context, accountID string) error
+}
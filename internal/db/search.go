@@ -19,18 +19,68 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type Search interface {
 	// SearchForAccounts uses the given query text to search for accounts that accountID follows.
+	// If maxID and minID are not set, results are ordered by relevance, and paged using offset.
 	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, following bool, offset int) ([]*gtsmodel.Account, error)
 
 	// SearchForStatuses uses the given query text to search for statuses created by requestingAccountID, or in reply to requestingAccountID.
-	// If fromAccountID is used, the results are restricted to statuses created by fromAccountID.
-	SearchForStatuses(ctx context.Context, requestingAccountID string, query string, fromAccountID string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, error)
+	// If filter is set, the results are further restricted as described on StatusSearchFilter. If the query contains no searchable text,
+	// and filter contains no restrictions, nothing is returned.
+	// If maxID and minID are not set, results are ordered by relevance, and paged using offset.
+	SearchForStatuses(ctx context.Context, requestingAccountID string, query string, filter *StatusSearchFilter, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, error)
 
 	// SearchForTags searches for tags that start with the given query text (case insensitive).
 	SearchForTags(ctx context.Context, query string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Tag, error)
+
+	// IndexStatus adds or updates the given status in the full-text search index. This is done
+	// automatically when putting or updating a status, so it's only needed for statuses inserted by other means.
+	IndexStatus(ctx context.Context, status *gtsmodel.Status) error
+
+	// IndexAccount adds or updates the given account in the full-text search index. This is done
+	// automatically when putting or updating an account, so it's only needed for accounts inserted by other means.
+	IndexAccount(ctx context.Context, account *gtsmodel.Account) error
+}
+
+// StatusSearchFilter restricts the statuses returned by
+// SearchForStatuses, usually according to search operators
+// given in the query text. Zero values impose no restriction.
+type StatusSearchFilter struct {
+	// Only statuses created by this account.
+	FromAccountID string
+
+	// Only statuses containing each of these
+	// phrases, ie., the words in the same order.
+	Phrases []string
+
+	// Only statuses with media attachments.
+	HasMedia bool
+
+	// Only statuses with a poll.
+	HasPoll bool
+
+	// Only statuses replying to another status.
+	IsReply bool
+
+	// Only statuses marked as sensitive.
+	IsSensitive bool
+
+	// Only statuses in this language (BCP47 tag).
+	Language string
+
+	// Only statuses created at or after this time.
+	Since time.Time
+
+	// Only statuses created before this time.
+	Until time.Time
+
+	// Search statuses created, faved, or bookmarked by the
+	// requesting account, instead of statuses created by or
+	// in reply to them.
+	InLibrary bool
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Status contains functions for getting statuses, creating statuses, and checking various other fields on statuses.
@@ -78,4 +79,8 @@ type Status interface {
 
 	// GetStatusChildren gets the child statuses of a given status.
 	GetStatusChildren(ctx context.Context, statusID string) ([]*gtsmodel.Status, error)
+
+	// GetPendingStatusesForAccount returns replies to, and boosts of, statuses
+	// by the given account which are still pending that account's approval.
+	GetPendingStatusesForAccount(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Status, error)
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 type StatusFave interface {
@@ -30,6 +31,13 @@ type StatusFave interface {
 	// GetStatusFave returns one status fave with the given id.
 	GetStatusFaveByID(ctx context.Context, id string) (*gtsmodel.StatusFave, error)
 
+	// GetStatusFaveByURI returns one status fave with the given uri.
+	GetStatusFaveByURI(ctx context.Context, uri string) (*gtsmodel.StatusFave, error)
+
+	// GetPendingStatusFavesForAccount returns faves of statuses by the
+	// given account which are still pending that account's approval.
+	GetPendingStatusFavesForAccount(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.StatusFave, error)
+
 	// GetStatusFaves returns a slice of faves/likes of the status with given ID.
 	// This slice will be unfiltered, not taking account of blocks and whatnot, so filter it before serving it back to a user.
 	GetStatusFaves(ctx context.Context, statusID string) ([]*gtsmodel.StatusFave, error)
@@ -40,6 +48,10 @@ type StatusFave interface {
 	// PutStatusFave inserts the given statusFave into the database.
 	PutStatusFave(ctx context.Context, statusFave *gtsmodel.StatusFave) error
 
+	// UpdateStatusFave updates the given status fave in the database,
+	// only updating the given columns if any are provided.
+	UpdateStatusFave(ctx context.Context, statusFave *gtsmodel.StatusFave, columns ...string) error
+
 	// DeleteStatusFave deletes one status fave with the given id.
 	DeleteStatusFaveByID(ctx context.Context, id string) error
 
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Tag contains functions for getting/creating tags in the database.
@@ -36,4 +37,28 @@ type Tag interface {
 
 	// GetTags gets multiple tags.
 	GetTags(ctx context.Context, ids []string) ([]*gtsmodel.Tag, error)
+
+	// GetFollowedTags gets the tags followed by the given account, paged by tag ID.
+	GetFollowedTags(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Tag, error)
+
+	// GetFollowedTagIDs gets the IDs of tags followed by the given account, paged by tag ID.
+	GetFollowedTagIDs(ctx context.Context, accountID string, page *paging.Page) ([]string, error)
+
+	// IsAccountFollowingTag returns whether the given account follows the given tag.
+	IsAccountFollowingTag(ctx context.Context, accountID string, tagID string) (bool, error)
+
+	// PutFollowedTag marks the given tag as followed by the given
+	// account. Following an already followed tag is not an error.
+	PutFollowedTag(ctx context.Context, accountID string, tagID string) error
+
+	// DeleteFollowedTag marks the given tag as no longer followed by
+	// the given account. Unfollowing an unfollowed tag is not an error.
+	DeleteFollowedTag(ctx context.Context, accountID string, tagID string) error
+
+	// DeleteFollowedTagsByAccountID deletes all tag follows of the given account.
+	DeleteFollowedTagsByAccountID(ctx context.Context, accountID string) error
+
+	// GetAccountIDsFollowingTagIDs returns the IDs of accounts
+	// following any of the given tags, without duplicates.
+	GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, error)
 }
@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Trend contains functions for getting/creating/aggregating trends in the database.
+type Trend interface {
+	// GetTrendByID gets one trend by its db id.
+	GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error)
+
+	// GetTrends gets trends of the given type with a score above zero, in order of
+	// descending score. If review is not TrendReviewUnknown, only trends with that
+	// review are returned. Results are paged using limit and offset.
+	GetTrends(ctx context.Context, trendType gtsmodel.TrendType, review gtsmodel.TrendReview, limit int, offset int) ([]*gtsmodel.Trend, error)
+
+	// GetAllTrends gets all trends of the given type, including those no longer trending.
+	GetAllTrends(ctx context.Context, trendType gtsmodel.TrendType) ([]*gtsmodel.Trend, error)
+
+	// PutTrend puts the given trend in the database.
+	PutTrend(ctx context.Context, trend *gtsmodel.Trend) error
+
+	// UpdateTrend updates the given trend in the database. If any columns
+	// are specified, these will be updated exclusively; otherwise all columns are updated.
+	UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error
+
+	// DeleteTrendByID deletes one trend by its db id.
+	DeleteTrendByID(ctx context.Context, id string) error
+
+	// GetTrendTagUses returns one TrendUse per public, non-boost status
+	// created since the given time using a tag, for each tag it uses.
+	GetTrendTagUses(ctx context.Context, since time.Time) ([]*TrendUse, error)
+
+	// GetTrendStatusUses returns one TrendUse per fave or boost, by an
+	// account other than the author, of an original public status that
+	// isn't a reply, by a discoverable author, created since the given time.
+	GetTrendStatusUses(ctx context.Context, since time.Time) ([]*TrendUse, error)
+
+	// GetTrendLinkUses returns one TrendUse, with Content set, per public,
+	// non-boost status created since the given time that may contain links.
+	GetTrendLinkUses(ctx context.Context, since time.Time) ([]*TrendUse, error)
+}
+
+// TrendUse models one use of, or interaction
+// with, a potentially trending tag, status or link,
+// as returned by the trend aggregation functions.
+//
+// Uses of statuses authored by suspended
+// or silenced accounts are never included.
+type TrendUse struct {
+	// ID of the used tag or
+	// interacted-with status,
+	// or status using a link.
+	TargetID string
+
+	// ID of the account using
+	// or interacting with target.
+	AccountID string
+
+	// Domain of the account that authored the status
+	// using the tag / link, or the interacted-with
+	// status. Empty for local accounts.
+	Domain string
+
+	// Time of the use or interaction.
+	CreatedAt time.Time
+
+	// HTML content of the status
+	// using a link; only set by
+	// GetTrendLinkUses.
+	Content string
+}
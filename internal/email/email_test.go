@@ -220,6 +220,84 @@ func (suite *EmailTestSuite) TestTemplateReportClosedLocalAccountNoComment() {
 	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Report Closed\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello !\r\n\r\nYou recently reported the account @1happyturtle to the moderator(s) of Test Instance (https://example.org).\r\n\r\nThe report you submitted has now been closed.\r\n\r\nThe moderator who closed the report did not leave a comment.\r\n\r\n---\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
 }
 
+func (suite *EmailTestSuite) TestTemplateNotificationDirect() {
+	notificationData := email.NotificationData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		Notification: email.Notification{
+			Type:       "direct",
+			Account:    "someone@fossbros-anonymous.io",
+			AccountURL: "https://fossbros-anonymous.io/@someone",
+			Text:       "hey, are you around this weekend?",
+			StatusURL:  "https://fossbros-anonymous.io/@someone/statuses/01J3ZC1YT1N9S5R1XT8Q7C8MQM",
+		},
+		UnsubscribeURL: "https://example.org/unsubscribe_email?user=01F8MGVGPHQ2D3P3X0454H54Z5&token=c2lnbmF0dXJl",
+	}
+
+	suite.sender.SendNotificationEmail("user@example.org", notificationData)
+	suite.stripHeaders()
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Notification\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello test!\r\n\r\n@someone@fossbros-anonymous.io sent you a direct message:\r\n\r\nhey, are you around this weekend?\r\n\r\nTo view this post, paste the following link into your browser: https://fossbros-anonymous.io/@someone/statuses/01J3ZC1YT1N9S5R1XT8Q7C8MQM\r\n\r\n---\r\n\r\nYou're receiving this email because you turned on email notifications at Test Instance (https://example.org).\r\n\r\nTo stop receiving email notifications, paste the following link into your browser: https://example.org/unsubscribe_email?user=01F8MGVGPHQ2D3P3X0454H54Z5&token=c2lnbmF0dXJl\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *EmailTestSuite) TestTemplateNotificationFollow() {
+	notificationData := email.NotificationData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		Notification: email.Notification{
+			Type:       "follow",
+			Account:    "someone@fossbros-anonymous.io",
+			AccountURL: "https://fossbros-anonymous.io/@someone",
+		},
+		UnsubscribeURL: "https://example.org/unsubscribe_email?user=01F8MGVGPHQ2D3P3X0454H54Z5&token=c2lnbmF0dXJl",
+	}
+
+	suite.sender.SendNotificationEmail("user@example.org", notificationData)
+	suite.stripHeaders()
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Notification\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello test!\r\n\r\n@someone@fossbros-anonymous.io followed you.\r\n\r\nTo view their profile, paste the following link into your browser: https://fossbros-anonymous.io/@someone\r\n\r\n---\r\n\r\nYou're receiving this email because you turned on email notifications at Test Instance (https://example.org).\r\n\r\nTo stop receiving email notifications, paste the following link into your browser: https://example.org/unsubscribe_email?user=01F8MGVGPHQ2D3P3X0454H54Z5&token=c2lnbmF0dXJl\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *EmailTestSuite) TestTemplateNotificationDigest() {
+	digestData := email.NotificationDigestData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		Notifications: []email.Notification{
+			{
+				Type:       "mention",
+				Account:    "someone@fossbros-anonymous.io",
+				AccountURL: "https://fossbros-anonymous.io/@someone",
+				Text:       "@test nice post!",
+				StatusURL:  "https://fossbros-anonymous.io/@someone/statuses/01J3ZC1YT1N9S5R1XT8Q7C8MQM",
+			},
+			{
+				Type:       "follow_request",
+				Account:    "someone_else@example.com",
+				AccountURL: "https://example.com/@someone_else",
+			},
+		},
+		UnsubscribeURL: "https://example.org/unsubscribe_email?user=01F8MGVGPHQ2D3P3X0454H54Z5&token=c2lnbmF0dXJl",
+	}
+
+	suite.sender.SendNotificationDigestEmail("user@example.org", digestData)
+	suite.stripHeaders()
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Notification Digest\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello test!\r\n\r\nHere's what you missed on Test Instance (https://example.org) since your last digest.\r\n\r\n@someone@fossbros-anonymous.io mentioned you:\r\n@test nice post!\r\nhttps://fossbros-anonymous.io/@someone/statuses/01J3ZC1YT1N9S5R1XT8Q7C8MQM\r\n\r\n@someone_else@example.com requested to follow you.\r\nhttps://example.com/@someone_else\r\n\r\n---\r\n\r\nYou're receiving this email because you turned on email notification digests at Test Instance (https://example.org).\r\n\r\nTo stop receiving email notifications, paste the following link into your browser: https://example.org/unsubscribe_email?user=01F8MGVGPHQ2D3P3X0454H54Z5&token=c2lnbmF0dXJl\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *EmailTestSuite) TestUnsubscribeToken() {
+	key := []byte("01234567890123456789012345678901")
+	token := email.UnsubscribeToken(key, "01F8MGVGPHQ2D3P3X0454H54Z5")
+
+	suite.True(email.ValidUnsubscribeToken(key, "01F8MGVGPHQ2D3P3X0454H54Z5", token))
+	suite.False(email.ValidUnsubscribeToken(key, "01F8MGY43H3N2C8EWPR2FPYEXG", token))
+	suite.False(email.ValidUnsubscribeToken([]byte("some other key"), "01F8MGVGPHQ2D3P3X0454H54Z5", token))
+	suite.False(email.ValidUnsubscribeToken(key, "01F8MGVGPHQ2D3P3X0454H54Z5", ""))
+}
+
 func TestEmailTestSuite(t *testing.T) {
 	suite.Run(t, new(EmailTestSuite))
 }
@@ -83,6 +83,14 @@ func (s *noopSender) SendSignupRejectedEmail(toAddress string, data SignupReject
 	return s.sendTemplate(signupRejectedTemplate, signupRejectedSubject, data, toAddress)
 }
 
+func (s *noopSender) SendNotificationEmail(toAddress string, data NotificationData) error {
+	return s.sendTemplate(notificationTemplate, notificationSubject, data, toAddress)
+}
+
+func (s *noopSender) SendNotificationDigestEmail(toAddress string, data NotificationDigestData) error {
+	return s.sendTemplate(notificationDigestTemplate, notificationDigestSubject, data, toAddress)
+}
+
 func (s *noopSender) sendTemplate(template string, subject string, data any, toAddresses ...string) error {
 	buf := &bytes.Buffer{}
 	if err := s.template.ExecuteTemplate(buf, template, data); err != nil {
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+const (
+	notificationTemplate       = "email_notification.tmpl"
+	notificationSubject        = "GoToSocial Notification"
+	notificationDigestTemplate = "email_notification_digest.tmpl"
+	notificationDigestSubject  = "GoToSocial Notification Digest"
+)
+
+// Notification describes one notification
+// to be included in a notification email.
+type Notification struct {
+	// Type of the notification: mention,
+	// direct, follow or follow_request.
+	Type string
+	// Username@domain of the account
+	// that caused the notification.
+	Account string
+	// URL of the account that
+	// caused the notification.
+	AccountURL string
+	// Plaintext content of the status that
+	// caused the notification, if any.
+	Text string
+	// URL of the status that caused
+	// the notification, if any.
+	StatusURL string
+}
+
+type NotificationData struct {
+	// Username to be addressed.
+	Username string
+	// URL of the instance to present to the receiver.
+	InstanceURL string
+	// Name of the instance to present to the receiver.
+	InstanceName string
+	// The notification to email about.
+	Notification Notification
+	// URL to unsubscribe from email notifications.
+	UnsubscribeURL string
+}
+
+func (s *sender) SendNotificationEmail(toAddress string, data NotificationData) error {
+	return s.sendTemplate(notificationTemplate, notificationSubject, data, toAddress)
+}
+
+type NotificationDigestData struct {
+	// Username to be addressed.
+	Username string
+	// URL of the instance to present to the receiver.
+	InstanceURL string
+	// Name of the instance to present to the receiver.
+	InstanceName string
+	// Notifications since the last digest, newest first.
+	Notifications []Notification
+	// Number of further, older notifications since
+	// the last digest, left out as there were too many.
+	MoreNotifications int
+	// URL to unsubscribe from email notifications.
+	UnsubscribeURL string
+}
+
+func (s *sender) SendNotificationDigestEmail(toAddress string, data NotificationDigestData) error {
+	return s.sendTemplate(notificationDigestTemplate, notificationDigestSubject, data, toAddress)
+}
+
+// UnsubscribeToken returns a token signing an email notifications
+// unsubscribe link for the given user ID, using the given secret key.
+func UnsubscribeToken(key []byte, userID string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("unsubscribe:" + userID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidUnsubscribeToken returns whether token is a valid
+// unsubscribe token for the given user ID and secret key.
+func ValidUnsubscribeToken(key []byte, userID string, token string) bool {
+	return hmac.Equal(
+		[]byte(UnsubscribeToken(key, userID)),
+		[]byte(token),
+	)
+}
@@ -730,6 +730,11 @@ func (d *Dereferencer) enrichAccount(
 	latestAcc.ID = account.ID
 	latestAcc.FetchedAt = time.Now()
 
+	// Carry over any moderation
+	// applied to this account.
+	latestAcc.SilencedAt = account.SilencedAt
+	latestAcc.SensitizedAt = account.SensitizedAt
+
 	// Ensure the account's avatar media is populated, passing in existing to check for changes.
 	if err := d.fetchRemoteAccountAvatar(ctx, tsport, account, latestAcc); err != nil {
 		log.Errorf(ctx, "error fetching remote avatar for account %s: %v", uri, err)
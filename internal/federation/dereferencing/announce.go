@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // EnrichAnnounce enriches the given boost wrapper status
@@ -92,9 +93,30 @@ func (d *Dereferencer) EnrichAnnounce(
 	boost.BoostOfAccount = target.Account
 	boost.Visibility = target.Visibility
 	boost.Federated = target.Federated
-	boost.Boostable = target.Boostable
-	boost.Replyable = target.Replyable
-	boost.Likeable = target.Likeable
+
+	// Approval of boosts of our own statuses
+	// is handled by us, so ignore any remote
+	// claims of approval and only trust ours.
+	boost.PendingApproval = util.Ptr(false)
+	if *target.Local {
+		boost.ApprovedByURI = ""
+
+		// Check boost against the interaction policy of target.
+		boostable, err := d.visibility.StatusBoostable(ctx,
+			boost.Account,
+			target,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error checking boostability of %s: %w", targetURI, err)
+		}
+
+		if boostable.Forbidden() {
+			err := gtserror.Newf("boost of %s not permitted by interaction policy", targetURI)
+			return nil, gtserror.SetNotPermitted(err)
+		}
+
+		boost.PendingApproval = util.Ptr(boostable.PermittedWithApproval())
+	}
 
 	// Store the boost wrapper status in database.
 	switch err = d.state.DB.PutStatus(ctx, boost); {
@@ -501,6 +501,8 @@ func (d *Dereferencer) enrichStatus(
 	latestStatus.UpdatedAt = status.UpdatedAt
 	latestStatus.FetchedAt = time.Now()
 	latestStatus.Local = status.Local
+	latestStatus.PreviewCardID = status.PreviewCardID
+	latestStatus.PreviewCard = status.PreviewCard
 
 	// Check if this is a permitted status we should accept.
 	permit, err := d.isPermittedStatus(ctx, status, latestStatus)
@@ -514,6 +516,13 @@ func (d *Dereferencer) enrichStatus(
 		return nil, nil, gtserror.SetNotPermitted(err)
 	}
 
+	var edit *gtsmodel.StatusEdit
+	if !isNew {
+		// Snapshot the existing status revision
+		// before fetching any changed attachments etc.
+		edit = statusToEdit(status)
+	}
+
 	// Ensure the status' mentions are populated, and pass in existing to check for changes.
 	if err := d.fetchStatusMentions(ctx, requestUser, status, latestStatus); err != nil {
 		return nil, nil, gtserror.Newf("error populating mentions for status %s: %w", uri, err)
@@ -545,6 +554,13 @@ func (d *Dereferencer) enrichStatus(
 		return nil, nil, gtserror.Newf("error populating emojis for status %s: %w", uri, err)
 	}
 
+	if !isNew {
+		// Handle any edits to status since we last saw it.
+		if err := d.handleStatusEdit(ctx, status, latestStatus, edit); err != nil {
+			return nil, nil, gtserror.Newf("error handling edit for status %s: %w", uri, err)
+		}
+	}
+
 	if isNew {
 		// This is new, put the status in the database.
 		err := d.state.DB.PutStatus(ctx, latestStatus)
@@ -561,6 +577,82 @@ func (d *Dereferencer) enrichStatus(
 	return latestStatus, apubStatus, nil
 }
 
+// handleStatusEdit carries over the edit history of the existing status
+// to the latest, and if the status has changed since we last saw it,
+// stores the given existing revision of the status in its history.
+func (d *Dereferencer) handleStatusEdit(
+	ctx context.Context,
+	existing *gtsmodel.Status,
+	status *gtsmodel.Status,
+	edit *gtsmodel.StatusEdit,
+) error {
+	// Carry-over the existing edit history.
+	status.EditIDs = existing.EditIDs
+	status.Edits = existing.Edits
+
+	if !statusChanged(existing, status) {
+		// Nothing was changed, so leave
+		// edit time as we last saw it.
+		status.EditedAt = existing.EditedAt
+		return nil
+	}
+
+	if status.EditedAt.IsZero() ||
+		!status.EditedAt.After(edit.CreatedAt) {
+		// Remote didn't provide a
+		// (usable) updated time.
+		status.EditedAt = time.Now()
+	}
+
+	// Insert the existing status revision in the database.
+	if err := d.state.DB.PutStatusEdit(ctx, edit); err != nil {
+		return gtserror.Newf("error putting status edit in database: %w", err)
+	}
+
+	// Append the new revision to the status history.
+	status.EditIDs = append(slices.Clone(status.EditIDs), edit.ID)
+	status.Edits = append(slices.Clone(status.Edits), edit)
+	return nil
+}
+
+// statusToEdit returns a new StatusEdit model
+// snapshotting the current revision of status.
+func statusToEdit(status *gtsmodel.Status) *gtsmodel.StatusEdit {
+	edit := &gtsmodel.StatusEdit{
+		ID:             id.NewULID(),
+		Content:        status.Content,
+		ContentWarning: status.ContentWarning,
+		Text:           status.Text,
+		Language:       status.Language,
+		Sensitive:      util.Ptr(util.PtrValueOr(status.Sensitive, false)),
+		AttachmentIDs:  slices.Clone(status.AttachmentIDs),
+		StatusID:       status.ID,
+	}
+
+	// The previous revision was created either
+	// at the last edit, or when status was created.
+	edit.CreatedAt = status.EditedAt
+	if edit.CreatedAt.IsZero() {
+		edit.CreatedAt = status.CreatedAt
+	}
+
+	// Store attachment descriptions at this revision.
+	if len(status.Attachments) > 0 {
+		edit.Attachments = status.Attachments
+		edit.AttachmentDescriptions = make([]string, len(status.Attachments))
+		for i, attachment := range status.Attachments {
+			edit.AttachmentDescriptions[i] = attachment.Description
+		}
+	}
+
+	if status.Poll != nil {
+		edit.PollOptions = slices.Clone(status.Poll.Options)
+		edit.PollVotes = slices.Clone(status.Poll.Votes)
+	}
+
+	return edit
+}
+
 // isPermittedStatus returns whether the given status
 // is permitted to be stored on this instance, checking
 // whether the author is suspended, and passes visibility
@@ -620,27 +712,60 @@ func (d *Dereferencer) isPermittedStatus(
 		return onFail()
 	}
 
-	// Default to true
-	permitted = true
+	if !*status.InReplyTo.Local {
+		// Replies to remote statuses
+		// are subject to the policy
+		// enforcement of their origin.
+		status.PendingApproval = util.Ptr(false)
+		return true, nil
+	}
 
-	if *status.InReplyTo.Local {
-		// Check visibility of inReplyTo to status author.
-		permitted, err = d.visibility.StatusVisible(ctx,
-			status.Account,
-			status.InReplyTo,
-		)
-		if err != nil {
-			return false, gtserror.Newf("error checking in-reply-to visibility: %w", err)
-		}
+	// Check visibility of inReplyTo to status author.
+	permitted, err = d.visibility.StatusVisible(ctx,
+		status.Account,
+		status.InReplyTo,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error checking in-reply-to visibility: %w", err)
 	}
 
-	if permitted &&
-		*status.InReplyTo.Replyable {
-		// Status is reply-able to.
-		return true, nil
+	if !permitted {
+		return onFail()
 	}
 
-	return onFail()
+	// Check reply against the interaction policy of inReplyTo.
+	replyable, err := d.visibility.StatusReplyable(ctx,
+		status.Account,
+		status.InReplyTo,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error checking in-reply-to replyability: %w", err)
+	}
+
+	// Approval of replies to our own statuses
+	// is handled by us, so ignore any remote
+	// claims of approval and only trust ours.
+	status.ApprovedByURI = ""
+	if existing != nil {
+		status.ApprovedByURI = existing.ApprovedByURI
+	}
+
+	switch {
+	case replyable.Permitted():
+		// Reply is permitted outright.
+		status.PendingApproval = util.Ptr(false)
+		return true, nil
+
+	case replyable.PermittedWithApproval():
+		// Reply is permitted, but is pending
+		// approval unless we already approved it.
+		status.PendingApproval = util.Ptr(status.ApprovedByURI == "")
+		return true, nil
+
+	default:
+		log.Debugf(ctx, "reply %s not permitted by interaction policy", status.URI)
+		return onFail()
+	}
 }
 
 // populateMentionTarget tries to populate the given
@@ -906,10 +1031,13 @@ func (d *Dereferencer) fetchStatusPoll(ctx context.Context, existing, status *gt
 		insertStatusPoll = func(ctx context.Context, status *gtsmodel.Status) error {
 			var err error
 
-			// Generate new ID for poll from the status CreatedAt.
-			// TODO: update this to use "edited_at" when we add
-			//       support for edited status revision history.
-			status.Poll.ID, err = id.NewULIDFromTime(status.CreatedAt)
+			// Generate new ID for poll from the status
+			// EditedAt (if set), falling back to CreatedAt.
+			createdAt := status.EditedAt
+			if createdAt.IsZero() {
+				createdAt = status.CreatedAt
+			}
+			status.Poll.ID, err = id.NewULIDFromTime(createdAt)
 			if err != nil {
 				log.Errorf(ctx, "invalid created at date (falling back to 'now'): %v", err)
 				status.Poll.ID = id.NewULID() // just use "now"
@@ -994,11 +1122,27 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 	// Allocate new slice to take the yet-to-be fetched attachment IDs.
 	status.AttachmentIDs = make([]string, len(status.Attachments))
 
+	// Check whether media from the status
+	// author's domain is rejected by a limit.
+	limit, err := d.state.DB.MatchDomainLimit(ctx, status.Account.Domain)
+	if err != nil {
+		return gtserror.Newf("error checking domain limit: %w", err)
+	}
+	rejectMedia := (limit != nil && *limit.RejectMedia)
+
 	for i := range status.Attachments {
 		attachment := status.Attachments[i]
 
 		// Look for existing media attachment with remote URL first.
 		existing, ok := existing.GetAttachmentByRemoteURL(attachment.RemoteURL)
+		if ok && existing.ID != "" && rejectMedia {
+			// Media is rejected, keep the
+			// existing model without recaching.
+			status.Attachments[i] = existing
+			status.AttachmentIDs[i] = existing.ID
+			continue
+		}
+
 		if ok && existing.ID != "" {
 
 			// Ensure the existing media attachment is up-to-date and cached.
@@ -1018,18 +1162,39 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 			continue
 		}
 
+		info := &media.AdditionalMediaInfo{
+			StatusID:    &status.ID,
+			RemoteURL:   &attachment.RemoteURL,
+			Description: &attachment.Description,
+			Blurhash:    &attachment.Blurhash,
+		}
+
+		if rejectMedia {
+			// Media is rejected, just store a
+			// placeholder linking to the remote.
+			attachment, err := d.loadRejectedAttachment(
+				ctx,
+				status.AccountID,
+				info,
+			)
+			if err != nil && attachment == nil {
+				log.Errorf(ctx, "error loading rejected attachment: %v", err)
+				continue
+			}
+
+			// Set the *new* attachment and ID.
+			status.Attachments[i] = attachment
+			status.AttachmentIDs[i] = attachment.ID
+			continue
+		}
+
 		// Load this new media attachment.
 		attachment, err := d.loadAttachment(
 			ctx,
 			tsport,
 			status.AccountID,
 			attachment.RemoteURL,
-			&media.AdditionalMediaInfo{
-				StatusID:    &status.ID,
-				RemoteURL:   &attachment.RemoteURL,
-				Description: &attachment.Description,
-				Blurhash:    &attachment.Blurhash,
-			},
+			info,
 		)
 		if err != nil && attachment == nil {
 			log.Errorf(ctx, "error loading attachment: %v", err)
@@ -61,6 +61,31 @@ func (d *Dereferencer) loadAttachment(
 	return processing.LoadAttachment(ctx)
 }
 
+// loadRejectedAttachment handles the case of a new media attachment
+// from a domain with a media-rejecting limit in place. the media is
+// never fetched, instead a placeholder linking to the remote is stored.
+func (d *Dereferencer) loadRejectedAttachment(
+	ctx context.Context,
+	accountID string, // media account owner
+	info *media.AdditionalMediaInfo,
+) (
+	*gtsmodel.MediaAttachment,
+	error,
+) {
+	// Start pre-processing media with a data function that
+	// always fails, so only a placeholder ends up stored.
+	processing := d.mediaManager.PreProcessMedia(
+		func(ctx context.Context) (io.ReadCloser, int64, error) {
+			return nil, 0, gtserror.New("media rejected by domain limit")
+		},
+		accountID,
+		info,
+	)
+
+	// Force attachment loading *right now*.
+	return processing.LoadAttachment(ctx)
+}
+
 // updateAttachment handles the case of an existing media attachment
 // that *may* have changes or need recaching. it checks for changed
 // fields, updating in the database if so, and recaches uncached media.
@@ -134,6 +159,25 @@ func (d *Dereferencer) updateAttachment(
 	return existing, err
 }
 
+// statusChanged returns whether a status has changed in a way
+// that indicates it has been edited, i.e. if its content, content
+// warning, sensitivity, attachments or poll options have changed.
+func statusChanged(existing, latest *gtsmodel.Status) bool {
+	switch {
+	case existing.Content != latest.Content,
+		existing.ContentWarning != latest.ContentWarning,
+		*existing.Sensitive != *latest.Sensitive,
+		!slices.Equal(existing.AttachmentIDs, latest.AttachmentIDs):
+		return true
+
+	case existing.Poll == nil || latest.Poll == nil:
+		return (existing.Poll == nil) != (latest.Poll == nil)
+
+	default:
+		return !slices.Equal(existing.Poll.Options, latest.Poll.Options)
+	}
+}
+
 // pollChanged returns whether a poll has changed in way that
 // indicates that this should be an entirely new poll. i.e. if
 // the available options have changed, or the expiry has increased.
@@ -25,9 +25,11 @@ import (
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsAccept) error {
@@ -66,6 +68,18 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 				// Cast the vocab.Type object to known AS type.
 				asFollow := objType.(vocab.ActivityStreamsFollow)
 
+				// ACCEPT RELAY FOLLOW
+				if followIRI := ap.GetJSONLDId(asFollow); followIRI != nil {
+					isRelay, err := f.relayFollowResponse(ctx, followIRI.String(), requestingAcct, gtsmodel.RelayStateAccepted)
+					if err != nil {
+						return err
+					}
+
+					if isRelay {
+						continue
+					}
+				}
+
 				// convert the follow to something we can understand
 				gtsFollow, err := f.converter.ASFollowToFollow(ctx, asFollow)
 				if err != nil {
@@ -107,13 +121,40 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 
 			// Extract IRI from object.
 			iri := object.GetIRI()
-			if !uris.IsFollowPath(iri) {
+
+			switch {
+
+			// ACCEPT LIKE
+			case uris.IsLikePath(iri):
+				if err := f.acceptLikeIRI(ctx, accept, iri.String(), receivingAcct, requestingAcct); err != nil {
+					return err
+				}
+				continue
+
+			// ACCEPT REPLY or ANNOUNCE
+			case uris.IsStatusesPath(iri):
+				if err := f.acceptStatusIRI(ctx, accept, iri.String(), receivingAcct, requestingAcct); err != nil {
+					return err
+				}
+				continue
+
+			case !uris.IsFollowPath(iri):
 				continue
 			}
 
 			// Serialize IRI.
 			iriStr := iri.String()
 
+			// ACCEPT RELAY FOLLOW
+			isRelay, err := f.relayFollowResponse(ctx, iriStr, requestingAcct, gtsmodel.RelayStateAccepted)
+			if err != nil {
+				return err
+			}
+
+			if isRelay {
+				continue
+			}
+
 			// ACCEPT FOLLOW
 			followReq, err := f.state.DB.GetFollowRequestByURI(ctx, iriStr)
 			if err != nil {
@@ -152,3 +193,134 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 
 	return nil
 }
+
+// acceptLikeIRI handles an Accept of a pending Like,
+// with the given IRI, sent by one of our local accounts.
+func (f *federatingDB) acceptLikeIRI(
+	ctx context.Context,
+	accept vocab.ActivityStreamsAccept,
+	likeIRI string,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) error {
+	fave, err := f.state.DB.GetStatusFaveByURI(ctx, likeIRI)
+	if err != nil {
+		return fmt.Errorf("ACCEPT: couldn't get status fave with id %s from the database: %w", likeIRI, err)
+	}
+
+	// Make sure the creator of the original like
+	// is the same as whatever inbox this landed in.
+	if fave.AccountID != receivingAcct.ID {
+		return errors.New("ACCEPT: like account and inbox account were not the same")
+	}
+
+	// Make sure the target of the original like
+	// is the same as the account making the request.
+	if fave.TargetAccountID != requestingAcct.ID {
+		return errors.New("ACCEPT: like target account and requesting account were not the same")
+	}
+
+	if !util.PtrValueOr(fave.PendingApproval, false) {
+		// Nothing to do.
+		return nil
+	}
+
+	acceptURI := ap.GetJSONLDId(accept)
+	if acceptURI == nil {
+		return errors.New("ACCEPT: accept had no id")
+	}
+
+	fave.PendingApproval = util.Ptr(false)
+	fave.ApprovedByURI = acceptURI.String()
+	if err := f.state.DB.UpdateStatusFave(ctx,
+		fave,
+		"pending_approval",
+		"approved_by_uri",
+	); err != nil {
+		return fmt.Errorf("ACCEPT: error updating status fave: %w", err)
+	}
+
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityAccept,
+		GTSModel:       fave,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+
+	return nil
+}
+
+// acceptStatusIRI handles an Accept of a pending reply
+// or announce, with the given IRI, sent by one of our
+// local accounts.
+func (f *federatingDB) acceptStatusIRI(
+	ctx context.Context,
+	accept vocab.ActivityStreamsAccept,
+	statusIRI string,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) error {
+	status, err := f.state.DB.GetStatusByURI(ctx, statusIRI)
+	if err != nil {
+		return fmt.Errorf("ACCEPT: couldn't get status with id %s from the database: %w", statusIRI, err)
+	}
+
+	// Make sure the creator of the original status
+	// is the same as whatever inbox this landed in.
+	if status.AccountID != receivingAcct.ID {
+		return errors.New("ACCEPT: status account and inbox account were not the same")
+	}
+
+	// Make sure the account making the request is
+	// the author of the replied-to or boosted status.
+	apObjectType, ok := interactionObjectType(status, requestingAcct)
+	if !ok {
+		return errors.New("ACCEPT: status target account and requesting account were not the same")
+	}
+
+	if !util.PtrValueOr(status.PendingApproval, false) {
+		// Nothing to do.
+		return nil
+	}
+
+	acceptURI := ap.GetJSONLDId(accept)
+	if acceptURI == nil {
+		return errors.New("ACCEPT: accept had no id")
+	}
+
+	status.PendingApproval = util.Ptr(false)
+	status.ApprovedByURI = acceptURI.String()
+	if err := f.state.DB.UpdateStatus(ctx,
+		status,
+		"pending_approval",
+		"approved_by_uri",
+	); err != nil {
+		return fmt.Errorf("ACCEPT: error updating status: %w", err)
+	}
+
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   apObjectType,
+		APActivityType: ap.ActivityAccept,
+		GTSModel:       status,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+
+	return nil
+}
+
+// interactionObjectType returns the AP object type of
+// the interaction that status represents towards target,
+// ie., ObjectNote for a reply, or ActivityAnnounce for
+// a boost. Returns false if target is not the interactee.
+func interactionObjectType(status *gtsmodel.Status, target *gtsmodel.Account) (string, bool) {
+	switch {
+	case status.BoostOfID != "" && status.BoostOfAccountID == target.ID:
+		return ap.ActivityAnnounce, true
+	case status.InReplyToID != "" && status.InReplyToAccountID == target.ID:
+		return ap.ObjectNote, true
+	default:
+		return "", false
+	}
+}
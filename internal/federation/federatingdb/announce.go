@@ -69,6 +69,31 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 		)
 	}
 
+	relay, err := f.getRelay(ctx, requestingAcct)
+	if err != nil {
+		return err
+	}
+
+	if relay != nil {
+		// Relays Announce the statuses of others,
+		// which shouldn't show up as boosts by the
+		// relay, so just take the statuses as-is.
+		for _, object := range ap.ExtractObjects(announce) {
+			var statusIRI *url.URL
+			if object.IsIRI() {
+				statusIRI = object.GetIRI()
+			} else if t := object.GetType(); t != nil {
+				statusIRI = ap.GetJSONLDId(t)
+			}
+
+			if statusIRI != nil {
+				f.relayStatus(statusIRI, receivingAcct, requestingAcct)
+			}
+		}
+
+		return nil
+	}
+
 	boost, isNew, err := f.converter.ASAnnounceToStatus(ctx, announce)
 	if err != nil {
 		return gtserror.Newf("error converting announce to boost: %w", err)
@@ -33,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // Create adds a new entry to the database which must be able to be
@@ -326,6 +327,21 @@ func (f *federatingDB) createStatusable(
 	statusable ap.Statusable,
 	forwarded bool,
 ) error {
+	if forwarded {
+		// Mastodon relays forward the Creates
+		// of the statuses they relay, which
+		// wouldn't pass the relevancy checks.
+		relay, err := f.getRelay(ctx, requester)
+		if err != nil {
+			return err
+		}
+
+		if relay != nil {
+			f.relayStatus(ap.GetJSONLDId(statusable), receiver, requester)
+			return nil
+		}
+	}
+
 	// Check whether this status is both
 	// relevant, and doesn't look like spam.
 	err := f.spamFilter.StatusableOK(ctx,
@@ -472,6 +488,30 @@ func (f *federatingDB) activityLike(ctx context.Context, asType vocab.Type, rece
 		)
 	}
 
+	// Assume not pending
+	// approval by default.
+	fave.PendingApproval = util.Ptr(false)
+
+	if fave.Status.IsLocal() {
+		// Check the Like is permitted by
+		// the interaction policy of our status.
+		likeable, err := f.visFilter.StatusLikeable(ctx, requestingAccount, fave.Status)
+		if err != nil {
+			return fmt.Errorf("activityLike: error checking status likeability: %w", err)
+		}
+
+		if likeable.Forbidden() {
+			log.Debugf(ctx, "dropping forbidden Like of %s", fave.Status.URI)
+			return nil
+		}
+
+		// Only we can approve Likes of
+		// our own statuses, so ignore
+		// any approval sent with it.
+		fave.PendingApproval = util.Ptr(likeable.PermittedWithApproval())
+		fave.ApprovedByURI = ""
+	}
+
 	fave.ID = id.NewULID()
 
 	if err := f.state.DB.PutStatusFave(ctx, fave); err != nil {
@@ -25,7 +25,9 @@ import (
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -53,7 +55,35 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 		if obj.IsIRI() {
 			// we have just the URI of whatever is being rejected, so we need to find out what it is
 			rejectedObjectIRI := obj.GetIRI()
+
+			switch {
+
+			// REJECT LIKE
+			case uris.IsLikePath(rejectedObjectIRI):
+				if err := f.rejectLikeIRI(ctx, rejectedObjectIRI.String(), receivingAcct, requestingAcct); err != nil {
+					return err
+				}
+				continue
+
+			// REJECT REPLY or ANNOUNCE
+			case uris.IsStatusesPath(rejectedObjectIRI):
+				if err := f.rejectStatusIRI(ctx, rejectedObjectIRI.String(), receivingAcct, requestingAcct); err != nil {
+					return err
+				}
+				continue
+			}
+
 			if uris.IsFollowPath(rejectedObjectIRI) {
+				// REJECT RELAY FOLLOW
+				isRelay, err := f.relayFollowResponse(ctx, rejectedObjectIRI.String(), requestingAcct, gtsmodel.RelayStateRejected)
+				if err != nil {
+					return err
+				}
+
+				if isRelay {
+					continue
+				}
+
 				// REJECT FOLLOW
 				followReq, err := f.state.DB.GetFollowRequestByURI(ctx, rejectedObjectIRI.String())
 				if err != nil {
@@ -84,6 +114,18 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 				return errors.New("Reject: couldn't parse follow into vocab.ActivityStreamsFollow")
 			}
 
+			// REJECT RELAY FOLLOW
+			if followIRI := ap.GetJSONLDId(asFollow); followIRI != nil {
+				isRelay, err := f.relayFollowResponse(ctx, followIRI.String(), requestingAcct, gtsmodel.RelayStateRejected)
+				if err != nil {
+					return err
+				}
+
+				if isRelay {
+					continue
+				}
+			}
+
 			// convert the follow to something we can understand
 			gtsFollow, err := f.converter.ASFollowToFollow(ctx, asFollow)
 			if err != nil {
@@ -108,3 +150,77 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 
 	return nil
 }
+
+// rejectLikeIRI handles a Reject of a pending Like,
+// with the given IRI, sent by one of our local accounts.
+func (f *federatingDB) rejectLikeIRI(
+	ctx context.Context,
+	likeIRI string,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) error {
+	fave, err := f.state.DB.GetStatusFaveByURI(ctx, likeIRI)
+	if err != nil {
+		return fmt.Errorf("Reject: couldn't get status fave with id %s from the database: %w", likeIRI, err)
+	}
+
+	// Make sure the creator of the original like
+	// is the same as whatever inbox this landed in.
+	if fave.AccountID != receivingAcct.ID {
+		return errors.New("Reject: like account and inbox account were not the same")
+	}
+
+	// Make sure the target of the original like
+	// is the same as the account making the request.
+	if fave.TargetAccountID != requestingAcct.ID {
+		return errors.New("Reject: like target account and requesting account were not the same")
+	}
+
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityReject,
+		GTSModel:       fave,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+
+	return nil
+}
+
+// rejectStatusIRI handles a Reject of a pending reply
+// or announce, with the given IRI, sent by one of our
+// local accounts.
+func (f *federatingDB) rejectStatusIRI(
+	ctx context.Context,
+	statusIRI string,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) error {
+	status, err := f.state.DB.GetStatusByURI(ctx, statusIRI)
+	if err != nil {
+		return fmt.Errorf("Reject: couldn't get status with id %s from the database: %w", statusIRI, err)
+	}
+
+	// Make sure the creator of the original status
+	// is the same as whatever inbox this landed in.
+	if status.AccountID != receivingAcct.ID {
+		return errors.New("Reject: status account and inbox account were not the same")
+	}
+
+	// Make sure the account making the request is
+	// the author of the replied-to or boosted status.
+	apObjectType, ok := interactionObjectType(status, requestingAcct)
+	if !ok {
+		return errors.New("Reject: status target account and requesting account were not the same")
+	}
+
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   apObjectType,
+		APActivityType: ap.ActivityReject,
+		GTSModel:       status,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+
+	return nil
+}
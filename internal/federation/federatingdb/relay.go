@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// getRelay returns the accepted relay subscription whose
+// actor is the given account, or nil if the account is
+// not the actor of a relay we're subscribed to.
+func (f *federatingDB) getRelay(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*gtsmodel.Relay, error) {
+	relay, err := f.state.DB.GetRelayByActorURI(
+		gtscontext.SetBarebones(ctx),
+		account.URI,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting relay: %w", err)
+	}
+
+	if relay == nil || relay.State != gtsmodel.RelayStateAccepted {
+		return nil, nil
+	}
+
+	return relay, nil
+}
+
+// relayFollowResponse handles an Accept or Reject of the Follow with
+// the given IRI, if it's the Follow of one of our relay subscriptions,
+// setting the subscription to the given state. Returns false if the
+// Follow is not one of a relay subscription.
+func (f *federatingDB) relayFollowResponse(
+	ctx context.Context,
+	followIRI string,
+	requestingAcct *gtsmodel.Account,
+	state gtsmodel.RelayState,
+) (bool, error) {
+	relay, err := f.state.DB.GetRelayByFollowURI(
+		gtscontext.SetBarebones(ctx),
+		followIRI,
+	)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Not a relay Follow.
+			return false, nil
+		}
+		return false, gtserror.Newf("db error getting relay: %w", err)
+	}
+
+	if relay.ActorURI == "" {
+		// Mastodon relays are subscribed to by their inbox,
+		// so we only learn the relay actor now. Make sure
+		// it at least lives on the same host as the inbox.
+		inbox, err := url.Parse(relay.InboxURI)
+		if err != nil {
+			return true, gtserror.Newf("error parsing relay inbox uri: %w", err)
+		}
+
+		if inbox.Host != requestingAcct.Domain {
+			return true, gtserror.Newf(
+				"requesting account %s not on relay host %s",
+				requestingAcct.URI, inbox.Host,
+			)
+		}
+
+		relay.ActorURI = requestingAcct.URI
+	} else if relay.ActorURI != requestingAcct.URI {
+		return true, gtserror.Newf(
+			"relay actor %s and requesting account %s were not the same",
+			relay.ActorURI, requestingAcct.URI,
+		)
+	}
+
+	relay.State = state
+	if err := f.state.DB.UpdateRelay(ctx, relay,
+		"actor_uri",
+		"state",
+	); err != nil {
+		return true, gtserror.Newf("db error updating relay: %w", err)
+	}
+
+	return true, nil
+}
+
+// relayStatus passes the status with the given IRI, Announced
+// or forwarded by a relay, to the processor, to be dereferenced
+// from its origin. We don't trust the relay's copy of it.
+func (f *federatingDB) relayStatus(
+	statusIRI *url.URL,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) {
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityAnnounce,
+		APIRI:          statusIRI,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+}
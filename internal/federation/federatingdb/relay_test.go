@@ -0,0 +1,170 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RelayTestSuite struct {
+	FederatingDBTestSuite
+}
+
+// putRelay stores a new relay subscription
+// in the given state, and returns it.
+func (suite *RelayTestSuite) putRelay(
+	uri string,
+	actorURI string,
+	state gtsmodel.RelayState,
+) *gtsmodel.Relay {
+	relayID := id.NewULID()
+	relay := &gtsmodel.Relay{
+		ID:                 relayID,
+		URI:                uri,
+		InboxURI:           "http://fossbros-anonymous.io/inbox",
+		ActorURI:           actorURI,
+		FollowURI:          uris.GenerateURIForFollow(suite.testAccounts["instance_account"].Username, relayID),
+		State:              state,
+		Forward:            util.Ptr(false),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.state.DB.PutRelay(context.Background(), relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return relay
+}
+
+func (suite *RelayTestSuite) TestAcceptRelayFollow() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	// Mastodon relay, so
+	// actor isn't known yet.
+	relay := suite.putRelay(
+		"http://fossbros-anonymous.io/inbox",
+		"",
+		gtsmodel.RelayStatePending,
+	)
+
+	asFollow, err := suite.tc.RelayToASFollow(ctx, relay)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	accept := streams.NewActivityStreamsAccept()
+
+	acceptActorProp := streams.NewActivityStreamsActorProperty()
+	acceptActorProp.AppendIRI(testrig.URLMustParse(relayAccount.URI))
+	accept.SetActivityStreamsActor(acceptActorProp)
+
+	acceptObject := streams.NewActivityStreamsObjectProperty()
+	acceptObject.AppendActivityStreamsFollow(asFollow)
+	accept.SetActivityStreamsObject(acceptObject)
+
+	if err := suite.federatingDB.Accept(ctx, accept); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	relay, err = suite.state.DB.GetRelayByID(ctx, relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(gtsmodel.RelayStateAccepted, relay.State)
+	suite.Equal(relayAccount.URI, relay.ActorURI)
+
+	// Nothing should have
+	// gone to the processor.
+	_, ok := suite.getFederatorMsg(time.Second)
+	suite.False(ok)
+}
+
+func (suite *RelayTestSuite) TestAnnounceFromRelay() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	suite.putRelay(
+		relayAccount.URI,
+		relayAccount.URI,
+		gtsmodel.RelayStateAccepted,
+	)
+
+	announce := suite.testActivities["announce_forwarded_1_zork"]
+	if err := suite.federatingDB.Announce(ctx, announce.Activity.(vocab.ActivityStreamsAnnounce)); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The announced status should be passed on
+	// to be dereferenced, without creating a boost.
+	msg, ok := suite.getFederatorMsg(5 * time.Second)
+	if !suite.True(ok) {
+		suite.FailNow("no message")
+	}
+
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityAnnounce, msg.APActivityType)
+	suite.Nil(msg.GTSModel)
+	suite.Equal("http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1", msg.APIRI.String())
+}
+
+func (suite *RelayTestSuite) TestAnnounceFromPendingRelay() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	suite.putRelay(
+		relayAccount.URI,
+		relayAccount.URI,
+		gtsmodel.RelayStatePending,
+	)
+
+	announce := suite.testActivities["announce_forwarded_1_zork"]
+	if err := suite.federatingDB.Announce(ctx, announce.Activity.(vocab.ActivityStreamsAnnounce)); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Not subscribed yet, so this
+	// is handled as a regular boost.
+	msg, ok := suite.getFederatorMsg(5 * time.Second)
+	if !suite.True(ok) {
+		suite.FailNow("no message")
+	}
+
+	suite.Equal(ap.ActivityAnnounce, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, &RelayTestSuite{})
+}
@@ -20,6 +20,7 @@ package visibility
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
@@ -190,8 +191,19 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 	}
 
 	if follow == nil {
-		log.Trace(ctx, "ignoring status from unfollowed author")
-		return false, nil
+		// Owner doesn't follow the author, but
+		// they may follow one of the status' tags.
+		followsTag, err := f.isFollowingStatusTag(ctx, owner, status)
+		if err != nil {
+			return false, err
+		}
+
+		if !followsTag {
+			log.Trace(ctx, "ignoring status from unfollowed author")
+			return false, nil
+		}
+
+		return true, nil
 	}
 
 	if status.BoostOfID != "" && !*follow.ShowReblogs {
@@ -203,6 +215,38 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 	return true, nil
 }
 
+// isFollowingStatusTag returns whether owner follows any
+// useable tag of the given status. Only public statuses
+// that aren't boosts are eligible to be timelined this way.
+func (f *Filter) isFollowingStatusTag(ctx context.Context, owner *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	if status.Visibility != gtsmodel.VisibilityPublic ||
+		status.BoostOfID != "" || len(status.TagIDs) == 0 {
+		return false, nil
+	}
+
+	followedTagIDs, err := f.state.DB.GetFollowedTagIDs(ctx, owner.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error getting followed tags of %s: %w", owner.ID, err)
+	}
+
+	for _, tagID := range status.TagIDs {
+		if !slices.Contains(followedTagIDs, tagID) {
+			continue
+		}
+
+		tag, err := f.state.DB.GetTag(ctx, tagID)
+		if err != nil {
+			return false, gtserror.Newf("error getting tag %s: %w", tagID, err)
+		}
+
+		if *tag.Useable {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (f *Filter) isVisibleConversation(
 	ctx context.Context,
 	owner *gtsmodel.Account,
@@ -98,6 +98,26 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestNotFollowingStatusHomeTi
 	suite.False(timelineable)
 }
 
+func (suite *StatusStatusHomeTimelineableTestSuite) TestFollowedTagStatusHomeTimelineable() {
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	testAccount := suite.testAccounts["local_account_2"]
+	ctx := context.Background()
+
+	// Not following the author.
+	timelineable, err := suite.filter.StatusHomeTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// Follow the #welcome tag used by the status.
+	if err := suite.db.PutFollowedTag(ctx, testAccount.ID, suite.testTags["welcome"].ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err = suite.filter.StatusHomeTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(timelineable)
+}
+
 func (suite *StatusStatusHomeTimelineableTestSuite) TestStatusTooNewNotTimelineable() {
 	testStatus := &gtsmodel.Status{}
 	*testStatus = *suite.testStatuses["local_account_1_status_1"]
@@ -161,9 +181,6 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestThread() {
 		Language:                 "en",
 		CreatedWithApplicationID: "",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 	if err := suite.db.PutStatus(ctx, firstReplyStatus); err != nil {
@@ -214,9 +231,6 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestChainReplyFollowersOnly(
 		Language:                 "en",
 		CreatedWithApplicationID: "",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 	if err := suite.db.PutStatus(ctx, originalStatus); err != nil {
@@ -248,9 +262,6 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestChainReplyFollowersOnly(
 		Language:                 "en",
 		CreatedWithApplicationID: "",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 	if err := suite.db.PutStatus(ctx, firstReplyStatus); err != nil {
@@ -282,9 +293,6 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestChainReplyFollowersOnly(
 		Language:                 "en",
 		CreatedWithApplicationID: "",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 	if err := suite.db.PutStatus(ctx, secondReplyStatus); err != nil {
@@ -327,9 +335,6 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestChainReplyPublicAndUnloc
 		Language:                 "en",
 		CreatedWithApplicationID: "",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 	if err := suite.db.PutStatus(ctx, originalStatus); err != nil {
@@ -361,9 +366,6 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestChainReplyPublicAndUnloc
 		Language:                 "en",
 		CreatedWithApplicationID: "",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 	if err := suite.db.PutStatus(ctx, firstReplyStatus); err != nil {
@@ -395,9 +397,6 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestChainReplyPublicAndUnloc
 		Language:                 "en",
 		CreatedWithApplicationID: "",
 		Federated:                util.Ptr(true),
-		Boostable:                util.Ptr(true),
-		Replyable:                util.Ptr(true),
-		Likeable:                 util.Ptr(true),
 		ActivityStreamsType:      ap.ObjectNote,
 	}
 	if err := suite.db.PutStatus(ctx, secondReplyStatus); err != nil {
@@ -0,0 +1,198 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// forbidden is a convenience policy check
+// result for an interaction that is not allowed.
+var forbidden = &gtsmodel.PolicyCheckResult{
+	Permission: gtsmodel.PolicyPermissionForbidden,
+}
+
+// StatusLikeable checks if given status can be liked by requester, checking status visibility to requester and the status' interaction policy.
+func (f *Filter) StatusLikeable(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (*gtsmodel.PolicyCheckResult, error) {
+	if status.BoostOfID != "" {
+		log.Trace(ctx, "boost wrappers are not likeable")
+		return forbidden, nil
+	}
+
+	return f.checkInteraction(ctx, requester, status, func(p *gtsmodel.InteractionPolicy) gtsmodel.PolicyRules {
+		return p.CanLike
+	})
+}
+
+// StatusReplyable checks if given status can be replied to by requester, checking status visibility to requester and the status' interaction policy.
+func (f *Filter) StatusReplyable(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (*gtsmodel.PolicyCheckResult, error) {
+	if status.BoostOfID != "" {
+		log.Trace(ctx, "boost wrappers are not replyable")
+		return forbidden, nil
+	}
+
+	return f.checkInteraction(ctx, requester, status, func(p *gtsmodel.InteractionPolicy) gtsmodel.PolicyRules {
+		return p.CanReply
+	})
+}
+
+// StatusBoostable checks if given status can be boosted by requester, checking status visibility to requester and the status' interaction policy.
+func (f *Filter) StatusBoostable(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (*gtsmodel.PolicyCheckResult, error) {
+	if status.Visibility == gtsmodel.VisibilityDirect {
+		log.Trace(ctx, "direct statuses are not boostable")
+		return forbidden, nil
+	}
+
+	if status.BoostOfID != "" {
+		log.Trace(ctx, "boost wrappers are not boostable")
+		return forbidden, nil
+	}
+
+	return f.checkInteraction(ctx, requester, status, func(p *gtsmodel.InteractionPolicy) gtsmodel.PolicyRules {
+		return p.CanAnnounce
+	})
+}
+
+// checkInteraction checks whether requester may perform the interaction
+// selected from the status' interaction policy by getRules.
+func (f *Filter) checkInteraction(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+	getRules func(*gtsmodel.InteractionPolicy) gtsmodel.PolicyRules,
+) (*gtsmodel.PolicyCheckResult, error) {
+	// Check whether status is visible to requesting account.
+	visible, err := f.StatusVisible(ctx, requester, status)
+	if err != nil {
+		return nil, err
+	}
+
+	if !visible {
+		log.Trace(ctx, "status not visible to requesting account")
+		return forbidden, nil
+	}
+
+	if requester.ID == status.AccountID {
+		// Status author can always
+		// interact with their own status.
+		return &gtsmodel.PolicyCheckResult{
+			Permission: gtsmodel.PolicyPermissionPermitted,
+			MatchedOn:  gtsmodel.PolicyValueAuthor,
+		}, nil
+	}
+
+	policy := status.InteractionPolicy
+	if policy == nil {
+		// No policy set, use the default for this visibility.
+		policy = gtsmodel.DefaultInteractionPolicyFor(status.Visibility)
+	}
+
+	rules := getRules(policy)
+
+	// Check the "always" values first, as
+	// it's the most permissive result.
+	value, err := f.matchPolicyValues(ctx, requester, status, rules.Always)
+	if err != nil {
+		return nil, err
+	}
+
+	if value != "" {
+		return &gtsmodel.PolicyCheckResult{
+			Permission: gtsmodel.PolicyPermissionPermitted,
+			MatchedOn:  value,
+		}, nil
+	}
+
+	value, err = f.matchPolicyValues(ctx, requester, status, rules.WithApproval)
+	if err != nil {
+		return nil, err
+	}
+
+	if value != "" {
+		return &gtsmodel.PolicyCheckResult{
+			Permission: gtsmodel.PolicyPermissionWithApproval,
+			MatchedOn:  value,
+		}, nil
+	}
+
+	return forbidden, nil
+}
+
+// matchPolicyValues returns the first of the given policy
+// values that the requester matches for the given status,
+// or an empty string if the requester matches none of them.
+func (f *Filter) matchPolicyValues(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+	values gtsmodel.PolicyValues,
+) (gtsmodel.PolicyValue, error) {
+	for _, value := range values {
+		switch value {
+		case gtsmodel.PolicyValuePublic:
+			return value, nil
+
+		case gtsmodel.PolicyValueAuthor:
+			if requester.ID == status.AccountID {
+				return value, nil
+			}
+
+		case gtsmodel.PolicyValueMentioned:
+			if status.MentionsAccount(requester.ID) {
+				return value, nil
+			}
+
+		case gtsmodel.PolicyValueFollowers:
+			// Check requester follows status author.
+			follows, err := f.state.DB.IsFollowing(ctx,
+				requester.ID,
+				status.AccountID,
+			)
+			if err != nil {
+				return "", gtserror.Newf("error checking follow %s->%s: %w", requester.ID, status.AccountID, err)
+			}
+
+			if follows {
+				return value, nil
+			}
+
+		case gtsmodel.PolicyValueFollowing:
+			// Check status author follows requester.
+			following, err := f.state.DB.IsFollowing(ctx,
+				status.AccountID,
+				requester.ID,
+			)
+			if err != nil {
+				return "", gtserror.Newf("error checking follow %s->%s: %w", status.AccountID, requester.ID, err)
+			}
+
+			if following {
+				return value, nil
+			}
+
+		default:
+			log.Warnf(ctx, "unrecognized policy value %s on status %s", value, status.URI)
+		}
+	}
+
+	return "", nil
+}
@@ -22,13 +22,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
-type StatusBoostableTestSuite struct {
+type StatusInteractionTestSuite struct {
 	FilterStandardTestSuite
 }
 
-func (suite *StatusBoostableTestSuite) TestOwnPublicBoostable() {
+func (suite *StatusInteractionTestSuite) TestOwnPublicBoostable() {
 	testStatus := suite.testStatuses["local_account_1_status_1"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -36,10 +37,10 @@ func (suite *StatusBoostableTestSuite) TestOwnPublicBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.True(boostable)
+	suite.True(boostable.Permitted())
 }
 
-func (suite *StatusBoostableTestSuite) TestOwnUnlockedBoostable() {
+func (suite *StatusInteractionTestSuite) TestOwnUnlockedBoostable() {
 	testStatus := suite.testStatuses["local_account_1_status_2"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -47,10 +48,10 @@ func (suite *StatusBoostableTestSuite) TestOwnUnlockedBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.True(boostable)
+	suite.True(boostable.Permitted())
 }
 
-func (suite *StatusBoostableTestSuite) TestOwnMutualsOnlyNonInteractiveBoostable() {
+func (suite *StatusInteractionTestSuite) TestOwnMutualsOnlyNonInteractiveBoostable() {
 	testStatus := suite.testStatuses["local_account_1_status_3"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -58,10 +59,10 @@ func (suite *StatusBoostableTestSuite) TestOwnMutualsOnlyNonInteractiveBoostable
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.True(boostable)
+	suite.True(boostable.Permitted())
 }
 
-func (suite *StatusBoostableTestSuite) TestOwnMutualsOnlyBoostable() {
+func (suite *StatusInteractionTestSuite) TestOwnMutualsOnlyBoostable() {
 	testStatus := suite.testStatuses["local_account_1_status_4"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -69,10 +70,10 @@ func (suite *StatusBoostableTestSuite) TestOwnMutualsOnlyBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.True(boostable)
+	suite.True(boostable.Permitted())
 }
 
-func (suite *StatusBoostableTestSuite) TestOwnFollowersOnlyBoostable() {
+func (suite *StatusInteractionTestSuite) TestOwnFollowersOnlyBoostable() {
 	testStatus := suite.testStatuses["local_account_1_status_5"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -80,10 +81,10 @@ func (suite *StatusBoostableTestSuite) TestOwnFollowersOnlyBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.True(boostable)
+	suite.True(boostable.Permitted())
 }
 
-func (suite *StatusBoostableTestSuite) TestOwnDirectNotBoostable() {
+func (suite *StatusInteractionTestSuite) TestOwnDirectNotBoostable() {
 	testStatus := suite.testStatuses["local_account_2_status_6"]
 	testAccount := suite.testAccounts["local_account_2"]
 	ctx := context.Background()
@@ -91,10 +92,10 @@ func (suite *StatusBoostableTestSuite) TestOwnDirectNotBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.False(boostable)
+	suite.True(boostable.Forbidden())
 }
 
-func (suite *StatusBoostableTestSuite) TestOtherPublicBoostable() {
+func (suite *StatusInteractionTestSuite) TestOtherPublicBoostable() {
 	testStatus := suite.testStatuses["local_account_2_status_1"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -102,10 +103,10 @@ func (suite *StatusBoostableTestSuite) TestOtherPublicBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.True(boostable)
+	suite.True(boostable.Permitted())
 }
 
-func (suite *StatusBoostableTestSuite) TestOtherUnlistedBoostable() {
+func (suite *StatusInteractionTestSuite) TestOtherUnlistedBoostable() {
 	testStatus := suite.testStatuses["local_account_1_status_2"]
 	testAccount := suite.testAccounts["local_account_2"]
 	ctx := context.Background()
@@ -113,10 +114,10 @@ func (suite *StatusBoostableTestSuite) TestOtherUnlistedBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.True(boostable)
+	suite.True(boostable.Permitted())
 }
 
-func (suite *StatusBoostableTestSuite) TestOtherFollowersOnlyNotBoostable() {
+func (suite *StatusInteractionTestSuite) TestOtherFollowersOnlyNotBoostable() {
 	testStatus := suite.testStatuses["local_account_2_status_7"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -124,10 +125,10 @@ func (suite *StatusBoostableTestSuite) TestOtherFollowersOnlyNotBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.False(boostable)
+	suite.True(boostable.Forbidden())
 }
 
-func (suite *StatusBoostableTestSuite) TestOtherDirectNotBoostable() {
+func (suite *StatusInteractionTestSuite) TestOtherDirectNotBoostable() {
 	testStatus := suite.testStatuses["local_account_2_status_6"]
 	testAccount := suite.testAccounts["local_account_1"]
 	ctx := context.Background()
@@ -135,10 +136,10 @@ func (suite *StatusBoostableTestSuite) TestOtherDirectNotBoostable() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.False(boostable)
+	suite.True(boostable.Forbidden())
 }
 
-func (suite *StatusBoostableTestSuite) TestRemoteFollowersOnlyNotVisible() {
+func (suite *StatusInteractionTestSuite) TestRemoteFollowersOnlyNotVisible() {
 	testStatus := suite.testStatuses["local_account_1_status_5"]
 	testAccount := suite.testAccounts["remote_account_1"]
 	ctx := context.Background()
@@ -146,9 +147,59 @@ func (suite *StatusBoostableTestSuite) TestRemoteFollowersOnlyNotVisible() {
 	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
 	suite.NoError(err)
 
-	suite.False(boostable)
+	suite.True(boostable.Forbidden())
 }
 
-func TestStatusBoostableTestSuite(t *testing.T) {
-	suite.Run(t, new(StatusBoostableTestSuite))
+func (suite *StatusInteractionTestSuite) TestOtherReplyWithApproval() {
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	// Followers of the author can
+	// reply, but only with approval.
+	testStatus.InteractionPolicy = &gtsmodel.InteractionPolicy{
+		CanLike: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+		},
+		CanReply: gtsmodel.PolicyRules{
+			Always:       gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+			WithApproval: gtsmodel.PolicyValues{gtsmodel.PolicyValueFollowers},
+		},
+		CanAnnounce: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueMentioned},
+		},
+	}
+
+	replyable, err := suite.filter.StatusReplyable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(replyable.PermittedWithApproval())
+	suite.Equal(gtsmodel.PolicyValueFollowers, replyable.MatchedOn)
+
+	likeable, err := suite.filter.StatusLikeable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(likeable.Permitted())
+	suite.Equal(gtsmodel.PolicyValuePublic, likeable.MatchedOn)
+
+	boostable, err := suite.filter.StatusBoostable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(boostable.Forbidden())
+}
+
+func (suite *StatusInteractionTestSuite) TestOtherNonInteractiveNotLikeable() {
+	testStatus := suite.testStatuses["local_account_1_status_3"]
+	testAccount := suite.testAccounts["local_account_2"]
+	ctx := context.Background()
+
+	likeable, err := suite.filter.StatusLikeable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(likeable.Forbidden())
+
+	replyable, err := suite.filter.StatusReplyable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(replyable.Forbidden())
+}
+
+func TestStatusInteractionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusInteractionTestSuite))
 }
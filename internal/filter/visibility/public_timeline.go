@@ -91,6 +91,18 @@ func (f *Filter) isStatusPublicTimelineable(ctx context.Context, requester *gtsm
 		return false, nil
 	}
 
+	// Check whether status author's domain
+	// is limited from appearing on public timelines.
+	limit, err := f.state.DB.MatchDomainLimit(ctx, status.Account.Domain)
+	if err != nil {
+		return false, gtserror.Newf("error checking domain limit: %w", err)
+	}
+
+	if limit != nil && *limit.HidePublic {
+		log.Trace(ctx, "status author domain hidden from public timelines")
+		return false, nil
+	}
+
 	for parent := status; parent.InReplyToURI != ""; {
 		// Fetch next parent to lookup.
 		parentID := parent.InReplyToID
@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // StatusesVisible calls StatusVisible for each status in the statuses slice, and returns a slice of only statuses which are visible to the requester.
@@ -90,6 +91,35 @@ func (f *Filter) isStatusVisible(ctx context.Context, requester *gtsmodel.Accoun
 		return false, nil
 	}
 
+	if util.PtrValueOr(status.PendingApproval, false) {
+		// Statuses pending approval are only visible to
+		// their author, and the author of the status being
+		// replied to / boosted, who needs to approve it.
+		if requester == nil {
+			return false, nil
+		}
+
+		switch requester.ID {
+		case status.AccountID,
+			status.InReplyToAccountID,
+			status.BoostOfAccountID:
+			return true, nil
+		}
+
+		log.Trace(ctx, "pending status not visible to requester")
+		return false, nil
+	}
+
+	// Check whether status is from a
+	// silenced account, and if so,
+	// whether requester may see it.
+	visible, err = f.isSilencedStatusVisible(ctx, requester, status)
+	if err != nil {
+		return false, gtserror.Newf("error checking status %s silenced visibility: %w", status.ID, err)
+	} else if !visible {
+		return false, nil
+	}
+
 	if status.Visibility == gtsmodel.VisibilityPublic {
 		// This status will be visible to all.
 		return true, nil
@@ -211,3 +241,53 @@ func (f *Filter) areStatusAccountsVisible(ctx context.Context, requester *gtsmod
 
 	return true, nil
 }
+
+// isSilencedStatusVisible checks whether status is visible to requester, taking account of silencing
+// of the status author and boost-of author. Statuses (and boosts of statuses) by silenced accounts are
+// only visible to the author, to accounts following the author, and to accounts mentioned in the status.
+func (f *Filter) isSilencedStatusVisible(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	for _, check := range []struct {
+		author *gtsmodel.Account
+		status *gtsmodel.Status
+	}{
+		{status.Account, status},
+		{status.BoostOfAccount, status.BoostOf},
+	} {
+		if check.author == nil || !check.author.IsSilenced() {
+			// Not set, or
+			// not silenced.
+			continue
+		}
+
+		if requester == nil {
+			log.Trace(ctx, "silenced account status not visible to unauthed requester")
+			return false, nil
+		}
+
+		if requester.ID == check.author.ID {
+			// Author can always see their own status.
+			continue
+		}
+
+		if check.status != nil && check.status.MentionsAccount(requester.ID) {
+			// Status mentions the requesting account.
+			continue
+		}
+
+		// Check requester follows status author.
+		follows, err := f.state.DB.IsFollowing(ctx,
+			requester.ID,
+			check.author.ID,
+		)
+		if err != nil {
+			return false, gtserror.Newf("error checking follow %s->%s: %w", requester.ID, check.author.ID, err)
+		}
+
+		if !follows {
+			log.Trace(ctx, "silenced account status not visible to non-follower")
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -20,9 +20,11 @@ package visibility_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type StatusVisibleTestSuite struct {
@@ -156,6 +158,76 @@ func (suite *StatusVisibleTestSuite) TestStatusNotVisibleIfNotFollowingCached()
 	suite.False(visible)
 }
 
+func (suite *StatusVisibleTestSuite) TestSilencedStatusVisibleToFollowersOnly() {
+	ctx := context.Background()
+
+	// Silence local_account_2.
+	author := new(gtsmodel.Account)
+	*author = *suite.testAccounts["local_account_2"]
+	author.SilencedAt = time.Now()
+	if err := suite.db.UpdateAccount(ctx, author, "silenced_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Get a public status by local_account_2.
+	testStatusID := suite.testStatuses["local_account_2_status_1"].ID
+	testStatus, err := suite.db.GetStatusByID(ctx, testStatusID)
+	suite.NoError(err)
+	suite.True(testStatus.Account.IsSilenced())
+
+	for _, test := range []struct {
+		requester *gtsmodel.Account
+		visible   bool
+	}{
+		{nil, false}, // no auth
+		{suite.testAccounts["local_account_2"], true}, // author
+		{suite.testAccounts["local_account_1"], true}, // follower
+		{suite.testAccounts["admin_account"], false},  // not a follower
+	} {
+		visible, err := suite.filter.StatusVisible(ctx, test.requester, testStatus)
+		suite.NoError(err)
+		suite.Equal(test.visible, visible)
+	}
+}
+
+func (suite *StatusVisibleTestSuite) TestDomainLimitHidesFromPublicTimeline() {
+	ctx := context.Background()
+
+	testStatusID := suite.testStatuses["remote_account_1_status_1"].ID
+	testStatus, err := suite.db.GetStatusByID(ctx, testStatusID)
+	suite.NoError(err)
+
+	requester := suite.testAccounts["local_account_1"]
+
+	// Status should be timelineable by default.
+	timelineable, err := suite.filter.StatusPublicTimelineable(ctx, requester, testStatus)
+	suite.NoError(err)
+	suite.True(timelineable)
+
+	// Limit the author's domain from public timelines.
+	if err := suite.db.CreateDomainLimit(ctx, &gtsmodel.DomainLimit{
+		ID:                 "01J4CR3H0N6WQ8Y1J0X4M0Y3ZD",
+		Domain:             testStatus.Account.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		MediaSensitive:     util.Ptr(false),
+		HidePublic:         util.Ptr(true),
+		RejectMedia:        util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.state.Caches.Visibility.Clear()
+
+	// Status should no longer be timelineable...
+	timelineable, err = suite.filter.StatusPublicTimelineable(ctx, requester, testStatus)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// ...but still visible otherwise.
+	visible, err := suite.filter.StatusVisible(ctx, requester, testStatus)
+	suite.NoError(err)
+	suite.True(visible)
+}
+
 func TestStatusVisibleTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusVisibleTestSuite))
 }
@@ -32,4 +32,100 @@ type AccountSettings struct {
 	CustomCSS         string     `bun:",nullzero"`                                                   // Custom CSS that should be displayed for this Account's profile and statuses.
 	EnableRSS         *bool      `bun:",nullzero,notnull,default:false"`                             // enable RSS feed subscription for this account's public posts at [URL]/feed
 	HideCollections   *bool      `bun:",nullzero,notnull,default:false"`                             // Hide this account's followers/following collections.
+
+	// Default interaction policies for statuses
+	// created by this account, per visibility.
+	// If nil, the instance default is used.
+	InteractionPolicyDirect        *InteractionPolicy `bun:""`
+	InteractionPolicyMutualsOnly   *InteractionPolicy `bun:""`
+	InteractionPolicyFollowersOnly *InteractionPolicy `bun:""`
+	InteractionPolicyUnlocked      *InteractionPolicy `bun:""`
+	InteractionPolicyPublic        *InteractionPolicy `bun:""`
+
+	// Email notification preferences. Emails are only
+	// sent for notification types opted in to here.
+	EmailNotifyMention       *bool       `bun:",nullzero,notnull,default:false"` // Email non-direct mentions of this account.
+	EmailNotifyDirect        *bool       `bun:",nullzero,notnull,default:false"` // Email direct messages to this account.
+	EmailNotifyFollow        *bool       `bun:",nullzero,notnull,default:false"` // Email new follows of this account.
+	EmailNotifyFollowRequest *bool       `bun:",nullzero,notnull,default:false"` // Email new follow requests to this account.
+	EmailDigest              EmailDigest `bun:",nullzero"`                       // Send emails batched into a digest rather than one per notification.
+	EmailDigestAt            time.Time   `bun:"type:timestamptz,nullzero"`       // When a digest was last due for this account.
+}
+
+// EmailNotifyAny returns true if any
+// email notification type is opted in to.
+func (s *AccountSettings) EmailNotifyAny() bool {
+	return *s.EmailNotifyMention ||
+		*s.EmailNotifyDirect ||
+		*s.EmailNotifyFollow ||
+		*s.EmailNotifyFollowRequest
+}
+
+// EmailNotifyFor returns true if the account opted in
+// to email notifications for the given notification.
+// For mentions, direct should be true if the mentioning
+// status has direct visibility.
+func (s *AccountSettings) EmailNotifyFor(notifType NotificationType, direct bool) bool {
+	switch notifType {
+	case NotificationMention:
+		if direct {
+			return *s.EmailNotifyDirect
+		}
+		return *s.EmailNotifyMention
+	case NotificationFollow:
+		return *s.EmailNotifyFollow
+	case NotificationFollowRequest:
+		return *s.EmailNotifyFollowRequest
+	default:
+		return false
+	}
+}
+
+// EmailDigest describes how often email
+// notifications are batched into a digest.
+type EmailDigest string
+
+const (
+	EmailDigestNone   EmailDigest = ""       // No digest, one email per notification.
+	EmailDigestDaily  EmailDigest = "daily"  // One digest email per day.
+	EmailDigestWeekly EmailDigest = "weekly" // One digest email per week.
+)
+
+// Interval returns the minimum time between two
+// digests of this type, or 0 for EmailDigestNone.
+func (d EmailDigest) Interval() time.Duration {
+	switch d {
+	case EmailDigestDaily:
+		return 24 * time.Hour
+	case EmailDigestWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// InteractionPolicyFor returns the default interaction policy
+// to use for new statuses of the given visibility, falling back
+// to the instance default if the account hasn't set one.
+func (s *AccountSettings) InteractionPolicyFor(v Visibility) *InteractionPolicy {
+	var policy *InteractionPolicy
+
+	switch v {
+	case VisibilityDirect:
+		policy = s.InteractionPolicyDirect
+	case VisibilityMutualsOnly:
+		policy = s.InteractionPolicyMutualsOnly
+	case VisibilityFollowersOnly:
+		policy = s.InteractionPolicyFollowersOnly
+	case VisibilityUnlocked:
+		policy = s.InteractionPolicyUnlocked
+	case VisibilityPublic:
+		policy = s.InteractionPolicyPublic
+	}
+
+	if policy == nil {
+		return DefaultInteractionPolicyFor(v)
+	}
+
+	return policy
 }
@@ -73,6 +73,9 @@ const (
 	AdminActionSuspend
 	AdminActionUnsuspend
 	AdminActionExpireKeys
+	AdminActionSensitize
+	AdminActionUnsensitize
+	AdminActionNone
 )
 
 func (t AdminActionType) String() string {
@@ -91,6 +94,12 @@ func (t AdminActionType) String() string {
 		return "unsuspend"
 	case AdminActionExpireKeys:
 		return "expire-keys"
+	case AdminActionSensitize:
+		return "sensitive"
+	case AdminActionUnsensitize:
+		return "unsensitive"
+	case AdminActionNone:
+		return "none"
 	default:
 		return "unknown"
 	}
@@ -112,6 +121,12 @@ func NewAdminActionType(in string) AdminActionType {
 		return AdminActionUnsuspend
 	case "expire-keys":
 		return AdminActionExpireKeys
+	case "sensitive":
+		return AdminActionSensitize
+	case "unsensitive":
+		return AdminActionUnsensitize
+	case "none":
+		return AdminActionNone
 	default:
 		return AdminActionUnknown
 	}
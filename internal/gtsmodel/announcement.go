@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Announcement models an instance announcement
+// made by an admin, shown to all local users.
+type Announcement struct {
+	ID          string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Text        string    `bun:""`                                                            // markdown text of the announcement, as written by the admin
+	Content     string    `bun:""`                                                            // html content of the announcement, formatted from Text
+	StartsAt    time.Time `bun:"type:timestamptz,nullzero"`                                   // start of the period the announcement is about, if any
+	EndsAt      time.Time `bun:"type:timestamptz,nullzero"`                                   // end of the period the announcement is about, if any, after which it is no longer shown
+	AllDay      *bool     `bun:",nullzero,notnull,default:false"`                             // StartsAt and EndsAt are whole days, rather than times
+	PublishedAt time.Time `bun:"type:timestamptz,nullzero"`                                   // when the announcement was published to users, zero if not (yet) published
+	EmojiIDs    []string  `bun:"emojis,array"`                                                // database IDs of any emojis used in the announcement
+	Emojis      []*Emoji  `bun:"-"`                                                           // emojis corresponding to EmojiIDs
+}
+
+// IsPublished returns whether the
+// announcement is published to users.
+func (a *Announcement) IsPublished() bool {
+	return !a.PublishedAt.IsZero()
+}
+
+// IsEnded returns whether the announcement has an
+// end, which had passed at the given time. All day
+// announcements end at the end of their last day.
+func (a *Announcement) IsEnded(now time.Time) bool {
+	if a.EndsAt.IsZero() {
+		return false
+	}
+
+	end := a.EndsAt
+	if *a.AllDay {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	return !now.Before(end)
+}
+
+// AnnouncementReaction models an emoji reaction
+// to an announcement by a local account. Each
+// account may react with any emoji only once.
+type AnnouncementReaction struct {
+	ID             string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                          // id of this item in the database
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`       // when was item created
+	AnnouncementID string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reactions_uniq"` // id of the announcement reacted to
+	AccountID      string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reactions_uniq"` // id of the reacting account
+	Name           string    `bun:",nullzero,notnull,unique:announcement_reactions_uniq"`              // the reaction; either a unicode emoji, or the shortcode of a local custom emoji
+	EmojiID        string    `bun:"type:CHAR(26),nullzero"`                                            // id of the custom emoji, if this is a custom emoji reaction
+	Emoji          *Emoji    `bun:"-"`                                                                 // custom emoji corresponding to EmojiID
+}
+
+// AnnouncementRead marks an announcement as
+// read (dismissed) by a local account.
+type AnnouncementRead struct {
+	AccountID      string    `bun:"type:CHAR(26),pk,nullzero,notnull"`                           // id of the account that read the announcement
+	AnnouncementID string    `bun:"type:CHAR(26),pk,nullzero,notnull"`                           // id of the read announcement
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+}
@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainLimit represents a federation limit towards a particular
+// domain. Unlike a DomainBlock, a limit does not sever federation
+// with the domain, but instead applies moderation measures to
+// content that is received from it.
+type DomainLimit struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain             string    `bun:",nullzero,notnull"`                                           // domain to limit. Eg. 'whatever.com'
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this limit
+	CreatedByAccount   *Account  `bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	PrivateComment     string    `bun:""`                                                            // Private comment on this limit, viewable to admins
+	PublicComment      string    `bun:""`                                                            // Public comment on this limit, viewable (optionally) by everyone
+	Obfuscate          *bool     `bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
+	SubscriptionID     string    `bun:"type:CHAR(26),nullzero"`                                      // if this limit was created through a subscription, what's the subscription ID?
+	ContentWarning     string    `bun:""`                                                            // content warning to apply to statuses from this domain that don't already have one
+	MediaSensitive     *bool     `bun:",nullzero,notnull,default:false"`                             // mark all media attachments from this domain as sensitive
+	HidePublic         *bool     `bun:",nullzero,notnull,default:false"`                             // hide statuses from this domain from public timelines
+	RejectMedia        *bool     `bun:",nullzero,notnull,default:false"`                             // don't download / cache media attachments from this domain
+}
+
+func (d *DomainLimit) GetID() string {
+	return d.ID
+}
+
+func (d *DomainLimit) GetCreatedAt() time.Time {
+	return d.CreatedAt
+}
+
+func (d *DomainLimit) GetUpdatedAt() time.Time {
+	return d.UpdatedAt
+}
+
+func (d *DomainLimit) GetDomain() string {
+	return d.Domain
+}
+
+func (d *DomainLimit) GetCreatedByAccountID() string {
+	return d.CreatedByAccountID
+}
+
+func (d *DomainLimit) GetCreatedByAccount() *Account {
+	return d.CreatedByAccount
+}
+
+func (d *DomainLimit) GetPrivateComment() string {
+	return d.PrivateComment
+}
+
+func (d *DomainLimit) GetPublicComment() string {
+	return d.PublicComment
+}
+
+func (d *DomainLimit) GetObfuscate() *bool {
+	return d.Obfuscate
+}
+
+func (d *DomainLimit) GetSubscriptionID() string {
+	return d.SubscriptionID
+}
+
+func (d *DomainLimit) SetSubscriptionID(i string) {
+	d.SubscriptionID = i
+}
+
+func (d *DomainLimit) GetType() DomainPermissionType {
+	return DomainPermissionLimit
+}
@@ -20,7 +20,7 @@ package gtsmodel
 import "time"
 
 // DomainPermission models a domain
-// permission entry (block/allow).
+// permission entry (block/allow/limit).
 type DomainPermission interface {
 	GetID() string
 	GetCreatedAt() time.Time
@@ -32,6 +32,7 @@ type DomainPermission interface {
 	GetPublicComment() string
 	GetObfuscate() *bool
 	GetSubscriptionID() string
+	SetSubscriptionID(string)
 	GetType() DomainPermissionType
 }
 
@@ -42,6 +43,7 @@ const (
 	DomainPermissionUnknown DomainPermissionType = iota
 	DomainPermissionBlock                        // Explicitly block a domain.
 	DomainPermissionAllow                        // Explicitly allow a domain.
+	DomainPermissionLimit                        // Limit (but don't block) a domain.
 )
 
 func (p DomainPermissionType) String() string {
@@ -50,6 +52,8 @@ func (p DomainPermissionType) String() string {
 		return "block"
 	case DomainPermissionAllow:
 		return "allow"
+	case DomainPermissionLimit:
+		return "limit"
 	default:
 		return "unknown"
 	}
@@ -61,6 +65,8 @@ func NewDomainPermissionType(in string) DomainPermissionType {
 		return DomainPermissionBlock
 	case "allow":
 		return DomainPermissionAllow
+	case "limit":
+		return DomainPermissionLimit
 	default:
 		return DomainPermissionUnknown
 	}
@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// ImportType describes the kind of
+// data contained in an account import.
+type ImportType uint8
+
+// Only ever add new import types to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	ImportTypeUnknown ImportType = iota
+	ImportTypeFollowing
+	ImportTypeBlocks
+	ImportTypeMutes
+	ImportTypeLists
+	ImportTypeBookmarks
+	ImportTypeArchive
+)
+
+func (t ImportType) String() string {
+	switch t {
+	case ImportTypeFollowing:
+		return "following"
+	case ImportTypeBlocks:
+		return "blocks"
+	case ImportTypeMutes:
+		return "mutes"
+	case ImportTypeLists:
+		return "lists"
+	case ImportTypeBookmarks:
+		return "bookmarks"
+	case ImportTypeArchive:
+		return "archive"
+	default:
+		return "unknown"
+	}
+}
+
+func NewImportType(in string) ImportType {
+	switch in {
+	case "following":
+		return ImportTypeFollowing
+	case "blocks":
+		return ImportTypeBlocks
+	case "mutes":
+		return ImportTypeMutes
+	case "lists":
+		return ImportTypeLists
+	case "bookmarks":
+		return ImportTypeBookmarks
+	case "archive":
+		return ImportTypeArchive
+	default:
+		return ImportTypeUnknown
+	}
+}
+
+// ImportState describes how far along
+// the processing of an account import is.
+type ImportState uint8
+
+// Only ever add new import states to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	ImportStateUnknown ImportState = iota
+	ImportStatePending
+	ImportStateProcessing
+	ImportStateFinished
+	ImportStateFailed
+)
+
+func (s ImportState) String() string {
+	switch s {
+	case ImportStatePending:
+		return "pending"
+	case ImportStateProcessing:
+		return "processing"
+	case ImportStateFinished:
+		return "finished"
+	case ImportStateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Import represents an upload of account data (follows,
+// blocks, mutes, etc, or a whole account archive) exported
+// from this or another instance, which is processed in the
+// background by the client worker.
+type Import struct {
+	ID             string      `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt      time.Time   `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt      time.Time   `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	FinishedAt     time.Time   `bun:"type:timestamptz,nullzero"`                                   // when processing of the import finished (or failed)
+	AccountID      string      `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that uploaded the import
+	Account        *Account    `bun:"-"`                                                           // account corresponding to accountID
+	Type           ImportType  `bun:",nullzero,notnull"`                                           // type of data contained in the import
+	Overwrite      *bool       `bun:",nullzero,notnull,default:false"`                             // remove existing entries not present in the import, instead of merging
+	DryRun         *bool       `bun:",nullzero,notnull,default:false"`                             // only check what would be imported, without importing anything (archive imports only)
+	Federate       *bool       `bun:",nullzero,notnull,default:false"`                             // federate imported statuses to followers (archive imports only)
+	State          ImportState `bun:",nullzero,notnull"`                                           // processing state of the import
+	TotalItems     int         `bun:",notnull,default:0"`                                          // total number of items (rows) contained in the import
+	ProcessedItems int         `bun:",notnull,default:0"`                                          // number of items processed so far, including failed items
+	FailedItems    int         `bun:",notnull,default:0"`                                          // number of items that could not be imported
+	Data           []byte      `bun:",nullzero" json:"-"`                                          // uploaded data, cleared once processing has finished (archives are kept in storage instead)
+}
+
+// ImportStatus maps the original URI of one status
+// in an account archive import to the status it was
+// imported as, so that an interrupted import can still
+// continue threads when resumed. Entries are removed
+// once processing of the import has finished.
+type ImportStatus struct {
+	ID       string `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                            // id of this item in the database
+	ImportID string `bun:"type:CHAR(26),nullzero,notnull,unique:import_statuses_import_id_uri"` // id of the import the status was imported by
+	URI      string `bun:",nullzero,notnull,unique:import_statuses_import_id_uri"`              // original uri of the status in the archive
+	StatusID string `bun:"type:CHAR(26),nullzero,notnull"`                                      // id of the status it was imported as
+}
@@ -43,4 +43,6 @@ type Instance struct {
 	Reputation             int64        `bun:",notnull,default:0"`                                          // Reputation score of this instance
 	Version                string       `bun:",nullzero"`                                                   // Version of the software used on this instance
 	Rules                  []Rule       `bun:"-"`                                                           // List of instance rules
+	VAPIDPublicKey         string       `bun:",nullzero"`                                                   // base64url encoded public key used to sign Web Push requests, local instance only
+	VAPIDPrivateKey        string       `bun:",nullzero"`                                                   // base64url encoded private key used to sign Web Push requests, local instance only
 }
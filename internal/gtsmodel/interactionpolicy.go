@@ -0,0 +1,209 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+// PolicyValue represents a single value
+// in an interaction policy rule, which
+// describes a group of accounts that
+// can match the rule.
+type PolicyValue string
+
+const (
+	// Anyone can do this.
+	PolicyValuePublic PolicyValue = "public"
+	// Followers of the author can do this.
+	PolicyValueFollowers PolicyValue = "followers"
+	// Accounts followed by the author can do this.
+	PolicyValueFollowing PolicyValue = "following"
+	// Accounts mentioned in the status can do this.
+	PolicyValueMentioned PolicyValue = "mentioned"
+	// Only the author can do this (ie., nobody else).
+	PolicyValueAuthor PolicyValue = "author"
+)
+
+// Valid returns whether this
+// is a recognized policy value.
+func (p PolicyValue) Valid() bool {
+	switch p {
+	case PolicyValuePublic,
+		PolicyValueFollowers,
+		PolicyValueFollowing,
+		PolicyValueMentioned,
+		PolicyValueAuthor:
+		return true
+	default:
+		return false
+	}
+}
+
+// PolicyValues is a slice of PolicyValue.
+type PolicyValues []PolicyValue
+
+// InteractionPolicy models the interaction
+// policy of a status, or the default policy
+// of an account for a given visibility.
+//
+// Absence of an interaction policy on a status
+// means the default policy for the status'
+// visibility should be used instead.
+type InteractionPolicy struct {
+	// Conditions in which a Like
+	// interaction will be accepted
+	// for a status with this policy.
+	CanLike PolicyRules
+	// Conditions in which a Reply
+	// interaction will be accepted
+	// for a status with this policy.
+	CanReply PolicyRules
+	// Conditions in which an Announce
+	// interaction will be accepted
+	// for a status with this policy.
+	CanAnnounce PolicyRules
+}
+
+// PolicyRules represents the rules according
+// to which a certain interaction is permitted
+// to various Actor and Actor Collection URIs.
+type PolicyRules struct {
+	// Always is for PolicyValues who are
+	// permitted to do an interaction
+	// without requiring approval.
+	Always PolicyValues
+	// WithApproval is for PolicyValues who
+	// are conditionally permitted to do
+	// an interaction, pending approval.
+	WithApproval PolicyValues
+}
+
+// PolicyPermission represents the permission
+// state of an interaction, after checking the
+// relevant interaction policy rules.
+type PolicyPermission int
+
+const (
+	// Interaction is forbidden for this
+	// PolicyValue + interaction combination.
+	PolicyPermissionForbidden PolicyPermission = iota
+	// Interaction is conditionally permitted
+	// for this PolicyValue + interaction combo,
+	// pending approval by the item owner.
+	PolicyPermissionWithApproval
+	// Interaction is permitted for this
+	// PolicyValue + interaction combination.
+	PolicyPermissionPermitted
+)
+
+// PolicyCheckResult encapsulates the results
+// of checking a certain Actor URI + type
+// of interaction against an interaction policy.
+type PolicyCheckResult struct {
+	// Permission permitted /
+	// with approval / forbidden.
+	Permission PolicyPermission
+
+	// Value that this check matched on.
+	// Only set if Permission = permitted
+	// or permitted with approval.
+	MatchedOn PolicyValue
+}
+
+// Permitted returns true if the interaction is
+// permitted without requiring any approval.
+func (pcr *PolicyCheckResult) Permitted() bool {
+	return pcr.Permission == PolicyPermissionPermitted
+}
+
+// PermittedWithApproval returns true if the
+// interaction is permitted pending approval.
+func (pcr *PolicyCheckResult) PermittedWithApproval() bool {
+	return pcr.Permission == PolicyPermissionWithApproval
+}
+
+// Forbidden returns true if the
+// interaction is not permitted at all.
+func (pcr *PolicyCheckResult) Forbidden() bool {
+	return pcr.Permission == PolicyPermissionForbidden
+}
+
+// DefaultInteractionPolicyFor returns the default interaction
+// policy for the given visibility level, as used for statuses
+// without an explicit policy, and for accounts that haven't
+// set their own default policy for that visibility.
+func DefaultInteractionPolicyFor(v Visibility) *InteractionPolicy {
+	switch v {
+	case VisibilityPublic:
+		return DefaultInteractionPolicyPublic()
+	case VisibilityUnlocked:
+		return DefaultInteractionPolicyUnlocked()
+	case VisibilityFollowersOnly, VisibilityMutualsOnly:
+		return DefaultInteractionPolicyFollowersOnly()
+	case VisibilityDirect:
+		return DefaultInteractionPolicyDirect()
+	default:
+		panic("visibility " + v + " not recognized")
+	}
+}
+
+// DefaultInteractionPolicyPublic returns the default
+// interaction policy for a public visibility status:
+// anyone can like, reply to, or announce it.
+func DefaultInteractionPolicyPublic() *InteractionPolicy {
+	return &InteractionPolicy{
+		CanLike: PolicyRules{
+			Always: PolicyValues{PolicyValuePublic},
+		},
+		CanReply: PolicyRules{
+			Always: PolicyValues{PolicyValuePublic},
+		},
+		CanAnnounce: PolicyRules{
+			Always: PolicyValues{PolicyValuePublic},
+		},
+	}
+}
+
+// DefaultInteractionPolicyUnlocked returns the default
+// interaction policy for an unlocked (unlisted) status,
+// which is the same as for a public visibility status.
+func DefaultInteractionPolicyUnlocked() *InteractionPolicy {
+	return DefaultInteractionPolicyPublic()
+}
+
+// DefaultInteractionPolicyFollowersOnly returns the default
+// interaction policy for a followers-only status: anyone who
+// can see it can like or reply to it, but only the author
+// can announce it.
+func DefaultInteractionPolicyFollowersOnly() *InteractionPolicy {
+	return &InteractionPolicy{
+		CanLike: PolicyRules{
+			Always: PolicyValues{PolicyValuePublic},
+		},
+		CanReply: PolicyRules{
+			Always: PolicyValues{PolicyValuePublic},
+		},
+		CanAnnounce: PolicyRules{
+			Always: PolicyValues{PolicyValueAuthor},
+		},
+	}
+}
+
+// DefaultInteractionPolicyDirect returns the default
+// interaction policy for a direct status, which is
+// the same as for a followers-only status.
+func DefaultInteractionPolicyDirect() *InteractionPolicy {
+	return DefaultInteractionPolicyFollowersOnly()
+}
@@ -106,9 +106,9 @@ type Original struct {
 	Height    int      // height in pixels
 	Size      int      // size in pixels (width * height)
 	Aspect    float32  // aspect ratio (width / height)
-	Duration  *float32 // video-specific: duration of the video in seconds
+	Duration  *float32 // video/audio-specific: duration of the video or audio in seconds
 	Framerate *float32 // video-specific: fps
-	Bitrate   *uint64  // video-specific: bitrate
+	Bitrate   *uint64  // video/audio-specific: bitrate
 }
 
 // Focus describes the 'center' of the image for display purposes.
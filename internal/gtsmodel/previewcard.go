@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// PreviewCard represents a rich preview of a
+// link, generated from the OpenGraph / oEmbed
+// metadata of the linked page, and attached
+// to statuses which link to that page.
+type PreviewCard struct {
+	ID                string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt         time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt         time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	FetchedAt         time.Time        `bun:"type:timestamptz,nullzero"`                                   // when was the linked page last fetched
+	URL               string           `bun:",nullzero,notnull,unique"`                                    // url of the linked page
+	Title             string           `bun:",nullzero"`                                                   // title of the linked page
+	Description       string           `bun:",nullzero"`                                                   // description of the linked page
+	Type              PreviewCardType  `bun:",nullzero,notnull"`                                           // type of preview card
+	AuthorName        string           `bun:",nullzero"`                                                   // name of the author of the linked page
+	AuthorURL         string           `bun:",nullzero"`                                                   // url of the author of the linked page
+	ProviderName      string           `bun:",nullzero"`                                                   // name of the provider (site) of the linked page
+	ProviderURL       string           `bun:",nullzero"`                                                   // url of the provider (site) of the linked page
+	HTML              string           `bun:",nullzero"`                                                   // oEmbed html for rich / video cards
+	Width             int              `bun:",nullzero"`                                                   // width of the oEmbed content or image, in pixels
+	Height            int              `bun:",nullzero"`                                                   // height of the oEmbed content or image, in pixels
+	EmbedURL          string           `bun:",nullzero"`                                                   // url of the oEmbed photo for photo cards
+	ImageRemoteURL    string           `bun:",nullzero"`                                                   // remote url of the card image
+	ImageAttachmentID string           `bun:"type:CHAR(26),nullzero"`                                      // id of the stored, thumbnailed card image
+	Image             *MediaAttachment `bun:"-"`                                                           // media attachment corresponding to imageAttachmentID
+}
+
+// PreviewCardType is the type of
+// a preview card, as per oEmbed.
+type PreviewCardType string
+
+// PreviewCard types.
+const (
+	PreviewCardTypeLink  PreviewCardType = "link"  // PreviewCardTypeLink is for plain link previews
+	PreviewCardTypePhoto PreviewCardType = "photo" // PreviewCardTypePhoto is for oEmbed photos
+	PreviewCardTypeVideo PreviewCardType = "video" // PreviewCardTypeVideo is for oEmbed videos
+	PreviewCardTypeRich  PreviewCardType = "rich"  // PreviewCardTypeRich is for oEmbed rich html
+)
@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// RelayState describes how far along
+// the subscription to a relay is.
+type RelayState uint8
+
+// Only ever add new relay states to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	RelayStateUnknown RelayState = iota
+	RelayStatePending
+	RelayStateAccepted
+	RelayStateRejected
+)
+
+func (s RelayState) String() string {
+	switch s {
+	case RelayStatePending:
+		return "pending"
+	case RelayStateAccepted:
+		return "accepted"
+	case RelayStateRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+// Relay represents a subscription of this instance
+// to a fediverse relay, which Announces (LitePub) or
+// forwards (Mastodon) the public statuses it receives
+// from its subscribers to all of its subscribers.
+//
+// The subscription is a Follow sent by the instance
+// account, which the relay may Accept or Reject.
+type Relay struct {
+	ID                 string     `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	URI                string     `bun:",nullzero,notnull,unique"`                                    // URI of the relay as given by the admin, either the relay actor or its inbox
+	InboxURI           string     `bun:",nullzero,notnull"`                                           // inbox of the relay that activities are delivered to
+	ActorURI           string     `bun:",nullzero,unique"`                                            // URI of the relay actor; for Mastodon relays only known once the relay accepts
+	FollowURI          string     `bun:",nullzero,notnull,unique"`                                    // URI of the Follow sent to subscribe to the relay
+	State              RelayState `bun:",nullzero,notnull"`                                           // state of the subscription
+	Forward            *bool      `bun:",nullzero,notnull,default:false"`                             // deliver public statuses of local accounts to the relay
+	CreatedByAccountID string     `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin account that created the subscription
+	CreatedByAccount   *Account   `bun:"-"`                                                           // account corresponding to createdByAccountID
+}
+
+// IsLitePub returns true if the relay is subscribed
+// to by following its actor, as opposed to following
+// the Public collection, as is done for Mastodon relays.
+func (r *Relay) IsLitePub() bool {
+	return r.URI != r.InboxURI
+}
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// ScheduledStatus represents a status created by a local account
+// to be posted at a later time. Its fields mirror the parameters of
+// a status creation request, which will be made on the account's
+// behalf once ScheduledAt is reached. Account defaults are resolved
+// when the status is scheduled, not when it's published.
+type ScheduledStatus struct {
+	ID                string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt         time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt         time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID         string             `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that scheduled the status
+	Account           *Account           `bun:"-"`                                                           // account corresponding to accountID
+	ScheduledAt       time.Time          `bun:"type:timestamptz,nullzero,notnull"`                           // when the status should be posted
+	Text              string             `bun:""`                                                            // text content of the status
+	Poll              *ScheduledPoll     `bun:""`                                                            // poll to attach to the status, if any
+	MediaIDs          []string           `bun:"attachments,array"`                                           // database IDs of media attachments to attach to the status
+	MediaAttachments  []*MediaAttachment `bun:"-"`                                                           // attachments corresponding to mediaIDs
+	Sensitive         *bool              `bun:",nullzero,notnull,default:false"`                             // mark the status as sensitive
+	SpoilerText       string             `bun:""`                                                            // content warning of the status
+	Visibility        Visibility         `bun:",nullzero"`                                                   // visibility of the status
+	Federated         *bool              `bun:",nullzero,notnull,default:true"`                              // status will be federated beyond the local timeline(s)
+	InReplyToID       string             `bun:"type:CHAR(26),nullzero"`                                      // id of the status being replied to, if any
+	Language          string             `bun:",nullzero"`                                                   // language of the status
+	ContentType       string             `bun:",nullzero"`                                                   // content type of the status text
+	InteractionPolicy *InteractionPolicy `bun:""`                                                            // interaction policy of the status
+	ApplicationID     string             `bun:"type:CHAR(26),nullzero"`                                      // id of the application the status was scheduled with
+	Application       *Application       `bun:"-"`                                                           // application corresponding to applicationID
+}
+
+// ScheduledPoll contains the parameters of a poll
+// to be attached to a scheduled status when posted.
+type ScheduledPoll struct {
+	Options    []string `json:"options"`     // The available options for this poll.
+	ExpiresIn  int      `json:"expires_in"`  // Duration the poll should be open for, in seconds.
+	Multiple   bool     `json:"multiple"`    // Is this a multiple choice poll?
+	HideTotals bool     `json:"hide_totals"` // Hide vote counts until poll ends?
+}
@@ -29,6 +29,7 @@ type Status struct {
 	UpdatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                   // when was item (remote) last fetched.
 	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was pinned by owning account at this time.
+	EditedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was last edited at this time (zero if never edited).
 	URI                      string             `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this status
 	URL                      string             `bun:",nullzero"`                                                   // web url for viewing this status
 	Content                  string             `bun:""`                                                            // content of this status; likely html-formatted but not guaranteed
@@ -40,6 +41,8 @@ type Status struct {
 	Mentions                 []*Mention         `bun:"attached_mentions,rel:has-many"`                              // Mentions corresponding to mentionIDs
 	EmojiIDs                 []string           `bun:"emojis,array"`                                                // Database IDs of any emojis used in this status
 	Emojis                   []*Emoji           `bun:"attached_emojis,m2m:status_to_emojis"`                        // Emojis corresponding to emojiIDs. https://bun.uptrace.dev/guide/relations.html#many-to-many-relation
+	EditIDs                  []string           `bun:"edits,array"`                                                 // Database IDs of historical edits of this status, oldest first
+	Edits                    []*StatusEdit      `bun:"-"`                                                           // Edits corresponding to editIDs
 	Local                    *bool              `bun:",nullzero,notnull,default:false"`                             // is this status from a local account?
 	AccountID                string             `bun:"type:CHAR(26),nullzero,notnull"`                              // which account posted this status?
 	Account                  *Account           `bun:"rel:belongs-to"`                                              // account corresponding to accountID
@@ -57,6 +60,8 @@ type Status struct {
 	ThreadID                 string             `bun:"type:CHAR(26),nullzero"`                                      // id of the thread to which this status belongs; only set for remote statuses if a local account is involved at some point in the thread, otherwise null
 	PollID                   string             `bun:"type:CHAR(26),nullzero"`                                      //
 	Poll                     *Poll              `bun:"-"`                                                           //
+	PreviewCardID            string             `bun:"type:CHAR(26),nullzero"`                                      // id of the preview card of the first link in this status
+	PreviewCard              *PreviewCard       `bun:"-"`                                                           // preview card corresponding to previewCardID
 	ContentWarning           string             `bun:",nullzero"`                                                   // cw string for this status
 	Visibility               Visibility         `bun:",nullzero,notnull"`                                           // visibility entry for this status
 	Sensitive                *bool              `bun:",nullzero,notnull,default:false"`                             // mark the status as sensitive?
@@ -66,9 +71,9 @@ type Status struct {
 	ActivityStreamsType      string             `bun:",nullzero,notnull"`                                           // What is the activitystreams type of this status? See: https://www.w3.org/TR/activitystreams-vocabulary/#object-types. Will probably almost always be Note but who knows!.
 	Text                     string             `bun:""`                                                            // Original text of the status without formatting
 	Federated                *bool              `bun:",notnull"`                                                    // This status will be federated beyond the local timeline(s)
-	Boostable                *bool              `bun:",notnull"`                                                    // This status can be boosted/reblogged
-	Replyable                *bool              `bun:",notnull"`                                                    // This status can be replied to
-	Likeable                 *bool              `bun:",notnull"`                                                    // This status can be liked/faved
+	InteractionPolicy        *InteractionPolicy `bun:""`                                                            // InteractionPolicy for this status. If nil then the default InteractionPolicy for this status' Visibility should be assumed. Always nil for boost wrappers.
+	PendingApproval          *bool              `bun:",nullzero,notnull,default:false"`                             // If true then status is a reply or boost wrapper that must be approved by the reply-ee or boost-ee before being fully distributed.
+	ApprovedByURI            string             `bun:",nullzero"`                                                   // URI of an Accept Activity that approves this reply or boost.
 }
 
 // GetID implements timeline.Timelineable{}.
@@ -147,6 +152,20 @@ func (s *Status) EmojisPopulated() bool {
 	return true
 }
 
+// EditsPopulated returns whether edits are populated according to current EditIDs.
+func (s *Status) EditsPopulated() bool {
+	if len(s.EditIDs) != len(s.Edits) {
+		// this is the quickest indicator.
+		return false
+	}
+	for i, id := range s.EditIDs {
+		if s.Edits[i].ID != id {
+			return false
+		}
+	}
+	return true
+}
+
 // EmojissUpToDate returns whether status emoji attachments of receiving status are up-to-date
 // according to emoji attachments of the passed status, by comparing their emoji URIs. We don't
 // use IDs as this is used to determine whether there are new emojis to fetch.
@@ -0,0 +1,126 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// TrendType describes the type of
+// entity that a trend is about.
+type TrendType uint8
+
+// Only ever add new trend types to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	TrendTypeUnknown TrendType = iota
+	TrendTypeTag
+	TrendTypeStatus
+	TrendTypeLink
+)
+
+func (t TrendType) String() string {
+	switch t {
+	case TrendTypeTag:
+		return "tag"
+	case TrendTypeStatus:
+		return "status"
+	case TrendTypeLink:
+		return "link"
+	default:
+		return "unknown" //nolint:goconst
+	}
+}
+
+func NewTrendType(in string) TrendType {
+	switch in {
+	case "tag":
+		return TrendTypeTag
+	case "status":
+		return TrendTypeStatus
+	case "link":
+		return TrendTypeLink
+	default:
+		return TrendTypeUnknown
+	}
+}
+
+// TrendReview describes the outcome of
+// an admin reviewing a trend. Only trends
+// approved by an admin are shown to users.
+type TrendReview uint8
+
+// Only ever add new trend reviews to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	TrendReviewUnknown TrendReview = iota
+	TrendReviewPending
+	TrendReviewApproved
+	TrendReviewRejected
+)
+
+func (r TrendReview) String() string {
+	switch r {
+	case TrendReviewPending:
+		return "pending"
+	case TrendReviewApproved:
+		return "approved"
+	case TrendReviewRejected:
+		return "rejected"
+	default:
+		return "unknown" //nolint:goconst
+	}
+}
+
+func NewTrendReview(in string) TrendReview {
+	switch in {
+	case "pending":
+		return TrendReviewPending
+	case "approved":
+		return TrendReviewApproved
+	case "rejected":
+		return TrendReviewRejected
+	default:
+		return TrendReviewUnknown
+	}
+}
+
+// Trend models a hashtag, status or link which is
+// being used or interacted with by many accounts,
+// as periodically aggregated from the database.
+type Trend struct {
+	ID          string      `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt   time.Time   `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Creation time of this item.
+	UpdatedAt   time.Time   `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Last time this trend was aggregated.
+	Type        TrendType   `bun:",nullzero,notnull,unique:trends_type_target_uniq"`            // Type of entity this trend is about.
+	TargetID    string      `bun:",nullzero,notnull,unique:trends_type_target_uniq"`            // ID of the trending tag or status, or URL of the trending link.
+	Tag         *Tag        `bun:"-"`                                                           // Trending tag corresponding to TargetID, if Type is tag.
+	Status      *Status     `bun:"-"`                                                           // Trending status corresponding to TargetID, if Type is status.
+	Score       float64     `bun:",notnull,default:0"`                                          // Score of this trend as of UpdatedAt; zero once it stopped trending.
+	DayUses     []int       `bun:",array"`                                                      // Number of uses of / interactions with the target per day, most recent day first.
+	DayAccounts []int       `bun:",array"`                                                      // Number of distinct accounts using / interacting with the target per day, most recent day first.
+	Review      TrendReview `bun:",nullzero,notnull"`                                           // Admin review of this trend.
+	ReviewedAt  time.Time   `bun:"type:timestamptz,nullzero"`                                   // When was this trend last reviewed by an admin.
+}
+
+// Trendable returns whether this trend is
+// currently trending, and has been approved
+// to be shown to users by an admin.
+func (t *Trend) Trendable() bool {
+	return t.Score > 0 && t.Review == TrendReviewApproved
+}
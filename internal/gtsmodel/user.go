@@ -56,6 +56,17 @@ type User struct {
 	ResetPasswordToken     string       `bun:",nullzero"`                                                   // The generated token that the user can use to reset their password
 	ResetPasswordSentAt    time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did we email the user their reset-password email?
 	ExternalID             string       `bun:",nullzero,unique"`                                            // If the login for the user is managed externally (e.g OIDC), we need to keep a stable reference to the external object (e.g OIDC sub claim)
+	TwoFactorSecret        string       `bun:",nullzero"`                                                   // Base32 encoded TOTP secret, set during enrolment and kept while two-factor authentication is enabled.
+	TwoFactorBackups       []string     `bun:",array"`                                                      // Bcrypt hashes of unused one-time recovery codes.
+	TwoFactorEnabledAt     time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did the user confirm enrolment in two-factor authentication, if at all.
+	TwoFactorLastCounter   int64        `bun:",nullzero"`                                                   // TOTP time step counter of the last accepted authenticator app code, so that codes can't be used twice.
+}
+
+// TwoFactorEnabled returns whether the user has
+// completed enrolment in two-factor authentication,
+// and must therefore provide a code when signing in.
+func (u *User) TwoFactorEnabled() bool {
+	return !u.TwoFactorEnabledAt.IsZero()
 }
 
 // DeniedUser represents one user sign-up that
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// WebPushSubscription represents an access token's
+// subscription to Web Push notifications, delivered
+// to a push service endpoint provided by the client.
+type WebPushSubscription struct {
+	ID        string                    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time                 `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time                 `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID string                    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that owns the subscription
+	TokenID   string                    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // id of the access token the subscription was created with
+	Endpoint  string                    `bun:",nullzero,notnull"`                                           // push service URL to deliver notifications to
+	Auth      string                    `bun:",nullzero,notnull"`                                           // base64url encoded auth secret of the subscription
+	P256dh    string                    `bun:",nullzero,notnull"`                                           // base64url encoded P-256 ECDH public key of the subscription
+	Alerts    WebPushSubscriptionAlerts `bun:",notnull"`                                                    // which notification types to push
+	Policy    WebPushSubscriptionPolicy `bun:",nullzero,notnull,default:'all'"`                             // which accounts to push notifications from
+}
+
+// WebPushSubscriptionAlerts holds flags for which
+// types of notifications should be pushed to a
+// WebPushSubscription.
+type WebPushSubscriptionAlerts struct {
+	Follow        bool `json:"follow"`
+	FollowRequest bool `json:"follow_request"`
+	Favourite     bool `json:"favourite"`
+	Mention       bool `json:"mention"`
+	Reblog        bool `json:"reblog"`
+	Poll          bool `json:"poll"`
+	Status        bool `json:"status"`
+	Update        bool `json:"update"`
+	AdminSignup   bool `json:"admin.sign_up"`
+	PendingFave   bool `json:"pending.favourite"`
+	PendingReply  bool `json:"pending.reply"`
+	PendingReblog bool `json:"pending.reblog"`
+}
+
+// Enabled returns whether notifications of the
+// given type should be pushed according to alerts.
+func (a *WebPushSubscriptionAlerts) Enabled(t NotificationType) bool {
+	switch t {
+	case NotificationFollow:
+		return a.Follow
+	case NotificationFollowRequest:
+		return a.FollowRequest
+	case NotificationFave:
+		return a.Favourite
+	case NotificationMention:
+		return a.Mention
+	case NotificationReblog:
+		return a.Reblog
+	case NotificationPoll:
+		return a.Poll
+	case NotificationStatus:
+		return a.Status
+	case NotificationUpdate:
+		return a.Update
+	case NotificationSignup:
+		return a.AdminSignup
+	case NotificationPendingFave:
+		return a.PendingFave
+	case NotificationPendingReply:
+		return a.PendingReply
+	case NotificationPendingReblog:
+		return a.PendingReblog
+	default:
+		return false
+	}
+}
+
+// WebPushSubscriptionPolicy determines which accounts
+// notifications should be pushed from, based on their
+// relationship with the subscribed account.
+type WebPushSubscriptionPolicy string
+
+const (
+	WebPushSubscriptionPolicyAll      WebPushSubscriptionPolicy = "all"      // push notifications from anyone
+	WebPushSubscriptionPolicyFollowed WebPushSubscriptionPolicy = "followed" // push notifications only from accounts the subscriber follows
+	WebPushSubscriptionPolicyFollower WebPushSubscriptionPolicy = "follower" // push notifications only from accounts following the subscriber
+	WebPushSubscriptionPolicyNone     WebPushSubscriptionPolicy = "none"     // push no notifications
+)
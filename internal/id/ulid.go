@@ -75,3 +75,13 @@ func NewRandomULID() (string, error) {
 	}
 	return newUlid.String(), nil
 }
+
+// TimeFromULID returns the time encoded in the given ULID
+// string, or an error if it's not a valid ULID string.
+func TimeFromULID(id string) (time.Time, error) {
+	parsed, err := ulid.ParseStrict(id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return ulid.Time(parsed.Time()), nil
+}
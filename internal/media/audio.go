@@ -0,0 +1,608 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// audioPlaceholderSize is the width and height of the blank
+// preview image generated for audio without any cover art.
+const audioPlaceholderSize = 512
+
+type gtsAudio struct {
+	cover    *gtsImage // embedded cover art, nil if none
+	duration float32   // in seconds
+	bitrate  uint64
+}
+
+// decodeAudio parses the given audio stream for duration and bitrate metadata,
+// and any embedded cover art to use as a preview image for the attachment.
+func decodeAudio(r io.Reader, contentType string) (*gtsAudio, error) {
+	rs, cleanup, err := getReadSeeker(r)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	// Determine the total file size, useful
+	// for estimating bitrate / duration.
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("error seeking audio: %w", err)
+	}
+
+	var audio *gtsAudio
+
+	switch contentType {
+	case mimeAudioMpeg:
+		audio, err = decodeMP3(rs, size)
+	case mimeAudioOgg:
+		audio, err = decodeOgg(rs, size)
+	case mimeAudioFlac:
+		audio, err = decodeFLAC(rs, size)
+	case mimeAudioWav:
+		audio, err = decodeWAV(rs, size)
+	case mimeAudioM4a:
+		var video *gtsVideo
+		video, err = decodeMP4(rs)
+		if err == nil {
+			audio = &gtsAudio{
+				cover:    video.frame,
+				duration: video.duration,
+				bitrate:  video.bitrate,
+			}
+		}
+	default:
+		err = fmt.Errorf("unsupported audio type %s", contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for empty audio metadata.
+	var empty []string
+	if audio.duration <= 0 {
+		empty = append(empty, "duration")
+	}
+	if audio.bitrate == 0 {
+		empty = append(empty, "bitrate")
+	}
+	if len(empty) > 0 {
+		return nil, fmt.Errorf("error determining audio metadata: %v", empty)
+	}
+
+	return audio, nil
+}
+
+// mp3 bitrates in kbps, indexed by [version][layer][index],
+// where version 0 = MPEG-1, 1 = MPEG-2 / 2.5, and layer
+// 0 = layer I, 1 = layer II, 2 = layer III.
+var mp3Bitrates = [2][3][16]uint64{
+	{
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+	},
+	{
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+	},
+}
+
+// mp3 sample rates in Hz, indexed by [version][index],
+// where version 0 = MPEG-1, 1 = MPEG-2, 2 = MPEG-2.5.
+var mp3SampleRates = [3][3]uint64{
+	{44100, 48000, 32000},
+	{22050, 24000, 16000},
+	{11025, 12000, 8000},
+}
+
+// decodeMP3 parses the given mp3 stream. Duration is taken from a Xing / Info /
+// VBRI header where available, else estimated from the first frame's bitrate.
+func decodeMP3(rs io.ReadSeeker, size int64) (*gtsAudio, error) {
+	var (
+		audio gtsAudio
+		start int64
+	)
+
+	// Skip over any ID3v2 tag at the start
+	// of the file, checking for cover art.
+	hdr, err := readAt(rs, 0, 10)
+	if err != nil {
+		return nil, fmt.Errorf("error reading mp3: %w", err)
+	}
+
+	if string(hdr[:3]) == "ID3" {
+		tagSize := int64(synchsafe(hdr[6:10]))
+		if hdr[5]&0x10 != 0 {
+			// Footer present.
+			tagSize += 10
+		}
+
+		tag, err := readAt(rs, 10, tagSize)
+		if err != nil {
+			return nil, fmt.Errorf("error reading mp3 id3 tag: %w", err)
+		}
+
+		audio.cover = decodeID3Cover(tag, hdr[3])
+		start = 10 + tagSize
+	}
+
+	if start >= size {
+		return nil, errors.New("error reading mp3: no audio frames")
+	}
+
+	// Read enough to find the first frame
+	// sync and its Xing / VBRI header.
+	buf, err := readAt(rs, start, min(size-start, 4096))
+	if err != nil {
+		return nil, fmt.Errorf("error reading mp3 frame: %w", err)
+	}
+
+	// Find first frame sync.
+	i := 0
+	for ; i+4 <= len(buf); i++ {
+		if buf[i] == 0xFF && buf[i+1]&0xE0 == 0xE0 &&
+			buf[i+1]&0x06 != 0 && buf[i+2]&0xF0 != 0xF0 {
+			break
+		}
+	}
+	if i+4 > len(buf) {
+		return nil, errors.New("error reading mp3: no frame sync found")
+	}
+	frame := buf[i:]
+	start += int64(i)
+
+	var (
+		versionBits = (frame[1] >> 3) & 0x03
+		layerBits   = (frame[1] >> 1) & 0x03
+		bitrateIdx  = frame[2] >> 4
+		rateIdx     = (frame[2] >> 2) & 0x03
+		mono        = frame[3]>>6 == 0x03
+	)
+
+	if versionBits == 1 || rateIdx == 3 {
+		return nil, errors.New("error reading mp3: invalid frame header")
+	}
+
+	// Convert to table indices.
+	var version int
+	switch versionBits {
+	case 3: // MPEG-1
+		version = 0
+	case 2: // MPEG-2
+		version = 1
+	default: // MPEG-2.5
+		version = 2
+	}
+	layer := 3 - int(layerBits)
+	bitrate := mp3Bitrates[min(version, 1)][layer][bitrateIdx] * 1000
+	sampleRate := mp3SampleRates[version][rateIdx]
+
+	// Determine samples per frame.
+	var samples uint64
+	switch {
+	case layer == 0:
+		samples = 384
+	case layer == 2 && version != 0:
+		samples = 576
+	default:
+		samples = 1152
+	}
+
+	// Offset of Xing / Info header
+	// depends on side info length.
+	xingOff := 4 + 32
+	switch {
+	case version == 0 && mono:
+		xingOff = 4 + 17
+	case version != 0 && !mono:
+		xingOff = 4 + 17
+	case version != 0 && mono:
+		xingOff = 4 + 9
+	}
+
+	var frames uint64
+	switch {
+	case len(frame) >= xingOff+12 &&
+		(string(frame[xingOff:xingOff+4]) == "Xing" ||
+			string(frame[xingOff:xingOff+4]) == "Info"):
+		if flags := binary.BigEndian.Uint32(frame[xingOff+4:]); flags&0x01 != 0 {
+			frames = uint64(binary.BigEndian.Uint32(frame[xingOff+8:]))
+		}
+
+	case len(frame) >= 4+32+18 && string(frame[4+32:4+36]) == "VBRI":
+		frames = uint64(binary.BigEndian.Uint32(frame[4+32+14:]))
+	}
+
+	audioSize := size - start
+	if frames > 0 && sampleRate > 0 {
+		// Accurate duration from frame count.
+		seconds := float64(frames*samples) / float64(sampleRate)
+		audio.duration = float32(seconds)
+		audio.bitrate = uint64(float64(audioSize*8) / seconds)
+	} else if bitrate > 0 {
+		// Estimate duration assuming constant bitrate.
+		audio.duration = float32(float64(audioSize*8) / float64(bitrate))
+		audio.bitrate = bitrate
+	}
+
+	return &audio, nil
+}
+
+// decodeID3Cover attempts to decode an attached picture
+// frame (APIC) from the given ID3v2.3 / v2.4 tag body,
+// returning nil if none was found or it couldn't be decoded.
+func decodeID3Cover(tag []byte, version byte) *gtsImage {
+	if version != 3 && version != 4 {
+		// Only support
+		// v2.3 and v2.4.
+		return nil
+	}
+
+	for len(tag) >= 10 && tag[0] != 0 {
+		id := string(tag[:4])
+
+		// Frame sizes are synchsafe from v2.4.
+		frameSize := binary.BigEndian.Uint32(tag[4:8])
+		if version == 4 {
+			frameSize = synchsafe(tag[4:8])
+		}
+
+		if uint64(frameSize) > uint64(len(tag)-10) {
+			return nil
+		}
+
+		body := tag[10 : 10+frameSize]
+		tag = tag[10+frameSize:]
+
+		if id != "APIC" || len(body) < 2 {
+			continue
+		}
+
+		// Frame body is: text encoding, null-terminated
+		// mime type, picture type, null-terminated
+		// description in text encoding, picture data.
+		enc := body[0]
+		body = body[1:]
+
+		i := bytes.IndexByte(body, 0)
+		if i < 0 || i+2 > len(body) {
+			continue
+		}
+		body = body[i+2:]
+
+		// UTF-16 encoded descriptions
+		// are double null-terminated.
+		term := []byte{0}
+		if enc == 1 || enc == 2 {
+			term = []byte{0, 0}
+		}
+
+		i = bytes.Index(body, term)
+		for enc == 1 || enc == 2 {
+			// Ensure aligned to 2 bytes.
+			if i < 0 || i%2 == 0 {
+				break
+			}
+			j := bytes.Index(body[i+1:], term)
+			if j < 0 {
+				i = -1
+				break
+			}
+			i += j + 1
+		}
+		if i < 0 {
+			continue
+		}
+
+		img, err := decodeImage(bytes.NewReader(body[i+len(term):]))
+		if err == nil {
+			return img
+		}
+	}
+
+	return nil
+}
+
+// synchsafe decodes a 4-byte ID3 synchsafe integer,
+// where the top bit of each byte is always unset.
+func synchsafe(b []byte) uint32 {
+	return uint32(b[0]&0x7F)<<21 |
+		uint32(b[1]&0x7F)<<14 |
+		uint32(b[2]&0x7F)<<7 |
+		uint32(b[3]&0x7F)
+}
+
+// decodeOgg parses the given ogg stream, supporting Vorbis and Opus codecs.
+// Duration is calculated from the granule position of the last ogg page.
+func decodeOgg(rs io.ReadSeeker, size int64) (*gtsAudio, error) {
+	// Read first page header and some
+	// of its payload (the codec header).
+	buf, err := readAt(rs, 0, min(size, 27+255+32))
+	if err != nil {
+		return nil, fmt.Errorf("error reading ogg: %w", err)
+	}
+
+	if len(buf) < 27 || string(buf[:4]) != "OggS" ||
+		len(buf) < 27+int(buf[26]) {
+		return nil, errors.New("error reading ogg: invalid page header")
+	}
+
+	serial := binary.LittleEndian.Uint32(buf[14:18])
+	payload := buf[27+int(buf[26]):]
+
+	var (
+		sampleRate uint64
+		preSkip    uint64
+	)
+
+	switch {
+	case len(payload) >= 16 && string(payload[:7]) == "\x01vorbis":
+		sampleRate = uint64(binary.LittleEndian.Uint32(payload[12:16]))
+
+	case len(payload) >= 12 && string(payload[:8]) == "OpusHead":
+		// Opus granule positions are always at 48kHz.
+		sampleRate = 48000
+		preSkip = uint64(binary.LittleEndian.Uint16(payload[10:12]))
+
+	default:
+		return nil, errors.New("error reading ogg: unsupported codec")
+	}
+
+	if sampleRate == 0 {
+		return nil, errors.New("error reading ogg: invalid sample rate")
+	}
+
+	// Read the tail end of the file to find the last
+	// page (max page size is just under 64KiB).
+	tailSize := min(size, 65307)
+	tail, err := readAt(rs, size-tailSize, tailSize)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ogg: %w", err)
+	}
+
+	// Search backwards for the last page
+	// of our stream with a granule position.
+	var granule uint64
+	for i := len(tail) - 27; i >= 0; i-- {
+		if string(tail[i:i+4]) != "OggS" ||
+			binary.LittleEndian.Uint32(tail[i+14:i+18]) != serial {
+			continue
+		}
+
+		g := binary.LittleEndian.Uint64(tail[i+6 : i+14])
+		if g == ^uint64(0) {
+			// No packets
+			// finish here.
+			continue
+		}
+
+		granule = g
+		break
+	}
+
+	if granule <= preSkip {
+		return nil, errors.New("error reading ogg: invalid granule position")
+	}
+
+	seconds := float64(granule-preSkip) / float64(sampleRate)
+
+	return &gtsAudio{
+		duration: float32(seconds),
+		bitrate:  uint64(float64(size*8) / seconds),
+	}, nil
+}
+
+// decodeFLAC parses the given flac stream, using the
+// STREAMINFO metadata block for duration, and any
+// PICTURE metadata block as cover art.
+func decodeFLAC(rs io.ReadSeeker, size int64) (*gtsAudio, error) {
+	hdr, err := readAt(rs, 0, 4)
+	if err != nil {
+		return nil, fmt.Errorf("error reading flac: %w", err)
+	}
+
+	if string(hdr) != "fLaC" {
+		return nil, errors.New("error reading flac: invalid header")
+	}
+
+	var (
+		audio   gtsAudio
+		seconds float64
+		off     int64 = 4
+	)
+
+	for last := false; !last; {
+		// Read metadata block header: last flag,
+		// 7-bit block type and 24-bit length.
+		hdr, err := readAt(rs, off, 4)
+		if err != nil {
+			return nil, fmt.Errorf("error reading flac metadata: %w", err)
+		}
+
+		last = hdr[0]&0x80 != 0
+		blockType := hdr[0] & 0x7F
+		length := int64(hdr[1])<<16 | int64(hdr[2])<<8 | int64(hdr[3])
+		off += 4
+
+		switch blockType {
+
+		// STREAMINFO
+		case 0:
+			block, err := readAt(rs, off, length)
+			if err != nil || len(block) < 18 {
+				return nil, errors.New("error reading flac streaminfo")
+			}
+
+			// 20 bits sample rate, 3 bits channels,
+			// 5 bits bits-per-sample, 36 bits samples.
+			v := binary.BigEndian.Uint64(block[10:18])
+			sampleRate := v >> 44
+			samples := v & (1<<36 - 1)
+
+			if sampleRate > 0 {
+				seconds = float64(samples) / float64(sampleRate)
+			}
+
+		// PICTURE
+		case 6:
+			if audio.cover != nil {
+				break
+			}
+
+			block, err := readAt(rs, off, length)
+			if err != nil {
+				return nil, fmt.Errorf("error reading flac picture: %w", err)
+			}
+
+			audio.cover = decodeFLACPicture(block)
+		}
+
+		off += length
+	}
+
+	if seconds > 0 {
+		audio.duration = float32(seconds)
+		audio.bitrate = uint64(float64(size*8) / seconds)
+	}
+
+	return &audio, nil
+}
+
+// decodeFLACPicture decodes image data from the given
+// flac PICTURE metadata block, returning nil on error.
+func decodeFLACPicture(block []byte) *gtsImage {
+	// Skip the picture type.
+	b := block[min(4, len(block)):]
+
+	// Skip length-prefixed mime
+	// type and description.
+	for i := 0; i < 2; i++ {
+		if len(b) < 4 {
+			return nil
+		}
+		n := uint64(binary.BigEndian.Uint32(b))
+		if n > uint64(len(b)-4) {
+			return nil
+		}
+		b = b[4+n:]
+	}
+
+	// Skip width, height, color
+	// depth and number of colors.
+	if len(b) < 20 {
+		return nil
+	}
+	b = b[16:]
+
+	n := uint64(binary.BigEndian.Uint32(b))
+	if n > uint64(len(b)-4) {
+		return nil
+	}
+
+	img, err := decodeImage(bytes.NewReader(b[4 : 4+n]))
+	if err != nil {
+		return nil
+	}
+
+	return img
+}
+
+// decodeWAV parses the given wav stream, calculating
+// duration from the fmt chunk byte rate and data size.
+func decodeWAV(rs io.ReadSeeker, size int64) (*gtsAudio, error) {
+	hdr, err := readAt(rs, 0, 12)
+	if err != nil {
+		return nil, fmt.Errorf("error reading wav: %w", err)
+	}
+
+	if string(hdr[:4]) != "RIFF" || string(hdr[8:12]) != "WAVE" {
+		return nil, errors.New("error reading wav: invalid header")
+	}
+
+	var (
+		byteRate uint64
+		dataSize uint64
+		off      int64 = 12
+	)
+
+	for off+8 <= size && (byteRate == 0 || dataSize == 0) {
+		chunk, err := readAt(rs, off, 8)
+		if err != nil {
+			return nil, fmt.Errorf("error reading wav chunk: %w", err)
+		}
+
+		id := string(chunk[:4])
+		length := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+		off += 8
+
+		switch id {
+		case "fmt ":
+			if length < 12 {
+				return nil, errors.New("error reading wav: invalid fmt chunk")
+			}
+
+			fmtChunk, err := readAt(rs, off, 12)
+			if err != nil {
+				return nil, fmt.Errorf("error reading wav fmt chunk: %w", err)
+			}
+
+			byteRate = uint64(binary.LittleEndian.Uint32(fmtChunk[8:12]))
+
+		case "data":
+			// Data size may be misreported
+			// (e.g. streamed recordings).
+			dataSize = uint64(min(length, size-off))
+		}
+
+		// Chunks are padded
+		// to even sizes.
+		off += length + length%2
+	}
+
+	if byteRate == 0 {
+		return nil, errors.New("error reading wav: missing fmt chunk")
+	}
+
+	return &gtsAudio{
+		duration: float32(float64(dataSize) / float64(byteRate)),
+		bitrate:  byteRate * 8,
+	}, nil
+}
+
+// readAt reads n bytes from the stream at the given offset.
+func readAt(rs io.ReadSeeker, off int64, n int64) ([]byte, error) {
+	if _, err := rs.Seek(off, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rs, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
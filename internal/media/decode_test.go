@@ -0,0 +1,268 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DecodeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DecodeTestSuite) TestDecodeMP4() {
+	f, err := os.Open("./test/test-mp4-original.mp4")
+	suite.NoError(err)
+	defer f.Close()
+
+	video, err := decodeVideoFrame(f, mimeVideoMp4)
+	suite.NoError(err)
+	suite.False(video.audioOnly())
+	suite.Equal(338, video.width)
+	suite.Equal(240, video.height)
+	suite.EqualValues(float32(6.640907), video.duration)
+	suite.EqualValues(float32(29.000029), video.framerate)
+	suite.EqualValues(0x59e74, video.bitrate)
+	suite.Equal(338, int(video.frame.Width()))
+	suite.Equal(240, int(video.frame.Height()))
+}
+
+func (suite *DecodeTestSuite) TestDecodeWebmVP9() {
+	b := testWebm(1, "V_VP9")
+
+	video, err := decodeVideoFrame(bytes.NewReader(b), mimeVideoWebm)
+	suite.NoError(err)
+	suite.False(video.audioOnly())
+	suite.Equal(320, video.width)
+	suite.Equal(240, video.height)
+	suite.EqualValues(2, video.duration)
+	suite.InDelta(30, video.framerate, 0.01)
+	suite.EqualValues(len(b)*8/2, video.bitrate)
+
+	// VP9 frame can't be decoded,
+	// so a placeholder is used.
+	suite.Equal(320, int(video.frame.Width()))
+	suite.Equal(240, int(video.frame.Height()))
+}
+
+func (suite *DecodeTestSuite) TestDecodeWebmAudioOnly() {
+	b := testWebm(2, "A_OPUS")
+
+	video, err := decodeVideoFrame(bytes.NewReader(b), mimeVideoWebm)
+	suite.NoError(err)
+	suite.True(video.audioOnly())
+	suite.Nil(video.frame)
+	suite.EqualValues(2, video.duration)
+	suite.EqualValues(len(b)*8/2, video.bitrate)
+}
+
+func (suite *DecodeTestSuite) TestDecodeWebmInvalid() {
+	_, err := decodeVideoFrame(bytes.NewReader([]byte("not a webm file")), mimeVideoWebm)
+	suite.Error(err)
+}
+
+func (suite *DecodeTestSuite) TestDecodeMP3CBR() {
+	// MPEG-1 layer III, 128kbps, 44.1kHz, stereo.
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x44})
+	b := bytes.Repeat(frame, 10)
+
+	audio, err := decodeAudio(bytes.NewReader(b), mimeAudioMpeg)
+	suite.NoError(err)
+	suite.Nil(audio.cover)
+	suite.EqualValues(128000, audio.bitrate)
+	suite.InDelta(0.2606, audio.duration, 0.001)
+}
+
+func (suite *DecodeTestSuite) TestDecodeMP3Xing() {
+	// ID3v2.4 tag with no frames.
+	b := []byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 10}
+	b = append(b, make([]byte, 10)...)
+
+	// MPEG-1 layer III, 128kbps, 44.1kHz, joint
+	// stereo, with Xing header claiming 100 frames.
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x44})
+	copy(frame[36:], "Xing")
+	binary.BigEndian.PutUint32(frame[40:], 0x01)
+	binary.BigEndian.PutUint32(frame[44:], 100)
+	b = append(b, frame...)
+
+	audio, err := decodeAudio(bytes.NewReader(b), mimeAudioMpeg)
+	suite.NoError(err)
+	suite.InDelta(2.6122, audio.duration, 0.001)
+	suite.EqualValues(1277, audio.bitrate)
+}
+
+func (suite *DecodeTestSuite) TestDecodeOggOpus() {
+	head := []byte("OpusHead")
+	head = append(head, 1, 2)
+	head = binary.LittleEndian.AppendUint16(head, 312)
+	head = binary.LittleEndian.AppendUint32(head, 48000)
+	head = append(head, 0, 0, 0)
+
+	b := testOggPage(1234, 0, head)
+	b = append(b, testOggPage(1234, 3*48000+312, make([]byte, 100))...)
+
+	audio, err := decodeAudio(bytes.NewReader(b), mimeAudioOgg)
+	suite.NoError(err)
+	suite.EqualValues(3, audio.duration)
+	suite.EqualValues(len(b)*8/3, audio.bitrate)
+}
+
+func (suite *DecodeTestSuite) TestDecodeOggVorbis() {
+	head := []byte("\x01vorbis")
+	head = binary.LittleEndian.AppendUint32(head, 0)
+	head = append(head, 2)
+	head = binary.LittleEndian.AppendUint32(head, 44100)
+	head = append(head, make([]byte, 15)...)
+
+	b := testOggPage(1, 0, head)
+	b = append(b, testOggPage(1, 44100*5, make([]byte, 100))...)
+
+	audio, err := decodeAudio(bytes.NewReader(b), mimeAudioOgg)
+	suite.NoError(err)
+	suite.EqualValues(5, audio.duration)
+}
+
+func (suite *DecodeTestSuite) TestDecodeFLAC() {
+	streamInfo := make([]byte, 34)
+	binary.BigEndian.PutUint64(streamInfo[10:], 44100<<44|2<<41|15<<36|441000)
+
+	b := []byte("fLaC")
+	b = append(b, 0x80, 0, 0, 34)
+	b = append(b, streamInfo...)
+	b = append(b, make([]byte, 1000)...)
+
+	audio, err := decodeAudio(bytes.NewReader(b), mimeAudioFlac)
+	suite.NoError(err)
+	suite.EqualValues(10, audio.duration)
+	suite.EqualValues(len(b)*8/10, audio.bitrate)
+}
+
+func (suite *DecodeTestSuite) TestDecodeWAV() {
+	fmtChunk := make([]byte, 16)
+	binary.LittleEndian.PutUint16(fmtChunk[0:], 1)     // PCM
+	binary.LittleEndian.PutUint16(fmtChunk[2:], 1)     // channels
+	binary.LittleEndian.PutUint32(fmtChunk[4:], 8000)  // sample rate
+	binary.LittleEndian.PutUint32(fmtChunk[8:], 16000) // byte rate
+	binary.LittleEndian.PutUint16(fmtChunk[12:], 2)    // block align
+	binary.LittleEndian.PutUint16(fmtChunk[14:], 16)   // bits per sample
+
+	b := []byte("RIFF\x00\x00\x00\x00WAVE")
+	b = append(b, "fmt \x10\x00\x00\x00"...)
+	b = append(b, fmtChunk...)
+	b = append(b, "data"...)
+	b = binary.LittleEndian.AppendUint32(b, 32000)
+	b = append(b, make([]byte, 32000)...)
+
+	audio, err := decodeAudio(bytes.NewReader(b), mimeAudioWav)
+	suite.NoError(err)
+	suite.EqualValues(2, audio.duration)
+	suite.EqualValues(128000, audio.bitrate)
+}
+
+func (suite *DecodeTestSuite) TestDecodeAudioInvalid() {
+	for _, mime := range []string{
+		mimeAudioMpeg,
+		mimeAudioOgg,
+		mimeAudioFlac,
+		mimeAudioWav,
+		mimeAudioM4a,
+	} {
+		_, err := decodeAudio(bytes.NewReader([]byte("not an audio file")), mime)
+		suite.Error(err, mime)
+	}
+}
+
+// testWebm returns a minimal WebM file with a single
+// track of given type and codec, 2 seconds in length.
+func testWebm(trackType byte, codec string) []byte {
+	duration := make([]byte, 8)
+	binary.BigEndian.PutUint64(duration, math.Float64bits(2000))
+
+	track := [][]byte{
+		testEBML(ebmlIDTrackNumber, []byte{1}),
+		testEBML(ebmlIDTrackType, []byte{trackType}),
+		testEBML(ebmlIDCodecID, []byte(codec)),
+	}
+	if trackType == webmTrackTypeVideo {
+		track = append(track,
+			testEBML(ebmlIDDefaultDuration, []byte{0x01, 0xFC, 0xA0, 0x55}), // 33333333ns
+			testEBML(ebmlIDVideo, bytes.Join([][]byte{
+				testEBML(ebmlIDPixelWidth, []byte{0x01, 0x40}),
+				testEBML(ebmlIDPixelHeight, []byte{0xF0}),
+			}, nil)),
+		)
+	}
+
+	segment := bytes.Join([][]byte{
+		testEBML(ebmlIDInfo, bytes.Join([][]byte{
+			testEBML(ebmlIDTimecodeScale, []byte{0x0F, 0x42, 0x40}),
+			testEBML(ebmlIDDuration, duration),
+		}, nil)),
+		testEBML(ebmlIDTracks, testEBML(ebmlIDTrackEntry, bytes.Join(track, nil))),
+		testEBML(ebmlIDCluster, bytes.Join([][]byte{
+			testEBML(ebmlIDTimecode, []byte{0}),
+			testEBML(ebmlIDSimpleBlock, append([]byte{0x81, 0, 0, 0x80}, make([]byte, 64)...)),
+		}, nil)),
+	}, nil)
+
+	return append(
+		testEBML(ebmlIDHeader, testEBML(0x4282, []byte("webm"))),
+		testEBML(ebmlIDSegment, segment)...,
+	)
+}
+
+// testEBML encodes an EBML element with given ID and data.
+func testEBML(id uint32, data []byte) []byte {
+	var b []byte
+	for shift := 24; shift >= 0; shift -= 8 {
+		if v := byte(id >> shift); v != 0 || len(b) > 0 {
+			b = append(b, v)
+		}
+	}
+
+	// Always use an 8 byte size vint.
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(data)))
+	size[0] = 0x01
+	b = append(b, size...)
+
+	return append(b, data...)
+}
+
+// testOggPage encodes a single-segment ogg page.
+func testOggPage(serial uint32, granule uint64, payload []byte) []byte {
+	b := []byte("OggS\x00\x00")
+	b = binary.LittleEndian.AppendUint64(b, granule)
+	b = binary.LittleEndian.AppendUint32(b, serial)
+	b = append(b, make([]byte, 8)...) // sequence + crc
+	b = append(b, 1, byte(len(payload)))
+	return append(b, payload...)
+}
+
+func TestDecodeTestSuite(t *testing.T) {
+	suite.Run(t, &DecodeTestSuite{})
+}
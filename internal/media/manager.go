@@ -39,6 +39,12 @@ var SupportedMIMETypes = []string{
 	mimeImagePng,
 	mimeImageWebp,
 	mimeVideoMp4,
+	mimeVideoWebm,
+	mimeAudioMpeg,
+	mimeAudioOgg,
+	mimeAudioM4a,
+	mimeAudioFlac,
+	mimeAudioWav,
 }
 
 var SupportedEmojiMIMETypes = []string{
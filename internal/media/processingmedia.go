@@ -256,7 +256,10 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	store := true
 
 	switch info.Extension {
-	case "mp4":
+	case "mp4", "webm":
+		// No problem.
+
+	case "mp3", "ogg", "m4a", "flac", "wav":
 		// No problem.
 
 	case "gif":
@@ -424,17 +427,32 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		// we know for sure we can decode it.
 		p.media.Type = gtsmodel.FileTypeImage
 
-	// .mp4 video type
-	case mimeVideoMp4:
-		video, err := decodeVideoFrame(rc)
+	// .mp4, .webm video type
+	case mimeVideoMp4, mimeVideoWebm:
+		video, err := decodeVideoFrame(rc, p.media.File.ContentType)
 		if err != nil {
 			return gtserror.Newf("error decoding video: %w", err)
 		}
 
+		if video.audioOnly() {
+			// No video track in container,
+			// treat it as any other audio.
+			fullImg = p.setAudioMeta(&gtsAudio{
+				cover:    video.frame,
+				duration: video.duration,
+				bitrate:  video.bitrate,
+			})
+			break
+		}
+
 		// Set video frame as image.
 		fullImg = video.frame
 
 		// Set video metadata in attachment info.
+		p.media.FileMeta.Original.Width = video.width
+		p.media.FileMeta.Original.Height = video.height
+		p.media.FileMeta.Original.Size = video.width * video.height
+		p.media.FileMeta.Original.Aspect = float32(video.width) / float32(video.height)
 		p.media.FileMeta.Original.Duration = &video.duration
 		p.media.FileMeta.Original.Framerate = &video.framerate
 		p.media.FileMeta.Original.Bitrate = &video.bitrate
@@ -442,6 +460,15 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		// Mark as no longer unknown type now
 		// we know for sure we can decode it.
 		p.media.Type = gtsmodel.FileTypeVideo
+
+	// .mp3, .ogg, .m4a, .flac, .wav audio type
+	case mimeAudioMpeg, mimeAudioOgg, mimeAudioM4a, mimeAudioFlac, mimeAudioWav:
+		audio, err := decodeAudio(rc, p.media.File.ContentType)
+		if err != nil {
+			return gtserror.Newf("error decoding audio: %w", err)
+		}
+
+		fullImg = p.setAudioMeta(audio)
 	}
 
 	// fullImg should be in-memory by
@@ -450,11 +477,13 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		return gtserror.Newf("error closing file: %w", err)
 	}
 
-	// Set full-size dimensions in attachment info.
-	p.media.FileMeta.Original.Width = int(fullImg.Width())
-	p.media.FileMeta.Original.Height = int(fullImg.Height())
-	p.media.FileMeta.Original.Size = int(fullImg.Size())
-	p.media.FileMeta.Original.Aspect = fullImg.AspectRatio()
+	if p.media.Type == gtsmodel.FileTypeImage {
+		// Set full-size dimensions in attachment info.
+		p.media.FileMeta.Original.Width = int(fullImg.Width())
+		p.media.FileMeta.Original.Height = int(fullImg.Height())
+		p.media.FileMeta.Original.Size = int(fullImg.Size())
+		p.media.FileMeta.Original.Aspect = fullImg.AspectRatio()
+	}
 
 	// Get smaller thumbnail image
 	thumbImg := fullImg.Thumbnail()
@@ -516,3 +545,23 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 
 	return nil
 }
+
+// setAudioMeta sets decoded audio metadata in attachment info, marking
+// it as audio, and returns the cover art to use as preview image.
+func (p *ProcessingMedia) setAudioMeta(audio *gtsAudio) *gtsImage {
+	// Audio has no dimensions of its own,
+	// only duration and bitrate are relevant.
+	p.media.FileMeta.Original.Duration = &audio.duration
+	p.media.FileMeta.Original.Bitrate = &audio.bitrate
+
+	// Mark as no longer unknown type now
+	// we know for sure we can decode it.
+	p.media.Type = gtsmodel.FileTypeAudio
+
+	if audio.cover == nil {
+		// No cover art, create new blank preview image.
+		return blankImage(audioPlaceholderSize, audioPlaceholderSize)
+	}
+
+	return audio.cover
+}
@@ -27,6 +27,7 @@ import (
 const (
 	mimeImage = "image"
 	mimeVideo = "video"
+	mimeAudio = "audio"
 
 	mimeJpeg      = "jpeg"
 	mimeImageJpeg = mimeImage + "/" + mimeJpeg
@@ -42,6 +43,24 @@ const (
 
 	mimeMp4      = "mp4"
 	mimeVideoMp4 = mimeVideo + "/" + mimeMp4
+
+	mimeWebm      = "webm"
+	mimeVideoWebm = mimeVideo + "/" + mimeWebm
+
+	mimeMpeg      = "mpeg"
+	mimeAudioMpeg = mimeAudio + "/" + mimeMpeg
+
+	mimeOgg      = "ogg"
+	mimeAudioOgg = mimeAudio + "/" + mimeOgg
+
+	mimeM4a      = "m4a"
+	mimeAudioM4a = mimeAudio + "/" + mimeM4a
+
+	mimeFlac      = "x-flac"
+	mimeAudioFlac = mimeAudio + "/" + mimeFlac
+
+	mimeWav      = "x-wav"
+	mimeAudioWav = mimeAudio + "/" + mimeWav
 )
 
 type Size string
@@ -17,6 +17,14 @@
 
 package media
 
+import (
+	"fmt"
+	"io"
+
+	"github.com/superseriousbusiness/gotosocial/internal/iotools"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
 // newHdrBuf returns a buffer of suitable size to
 // read bytes from a file header or magic number.
 //
@@ -40,3 +48,27 @@ func newHdrBuf(fileSize int) []byte {
 
 	return make([]byte, bufSize)
 }
+
+// getReadSeeker returns the given stream as an io.ReadSeeker,
+// storing it to a temporary location first if it doesn't
+// already support seeking (usually it will be an *os.File).
+// The returned cleanup function must be called when done.
+func getReadSeeker(r io.Reader) (io.ReadSeeker, func(), error) {
+	if rs, ok := r.(io.ReadSeeker); ok {
+		return rs, func() {}, nil
+	}
+
+	// Store stream to temporary location
+	// in order that we can get seek-reads.
+	rsc, err := iotools.TempFileSeeker(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating temp file seeker: %w", err)
+	}
+
+	return rsc, func() {
+		// Ensure temp. read seeker closed.
+		if err := rsc.Close(); err != nil {
+			log.Errorf(nil, "error closing temp file seeker: %s", err)
+		}
+	}, nil
+}
@@ -18,62 +18,109 @@
 package media
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 
 	"github.com/abema/go-mp4"
-	"github.com/superseriousbusiness/gotosocial/internal/iotools"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 type gtsVideo struct {
-	frame     *gtsImage
-	duration  float32 // in seconds
+	frame     *gtsImage // representative frame, nil if audio-only without cover art
+	width     int       // 0 if container has no video track
+	height    int       // 0 if container has no video track
+	duration  float32   // in seconds
 	bitrate   uint64
 	framerate float32
 }
 
-// decodeVideoFrame decodes and returns an image from a single frame in the given video stream.
-// (note: currently this only returns a blank image resized to fit video dimensions).
-func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
-	// Check if video stream supports
-	// seeking, usually when *os.File.
-	rsc, ok := r.(io.ReadSeekCloser)
-	if !ok {
-		var err error
-
-		// Store stream to temporary location
-		// in order that we can get seek-reads.
-		rsc, err = iotools.TempFileSeeker(r)
-		if err != nil {
-			return nil, fmt.Errorf("error creating temp file seeker: %w", err)
-		}
+// audioOnly returns whether the decoded container
+// held only audio tracks, and no video at all.
+func (v *gtsVideo) audioOnly() bool {
+	return v.width == 0 && v.height == 0
+}
 
-		defer func() {
-			// Ensure temp. read seeker closed.
-			if err := rsc.Close(); err != nil {
-				log.Errorf(nil, "error closing temp file seeker: %s", err)
-			}
-		}()
+// decodeVideoFrame returns metadata about the given video stream, and an image
+// to represent it. Only VP8 WebM frames can be decoded, in which case the image
+// is the first keyframe. For all other codecs, including H.264 MP4 and VP9 / AV1
+// WebM, any embedded cover art is used instead, falling back to a blank image
+// resized to fit video dimensions.
+func decodeVideoFrame(r io.Reader, contentType string) (*gtsVideo, error) {
+	rs, cleanup, err := getReadSeeker(r)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	var video *gtsVideo
+
+	switch contentType {
+	case mimeVideoWebm:
+		video, err = decodeWebm(rs)
+	default:
+		video, err = decodeMP4(rs)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if video.audioOnly() {
+		// Nothing else to
+		// check for audio.
+		return video, nil
+	}
+
+	// Check for empty video metadata.
+	var empty []string
+	if video.duration == 0 {
+		empty = append(empty, "duration")
+	}
+	if video.framerate == 0 {
+		empty = append(empty, "framerate")
+	}
+	if video.bitrate == 0 {
+		empty = append(empty, "bitrate")
 	}
+	if len(empty) > 0 {
+		return nil, fmt.Errorf("error determining video metadata: %v", empty)
+	}
+
+	if video.frame == nil {
+		// Couldn't decode a frame, create new empty "frame" image.
+		video.frame = blankImage(video.width, video.height)
+	}
+
+	return video, nil
+}
 
+// decodeMP4 probes the given mp4 stream for video metadata, using any
+// embedded cover art as the frame, since frames of mp4 video tracks
+// (e.g. H.264) aren't decoded. If the stream contains only audio
+// tracks, the returned video will have zero width and height.
+func decodeMP4(rs io.ReadSeeker) (*gtsVideo, error) {
 	// probe the video file to extract useful metadata from it; for methodology, see:
 	// https://github.com/abema/go-mp4/blob/7d8e5a7c5e644e0394261b0cf72fef79ce246d31/mp4tool/probe/probe.go#L85-L154
-	info, err := mp4.Probe(rsc)
+	info, err := mp4.Probe(rs)
 	if err != nil {
 		return nil, fmt.Errorf("error during mp4 probe: %w", err)
 	}
 
 	var (
-		width        int
-		height       int
 		videoBitrate uint64
 		audioBitrate uint64
+		audioOnly    = true
 		video        gtsVideo
 	)
 
 	for _, tr := range info.Tracks {
 		if tr.AVC == nil {
+			if tr.Codec != mp4.CodecMP4A {
+				// unknown track, probably
+				// video we can't parse.
+				audioOnly = false
+			}
+
 			// audio track
 			if br := tr.Samples.GetBitrate(tr.Timescale); br > audioBitrate {
 				audioBitrate = br
@@ -88,12 +135,14 @@ func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
 		}
 
 		// video track
-		if w := int(tr.AVC.Width); w > width {
-			width = w
+		audioOnly = false
+
+		if w := int(tr.AVC.Width); w > video.width {
+			video.width = w
 		}
 
-		if h := int(tr.AVC.Height); h > height {
-			height = h
+		if h := int(tr.AVC.Height); h > video.height {
+			video.height = h
 		}
 
 		if br := tr.Samples.GetBitrate(tr.Timescale); br > videoBitrate {
@@ -112,30 +161,57 @@ func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
 	// (since they're both playing at the same time)
 	video.bitrate = audioBitrate + videoBitrate
 
-	// Check for empty video metadata.
-	var empty []string
-	if width == 0 {
-		empty = append(empty, "width")
-	}
-	if height == 0 {
-		empty = append(empty, "height")
-	}
-	if video.duration == 0 {
-		empty = append(empty, "duration")
-	}
-	if video.framerate == 0 {
-		empty = append(empty, "framerate")
-	}
-	if video.bitrate == 0 {
-		empty = append(empty, "bitrate")
-	}
-	if len(empty) > 0 {
+	if !audioOnly && (video.width == 0 || video.height == 0) {
+		// Only error for video, audio-only
+		// files don't have any dimensions.
+		var empty []string
+		if video.width == 0 {
+			empty = append(empty, "width")
+		}
+		if video.height == 0 {
+			empty = append(empty, "height")
+		}
 		return nil, fmt.Errorf("error determining video metadata: %v", empty)
 	}
 
-	// Create new empty "frame" image.
-	// TODO: decode frame from video file.
-	video.frame = blankImage(width, height)
+	// We can't decode H.264 frames, but check
+	// for embedded cover art we can use instead.
+	video.frame = decodeMP4CoverArt(rs)
 
 	return &video, nil
 }
+
+// decodeMP4CoverArt attempts to decode cover art embedded in
+// the iTunes-style metadata of given mp4 stream, returning
+// nil if there is none or it could not be decoded.
+func decodeMP4CoverArt(rs io.ReadSeeker) *gtsImage {
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return nil
+	}
+
+	boxes, err := mp4.ExtractBoxWithPayload(rs, nil, mp4.BoxPath{
+		mp4.BoxTypeMoov(),
+		mp4.BoxTypeUdta(),
+		mp4.BoxTypeMeta(),
+		mp4.BoxTypeIlst(),
+		mp4.StrToBoxType("covr"),
+		mp4.BoxTypeData(),
+	})
+	if err != nil {
+		return nil
+	}
+
+	for _, box := range boxes {
+		data, ok := box.Payload.(*mp4.Data)
+		if !ok {
+			continue
+		}
+
+		img, err := decodeImage(bytes.NewReader(data.Data))
+		if err == nil {
+			return img
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,490 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/image/vp8"
+)
+
+// EBML element IDs used when parsing WebM files; see:
+// https://www.matroska.org/technical/elements.html
+const (
+	ebmlIDHeader          = 0x1A45DFA3
+	ebmlIDSegment         = 0x18538067
+	ebmlIDInfo            = 0x1549A966
+	ebmlIDTimecodeScale   = 0x2AD7B1
+	ebmlIDDuration        = 0x4489
+	ebmlIDTracks          = 0x1654AE6B
+	ebmlIDTrackEntry      = 0xAE
+	ebmlIDTrackNumber     = 0xD7
+	ebmlIDTrackType       = 0x83
+	ebmlIDCodecID         = 0x86
+	ebmlIDDefaultDuration = 0x23E383
+	ebmlIDVideo           = 0xE0
+	ebmlIDPixelWidth      = 0xB0
+	ebmlIDPixelHeight     = 0xBA
+	ebmlIDCluster         = 0x1F43B675
+	ebmlIDTimecode        = 0xE7
+	ebmlIDBlockGroup      = 0xA0
+	ebmlIDBlock           = 0xA1
+	ebmlIDSimpleBlock     = 0xA3
+
+	// ebmlUnknownSize indicates a master
+	// element with unknown data size.
+	ebmlUnknownSize = -1
+
+	// matroska track types.
+	webmTrackTypeVideo = 1
+	webmTrackTypeAudio = 2
+)
+
+// webmTrack contains the information
+// we care about from a WebM TrackEntry.
+type webmTrack struct {
+	number          uint64
+	trackType       uint64
+	codecID         string
+	defaultDuration uint64 // in nanoseconds
+	width           int
+	height          int
+}
+
+// decodeWebm parses the given WebM stream for video metadata, decoding the first
+// keyframe of VP8 video tracks. Frames of other codecs (e.g. VP9, AV1) are not
+// decoded, in which case the returned video's frame will be nil. If the stream
+// contains no video track, the returned video will have zero width and height.
+func decodeWebm(rs io.ReadSeeker) (*gtsVideo, error) {
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("error seeking webm: %w", err)
+	}
+
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("error seeking webm: %w", err)
+	}
+
+	var (
+		r = &ebmlReader{r: rs}
+
+		// in nanoseconds per timecode tick.
+		timecodeScale uint64 = 1_000_000
+
+		// duration in timecode ticks.
+		duration float64
+
+		tracks  []*webmTrack
+		video   *webmTrack
+		frame   *gtsImage
+		decoded bool // whether frame decode was attempted
+		frames  int
+		cluster int64 // current cluster timecode
+		maxTick int64 // max seen block timecode
+	)
+
+	// done returns whether we've already
+	// gathered everything we need to know.
+	done := func() bool {
+		return decoded &&
+			duration > 0 &&
+			video.defaultDuration > 0
+	}
+
+	for first := true; !(video != nil && done()); first = false {
+		id, dataSize, err := r.readElementHeader()
+		if err != nil {
+			if errors.Is(err, io.EOF) && !first {
+				// End of file.
+				break
+			}
+			return nil, fmt.Errorf("error reading webm element: %w", err)
+		}
+
+		if first && id != ebmlIDHeader {
+			return nil, errors.New("invalid webm: missing ebml header")
+		}
+
+		switch id {
+
+		// Master elements we
+		// descend straight into.
+		case ebmlIDSegment,
+			ebmlIDInfo,
+			ebmlIDTracks,
+			ebmlIDVideo,
+			ebmlIDCluster,
+			ebmlIDBlockGroup:
+			continue
+
+		case ebmlIDTrackEntry:
+			tracks = append(tracks, new(webmTrack))
+			continue
+		}
+
+		if dataSize == ebmlUnknownSize || dataSize > size {
+			return nil, fmt.Errorf("invalid webm: bad size for element %x", id)
+		}
+
+		// Get current track entry, if any.
+		var track *webmTrack
+		if len(tracks) > 0 {
+			track = tracks[len(tracks)-1]
+		}
+
+		switch {
+		case id == ebmlIDTimecodeScale:
+			timecodeScale, err = r.readUint(dataSize)
+
+		case id == ebmlIDDuration:
+			duration, err = r.readFloat(dataSize)
+
+		case id == ebmlIDTimecode:
+			var tc uint64
+			tc, err = r.readUint(dataSize)
+			cluster = int64(tc)
+
+		case track != nil && id == ebmlIDTrackNumber:
+			track.number, err = r.readUint(dataSize)
+
+		case track != nil && id == ebmlIDTrackType:
+			track.trackType, err = r.readUint(dataSize)
+			if err == nil && track.trackType == webmTrackTypeVideo && video == nil {
+				video = track
+			}
+
+		case track != nil && id == ebmlIDCodecID:
+			var b []byte
+			b, err = r.readBytes(dataSize)
+			track.codecID = string(b)
+
+		case track != nil && id == ebmlIDDefaultDuration:
+			track.defaultDuration, err = r.readUint(dataSize)
+
+		case track != nil && id == ebmlIDPixelWidth:
+			var w uint64
+			w, err = r.readUint(dataSize)
+			track.width = int(w)
+
+		case track != nil && id == ebmlIDPixelHeight:
+			var h uint64
+			h, err = r.readUint(dataSize)
+			track.height = int(h)
+
+		case id == ebmlIDSimpleBlock || id == ebmlIDBlock:
+			if video == nil {
+				// Tracks always come before clusters,
+				// so this is an audio-only stream and
+				// we have no need to parse any blocks.
+				return webmAudio(duration, timecodeScale, size)
+			}
+
+			var block webmBlock
+			block, err = r.readBlockHeader(dataSize)
+			if err != nil {
+				break
+			}
+
+			if tick := cluster + int64(block.timecode); tick > maxTick {
+				maxTick = tick
+			}
+
+			if block.track != video.number {
+				// Not interested in non-video blocks.
+				err = r.skip(dataSize - block.headerLen)
+				break
+			}
+
+			frames++
+
+			if decoded || block.lacing != 0 || video.codecID != "V_VP8" {
+				// Only try decode first VP8 frame.
+				err = r.skip(dataSize - block.headerLen)
+				break
+			}
+
+			var data []byte
+			data, err = r.readBytes(dataSize - block.headerLen)
+			if err != nil {
+				break
+			}
+
+			// A keyframe has bit 0 of the frame tag unset.
+			if len(data) == 0 || data[0]&0x01 != 0 {
+				break
+			}
+
+			// Only attempt this once, if it fails
+			// a blank placeholder frame gets used.
+			var decodeErr error
+			frame, decodeErr = decodeVP8Frame(data)
+			if decodeErr != nil {
+				log.Warnf(nil, "error decoding webm frame: %v", decodeErr)
+			}
+			decoded = true
+
+		default:
+			// Not interested in
+			// this element, skip.
+			err = r.skip(dataSize)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading webm element %x: %w", id, err)
+		}
+	}
+
+	if video == nil {
+		// No video track found
+		// (and no blocks either).
+		return webmAudio(duration, timecodeScale, size)
+	}
+
+	if video.width <= 0 || video.height <= 0 {
+		return nil, errors.New("error determining video metadata: [width height]")
+	}
+
+	if duration == 0 {
+		// No duration in segment info, fall back
+		// to the latest seen block timecode.
+		duration = float64(maxTick)
+	}
+
+	var gtsVideo gtsVideo
+	gtsVideo.width = video.width
+	gtsVideo.height = video.height
+	gtsVideo.frame = frame
+
+	// Convert duration in ticks to seconds.
+	seconds := duration * float64(timecodeScale) / 1e9
+	gtsVideo.duration = float32(seconds)
+
+	if seconds > 0 {
+		// Estimate overall bitrate from file size.
+		gtsVideo.bitrate = uint64(float64(size*8) / seconds)
+	}
+
+	switch {
+	case video.defaultDuration > 0:
+		// Default frame duration is in nanoseconds.
+		gtsVideo.framerate = float32(1e9 / float64(video.defaultDuration))
+
+	case seconds > 0:
+		// Estimate from counted frames.
+		gtsVideo.framerate = float32(float64(frames) / seconds)
+	}
+
+	return &gtsVideo, nil
+}
+
+// webmAudio returns a gtsVideo{} for an audio-only WebM stream.
+func webmAudio(duration float64, timecodeScale uint64, size int64) (*gtsVideo, error) {
+	seconds := duration * float64(timecodeScale) / 1e9
+	if seconds <= 0 {
+		return nil, errors.New("error determining audio metadata: [duration]")
+	}
+	return &gtsVideo{
+		duration: float32(seconds),
+		bitrate:  uint64(float64(size*8) / seconds),
+	}, nil
+}
+
+// decodeVP8Frame decodes a single VP8 keyframe.
+func decodeVP8Frame(data []byte) (*gtsImage, error) {
+	dec := vp8.NewDecoder()
+	dec.Init(bytes.NewReader(data), len(data))
+
+	if _, err := dec.DecodeFrameHeader(); err != nil {
+		return nil, fmt.Errorf("error decoding vp8 frame header: %w", err)
+	}
+
+	img, err := dec.DecodeFrame()
+	if err != nil {
+		return nil, fmt.Errorf("error decoding vp8 frame: %w", err)
+	}
+
+	return &gtsImage{image: img}, nil
+}
+
+// webmBlock contains the header
+// fields of a (Simple)Block element.
+type webmBlock struct {
+	track     uint64
+	timecode  int16 // relative to cluster
+	lacing    byte
+	headerLen int64
+}
+
+// ebmlReader provides helpers for reading
+// EBML elements from an io.ReadSeeker.
+type ebmlReader struct {
+	r   io.ReadSeeker
+	buf [8]byte
+}
+
+// readElementHeader reads the next element ID and data size,
+// returning ebmlUnknownSize as size for unknown-sized elements.
+func (r *ebmlReader) readElementHeader() (uint32, int64, error) {
+	id, _, err := r.readVint(4, false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size, n, err := r.readVint(8, true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// All data bits set indicates unknown size.
+	if size == (1<<(7*n))-1 {
+		return uint32(id), ebmlUnknownSize, nil
+	}
+
+	if size > math.MaxInt64 {
+		return 0, 0, fmt.Errorf("invalid element size %d", size)
+	}
+
+	return uint32(id), int64(size), nil
+}
+
+// readVint reads a variable length EBML integer of max length, with
+// marker bit masked out if mask is set (e.g. for sizes, not for IDs).
+func (r *ebmlReader) readVint(max int, mask bool) (uint64, int, error) {
+	if _, err := io.ReadFull(r.r, r.buf[:1]); err != nil {
+		return 0, 0, err
+	}
+
+	// Length is determined by the
+	// number of leading zero bits.
+	n := bits.LeadingZeros8(r.buf[0]) + 1
+	if n > max {
+		return 0, 0, fmt.Errorf("invalid vint %x", r.buf[0])
+	}
+
+	if n > 1 {
+		if _, err := io.ReadFull(r.r, r.buf[1:n]); err != nil {
+			return 0, 0, unexpectedEOF(err)
+		}
+	}
+
+	if mask {
+		r.buf[0] &^= 0x80 >> (n - 1)
+	}
+
+	var v uint64
+	for _, b := range r.buf[:n] {
+		v = v<<8 | uint64(b)
+	}
+
+	return v, n, nil
+}
+
+// readUint reads an unsigned integer element body.
+func (r *ebmlReader) readUint(size int64) (uint64, error) {
+	if size > 8 {
+		return 0, fmt.Errorf("invalid uint size %d", size)
+	}
+
+	if _, err := io.ReadFull(r.r, r.buf[:size]); err != nil {
+		return 0, unexpectedEOF(err)
+	}
+
+	var v uint64
+	for _, b := range r.buf[:size] {
+		v = v<<8 | uint64(b)
+	}
+
+	return v, nil
+}
+
+// readFloat reads a float element body.
+func (r *ebmlReader) readFloat(size int64) (float64, error) {
+	if size != 0 && size != 4 && size != 8 {
+		return 0, fmt.Errorf("invalid float size %d", size)
+	}
+
+	if _, err := io.ReadFull(r.r, r.buf[:size]); err != nil {
+		return 0, unexpectedEOF(err)
+	}
+
+	switch size {
+	case 0:
+		return 0, nil
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(r.buf[:4]))), nil
+	default:
+		return math.Float64frombits(binary.BigEndian.Uint64(r.buf[:8])), nil
+	}
+}
+
+// readBytes reads an element body of given size.
+func (r *ebmlReader) readBytes(size int64) ([]byte, error) {
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return b, nil
+}
+
+// readBlockHeader reads the header of a (Simple)Block element body,
+// i.e. the track number, relative timecode and flags byte.
+func (r *ebmlReader) readBlockHeader(size int64) (webmBlock, error) {
+	var block webmBlock
+
+	track, n, err := r.readVint(8, true)
+	if err != nil {
+		return block, unexpectedEOF(err)
+	}
+
+	if _, err := io.ReadFull(r.r, r.buf[:3]); err != nil {
+		return block, unexpectedEOF(err)
+	}
+
+	block.track = track
+	block.timecode = int16(binary.BigEndian.Uint16(r.buf[:2]))
+	block.lacing = (r.buf[2] >> 1) & 0x03
+	block.headerLen = int64(n) + 3
+
+	if block.headerLen > size {
+		return block, fmt.Errorf("invalid block size %d", size)
+	}
+
+	return block, nil
+}
+
+// skip seeks past the given number of bytes.
+func (r *ebmlReader) skip(size int64) error {
+	_, err := r.r.Seek(size, io.SeekCurrent)
+	return err
+}
+
+// unexpectedEOF converts an io.EOF to io.ErrUnexpectedEOF,
+// for where we've already started reading an element.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -352,8 +352,9 @@ func resolveAPObject(data map[string]interface{}) (interface{}, error) {
 // we then need to wrangle back into the original type. So we also store the type name
 // and use this to determine the appropriate Go structure type to unmarshal into to.
 func resolveGTSModel(typ string, data []byte) (interface{}, error) {
-	if typ == "" && data == nil {
-		// No data given.
+	if typ == "" {
+		// No data given (a nil
+		// model is stored as "null").
 		return nil, nil
 	}
 
@@ -368,6 +369,8 @@ func resolveGTSModel(typ string, data []byte) (interface{}, error) {
 		value = new(gtsmodel.Follow)
 	case reflect.TypeOf((*gtsmodel.FollowRequest)(nil)).String():
 		value = new(gtsmodel.FollowRequest)
+	case reflect.TypeOf((*gtsmodel.Import)(nil)).String():
+		value = new(gtsmodel.Import)
 	case reflect.TypeOf((*gtsmodel.Move)(nil)).String():
 		value = new(gtsmodel.Move)
 	case reflect.TypeOf((*gtsmodel.Poll)(nil)).String():
@@ -18,7 +18,9 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -65,6 +67,9 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 		}
 		c.Set(oauth.SessionAuthorizedToken, ti)
 
+		// note when this token was last used
+		touchToken(ctx, dbConn, ti.GetAccess())
+
 		// check for user-level token
 		if userID := ti.GetUserID(); userID != "" {
 			log.Tracef(ctx, "authenticated user %s with bearer token, scope is %s", userID, ti.GetScope())
@@ -138,3 +143,29 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 		}
 	}
 }
+
+// tokenLastUsedFreq is the granularity at which a
+// token's last used time is kept up-to-date, to save
+// us from doing a database write on every request.
+const tokenLastUsedFreq = 5 * time.Minute
+
+// touchToken updates the last used time of the
+// token with given access string, if necessary.
+func touchToken(ctx context.Context, dbConn db.DB, access string) {
+	token, err := dbConn.GetTokenByAccess(ctx, access)
+	if err != nil {
+		log.Errorf(ctx, "database error looking for token: %s", err)
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(token.LastUsed) < tokenLastUsedFreq {
+		// recent enough
+		return
+	}
+
+	token.LastUsed = now
+	if err := dbConn.UpdateToken(ctx, token, "last_used"); err != nil {
+		log.Errorf(ctx, "database error updating token last used: %s", err)
+	}
+}
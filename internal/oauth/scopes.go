@@ -0,0 +1,221 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth
+
+import "strings"
+
+// Scope represents an OAuth scope, which
+// may be requested by an application,
+// granted to a token, and required by
+// a client API endpoint.
+type Scope string
+
+const (
+	/*
+		Top-level scopes,
+		granting everything
+		under their prefix.
+	*/
+
+	ScopeRead  Scope = "read"
+	ScopeWrite Scope = "write"
+	ScopeAdmin Scope = "admin"
+	ScopePush  Scope = "push"
+
+	// ScopeFollow is a deprecated scope, kept
+	// for compatibility with older clients, that
+	// grants reading + writing follows, blocks, mutes.
+	ScopeFollow Scope = "follow"
+
+	// ScopeUser is a legacy scope, requested by
+	// the settings panel before scopes were checked
+	// per endpoint, kept so that tokens issued with
+	// it still work. It grants the same as read + write.
+	ScopeUser Scope = "user"
+
+	/*
+		Granular read scopes.
+	*/
+
+	ScopeReadAccounts      Scope = "read:accounts"
+	ScopeReadBlocks        Scope = "read:blocks"
+	ScopeReadBookmarks     Scope = "read:bookmarks"
+	ScopeReadCustomEmojis  Scope = "read:custom_emojis"
+	ScopeReadFavourites    Scope = "read:favourites"
+	ScopeReadFilters       Scope = "read:filters"
+	ScopeReadFollows       Scope = "read:follows"
+	ScopeReadLists         Scope = "read:lists"
+	ScopeReadMedia         Scope = "read:media"
+	ScopeReadMutes         Scope = "read:mutes"
+	ScopeReadNotifications Scope = "read:notifications"
+	ScopeReadReports       Scope = "read:reports"
+	ScopeReadSearch        Scope = "read:search"
+	ScopeReadStatuses      Scope = "read:statuses"
+	ScopeReadStreaming     Scope = "read:streaming"
+	ScopeReadUser          Scope = "read:user"
+
+	/*
+		Granular write scopes.
+	*/
+
+	ScopeWriteAccounts      Scope = "write:accounts"
+	ScopeWriteBlocks        Scope = "write:blocks"
+	ScopeWriteBookmarks     Scope = "write:bookmarks"
+	ScopeWriteFavourites    Scope = "write:favourites"
+	ScopeWriteFilters       Scope = "write:filters"
+	ScopeWriteFollows       Scope = "write:follows"
+	ScopeWriteLists         Scope = "write:lists"
+	ScopeWriteMedia         Scope = "write:media"
+	ScopeWriteMutes         Scope = "write:mutes"
+	ScopeWriteNotifications Scope = "write:notifications"
+	ScopeWriteReports       Scope = "write:reports"
+	ScopeWriteStatuses      Scope = "write:statuses"
+	ScopeWriteUser          Scope = "write:user"
+
+	/*
+		Granular admin scopes.
+	*/
+
+	ScopeAdminRead              Scope = "admin:read"
+	ScopeAdminReadAccounts      Scope = "admin:read:accounts"
+	ScopeAdminReadReports       Scope = "admin:read:reports"
+	ScopeAdminReadDomainAllows  Scope = "admin:read:domain_allows"
+	ScopeAdminReadDomainBlocks  Scope = "admin:read:domain_blocks"
+	ScopeAdminReadDomainLimits  Scope = "admin:read:domain_limits"
+	ScopeAdminWrite             Scope = "admin:write"
+	ScopeAdminWriteAccounts     Scope = "admin:write:accounts"
+	ScopeAdminWriteReports      Scope = "admin:write:reports"
+	ScopeAdminWriteDomainAllows Scope = "admin:write:domain_allows"
+	ScopeAdminWriteDomainBlocks Scope = "admin:write:domain_blocks"
+	ScopeAdminWriteDomainLimits Scope = "admin:write:domain_limits"
+)
+
+// knownScopes contains all
+// scopes that we recognize.
+var knownScopes = map[Scope]struct{}{
+	ScopeRead:                   {},
+	ScopeWrite:                  {},
+	ScopeAdmin:                  {},
+	ScopePush:                   {},
+	ScopeFollow:                 {},
+	ScopeUser:                   {},
+	ScopeReadAccounts:           {},
+	ScopeReadBlocks:             {},
+	ScopeReadBookmarks:          {},
+	ScopeReadCustomEmojis:       {},
+	ScopeReadFavourites:         {},
+	ScopeReadFilters:            {},
+	ScopeReadFollows:            {},
+	ScopeReadLists:              {},
+	ScopeReadMedia:              {},
+	ScopeReadMutes:              {},
+	ScopeReadNotifications:      {},
+	ScopeReadReports:            {},
+	ScopeReadSearch:             {},
+	ScopeReadStatuses:           {},
+	ScopeReadStreaming:          {},
+	ScopeReadUser:               {},
+	ScopeWriteAccounts:          {},
+	ScopeWriteBlocks:            {},
+	ScopeWriteBookmarks:         {},
+	ScopeWriteFavourites:        {},
+	ScopeWriteFilters:           {},
+	ScopeWriteFollows:           {},
+	ScopeWriteLists:             {},
+	ScopeWriteMedia:             {},
+	ScopeWriteMutes:             {},
+	ScopeWriteNotifications:     {},
+	ScopeWriteReports:           {},
+	ScopeWriteStatuses:          {},
+	ScopeWriteUser:              {},
+	ScopeAdminRead:              {},
+	ScopeAdminReadAccounts:      {},
+	ScopeAdminReadReports:       {},
+	ScopeAdminReadDomainAllows:  {},
+	ScopeAdminReadDomainBlocks:  {},
+	ScopeAdminReadDomainLimits:  {},
+	ScopeAdminWrite:             {},
+	ScopeAdminWriteAccounts:     {},
+	ScopeAdminWriteReports:      {},
+	ScopeAdminWriteDomainAllows: {},
+	ScopeAdminWriteDomainBlocks: {},
+	ScopeAdminWriteDomainLimits: {},
+}
+
+// followScopes are the scopes
+// granted by deprecated "follow".
+var followScopes = map[Scope]struct{}{
+	ScopeReadFollows:  {},
+	ScopeWriteFollows: {},
+	ScopeReadBlocks:   {},
+	ScopeWriteBlocks:  {},
+	ScopeReadMutes:    {},
+	ScopeWriteMutes:   {},
+}
+
+// Known returns whether this is a scope we recognize.
+func (s Scope) Known() bool {
+	_, ok := knownScopes[s]
+	return ok
+}
+
+// Permits returns whether having this scope grants the wanted
+// scope. A scope grants itself, and any scope nested under it,
+// eg., "read" grants "read:statuses", and "admin" grants both
+// "admin:read" and "admin:read:accounts".
+func (s Scope) Permits(wanted Scope) bool {
+	if s == wanted {
+		return true
+	}
+
+	switch s {
+	case ScopeFollow:
+		_, ok := followScopes[wanted]
+		return ok
+
+	case ScopeUser:
+		return ScopeRead.Permits(wanted) ||
+			ScopeWrite.Permits(wanted)
+	}
+
+	return strings.HasPrefix(string(wanted), string(s)+":")
+}
+
+// ScopesPermit returns whether any of the space-separated
+// scopes in the given scope string (as stored on a token
+// or application) grants the wanted scope.
+func ScopesPermit(scopes string, wanted Scope) bool {
+	for _, s := range strings.Fields(scopes) {
+		if Scope(s).Permits(wanted) {
+			return true
+		}
+	}
+	return false
+}
+
+// KnownScopes returns the given space-separated scopes
+// string with any scopes we don't recognize dropped.
+func KnownScopes(scopes string) string {
+	known := make([]string, 0, strings.Count(scopes, " ")+1)
+	for _, s := range strings.Fields(scopes) {
+		if Scope(s).Known() {
+			known = append(known, s)
+		}
+	}
+	return strings.Join(known, " ")
+}
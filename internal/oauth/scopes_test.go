@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type ScopesTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopesTestSuite) TestScopesPermit() {
+	for _, test := range []struct {
+		scopes string
+		wanted oauth.Scope
+		expect bool
+	}{
+		{"read", oauth.ScopeReadStatuses, true},
+		{"read", oauth.ScopeRead, true},
+		{"read", oauth.ScopeWriteStatuses, false},
+		{"read:accounts", oauth.ScopeReadAccounts, true},
+		{"read:accounts", oauth.ScopeReadStatuses, false},
+		{"read:accounts", oauth.ScopeRead, false},
+		{"read write", oauth.ScopeWriteMedia, true},
+		{"follow", oauth.ScopeReadBlocks, true},
+		{"follow", oauth.ScopeWriteFollows, true},
+		{"follow", oauth.ScopeReadStatuses, false},
+		{"admin", oauth.ScopeAdminReadAccounts, true},
+		{"admin:read", oauth.ScopeAdminReadReports, true},
+		{"admin:read", oauth.ScopeAdminWriteReports, false},
+		{"admin:write:accounts", oauth.ScopeAdminWriteReports, false},
+		{"read write follow push", oauth.ScopeAdminRead, false},
+		{"", oauth.ScopeRead, false},
+		{"user admin", oauth.ScopeReadStatuses, true},
+		{"user admin", oauth.ScopeWriteUser, true},
+		{"user admin", oauth.ScopeAdminWriteAccounts, true},
+		{"user", oauth.ScopeAdminRead, false},
+		{"user", oauth.ScopePush, false},
+	} {
+		suite.Equal(
+			test.expect,
+			oauth.ScopesPermit(test.scopes, test.wanted),
+			"scopes %q wanted %s", test.scopes, test.wanted,
+		)
+	}
+}
+
+func (suite *ScopesTestSuite) TestKnown() {
+	suite.True(oauth.Scope("read:statuses").Known())
+	suite.True(oauth.Scope("admin:write:domain_blocks").Known())
+	suite.True(oauth.Scope("user").Known())
+	suite.False(oauth.Scope("read:nonsense").Known())
+}
+
+func (suite *ScopesTestSuite) TestKnownScopes() {
+	suite.Equal("read write", oauth.KnownScopes("read profile write"))
+	suite.Equal("read:statuses", oauth.KnownScopes("  read:statuses  read:nonsense "))
+	suite.Equal("", oauth.KnownScopes("profile"))
+	suite.Equal("", oauth.KnownScopes(""))
+}
+
+func TestScopesTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopesTestSuite))
+}
@@ -120,8 +120,46 @@ func New(ctx context.Context, database db.DB) Server {
 		if userID == "" {
 			return "", errors.New("userid was empty")
 		}
+
+		// Users with two-factor authentication enabled
+		// must have given a valid code before we hand
+		// out an authorization code on their behalf.
+		user, err := database.GetUserByID(r.Context(), userID)
+		if err != nil {
+			return "", fmt.Errorf("error getting user %s: %w", userID, err)
+		}
+		if user.TwoFactorEnabled() && r.FormValue("two_factor_verified") != "true" {
+			return "", errors.New("two-factor authentication code was not verified")
+		}
+
 		return userID, nil
 	})
+	srv.SetClientScopeHandler(func(tgr *oauth2.TokenGenerateRequest) (bool, error) {
+		// Ignore any scopes we don't recognize,
+		// same as when the app was registered.
+		tgr.Scope = KnownScopes(tgr.Scope)
+
+		if tgr.Scope == "" {
+			// Default to read-only
+			// if no scope requested.
+			tgr.Scope = string(ScopeRead)
+		}
+
+		// Apps may only be granted tokens
+		// within the scopes they registered.
+		app, err := database.GetApplicationByClientID(tgr.Request.Context(), tgr.ClientID)
+		if err != nil {
+			return false, fmt.Errorf("error getting application for client %s: %w", tgr.ClientID, err)
+		}
+
+		for _, scope := range strings.Fields(tgr.Scope) {
+			if !ScopesPermit(app.Scopes, Scope(scope)) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
 	srv.SetClientInfoHandler(server.ClientFormHandler)
 	return &s{
 		server: srv,
@@ -18,6 +18,8 @@
 package account
 
 import (
+	"sync"
+
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -43,6 +45,11 @@ type Processor struct {
 	federator    *federation.Federator
 	parseMention gtsmodel.ParseMentionFunc
 	themes       *Themes
+
+	// IDs of imports currently
+	// being processed, to avoid
+	// processing any one twice.
+	importing *sync.Map
 }
 
 // New returns a new account processor.
@@ -65,5 +72,6 @@ func New(
 		federator:    federator,
 		parseMention: parseMention,
 		themes:       PopulateThemes(),
+		importing:    new(sync.Map),
 	}
 }
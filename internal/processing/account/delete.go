@@ -466,6 +466,75 @@ func (p *Processor) deleteAccountPeripheral(ctx context.Context, account *gtsmod
 		return gtserror.Newf("error deleting poll votes by account: %w", err)
 	}
 
+	// Delete all conversations owned by given account.
+	// Conversations in which it has only participated will be retained;
+	// they can always be deleted by their owners.
+	if err := p.state.DB.DeleteConversationsByOwnerAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting conversations owned by account: %w", err)
+	}
+
+	// Delete all tags featured by given account.
+	if err := p.state.DB.DeleteFeaturedTagsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting featured tags by account: %w", err)
+	}
+
+	// Delete all tags followed by given account.
+	if err := p.state.DB.DeleteFollowedTagsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting followed tags by account: %w", err)
+	}
+
+	// Delete all announcement reactions by given account.
+	if err := p.state.DB.DeleteAnnouncementReactionsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting announcement reactions by account: %w", err)
+	}
+
+	// Delete all announcements read by given account.
+	if err := p.state.DB.DeleteAnnouncementReadsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting announcement reads by account: %w", err)
+	}
+
+	// Delete all statuses scheduled by given account. Any
+	// pending publish jobs will find nothing to publish.
+	if err := p.state.DB.DeleteScheduledStatusesByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting scheduled statuses by account: %w", err)
+	}
+
+	// Delete archives of imports not yet
+	// processed, which are kept in storage.
+	imports, err := p.state.DB.GetImportsForAccount(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting imports by account: %w", err)
+	}
+
+	for _, imp := range imports {
+		if imp.Type != gtsmodel.ImportTypeArchive ||
+			imp.State == gtsmodel.ImportStateFinished {
+			continue
+		}
+
+		if err := p.state.Storage.Delete(ctx, importArchivePath(imp)); err != nil {
+			log.Errorf(ctx, "error deleting import archive: %v", err)
+		}
+	}
+
+	// Delete all imports uploaded by given account.
+	if err := p.state.DB.DeleteImportsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting imports by account: %w", err)
+	}
+
+	// Delete all Web Push subscriptions owned by given account.
+	if err := p.state.DB.DeleteWebPushSubscriptionsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting web push subscriptions by account: %w", err)
+	}
+
 	// Delete account stats model.
 	if err := p.state.DB.DeleteAccountStats(ctx, account.ID); err != nil {
 		return gtserror.Newf("error deleting stats for account: %w", err)
@@ -0,0 +1,214 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/trans"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// ExportFollowing returns the accounts followed by the
+// requesting account as CSV records, in the format of
+// Mastodon's following_accounts.csv, including header.
+func (p *Processor) ExportFollowing(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+) ([][]string, gtserror.WithCode) {
+	follows, err := p.state.DB.GetAccountFollows(ctx, requestingAccount.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting follows: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	records := make([][]string, 0, len(follows)+1)
+	records = append(records, []string{
+		"Account address",
+		"Show boosts",
+		"Notify on new posts",
+		"Languages",
+	})
+
+	for _, follow := range follows {
+		records = append(records, []string{
+			exportAddress(follow.TargetAccount),
+			strconv.FormatBool(util.PtrValueOr(follow.ShowReblogs, true)),
+			strconv.FormatBool(util.PtrValueOr(follow.Notify, false)),
+			"", // We don't filter follows by language.
+		})
+	}
+
+	return records, nil
+}
+
+// ExportBlocks returns the accounts blocked by the
+// requesting account as CSV records, in the format of
+// Mastodon's blocked_accounts.csv, which has no header.
+func (p *Processor) ExportBlocks(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+) ([][]string, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetAccountBlocks(ctx, requestingAccount.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	records := make([][]string, 0, len(blocks))
+	for _, block := range blocks {
+		records = append(records, []string{
+			exportAddress(block.TargetAccount),
+		})
+	}
+
+	return records, nil
+}
+
+// ExportMutes returns the accounts muted by the
+// requesting account as CSV records, in the format of
+// Mastodon's muted_accounts.csv, including header.
+func (p *Processor) ExportMutes(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+) ([][]string, gtserror.WithCode) {
+	mutes, err := p.state.DB.GetAccountMutes(ctx, requestingAccount.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting mutes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	records := make([][]string, 0, len(mutes)+1)
+	records = append(records, []string{
+		"Account address",
+		"Hide notifications",
+	})
+
+	for _, mute := range mutes {
+		if mute.Expired(time.Now()) {
+			// Don't export
+			// expired mutes.
+			continue
+		}
+
+		records = append(records, []string{
+			exportAddress(mute.TargetAccount),
+			strconv.FormatBool(util.PtrValueOr(mute.Notifications, false)),
+		})
+	}
+
+	return records, nil
+}
+
+// ExportLists returns the list memberships of the
+// requesting account as CSV records, in the format of
+// Mastodon's lists.csv, which has no header.
+func (p *Processor) ExportLists(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+) ([][]string, gtserror.WithCode) {
+	lists, err := p.state.DB.GetListsForAccountID(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting lists: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var records [][]string
+	for _, list := range lists {
+		entries, err := p.state.DB.GetListEntries(ctx, list.ID, "", "", "", 0)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err = gtserror.Newf("db error getting entries of list %s: %w", list.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		for _, entry := range entries {
+			// List entry follows are
+			// only populated barebones,
+			// so fetch target separately.
+			target, err := p.state.DB.GetAccountByID(
+				gtscontext.SetBarebones(ctx),
+				entry.Follow.TargetAccountID,
+			)
+			if err != nil {
+				err = gtserror.Newf("db error getting target of list entry %s: %w", entry.ID, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+
+			records = append(records, []string{
+				list.Title,
+				exportAddress(target),
+			})
+		}
+	}
+
+	return records, nil
+}
+
+// ExportBookmarks returns the statuses bookmarked by
+// the requesting account as CSV records, in the format
+// of Mastodon's bookmarks.csv, which has no header.
+func (p *Processor) ExportBookmarks(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+) ([][]string, gtserror.WithCode) {
+	bookmarks, err := p.state.DB.GetStatusBookmarks(ctx, requestingAccount.ID, 0, "", "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting bookmarks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	records := make([][]string, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		records = append(records, []string{
+			bookmark.Status.URI,
+		})
+	}
+
+	return records, nil
+}
+
+// ExportArchive writes a zip archive of the requesting
+// account's actor, statuses, likes, bookmarks and original
+// media files to w. Since the archive is streamed, callers
+// may only be able to report errors before anything is written.
+func (p *Processor) ExportArchive(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	w io.Writer,
+) error {
+	archiver := trans.NewArchiver(p.state, p.converter)
+	return archiver.ExportArchive(ctx, requestingAccount, w)
+}
+
+// exportAddress returns the "username@domain" address
+// of the given account, as used in CSV exports.
+func exportAddress(account *gtsmodel.Account) string {
+	if account.IsLocal() {
+		return account.Username + "@" + config.GetAccountDomain()
+	}
+	return account.Username + "@" + account.Domain
+}
@@ -0,0 +1,232 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	// maxFeaturedTags is the maximum number of hashtags
+	// that an account may feature on their profile. This
+	// should match the instance's advertised max_featured_tags.
+	maxFeaturedTags = 10
+
+	// featuredTagSuggestionsLimit is the maximum
+	// number of featured tag suggestions to return.
+	featuredTagSuggestionsLimit = 10
+)
+
+// FeaturedTagsGet returns the hashtags featured on the
+// profile of the given target account, checking blocks
+// between the target and requesting account (if set).
+func (p *Processor) FeaturedTagsGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetAccountID string,
+) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	if requestingAccount != nil && requestingAccount.ID != targetAccountID {
+		blocked, err := p.state.DB.IsEitherBlocked(ctx, requestingAccount.ID, targetAccountID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if blocked {
+			// Block exists between accounts.
+			// Just return empty featured tags.
+			return []*apimodel.FeaturedTag{}, nil
+		}
+	}
+
+	featuredTags, err := p.state.DB.GetFeaturedTagsByAccountID(ctx, targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFeaturedTags := make([]*apimodel.FeaturedTag, 0, len(featuredTags))
+	for _, featuredTag := range featuredTags {
+		apiFeaturedTag, err := p.converter.FeaturedTagToAPIFeaturedTag(ctx, featuredTag)
+		if err != nil {
+			log.Errorf(ctx, "error converting featured tag %s: %v", featuredTag.ID, err)
+			continue
+		}
+		apiFeaturedTags = append(apiFeaturedTags, apiFeaturedTag)
+	}
+
+	return apiFeaturedTags, nil
+}
+
+// FeaturedTagCreate features the hashtag with the given
+// name on the requesting account's profile, creating the
+// hashtag first if it's not yet known to the instance.
+func (p *Processor) FeaturedTagCreate(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	name string,
+) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	name, ok := text.NormalizeHashtag(name)
+	if !ok {
+		const text = "name was not a valid hashtag"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	existing, err := p.state.DB.GetFeaturedTagsByAccountID(
+		gtscontext.SetBarebones(ctx),
+		requestingAccount.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(existing) >= maxFeaturedTags {
+		text := fmt.Sprintf("you may feature a maximum of %d hashtags", maxFeaturedTags)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	tag, errWithCode := p.getOrCreateTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	featuredTag := &gtsmodel.FeaturedTag{
+		ID:        id.NewULID(),
+		AccountID: requestingAccount.ID,
+		Account:   requestingAccount,
+		TagID:     tag.ID,
+		Tag:       tag,
+	}
+
+	if err := p.state.DB.PutFeaturedTag(ctx, featuredTag); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			const text = "you are already featuring this hashtag"
+			return nil, gtserror.NewErrorUnprocessableEntity(err, text)
+		}
+		err := gtserror.Newf("db error putting featured tag: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFeaturedTag, err := p.converter.FeaturedTagToAPIFeaturedTag(ctx, featuredTag)
+	if err != nil {
+		err := gtserror.Newf("error converting featured tag: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFeaturedTag, nil
+}
+
+// FeaturedTagDelete stops featuring the featured tag
+// with the given ID on the requesting account's profile.
+func (p *Processor) FeaturedTagDelete(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	featuredTagID string,
+) gtserror.WithCode {
+	featuredTag, err := p.state.DB.GetFeaturedTagByID(
+		gtscontext.SetBarebones(ctx),
+		featuredTagID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured tag: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if featuredTag == nil || featuredTag.AccountID != requestingAccount.ID {
+		// Don't reveal existence
+		// of other accounts' tags.
+		const text = "featured tag not found"
+		return gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if err := p.state.DB.DeleteFeaturedTagByID(ctx, featuredTag.ID); err != nil {
+		err := gtserror.Newf("db error deleting featured tag: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// FeaturedTagSuggestionsGet returns hashtags most used by
+// the requesting account that are not currently featured.
+func (p *Processor) FeaturedTagSuggestionsGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+) ([]*apimodel.Tag, gtserror.WithCode) {
+	tags, err := p.state.DB.GetFeaturedTagSuggestions(
+		ctx,
+		requestingAccount.ID,
+		featuredTagSuggestionsLimit,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured tag suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTags := make([]*apimodel.Tag, 0, len(tags))
+	for _, tag := range tags {
+		apiTag, err := p.converter.TagToAPITag(ctx, tag, true, nil)
+		if err != nil {
+			log.Errorf(ctx, "error converting tag %s: %v", tag.ID, err)
+			continue
+		}
+		apiTags = append(apiTags, &apiTag)
+	}
+
+	return apiTags, nil
+}
+
+// getOrCreateTag returns the tag with the given
+// (normalized) name, creating it if necessary.
+func (p *Processor) getOrCreateTag(ctx context.Context, name string) (*gtsmodel.Tag, gtserror.WithCode) {
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag != nil {
+		// We had it!
+		return tag, nil
+	}
+
+	// We didn't have a tag with
+	// this name, create one.
+	tag = &gtsmodel.Tag{
+		ID:   id.NewULID(),
+		Name: name,
+	}
+
+	if err := p.state.DB.PutTag(ctx, tag); err != nil {
+		err := gtserror.Newf("db error putting new tag %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FeaturedTagsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *FeaturedTagsTestSuite) TestFeaturedTagCreateDelete() {
+	var (
+		ctx            = context.Background()
+		requester      = suite.testAccounts["admin_account"]
+		otherRequester = suite.testAccounts["local_account_1"]
+	)
+
+	// Admin has used #welcome,
+	// so it should be suggested.
+	suggestions, errWithCode := suite.accountProcessor.FeaturedTagSuggestionsGet(ctx, requester)
+	suite.NoError(errWithCode)
+	suite.Len(suggestions, 1)
+	suite.Equal("welcome", suggestions[0].Name)
+
+	// Feature #welcome, checking
+	// stats are populated correctly.
+	featuredTag, errWithCode := suite.accountProcessor.FeaturedTagCreate(ctx, requester, "#welcome")
+	suite.NoError(errWithCode)
+	suite.Equal("welcome", featuredTag.Name)
+	suite.Equal("http://localhost:8080/tags/welcome", featuredTag.URL)
+	suite.Equal(1, featuredTag.StatusesCount)
+	suite.NotNil(featuredTag.LastStatusAt)
+
+	// #welcome should no longer be suggested.
+	suggestions, errWithCode = suite.accountProcessor.FeaturedTagSuggestionsGet(ctx, requester)
+	suite.NoError(errWithCode)
+	suite.Empty(suggestions)
+
+	// Featuring the same tag again should fail.
+	_, errWithCode = suite.accountProcessor.FeaturedTagCreate(ctx, requester, "welcome")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// Invalid hashtag names should be rejected.
+	_, errWithCode = suite.accountProcessor.FeaturedTagCreate(ctx, requester, "not a hashtag")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// Feature a brand new tag with no statuses.
+	newFeaturedTag, errWithCode := suite.accountProcessor.FeaturedTagCreate(ctx, requester, "SomeNewTag")
+	suite.NoError(errWithCode)
+	suite.Equal("somenewtag", newFeaturedTag.Name)
+	suite.Zero(newFeaturedTag.StatusesCount)
+	suite.Nil(newFeaturedTag.LastStatusAt)
+
+	featuredTags, errWithCode := suite.accountProcessor.FeaturedTagsGet(ctx, otherRequester, requester.ID)
+	suite.NoError(errWithCode)
+	suite.Len(featuredTags, 2)
+
+	// Another account shouldn't
+	// be able to remove the tag.
+	errWithCode = suite.accountProcessor.FeaturedTagDelete(ctx, otherRequester, featuredTag.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Requester should be able to remove it.
+	errWithCode = suite.accountProcessor.FeaturedTagDelete(ctx, requester, featuredTag.ID)
+	suite.NoError(errWithCode)
+
+	featuredTags, errWithCode = suite.accountProcessor.FeaturedTagsGet(ctx, requester, requester.ID)
+	suite.NoError(errWithCode)
+	suite.Len(featuredTags, 1)
+	suite.Equal(newFeaturedTag.ID, featuredTags[0].ID)
+}
+
+func TestFeaturedTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(FeaturedTagsTestSuite))
+}
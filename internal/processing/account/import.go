@@ -0,0 +1,844 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// maxImportSize is the maximum
+	// accepted size of an import file.
+	maxImportSize = 5 * 1024 * 1024 // 5MiB
+
+	// importProgressInterval is the number of
+	// items processed between progress updates
+	// of an import being stored in the database.
+	importProgressInterval = 25
+)
+
+// ImportCreate stores a new import of account data
+// from the uploaded CSV file or account archive, and
+// queues it for processing in the background by the
+// client worker.
+func (p *Processor) ImportCreate(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	form *apimodel.ImportRequest,
+) (*apimodel.Import, gtserror.WithCode) {
+	importType := gtsmodel.NewImportType(form.Type)
+	if importType == gtsmodel.ImportTypeUnknown {
+		const text = "import type %s not recognized, valid options are ['following', 'blocks', 'mutes', 'lists', 'bookmarks', 'archive']"
+		err := fmt.Errorf(text, form.Type)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	var overwrite bool
+	switch form.Mode {
+	case "", "merge":
+		// Default.
+	case "overwrite":
+		overwrite = true
+	default:
+		const text = "import mode %s not recognized, valid options are ['merge', 'overwrite']"
+		err := fmt.Errorf(text, form.Mode)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if importType == gtsmodel.ImportTypeArchive {
+		// Archives are stored and processed
+		// separately from CSV imports.
+		if overwrite {
+			const text = "import mode overwrite is not supported for archives"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		return p.importArchiveCreate(ctx, requestingAccount, form)
+	}
+
+	if form.Data.Size > maxImportSize {
+		err := fmt.Errorf("import file too large, max size is %d bytes", maxImportSize)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	file, err := form.Data.Open()
+	if err != nil {
+		err = gtserror.Newf("error opening import file: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxImportSize))
+	if err != nil {
+		err = gtserror.Newf("error reading import file: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Parse the data now, so that we
+	// can reject bad files immediately
+	// instead of during processing.
+	records, err := parseImportRecords(data)
+	if err != nil {
+		err = fmt.Errorf("error parsing import file as csv: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(records) == 0 {
+		const text = "import file contains no entries"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	imp := &gtsmodel.Import{
+		ID:         id.NewULID(),
+		AccountID:  requestingAccount.ID,
+		Account:    requestingAccount,
+		Type:       importType,
+		Overwrite:  &overwrite,
+		State:      gtsmodel.ImportStatePending,
+		TotalItems: len(records),
+		Data:       data,
+	}
+
+	if err := p.state.DB.PutImport(ctx, imp); err != nil {
+		err = gtserror.Newf("db error putting import: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process the import async.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectCollection,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       imp,
+		Origin:         requestingAccount,
+	})
+
+	return p.apiImport(ctx, imp)
+}
+
+// ImportsResume queues all imports left pending or processing,
+// eg., by a restart, for processing again. Since processing of
+// an import picks up where it left off, none are started over.
+func (p *Processor) ImportsResume(ctx context.Context) error {
+	imports, err := p.state.DB.GetUnfinishedImports(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Nothing to do.
+			return nil
+		}
+		return gtserror.Newf("db error getting unfinished imports: %w", err)
+	}
+
+	for _, imp := range imports {
+		if err := p.state.DB.PopulateImport(ctx, imp); err != nil {
+			log.Errorf(ctx, "error populating import %s: %v", imp.ID, err)
+			continue
+		}
+
+		// Process the import async.
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ObjectCollection,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       imp,
+			Origin:         imp.Account,
+		})
+	}
+
+	return nil
+}
+
+// ImportsGet returns all imports uploaded
+// by the requesting account, newest first.
+func (p *Processor) ImportsGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+) ([]*apimodel.Import, gtserror.WithCode) {
+	imports, err := p.state.DB.GetImportsForAccount(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting imports: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiImports := make([]*apimodel.Import, 0, len(imports))
+	for _, imp := range imports {
+		apiImport, errWithCode := p.apiImport(ctx, imp)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiImports = append(apiImports, apiImport)
+	}
+
+	return apiImports, nil
+}
+
+// ImportGet returns the import with the given
+// ID, if it was uploaded by the requesting account.
+func (p *Processor) ImportGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	importID string,
+) (*apimodel.Import, gtserror.WithCode) {
+	imp, err := p.state.DB.GetImportByID(gtscontext.SetBarebones(ctx), importID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting import: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if imp == nil || imp.AccountID != requestingAccount.ID {
+		err := fmt.Errorf("import %s not found", importID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return p.apiImport(ctx, imp)
+}
+
+func (p *Processor) apiImport(
+	ctx context.Context,
+	imp *gtsmodel.Import,
+) (*apimodel.Import, gtserror.WithCode) {
+	apiImport, err := p.converter.ImportToAPIImport(ctx, imp)
+	if err != nil {
+		err = gtserror.Newf("error converting import to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiImport, nil
+}
+
+// ImportProcess processes the items of the given import on
+// behalf of its account, updating progress as it goes. Items
+// that can't be imported are counted as failed, and skipped.
+// Processing of an interrupted import resumes where it left off.
+func (p *Processor) ImportProcess(ctx context.Context, imp *gtsmodel.Import) error {
+	// An unfinished import may be queued twice
+	// on startup, once from the persisted worker
+	// queue and once by ImportsResume, so make
+	// sure it's only being processed once.
+	if _, running := p.importing.LoadOrStore(imp.ID, struct{}{}); running {
+		return nil
+	}
+	defer p.importing.Delete(imp.ID)
+
+	// Reload the import to get the latest
+	// state, uploaded data, and account.
+	imp, err := p.state.DB.GetImportByID(ctx, imp.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Import was deleted in
+			// the meantime, ignore.
+			return nil
+		}
+		return gtserror.Newf("db error getting import: %w", err)
+	}
+
+	if imp.State == gtsmodel.ImportStateFinished ||
+		imp.State == gtsmodel.ImportStateFailed {
+		// Already done.
+		return nil
+	}
+
+	if imp.Type == gtsmodel.ImportTypeArchive {
+		return p.importArchive(ctx, imp)
+	}
+
+	records, err := parseImportRecords(imp.Data)
+	if err != nil {
+		// Should have been caught on upload.
+		imp.State = gtsmodel.ImportStateFailed
+		imp.FinishedAt = time.Now()
+		if err := p.state.DB.UpdateImport(ctx, imp, "state", "finished_at"); err != nil {
+			log.Errorf(ctx, "db error updating import: %v", err)
+		}
+		return gtserror.Newf("error parsing import data: %w", err)
+	}
+
+	imp.State = gtsmodel.ImportStateProcessing
+	if err := p.state.DB.UpdateImport(ctx, imp, "state"); err != nil {
+		return gtserror.Newf("db error updating import: %w", err)
+	}
+
+	// Select function to import one record.
+	var importRecord func(context.Context, *gtsmodel.Account, []string) error
+	switch imp.Type {
+	case gtsmodel.ImportTypeFollowing:
+		importRecord = p.importFollow
+	case gtsmodel.ImportTypeBlocks:
+		importRecord = p.importBlock
+	case gtsmodel.ImportTypeMutes:
+		importRecord = p.importMute
+	case gtsmodel.ImportTypeLists:
+		importRecord = p.listImporter()
+	case gtsmodel.ImportTypeBookmarks:
+		importRecord = p.importBookmark
+	default:
+		return gtserror.Newf("unrecognized import type %d", imp.Type)
+	}
+
+	for _, record := range records[min(imp.ProcessedItems, len(records)):] {
+		if err := importRecord(ctx, imp.Account, record); err != nil {
+			log.Warnf(ctx, "error importing %s record %v: %v", imp.Type, record, err)
+			imp.FailedItems++
+		}
+		imp.ProcessedItems++
+
+		if imp.ProcessedItems%importProgressInterval == 0 {
+			// Store progress so far.
+			if err := p.state.DB.UpdateImport(ctx, imp,
+				"processed_items",
+				"failed_items",
+			); err != nil {
+				log.Errorf(ctx, "db error updating import progress: %v", err)
+			}
+		}
+	}
+
+	if *imp.Overwrite {
+		// Remove existing entries
+		// not present in the import.
+		if err := p.importOverwrite(ctx, imp, records); err != nil {
+			log.Errorf(ctx, "error removing entries not present in import: %v", err)
+		}
+	}
+
+	// Mark as finished, and drop
+	// the no longer needed data.
+	imp.State = gtsmodel.ImportStateFinished
+	imp.FinishedAt = time.Now()
+	imp.Data = nil
+	if err := p.state.DB.UpdateImport(ctx, imp,
+		"state",
+		"processed_items",
+		"failed_items",
+		"finished_at",
+		"data",
+	); err != nil {
+		return gtserror.Newf("db error updating import: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Processor) importFollow(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	record []string,
+) error {
+	target, err := p.importAccount(ctx, requestingAccount, record[0])
+	if err != nil {
+		return err
+	}
+
+	form := &apimodel.AccountFollowRequest{
+		ID:      target.ID,
+		Reblogs: util.Ptr(importBool(record, 1, true)),
+		Notify:  util.Ptr(importBool(record, 2, false)),
+	}
+
+	if _, errWithCode := p.FollowCreate(ctx, requestingAccount, form); errWithCode != nil {
+		return errWithCode
+	}
+
+	return nil
+}
+
+func (p *Processor) importBlock(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	record []string,
+) error {
+	target, err := p.importAccount(ctx, requestingAccount, record[0])
+	if err != nil {
+		return err
+	}
+
+	if _, errWithCode := p.BlockCreate(ctx, requestingAccount, target.ID); errWithCode != nil {
+		return errWithCode
+	}
+
+	return nil
+}
+
+func (p *Processor) importMute(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	record []string,
+) error {
+	target, err := p.importAccount(ctx, requestingAccount, record[0])
+	if err != nil {
+		return err
+	}
+
+	form := &apimodel.UserMuteCreateUpdateRequest{
+		Notifications: util.Ptr(importBool(record, 1, true)),
+	}
+
+	if _, errWithCode := p.MuteCreate(ctx, requestingAccount, target.ID, form); errWithCode != nil {
+		return errWithCode
+	}
+
+	return nil
+}
+
+// listImporter returns a function to import list
+// records, creating lists by title as needed.
+func (p *Processor) listImporter() func(context.Context, *gtsmodel.Account, []string) error {
+	// Lists of the account by title,
+	// loaded lazily on first record.
+	var lists map[string]*gtsmodel.List
+
+	return func(ctx context.Context, requestingAccount *gtsmodel.Account, record []string) error {
+		if len(record) < 2 {
+			return errors.New("record has no account address")
+		}
+
+		if lists == nil {
+			existing, err := p.state.DB.GetListsForAccountID(ctx, requestingAccount.ID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return gtserror.Newf("db error getting lists: %w", err)
+			}
+
+			lists = make(map[string]*gtsmodel.List, len(existing))
+			for _, list := range existing {
+				lists[list.Title] = list
+			}
+		}
+
+		title := strings.TrimSpace(record[0])
+		if title == "" {
+			return errors.New("record has no list name")
+		}
+
+		target, err := p.importAccount(ctx, requestingAccount, record[1])
+		if err != nil {
+			return err
+		}
+
+		// List entries are made of follows,
+		// so the account must be followed.
+		follow, err := p.state.DB.GetFollow(
+			gtscontext.SetBarebones(ctx),
+			requestingAccount.ID,
+			target.ID,
+		)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				return fmt.Errorf("account %s is not followed", target.ID)
+			}
+			return gtserror.Newf("db error getting follow: %w", err)
+		}
+
+		list, ok := lists[title]
+		if !ok {
+			list = &gtsmodel.List{
+				ID:            id.NewULID(),
+				Title:         title,
+				AccountID:     requestingAccount.ID,
+				RepliesPolicy: gtsmodel.RepliesPolicyFollowed,
+			}
+
+			if err := p.state.DB.PutList(ctx, list); err != nil {
+				return gtserror.Newf("db error putting list: %w", err)
+			}
+
+			lists[title] = list
+		}
+
+		included, err := p.state.DB.ListIncludesAccount(ctx, list.ID, target.ID)
+		if err != nil {
+			return gtserror.Newf("db error checking list entries: %w", err)
+		}
+
+		if included {
+			// Already in list.
+			return nil
+		}
+
+		if err := p.state.DB.PutListEntries(ctx, []*gtsmodel.ListEntry{{
+			ID:       id.NewULID(),
+			ListID:   list.ID,
+			FollowID: follow.ID,
+		}}); err != nil {
+			return gtserror.Newf("db error putting list entry: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (p *Processor) importBookmark(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	record []string,
+) error {
+	uri, err := url.Parse(strings.TrimSpace(record[0]))
+	if err != nil || (uri.Scheme != "https" && uri.Scheme != "http") {
+		return fmt.Errorf("invalid status uri %s", record[0])
+	}
+
+	// Fetch the status, dereferencing it if necessary.
+	status, _, err := p.federator.GetStatusByURI(ctx,
+		requestingAccount.Username,
+		uri,
+	)
+	if err != nil {
+		return gtserror.Newf("error getting status %s: %w", uri, err)
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, requestingAccount, status)
+	if err != nil {
+		return gtserror.Newf("error checking status visibility: %w", err)
+	}
+
+	if !visible {
+		return fmt.Errorf("status %s is not visible", status.ID)
+	}
+
+	bookmark, err := p.state.DB.GetStatusBookmark(ctx, requestingAccount.ID, status.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error checking existing bookmark: %w", err)
+	}
+
+	if bookmark != nil {
+		// Already bookmarked.
+		return nil
+	}
+
+	if err := p.state.DB.PutStatusBookmark(ctx, &gtsmodel.StatusBookmark{
+		ID:              id.NewULID(),
+		AccountID:       requestingAccount.ID,
+		Account:         requestingAccount,
+		TargetAccountID: status.AccountID,
+		TargetAccount:   status.Account,
+		StatusID:        status.ID,
+		Status:          status,
+	}); err != nil {
+		return gtserror.Newf("db error putting bookmark: %w", err)
+	}
+
+	return p.c.InvalidateTimelinedStatus(ctx, requestingAccount.ID, status.ID)
+}
+
+// importOverwrite removes existing entries of the import's
+// type that aren't present in the given import records.
+func (p *Processor) importOverwrite(
+	ctx context.Context,
+	imp *gtsmodel.Import,
+	records [][]string,
+) error {
+	account := imp.Account
+
+	// Gather keys of all account addresses
+	// in the import, including failed ones,
+	// so that we never remove entries that
+	// we just failed to resolve this time.
+	addresses := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		if key, ok := importAddressKey(record[0]); ok {
+			addresses[key] = struct{}{}
+		}
+	}
+
+	// inImport returns whether given
+	// account is present in the import.
+	inImport := func(target *gtsmodel.Account) bool {
+		_, ok := addresses[strings.ToLower(target.Username+"@"+target.Domain)]
+		return ok
+	}
+
+	switch imp.Type {
+	case gtsmodel.ImportTypeFollowing:
+		follows, err := p.state.DB.GetAccountFollows(ctx, account.ID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting follows: %w", err)
+		}
+
+		followReqs, err := p.state.DB.GetAccountFollowRequesting(ctx, account.ID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting follow requests: %w", err)
+		}
+
+		targets := make([]*gtsmodel.Account, 0, len(follows)+len(followReqs))
+		for _, follow := range follows {
+			targets = append(targets, follow.TargetAccount)
+		}
+		for _, followReq := range followReqs {
+			targets = append(targets, followReq.TargetAccount)
+		}
+
+		for _, target := range targets {
+			if inImport(target) {
+				continue
+			}
+
+			// Unfollow handles both follows + follow requests.
+			if _, errWithCode := p.FollowRemove(ctx, account, target.ID); errWithCode != nil {
+				log.Errorf(ctx, "error unfollowing %s: %v", target.ID, errWithCode)
+			}
+		}
+
+	case gtsmodel.ImportTypeBlocks:
+		blocks, err := p.state.DB.GetAccountBlocks(ctx, account.ID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting blocks: %w", err)
+		}
+
+		for _, block := range blocks {
+			if inImport(block.TargetAccount) {
+				continue
+			}
+
+			if _, errWithCode := p.BlockRemove(ctx, account, block.TargetAccountID); errWithCode != nil {
+				log.Errorf(ctx, "error unblocking %s: %v", block.TargetAccountID, errWithCode)
+			}
+		}
+
+	case gtsmodel.ImportTypeMutes:
+		mutes, err := p.state.DB.GetAccountMutes(ctx, account.ID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting mutes: %w", err)
+		}
+
+		for _, mute := range mutes {
+			if inImport(mute.TargetAccount) {
+				continue
+			}
+
+			if _, errWithCode := p.MuteRemove(ctx, account, mute.TargetAccountID); errWithCode != nil {
+				log.Errorf(ctx, "error unmuting %s: %v", mute.TargetAccountID, errWithCode)
+			}
+		}
+
+	case gtsmodel.ImportTypeLists:
+		// Only entries of lists present in the
+		// import are removed; other lists are
+		// left as they are.
+		listAddresses := make(map[string]map[string]struct{})
+		for _, record := range records {
+			if len(record) < 2 {
+				continue
+			}
+
+			title := strings.TrimSpace(record[0])
+			if listAddresses[title] == nil {
+				listAddresses[title] = make(map[string]struct{})
+			}
+
+			if key, ok := importAddressKey(record[1]); ok {
+				listAddresses[title][key] = struct{}{}
+			}
+		}
+
+		lists, err := p.state.DB.GetListsForAccountID(ctx, account.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting lists: %w", err)
+		}
+
+		for _, list := range lists {
+			addresses, ok := listAddresses[list.Title]
+			if !ok {
+				continue
+			}
+
+			entries, err := p.state.DB.GetListEntries(ctx, list.ID, "", "", "", 0)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return gtserror.Newf("db error getting list entries: %w", err)
+			}
+
+			for _, entry := range entries {
+				target, err := p.state.DB.GetAccountByID(
+					gtscontext.SetBarebones(ctx),
+					entry.Follow.TargetAccountID,
+				)
+				if err != nil {
+					log.Errorf(ctx, "db error getting target of list entry %s: %v", entry.ID, err)
+					continue
+				}
+
+				key := strings.ToLower(target.Username + "@" + target.Domain)
+				if _, ok := addresses[key]; ok {
+					continue
+				}
+
+				if err := p.state.DB.DeleteListEntry(ctx, entry.ID); err != nil {
+					log.Errorf(ctx, "db error deleting list entry %s: %v", entry.ID, err)
+				}
+			}
+		}
+
+	case gtsmodel.ImportTypeBookmarks:
+		uris := make(map[string]struct{}, len(records))
+		for _, record := range records {
+			uris[strings.TrimSpace(record[0])] = struct{}{}
+		}
+
+		bookmarks, err := p.state.DB.GetStatusBookmarks(ctx, account.ID, 0, "", "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting bookmarks: %w", err)
+		}
+
+		for _, bookmark := range bookmarks {
+			_, uriOK := uris[bookmark.Status.URI]
+			_, urlOK := uris[bookmark.Status.URL]
+			if uriOK || urlOK {
+				continue
+			}
+
+			if err := p.state.DB.DeleteStatusBookmarkByID(ctx, bookmark.ID); err != nil {
+				log.Errorf(ctx, "db error deleting bookmark %s: %v", bookmark.ID, err)
+				continue
+			}
+
+			if err := p.c.InvalidateTimelinedStatus(ctx, account.ID, bookmark.StatusID); err != nil {
+				log.Errorf(ctx, "error invalidating status from timelines: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// importAccount fetches the account with the given address
+// (as found in CSV exports, eg., "someone@example.org"),
+// dereferencing it if it's remote and not yet known to us.
+func (p *Processor) importAccount(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	address string,
+) (*gtsmodel.Account, error) {
+	username, domain, err := importAddressParts(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if domain == "" {
+		// Local accounts can only come from the db.
+		return p.state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	}
+
+	account, _, err := p.federator.GetAccountByUsernameDomain(ctx,
+		requestingAccount.Username,
+		username,
+		domain,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error getting account %s@%s: %w", username, domain, err)
+	}
+
+	return account, nil
+}
+
+// importAddressParts splits the given account address, with
+// or without leading "@", into username and domain. Domain
+// is returned empty if the address is of a local account.
+func importAddressParts(address string) (string, string, error) {
+	address = strings.TrimSpace(address)
+	if !strings.HasPrefix(address, "@") {
+		address = "@" + address
+	}
+
+	username, domain, err := util.ExtractNamestringParts(address)
+	if err != nil {
+		return "", "", err
+	}
+
+	domain = strings.ToLower(domain)
+	if domain == config.GetHost() ||
+		domain == config.GetAccountDomain() {
+		domain = ""
+	}
+
+	return username, domain, nil
+}
+
+// importAddressKey returns a lowercase "username@domain"
+// key for the given account address, for comparing against
+// existing accounts. The domain is empty for local accounts.
+func importAddressKey(address string) (string, bool) {
+	username, domain, err := importAddressParts(address)
+	if err != nil {
+		return "", false
+	}
+	return strings.ToLower(username + "@" + domain), true
+}
+
+// importBool parses the bool at given index of the
+// record, returning def if it's missing or invalid.
+func importBool(record []string, i int, def bool) bool {
+	if i >= len(record) {
+		return def
+	}
+
+	b, err := strconv.ParseBool(strings.TrimSpace(record[i]))
+	if err != nil {
+		return def
+	}
+
+	return b
+}
+
+// parseImportRecords parses the given CSV data into records,
+// skipping the header row (if any) and empty records. The
+// first field of each returned record is never empty.
+func parseImportRecords(data []byte) ([][]string, error) {
+	// Mastodon exports can be edited
+	// in spreadsheet apps, which may
+	// prefix a byte order mark.
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Following and mutes exports have a header
+	// row; blocks may or may not, depending on
+	// the version of Mastodon it was exported from.
+	if len(records) > 0 &&
+		strings.EqualFold(strings.TrimSpace(records[0][0]), "Account address") {
+		records = records[1:]
+	}
+
+	records = slices.DeleteFunc(records, func(record []string) bool {
+		return strings.TrimSpace(record[0]) == ""
+	})
+
+	return records, nil
+}
@@ -0,0 +1,566 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ImportTestSuite struct {
+	AccountStandardTestSuite
+}
+
+// putImport stores a new pending import of given
+// type and data, and returns it for processing.
+func (suite *ImportTestSuite) putImport(
+	account *gtsmodel.Account,
+	importType gtsmodel.ImportType,
+	overwrite bool,
+	data string,
+) *gtsmodel.Import {
+	imp := &gtsmodel.Import{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Type:      importType,
+		Overwrite: &overwrite,
+		State:     gtsmodel.ImportStatePending,
+		Data:      []byte(data),
+	}
+
+	if err := suite.state.DB.PutImport(context.Background(), imp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return imp
+}
+
+func (suite *ImportTestSuite) processImport(imp *gtsmodel.Import) *gtsmodel.Import {
+	ctx := context.Background()
+
+	if err := suite.accountProcessor.ImportProcess(ctx, imp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	imp, err := suite.state.DB.GetImportByID(ctx, imp.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(gtsmodel.ImportStateFinished, imp.State)
+	suite.NotZero(imp.FinishedAt)
+	suite.Nil(imp.Data)
+
+	return imp
+}
+
+func (suite *ImportTestSuite) TestExportFollowing() {
+	records, errWithCode := suite.accountProcessor.ExportFollowing(
+		context.Background(),
+		suite.testAccounts["local_account_1"],
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal([][]string{
+		{"Account address", "Show boosts", "Notify on new posts", "Languages"},
+		{"admin@localhost:8080", "true", "false", ""},
+		{"1happyturtle@localhost:8080", "true", "false", ""},
+	}, records)
+}
+
+func (suite *ImportTestSuite) TestImportFollowing() {
+	var (
+		ctx           = context.Background()
+		account       = suite.testAccounts["local_account_1"]
+		remoteAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	imp := suite.processImport(suite.putImport(
+		account,
+		gtsmodel.ImportTypeFollowing,
+		false,
+		"Account address,Show boosts,Notify on new posts,Languages\n"+
+			"foss_satan@fossbros-anonymous.io,false,true,\n"+
+			"@not_a_real_account@localhost:8080,true,false,\n",
+	))
+	suite.Equal(2, imp.ProcessedItems)
+	suite.Equal(1, imp.FailedItems)
+
+	// Remote account should now be follow requested.
+	followReq, err := suite.state.DB.GetFollowRequest(ctx, account.ID, remoteAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*followReq.ShowReblogs)
+	suite.True(*followReq.Notify)
+
+	// Existing follows should be untouched.
+	follows, err := suite.state.DB.GetAccountFollows(ctx, account.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(follows, 2)
+}
+
+func (suite *ImportTestSuite) TestImportMutes() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		target  = suite.testAccounts["admin_account"]
+	)
+
+	imp := suite.processImport(suite.putImport(
+		account,
+		gtsmodel.ImportTypeMutes,
+		false,
+		"Account address,Hide notifications\n"+
+			"admin@localhost:8080,false\n",
+	))
+	suite.Equal(1, imp.ProcessedItems)
+	suite.Zero(imp.FailedItems)
+
+	mute, err := suite.state.DB.GetMute(ctx, account.ID, target.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*mute.Notifications)
+}
+
+func (suite *ImportTestSuite) TestImportListsOverwrite() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		list    = testrig.NewTestLists()["local_account_1_list_1"]
+	)
+
+	imp := suite.processImport(suite.putImport(
+		account,
+		gtsmodel.ImportTypeLists,
+		true,
+		list.Title+",admin@localhost:8080\n"+
+			"New List,1happyturtle@localhost:8080\n",
+	))
+	suite.Equal(2, imp.ProcessedItems)
+	suite.Zero(imp.FailedItems)
+
+	// Existing list should only contain admin now.
+	includesAdmin, err := suite.state.DB.ListIncludesAccount(ctx, list.ID, suite.testAccounts["admin_account"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(includesAdmin)
+
+	includesTurtle, err := suite.state.DB.ListIncludesAccount(ctx, list.ID, suite.testAccounts["local_account_2"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(includesTurtle)
+
+	// New list should have been created with turtle in it.
+	lists, err := suite.state.DB.GetListsForAccountID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	var newList *gtsmodel.List
+	for _, l := range lists {
+		if l.Title == "New List" {
+			newList = l
+		}
+	}
+	if newList == nil {
+		suite.FailNow("new list not created")
+	}
+
+	includesTurtle, err = suite.state.DB.ListIncludesAccount(ctx, newList.ID, suite.testAccounts["local_account_2"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(includesTurtle)
+}
+
+func (suite *ImportTestSuite) TestImportProcessDeleted() {
+	ctx := context.Background()
+
+	imp := suite.putImport(
+		suite.testAccounts["local_account_1"],
+		gtsmodel.ImportTypeBlocks,
+		false,
+		"admin@localhost:8080\n",
+	)
+
+	// Delete the import before it's processed,
+	// eg., because the account was deleted.
+	if err := suite.state.DB.DeleteImportsByAccountID(ctx, imp.AccountID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Processing should be a no-op.
+	if err := suite.accountProcessor.ImportProcess(ctx, imp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.state.DB.GetBlock(ctx, imp.AccountID, suite.testAccounts["admin_account"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ImportTestSuite) TestImportsResume() {
+	ctx := context.Background()
+
+	// Drop any messages
+	// left by other tests.
+	for {
+		if _, ok := suite.getClientMsg(time.Millisecond); !ok {
+			break
+		}
+	}
+
+	// One import left pending and one left
+	// processing, eg., by a restart, plus
+	// one that's already finished.
+	pending := suite.putImport(
+		suite.testAccounts["local_account_1"],
+		gtsmodel.ImportTypeBlocks,
+		false,
+		"admin@localhost:8080\n",
+	)
+	processing := suite.putImport(
+		suite.testAccounts["local_account_2"],
+		gtsmodel.ImportTypeMutes,
+		false,
+		"Account address,Hide notifications\n"+
+			"admin@localhost:8080,false\n",
+	)
+	processing.State = gtsmodel.ImportStateProcessing
+	if err := suite.state.DB.UpdateImport(ctx, processing, "state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	finished := suite.putImport(
+		suite.testAccounts["admin_account"],
+		gtsmodel.ImportTypeBlocks,
+		false,
+		"1happyturtle@localhost:8080\n",
+	)
+	finished.State = gtsmodel.ImportStateFinished
+	if err := suite.state.DB.UpdateImport(ctx, finished, "state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.accountProcessor.ImportsResume(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the unfinished imports
+	// should be queued, oldest first.
+	var queued []*gtsmodel.Import
+	for {
+		msg, ok := suite.getClientMsg(time.Second)
+		if !ok {
+			break
+		}
+		imp, ok := msg.GTSModel.(*gtsmodel.Import)
+		if !ok {
+			suite.FailNow("", "unexpected client msg model %T", msg.GTSModel)
+		}
+		suite.Equal(imp.AccountID, msg.Origin.ID)
+		queued = append(queued, imp)
+	}
+	if suite.Len(queued, 2) {
+		suite.Equal(pending.ID, queued[0].ID)
+		suite.Equal(processing.ID, queued[1].ID)
+	}
+
+	// Processing them from the
+	// queue should finish them.
+	for _, imp := range queued {
+		suite.processImport(imp)
+	}
+
+	_, err := suite.state.DB.GetBlock(ctx, pending.AccountID, suite.testAccounts["admin_account"].ID)
+	suite.NoError(err)
+
+	_, err = suite.state.DB.GetMute(ctx, processing.AccountID, suite.testAccounts["admin_account"].ID)
+	suite.NoError(err)
+
+	_, err = suite.state.DB.GetBlock(ctx, finished.AccountID, suite.testAccounts["local_account_2"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+// putArchiveImport stores a new pending archive import of
+// the given outbox, with actor.json and the given media files.
+func (suite *ImportTestSuite) putArchiveImport(
+	account *gtsmodel.Account,
+	dryRun bool,
+	outbox string,
+	files map[string]string,
+) *gtsmodel.Import {
+	ctx := context.Background()
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	files["outbox.json"] = outbox
+	files["actor.json"] = `{"followers":"https://old.example.org/users/someone/followers"}`
+	for name, data := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if _, err := w.Write([]byte(data)); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+	if err := zw.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	imp := &gtsmodel.Import{
+		ID:         id.NewULID(),
+		AccountID:  account.ID,
+		Type:       gtsmodel.ImportTypeArchive,
+		Overwrite:  util.Ptr(false),
+		DryRun:     &dryRun,
+		Federate:   util.Ptr(false),
+		State:      gtsmodel.ImportStatePending,
+		TotalItems: 3,
+	}
+
+	archivePath := account.ID + "/import/" + imp.ID + ".zip"
+	if _, err := suite.storage.Put(ctx, archivePath, buf.Bytes()); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.state.DB.PutImport(ctx, imp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return imp
+}
+
+const testArchiveOutbox = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    {
+      "type": "Create",
+      "id": "https://old.example.org/users/someone/statuses/1/activity",
+      "actor": "https://old.example.org/users/someone",
+      "object": {
+        "id": "https://old.example.org/users/someone/statuses/1",
+        "type": "Note",
+        "attributedTo": "https://old.example.org/users/someone",
+        "published": "2019-05-01T10:00:00Z",
+        "to": ["https://www.w3.org/ns/activitystreams#Public"],
+        "cc": ["https://old.example.org/users/someone/followers"],
+        "summary": "old post",
+        "content": "<p>hello from the past</p>",
+        "attachment": [
+          {
+            "type": "Document",
+            "mediaType": "image/jpeg",
+            "url": "https://old.example.org/system/media_attachments/files/000/000/001/original/photo.jpg",
+            "name": "a very old photo"
+          }
+        ]
+      }
+    },
+    {
+      "type": "Create",
+      "id": "https://old.example.org/users/someone/statuses/2/activity",
+      "actor": "https://old.example.org/users/someone",
+      "object": {
+        "id": "https://old.example.org/users/someone/statuses/2",
+        "type": "Note",
+        "attributedTo": "https://old.example.org/users/someone",
+        "published": "2019-05-01T10:05:00Z",
+        "inReplyTo": "https://old.example.org/users/someone/statuses/1",
+        "to": ["https://old.example.org/users/someone/followers"],
+        "content": "<p>and a reply, for followers only</p>"
+      }
+    },
+    {
+      "type": "Announce",
+      "id": "https://old.example.org/users/someone/statuses/3/activity",
+      "actor": "https://old.example.org/users/someone",
+      "published": "2019-05-02T10:00:00Z",
+      "to": ["https://www.w3.org/ns/activitystreams#Public"],
+      "object": "https://elsewhere.example.org/users/someone_else/statuses/1"
+    }
+  ]
+}`
+
+// importedArchiveStatuses returns the statuses
+// of the given account created before 2020.
+func (suite *ImportTestSuite) importedArchiveStatuses(account *gtsmodel.Account) []*gtsmodel.Status {
+	statuses, err := suite.state.DB.GetAccountStatuses(
+		context.Background(),
+		account.ID,
+		100, false, false, "", "", false, false,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+
+	var imported []*gtsmodel.Status
+	for _, status := range statuses {
+		if status.CreatedAt.Year() < 2020 {
+			imported = append(imported, status)
+		}
+	}
+
+	return imported
+}
+
+func (suite *ImportTestSuite) TestImportArchive() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	photo, err := os.ReadFile("../../../testrig/media/ohyou-original.jpg")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	imp := suite.processImport(suite.putArchiveImport(
+		account,
+		false,
+		testArchiveOutbox,
+		map[string]string{
+			"media_attachments/files/000/000/001/original/photo.jpg": string(photo),
+		},
+	))
+
+	// Boost should have failed.
+	suite.Equal(3, imp.ProcessedItems)
+	suite.Equal(1, imp.FailedItems)
+
+	statuses := suite.importedArchiveStatuses(account)
+	if !suite.Len(statuses, 2) {
+		suite.FailNow("")
+	}
+
+	// Newest first.
+	reply, status := statuses[0], statuses[1]
+
+	suite.Equal(time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC), status.CreatedAt.UTC())
+	suite.Equal("<p>hello from the past</p>", status.Content)
+	suite.Equal("old post", status.ContentWarning)
+	suite.Equal(gtsmodel.VisibilityPublic, status.Visibility)
+	suite.True(*status.Local)
+	suite.Equal(account.URI+"/statuses/"+status.ID, status.URI)
+	if suite.Len(status.AttachmentIDs, 1) {
+		attachment, err := suite.state.DB.GetAttachmentByID(ctx, status.AttachmentIDs[0])
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(gtsmodel.FileTypeImage, attachment.Type)
+		suite.Equal("a very old photo", attachment.Description)
+		suite.Equal(status.ID, attachment.StatusID)
+	}
+
+	suite.Equal(time.Date(2019, 5, 1, 10, 5, 0, 0, time.UTC), reply.CreatedAt.UTC())
+	suite.Equal(gtsmodel.VisibilityFollowersOnly, reply.Visibility)
+	suite.Equal(status.ID, reply.InReplyToID)
+	suite.Equal(status.ThreadID, reply.ThreadID)
+
+	// Archive should be
+	// gone from storage.
+	has, err := suite.storage.Has(ctx, account.ID+"/import/"+imp.ID+".zip")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(has)
+}
+
+func (suite *ImportTestSuite) TestImportArchiveDryRun() {
+	account := suite.testAccounts["local_account_1"]
+
+	imp := suite.processImport(suite.putArchiveImport(
+		account,
+		true,
+		testArchiveOutbox,
+		map[string]string{},
+	))
+
+	suite.Equal(3, imp.ProcessedItems)
+	suite.Equal(1, imp.FailedItems)
+
+	// Nothing should
+	// have been created.
+	suite.Empty(suite.importedArchiveStatuses(account))
+}
+
+func (suite *ImportTestSuite) TestImportArchiveResume() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	imp := suite.putArchiveImport(
+		account,
+		false,
+		testArchiveOutbox,
+		map[string]string{},
+	)
+
+	// Pretend an interrupted run already imported the first
+	// status of the outbox, but stopped before storing progress.
+	imported := suite.testStatuses["local_account_1_status_1"]
+	if err := suite.state.DB.PutImportStatus(ctx, &gtsmodel.ImportStatus{
+		ID:       id.NewULID(),
+		ImportID: imp.ID,
+		URI:      "https://old.example.org/users/someone/statuses/1",
+		StatusID: imported.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	imp = suite.processImport(imp)
+
+	// Only the boost should have failed.
+	suite.Equal(3, imp.ProcessedItems)
+	suite.Equal(1, imp.FailedItems)
+
+	// Only the reply should have been created,
+	// continuing the thread of the first status.
+	statuses := suite.importedArchiveStatuses(account)
+	if !suite.Len(statuses, 1) {
+		suite.FailNow("")
+	}
+	reply := statuses[0]
+	suite.Equal(imported.ID, reply.InReplyToID)
+	suite.Equal(imported.ThreadID, reply.ThreadID)
+
+	// Imported statuses are
+	// forgotten once finished.
+	_, err := suite.state.DB.GetImportStatuses(ctx, imp.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestImportTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportTestSuite))
+}
@@ -0,0 +1,712 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/iotools"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// maxArchiveImportSize is the maximum
+	// accepted size of an account archive.
+	maxArchiveImportSize = 1024 * 1024 * 1024 // 1GiB
+
+	// Files read from an account archive, named
+	// the same in Mastodon and GoToSocial archives.
+	archiveOutboxFile = "outbox.json"
+	archiveActorFile  = "actor.json"
+
+	// Maximum decompressed sizes of the above files. These are
+	// decoded in memory, so a small zip mustn't be able to
+	// inflate to something huge. An outbox of tens of thousands
+	// of statuses is still well below this.
+	maxArchiveOutboxSize = 128 * 1024 * 1024 // 128MiB
+	maxArchiveActorSize  = 1024 * 1024       // 1MiB
+)
+
+// archiveOutbox models the parts of an
+// account archive's outbox.json we need.
+type archiveOutbox struct {
+	Context      any              `json:"@context"`
+	OrderedItems []map[string]any `json:"orderedItems"`
+}
+
+// importArchiveCreate stores a new import of the uploaded
+// account archive, after checking it contains an outbox.
+// The archive is kept in storage until processing is done.
+func (p *Processor) importArchiveCreate(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	form *apimodel.ImportRequest,
+) (*apimodel.Import, gtserror.WithCode) {
+	if form.Data.Size > maxArchiveImportSize {
+		err := fmt.Errorf("import archive too large, max size is %d bytes", maxArchiveImportSize)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	file, err := form.Data.Open()
+	if err != nil {
+		err = gtserror.Newf("error opening import file: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	defer file.Close()
+
+	// Read the outbox now, so that we
+	// can reject bad files immediately
+	// instead of during processing.
+	outbox, err := readArchiveUpload(file, form.Data.Size)
+	if err != nil {
+		err = fmt.Errorf("error reading import file as account archive: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(outbox.OrderedItems) == 0 {
+		const text = "import archive outbox contains no entries"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	imp := &gtsmodel.Import{
+		ID:         id.NewULID(),
+		AccountID:  requestingAccount.ID,
+		Account:    requestingAccount,
+		Type:       gtsmodel.ImportTypeArchive,
+		Overwrite:  util.Ptr(false),
+		DryRun:     &form.DryRun,
+		Federate:   &form.Federate,
+		State:      gtsmodel.ImportStatePending,
+		TotalItems: len(outbox.OrderedItems),
+	}
+
+	// Rewind and store the whole archive,
+	// as media is read from it later on.
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		err = gtserror.Newf("error seeking import file: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if _, err := p.state.Storage.PutStream(ctx, importArchivePath(imp), file); err != nil {
+		err = gtserror.Newf("error storing import archive: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.PutImport(ctx, imp); err != nil {
+		err = gtserror.Newf("db error putting import: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process the import async.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectCollection,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       imp,
+		Origin:         requestingAccount,
+	})
+
+	return p.apiImport(ctx, imp)
+}
+
+// importArchive processes the outbox of an account
+// archive import, creating each status in it as a status
+// of the import's account, with its original publish date.
+// If the import is a dry run, nothing is created.
+func (p *Processor) importArchive(ctx context.Context, imp *gtsmodel.Import) error {
+	zr, cleanup, err := p.openImportArchive(ctx, imp)
+	if err != nil {
+		// Nothing we can do
+		// without the archive.
+		imp.State = gtsmodel.ImportStateFailed
+		imp.FinishedAt = time.Now()
+		if err := p.state.DB.UpdateImport(ctx, imp, "state", "finished_at"); err != nil {
+			log.Errorf(ctx, "db error updating import: %v", err)
+		}
+		return err
+	}
+	defer cleanup()
+
+	outbox, err := readArchiveOutbox(zr)
+	if err != nil {
+		return gtserror.Newf("error reading archive outbox: %w", err)
+	}
+
+	imp.State = gtsmodel.ImportStateProcessing
+	if err := p.state.DB.UpdateImport(ctx, imp, "state"); err != nil {
+		return gtserror.Newf("db error updating import: %w", err)
+	}
+
+	// Ensure account populated; we
+	// need settings for language.
+	if err := p.state.DB.PopulateAccount(ctx, imp.Account); err != nil {
+		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
+	}
+
+	importer := &archiveImporter{
+		p:            p,
+		importID:     imp.ID,
+		account:      imp.Account,
+		dryRun:       util.PtrValueOr(imp.DryRun, false),
+		federate:     util.PtrValueOr(imp.Federate, false),
+		context:      outbox.Context,
+		followersURI: readArchiveFollowersURI(zr),
+		files:        make(map[string]*zip.File, len(zr.File)),
+		statuses:     make(map[string]*gtsmodel.Status),
+	}
+
+	for _, f := range zr.File {
+		// Index files by both path and
+		// name, see archiveImporter.file().
+		importer.files[f.Name] = f
+		importer.files[path.Base(f.Name)] = f
+	}
+
+	// Items before this were processed
+	// already, by an interrupted run.
+	resumeFrom := min(imp.ProcessedItems, len(outbox.OrderedItems))
+
+	// Find any statuses imported by an
+	// earlier run, to continue threads.
+	importer.resume(ctx, outbox.OrderedItems[:resumeFrom])
+
+	for _, item := range outbox.OrderedItems[resumeFrom:] {
+		if err := importer.importItem(ctx, item); err != nil {
+			log.Debugf(ctx, "not importing archive item %v: %v", item["id"], err)
+			imp.FailedItems++
+		}
+		imp.ProcessedItems++
+
+		if imp.ProcessedItems%importProgressInterval == 0 {
+			// Store progress so far.
+			if err := p.state.DB.UpdateImport(ctx, imp,
+				"processed_items",
+				"failed_items",
+			); err != nil {
+				log.Errorf(ctx, "db error updating import progress: %v", err)
+			}
+		}
+	}
+
+	if !importer.dryRun {
+		// Statuses were added without going through
+		// the usual side effects, so recount them.
+		if err := p.state.DB.RegenerateAccountStats(ctx, imp.Account); err != nil {
+			log.Errorf(ctx, "db error regenerating account stats: %v", err)
+		}
+	}
+
+	log.Infof(ctx,
+		"finished importing archive %s for account %s: %d of %d items imported",
+		imp.ID, imp.Account.Username,
+		imp.ProcessedItems-imp.FailedItems, imp.TotalItems,
+	)
+
+	// Mark as finished, and drop
+	// the no longer needed archive.
+	imp.State = gtsmodel.ImportStateFinished
+	imp.FinishedAt = time.Now()
+	if err := p.state.DB.UpdateImport(ctx, imp,
+		"state",
+		"processed_items",
+		"failed_items",
+		"finished_at",
+	); err != nil {
+		return gtserror.Newf("db error updating import: %w", err)
+	}
+
+	if err := p.state.Storage.Delete(ctx, importArchivePath(imp)); err != nil {
+		log.Errorf(ctx, "error deleting import archive: %v", err)
+	}
+
+	// Imported statuses are only
+	// needed to resume the import.
+	if err := p.state.DB.DeleteImportStatuses(ctx, imp.ID); err != nil {
+		log.Errorf(ctx, "db error deleting import statuses: %v", err)
+	}
+
+	return nil
+}
+
+// openImportArchive copies the stored archive of the given
+// import to a temporary file, as zip files need random access,
+// and opens it. The returned func closes and removes the file.
+func (p *Processor) openImportArchive(ctx context.Context, imp *gtsmodel.Import) (*zip.Reader, func(), error) {
+	rc, err := p.state.Storage.GetStream(ctx, importArchivePath(imp))
+	if err != nil {
+		return nil, nil, gtserror.Newf("error getting import archive from storage: %w", err)
+	}
+	defer rc.Close()
+
+	tmp, err := os.CreateTemp("", "gotosocial-import-*.zip")
+	if err != nil {
+		return nil, nil, gtserror.Newf("error creating temporary file: %w", err)
+	}
+
+	cleanup := func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+
+	size, err := io.Copy(tmp, rc)
+	if err != nil {
+		cleanup()
+		return nil, nil, gtserror.Newf("error copying import archive: %w", err)
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		cleanup()
+		return nil, nil, gtserror.Newf("error reading import archive: %w", err)
+	}
+
+	return zr, cleanup, nil
+}
+
+// archiveImporter imports the items of one account
+// archive's outbox as statuses of the given account.
+type archiveImporter struct {
+	p        *Processor
+	importID string
+	account  *gtsmodel.Account
+	dryRun   bool
+	federate bool
+
+	// JSON-LD context of the outbox,
+	// for items that don't have their own.
+	context any
+
+	// Followers collection URI of the archive's
+	// actor, used to work out status visibility.
+	followersURI string
+
+	// Files in the archive, by full
+	// path, and by base name.
+	files map[string]*zip.File
+
+	// Imported statuses by their
+	// original URI, for threading.
+	statuses map[string]*gtsmodel.Status
+}
+
+// resume restores the statuses imported by an earlier,
+// interrupted run of the import, given the outbox items it
+// processed, so that replies to them can still be imported.
+func (i *archiveImporter) resume(ctx context.Context, items []map[string]any) {
+	if i.dryRun {
+		// Nothing was created, so just
+		// go through the items again.
+		for _, item := range items {
+			_ = i.importItem(ctx, item)
+		}
+		return
+	}
+
+	importStatuses, err := i.p.state.DB.GetImportStatuses(ctx, i.importID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting import statuses: %v", err)
+		return
+	}
+
+	for _, importStatus := range importStatuses {
+		status, err := i.p.state.DB.GetStatusByID(ctx, importStatus.StatusID)
+		if err != nil {
+			// Most likely deleted since.
+			log.Debugf(ctx, "error getting imported status %s: %v", importStatus.StatusID, err)
+			continue
+		}
+
+		i.statuses[importStatus.URI] = status
+	}
+}
+
+// importItem imports the statusable object of one
+// outbox item, if it's a Create that can be imported.
+func (i *archiveImporter) importItem(ctx context.Context, item map[string]any) error {
+	if t, _ := item["type"].(string); t != ap.ActivityCreate {
+		// Most likely a boost.
+		return fmt.Errorf("item type %s not supported", t)
+	}
+
+	object, ok := item["object"].(map[string]any)
+	if !ok {
+		return errors.New("item has no embedded object")
+	}
+
+	if _, ok := object["@context"]; !ok {
+		// Items inherit the outbox context,
+		// but the object is resolved alone.
+		object["@context"] = i.context
+	}
+
+	b, err := json.Marshal(object)
+	if err != nil {
+		return gtserror.Newf("error marshaling object: %w", err)
+	}
+
+	statusable, err := ap.ResolveStatusable(ctx, io.NopCloser(bytes.NewReader(b)))
+	if err != nil {
+		return err
+	}
+
+	// Original URI of the status. ResolveStatusable
+	// doesn't check this, but we need it for threads.
+	uri := ap.GetJSONLDId(statusable)
+	if uri == nil {
+		return errors.New("status has no id")
+	}
+	oldURI := uri.String()
+
+	if _, ok := i.statuses[oldURI]; ok {
+		// Already imported by an interrupted run,
+		// after its progress was last stored.
+		return nil
+	}
+
+	published := ap.GetPublished(statusable)
+	if published.IsZero() {
+		return errors.New("status has no published time")
+	}
+
+	visibility, err := ap.ExtractVisibility(statusable, i.followersURI)
+	if err != nil {
+		return err
+	}
+
+	if visibility == gtsmodel.VisibilityDirect {
+		// Recipients of direct messages
+		// wouldn't be able to see them.
+		return errors.New("direct messages not supported")
+	}
+
+	// Only replies to statuses which we've already
+	// imported (ie., threads) can be imported, since
+	// the original account's statuses would be gone.
+	var inReplyTo *gtsmodel.Status
+	if inReplyToURIs := ap.GetInReplyTo(statusable); len(inReplyToURIs) > 0 {
+		inReplyTo = i.statuses[inReplyToURIs[0].String()]
+		if inReplyTo == nil {
+			return fmt.Errorf("status replies to %s, which was not imported", inReplyToURIs[0])
+		}
+	}
+
+	attachments, err := ap.ExtractAttachments(statusable)
+	if err != nil {
+		log.Warnf(ctx, "error(s) extracting attachments for %s: %v", oldURI, err)
+	}
+
+	if i.dryRun {
+		for _, attachment := range attachments {
+			if i.file(attachment.RemoteURL) == nil {
+				log.Warnf(ctx, "attachment %s of %s not found in archive", attachment.RemoteURL, oldURI)
+			}
+		}
+
+		// Just note that we would
+		// have imported the status.
+		i.statuses[oldURI] = &gtsmodel.Status{}
+		return nil
+	}
+
+	status, err := i.createStatus(ctx, statusable, published, visibility, inReplyTo, attachments)
+	if err != nil {
+		return err
+	}
+
+	i.statuses[oldURI] = status
+
+	// Store the original URI of the status,
+	// in case the import has to be resumed.
+	if err := i.p.state.DB.PutImportStatus(ctx, &gtsmodel.ImportStatus{
+		ID:       id.NewULID(),
+		ImportID: i.importID,
+		URI:      oldURI,
+		StatusID: status.ID,
+	}); err != nil {
+		log.Errorf(ctx, "db error putting import status %s: %v", status.ID, err)
+	}
+
+	if i.federate {
+		if err := i.federateStatus(ctx, status); err != nil {
+			log.Errorf(ctx, "error federating imported status %s: %v", status.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// createStatus creates a new status of the importing account
+// from the given statusable, and inserts it in the database.
+func (i *archiveImporter) createStatus(
+	ctx context.Context,
+	statusable ap.Statusable,
+	published time.Time,
+	visibility gtsmodel.Visibility,
+	inReplyTo *gtsmodel.Status,
+	attachments []*gtsmodel.MediaAttachment,
+) (*gtsmodel.Status, error) {
+	// Generate new ID for status from its
+	// publish time, so that it sorts in
+	// timelines as it did originally.
+	statusID, err := id.NewULIDFromTime(published)
+	if err != nil {
+		return nil, gtserror.Newf("error generating status id: %w", err)
+	}
+
+	accountURIs := uris.GenerateURIsForAccount(i.account.Username)
+	sensitive := ap.ExtractSensitive(statusable)
+
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 accountURIs.StatusesURI + "/" + statusID,
+		URL:                 accountURIs.StatusesURL + "/" + statusID,
+		CreatedAt:           published,
+		UpdatedAt:           published,
+		Local:               util.Ptr(true),
+		Account:             i.account,
+		AccountID:           i.account.ID,
+		AccountURI:          i.account.URI,
+		ActivityStreamsType: ap.ObjectNote,
+		Sensitive:           &sensitive,
+		Visibility:          visibility,
+		Federated:           util.Ptr(true),
+		PendingApproval:     util.Ptr(false),
+	}
+
+	status.Content, status.Language = typeutils.ContentToContentLanguage(
+		ctx,
+		ap.ExtractContent(statusable),
+	)
+	if status.Language == "" && i.account.Settings != nil {
+		status.Language = i.account.Settings.Language
+	}
+
+	if summary := ap.ExtractSummary(statusable); summary != "" {
+		status.ContentWarning = summary
+	} else {
+		status.ContentWarning = ap.ExtractName(statusable)
+	}
+
+	if inReplyTo != nil {
+		// Continue the imported thread.
+		status.InReplyToID = inReplyTo.ID
+		status.InReplyTo = inReplyTo
+		status.InReplyToURI = inReplyTo.URI
+		status.InReplyToAccountID = inReplyTo.AccountID
+		status.ThreadID = inReplyTo.ThreadID
+	} else {
+		status.ThreadID = id.NewULID()
+		if err := i.p.state.DB.PutThread(ctx, &gtsmodel.Thread{
+			ID: status.ThreadID,
+		}); err != nil {
+			return nil, gtserror.Newf("error inserting new thread in db: %w", err)
+		}
+	}
+
+	for _, a := range attachments {
+		attachment, err := i.createAttachment(ctx, status, a)
+		if err != nil {
+			// Import the status
+			// without this media.
+			log.Warnf(ctx, "error importing attachment %s: %v", a.RemoteURL, err)
+			continue
+		}
+
+		status.Attachments = append(status.Attachments, attachment)
+		status.AttachmentIDs = append(status.AttachmentIDs, attachment.ID)
+	}
+
+	if err := i.p.state.DB.PutStatus(ctx, status); err != nil {
+		return nil, gtserror.Newf("error inserting status in db: %w", err)
+	}
+
+	return status, nil
+}
+
+// createAttachment processes the archived file of the
+// given extracted attachment as media for the status.
+func (i *archiveImporter) createAttachment(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	a *gtsmodel.MediaAttachment,
+) (*gtsmodel.MediaAttachment, error) {
+	f := i.file(a.RemoteURL)
+	if f == nil {
+		return nil, errors.New("file not found in archive")
+	}
+
+	data := func(context.Context) (io.ReadCloser, int64, error) {
+		rc, err := f.Open()
+		return rc, int64(f.UncompressedSize64), err // #nosec G115 -- Zip sizes are well within int64.
+	}
+
+	processing := i.p.mediaManager.PreProcessMedia(data, i.account.ID, &media.AdditionalMediaInfo{
+		CreatedAt:   &status.CreatedAt,
+		StatusID:    &status.ID,
+		Description: &a.Description,
+		Blurhash:    &a.Blurhash,
+	})
+
+	attachment, err := processing.LoadAttachment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.Type == gtsmodel.FileTypeUnknown {
+		return nil, fmt.Errorf("unsupported file type %s", attachment.File.ContentType)
+	}
+
+	return attachment, nil
+}
+
+// federateStatus sends a Create for the imported
+// status via the importing account's outbox.
+func (i *archiveImporter) federateStatus(ctx context.Context, status *gtsmodel.Status) error {
+	outboxIRI, err := url.Parse(i.account.OutboxURI)
+	if err != nil {
+		return gtserror.Newf("error parsing outbox uri: %w", err)
+	}
+
+	statusable, err := i.p.converter.StatusToAS(ctx, status)
+	if err != nil {
+		return gtserror.Newf("error converting status to Statusable: %w", err)
+	}
+
+	create := typeutils.WrapStatusableInCreate(statusable, false)
+	if _, err := i.p.federator.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
+		return gtserror.Newf("error sending Create activity via outbox %s: %w", outboxIRI, err)
+	}
+
+	return nil
+}
+
+// file returns the archived file for the given media URL.
+// Mastodon archives use the URL path as path in the archive,
+// while other archives (including our own) store media under
+// another path, so fall back to matching the file name only.
+func (i *archiveImporter) file(mediaURL string) *zip.File {
+	u, err := url.Parse(mediaURL)
+	if err != nil {
+		return nil
+	}
+
+	if f := i.files[strings.TrimPrefix(u.Path, "/")]; f != nil {
+		return f
+	}
+
+	return i.files[path.Base(u.Path)]
+}
+
+// readArchiveUpload opens the uploaded file as
+// a zip archive, and reads the outbox from it.
+func readArchiveUpload(file multipart.File, size int64) (*archiveOutbox, error) {
+	zr, err := zip.NewReader(file, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return readArchiveOutbox(zr)
+}
+
+// readArchiveOutbox reads and decodes
+// the outbox file from the given archive.
+func readArchiveOutbox(zr *zip.Reader) (*archiveOutbox, error) {
+	r, err := openArchiveFile(zr, archiveOutboxFile, maxArchiveOutboxSize)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var outbox archiveOutbox
+	if err := json.NewDecoder(r).Decode(&outbox); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", archiveOutboxFile, err)
+	}
+
+	return &outbox, nil
+}
+
+// readArchiveFollowersURI returns the followers collection
+// URI of the actor in the given archive, or an empty string.
+func readArchiveFollowersURI(zr *zip.Reader) string {
+	r, err := openArchiveFile(zr, archiveActorFile, maxArchiveActorSize)
+	if err != nil {
+		return ""
+	}
+	defer r.Close()
+
+	var actor struct {
+		Followers string `json:"followers"`
+	}
+	if err := json.NewDecoder(r).Decode(&actor); err != nil {
+		return ""
+	}
+
+	return actor.Followers
+}
+
+// openArchiveFile opens the file with the given name in
+// the given archive, rejecting it if its decompressed size
+// is over maxSize. Reads are limited to maxSize too, in case
+// the size recorded in the archive doesn't match the data.
+func openArchiveFile(zr *zip.Reader, name string, maxSize int64) (io.ReadCloser, error) {
+	f, err := zr.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if info.Size() > maxSize {
+		f.Close()
+		return nil, fmt.Errorf("%s too large, max size is %d bytes", name, maxSize)
+	}
+
+	return iotools.ReadFnCloser(io.LimitReader(f, maxSize), f.Close), nil
+}
+
+// importArchivePath returns the storage
+// path of the given import's archive.
+func importArchivePath(imp *gtsmodel.Import) string {
+	return imp.AccountID + "/import/" + imp.ID + ".zip"
+}
@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// DefaultInteractionPoliciesGet returns the default interaction
+// policies of the requester, one per visibility level, falling
+// back to instance defaults where the requester has set none.
+func (p *Processor) DefaultInteractionPoliciesGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.DefaultPolicies, gtserror.WithCode) {
+	return p.converter.DefaultPoliciesToAPIDefaultPolicies(requester.Settings), nil
+}
+
+// DefaultInteractionPoliciesUpdate updates the default interaction
+// policies of the requester. Policies left nil in the form are
+// reset to the instance default for that visibility level.
+func (p *Processor) DefaultInteractionPoliciesUpdate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.UpdateInteractionPoliciesRequest,
+) (*apimodel.DefaultPolicies, gtserror.WithCode) {
+	var (
+		settings = requester.Settings
+		err      error
+	)
+
+	for _, update := range []struct {
+		name   string
+		form   *apimodel.InteractionPolicy
+		policy **gtsmodel.InteractionPolicy
+	}{
+		{"direct", form.Direct, &settings.InteractionPolicyDirect},
+		{"mutuals_only", form.MutualsOnly, &settings.InteractionPolicyMutualsOnly},
+		{"private", form.Private, &settings.InteractionPolicyFollowersOnly},
+		{"unlisted", form.Unlisted, &settings.InteractionPolicyUnlocked},
+		{"public", form.Public, &settings.InteractionPolicyPublic},
+	} {
+		if update.form == nil {
+			// Reset to default.
+			*update.policy = nil
+			continue
+		}
+
+		*update.policy, err = typeutils.APIInteractionPolicyToInteractionPolicy(update.form)
+		if err != nil {
+			err := fmt.Errorf("invalid %s policy: %w", update.name, err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	if err := p.state.DB.UpdateAccountSettings(ctx,
+		settings,
+		"interaction_policy_direct",
+		"interaction_policy_mutuals_only",
+		"interaction_policy_followers_only",
+		"interaction_policy_unlocked",
+		"interaction_policy_public",
+	); err != nil {
+		err := gtserror.Newf("db error updating account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.DefaultPoliciesToAPIDefaultPolicies(settings), nil
+}
@@ -254,6 +254,35 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 
 			account.Settings.StatusContentType = *form.Source.StatusContentType
 		}
+
+		if form.Source.EmailNotifications != nil {
+			notify := make(map[string]bool, len(*form.Source.EmailNotifications))
+			for _, notificationType := range *form.Source.EmailNotifications {
+				if notificationType == "" {
+					// Allow a single empty
+					// string to unset all.
+					continue
+				}
+
+				if err := validate.EmailNotificationType(notificationType); err != nil {
+					return nil, gtserror.NewErrorBadRequest(err, err.Error())
+				}
+				notify[notificationType] = true
+			}
+
+			account.Settings.EmailNotifyMention = util.Ptr(notify["mention"])
+			account.Settings.EmailNotifyDirect = util.Ptr(notify["direct"])
+			account.Settings.EmailNotifyFollow = util.Ptr(notify["follow"])
+			account.Settings.EmailNotifyFollowRequest = util.Ptr(notify["follow_request"])
+		}
+
+		if form.Source.EmailDigest != nil {
+			if err := validate.EmailDigest(*form.Source.EmailDigest); err != nil {
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+
+			account.Settings.EmailDigest = gtsmodel.EmailDigest(*form.Source.EmailDigest)
+		}
 	}
 
 	if form.Theme != nil {
@@ -77,6 +77,71 @@ func (suite *AccountTestSuite) TestAccountActionSuspend() {
 	suite.NotZero(targetAcct.SuspendedAt)
 }
 
+func (suite *AccountTestSuite) TestAccountActionSilenceUnsilence() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		targetID  = suite.testAccounts["remote_account_1"].ID
+	)
+
+	for _, test := range []struct {
+		actionType gtsmodel.AdminActionType
+		silenced   bool
+	}{
+		{gtsmodel.AdminActionSilence, true},
+		{gtsmodel.AdminActionUnsilence, false},
+	} {
+		actionID, errWithCode := suite.adminProcessor.AccountAction(
+			ctx,
+			adminAcct,
+			&apimodel.AdminActionRequest{
+				Category: gtsmodel.AdminActionCategoryAccount.String(),
+				Type:     test.actionType.String(),
+				Text:     "shhh",
+				TargetID: targetID,
+			},
+		)
+		suite.NoError(errWithCode)
+		suite.NotEmpty(actionID)
+
+		// Wait for action to finish.
+		if !testrig.WaitFor(func() bool {
+			return suite.adminProcessor.Actions().TotalRunning() == 0
+		}) {
+			suite.FailNow("timed out waiting for admin action(s) to finish")
+		}
+
+		// Ensure target account (un)silenced.
+		targetAcct, err := suite.db.GetAccountByID(ctx, targetID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.silenced, targetAcct.IsSilenced())
+	}
+}
+
+func (suite *AccountTestSuite) TestAccountActionDisableRemote() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionDisable.String(),
+			Text:     "stinky",
+			TargetID: suite.testAccounts["remote_account_1"].ID,
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.EqualError(errWithCode, "admin action type disable is only supported for local accounts")
+	suite.Empty(actionID)
+}
+
 func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 	var (
 		ctx       = context.Background()
@@ -94,7 +159,7 @@ func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 		adminAcct,
 		request,
 	)
-	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\"]")
+	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"none\" \"sensitive\" \"unsensitive\" \"disable\" \"reenable\" \"silence\" \"unsilence\" \"suspend\"]")
 	suite.Empty(actionID)
 }
 
@@ -20,6 +20,7 @@ package admin
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -27,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 func (p *Processor) AccountAction(
@@ -40,14 +42,34 @@ func (p *Processor) AccountAction(
 		return "", gtserror.NewErrorInternalError(err)
 	}
 
-	switch gtsmodel.NewAdminActionType(request.Type) {
+	switch actionType := gtsmodel.NewAdminActionType(request.Type); actionType {
 	case gtsmodel.AdminActionSuspend:
 		return p.accountActionSuspend(ctx, adminAcct, targetAcct, request.Text)
 
+	case gtsmodel.AdminActionSilence,
+		gtsmodel.AdminActionUnsilence,
+		gtsmodel.AdminActionSensitize,
+		gtsmodel.AdminActionUnsensitize:
+		return p.accountActionLimit(ctx, adminAcct, targetAcct, actionType, request.Text)
+
+	case gtsmodel.AdminActionDisable,
+		gtsmodel.AdminActionReenable:
+		return p.accountActionDisable(ctx, adminAcct, targetAcct, actionType, request.Text)
+
+	case gtsmodel.AdminActionNone:
+		return p.accountActionNone(ctx, adminAcct, targetAcct, request.Text)
+
 	default:
 		// TODO: add more types to this slice when adding
 		//       more types to the switch statement above.
 		supportedTypes := []string{
+			gtsmodel.AdminActionNone.String(),
+			gtsmodel.AdminActionSensitize.String(),
+			gtsmodel.AdminActionUnsensitize.String(),
+			gtsmodel.AdminActionDisable.String(),
+			gtsmodel.AdminActionReenable.String(),
+			gtsmodel.AdminActionSilence.String(),
+			gtsmodel.AdminActionUnsilence.String(),
 			gtsmodel.AdminActionSuspend.String(),
 		}
 
@@ -100,3 +122,147 @@ func (p *Processor) accountActionSuspend(
 
 	return actionID, errWithCode
 }
+
+// accountActionLimit silences / unsilences or sensitizes /
+// unsensitizes the target account, which may be local or remote.
+func (p *Processor) accountActionLimit(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	actionType gtsmodel.AdminActionType,
+	text string,
+) (string, gtserror.WithCode) {
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           actionType,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			var column string
+
+			switch actionType {
+			case gtsmodel.AdminActionSilence:
+				targetAcct.SilencedAt = time.Now()
+				column = "silenced_at"
+			case gtsmodel.AdminActionUnsilence:
+				targetAcct.SilencedAt = time.Time{}
+				column = "silenced_at"
+			case gtsmodel.AdminActionSensitize:
+				targetAcct.SensitizedAt = time.Now()
+				column = "sensitized_at"
+			case gtsmodel.AdminActionUnsensitize:
+				targetAcct.SensitizedAt = time.Time{}
+				column = "sensitized_at"
+			}
+
+			if err := p.state.DB.UpdateAccount(ctx, targetAcct, column); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(gtserror.Newf("db error updating account %s: %w", targetAcct.ID, err))
+				return errs
+			}
+
+			// Status visibility is cached per status,
+			// so wipe the cache to ensure that changed
+			// visibility of this account's statuses is
+			// picked up straight away.
+			p.state.Caches.Visibility.Clear()
+
+			return nil
+		},
+	)
+
+	return actionID, errWithCode
+}
+
+// accountActionDisable disables or reenables the
+// target account, which must be a local account.
+func (p *Processor) accountActionDisable(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	actionType gtsmodel.AdminActionType,
+	text string,
+) (string, gtserror.WithCode) {
+	if !targetAcct.IsLocal() {
+		err := fmt.Errorf("admin action type %s is only supported for local accounts", actionType)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", targetAcct.ID, err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           actionType,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			user.Disabled = util.Ptr(actionType == gtsmodel.AdminActionDisable)
+			if err := p.state.DB.UpdateUser(ctx, user, "disabled"); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(gtserror.Newf("db error updating user %s: %w", user.ID, err))
+				return errs
+			}
+
+			// Status visibility is cached per status,
+			// so wipe the cache to ensure that changed
+			// visibility of this account's statuses is
+			// picked up straight away.
+			p.state.Caches.Visibility.Clear()
+
+			return nil
+		},
+	)
+
+	return actionID, errWithCode
+}
+
+// accountActionNone records a warning against the
+// target account, without taking any other action.
+func (p *Processor) accountActionNone(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	text string,
+) (string, gtserror.WithCode) {
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionNone,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			// Nothing to do, the action
+			// itself serves as the record.
+			return nil
+		},
+	)
+
+	return actionID, errWithCode
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
@@ -34,6 +36,9 @@ type Processor struct {
 	mediaManager        *media.Manager
 	transportController transport.Controller
 	emailSender         email.Sender
+	stream              *stream.Processor
+	formatter           *text.Formatter
+	parseMention        gtsmodel.ParseMentionFunc
 
 	// admin Actions currently
 	// undergoing processing
@@ -52,6 +57,8 @@ func New(
 	mediaManager *media.Manager,
 	transportController transport.Controller,
 	emailSender email.Sender,
+	stream *stream.Processor,
+	parseMention gtsmodel.ParseMentionFunc,
 ) Processor {
 	return Processor{
 		state:               state,
@@ -60,6 +67,9 @@ func New(
 		mediaManager:        mediaManager,
 		transportController: transportController,
 		emailSender:         emailSender,
+		stream:              stream,
+		formatter:           text.NewFormatter(state.DB),
+		parseMention:        parseMention,
 
 		actions: &Actions{
 			r:     make(map[string]*gtsmodel.AdminAction),
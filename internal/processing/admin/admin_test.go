@@ -114,6 +114,8 @@ func (suite *AdminStandardTestSuite) SetupTest() {
 		suite.mediaManager,
 		&suite.state,
 		suite.emailSender,
+		testrig.NewNoopWebPushSender(),
+		testrig.NewTestCardFetcher(&suite.state, suite.mediaManager),
 	)
 
 	testrig.StartWorkers(&suite.state, suite.processor.Workers())
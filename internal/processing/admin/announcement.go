@@ -0,0 +1,297 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// AnnouncementsGet returns all announcements on
+// this instance, published or not, newest first.
+func (p *Processor) AnnouncementsGet(ctx context.Context) ([]*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.AdminAnnouncement, 0, len(announcements))
+	for _, announcement := range announcements {
+		apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// AnnouncementGet returns the announcement with the given ID.
+func (p *Processor) AnnouncementGet(ctx context.Context, id string) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAnnouncement(ctx, announcement)
+}
+
+// AnnouncementCreate creates a new announcement from the given form.
+// Unless the form says otherwise, the announcement is published to
+// users straight away, and streamed to those currently connected.
+func (p *Processor) AnnouncementCreate(ctx context.Context, form *apimodel.AnnouncementRequest) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	if form.Text == nil {
+		const text = "text must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	announcement := &gtsmodel.Announcement{
+		ID:     id.NewULID(),
+		AllDay: util.Ptr(false),
+	}
+
+	if errWithCode := p.applyAnnouncementForm(ctx, announcement, form); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.Published == nil || *form.Published {
+		announcement.PublishedAt = time.Now()
+	}
+
+	if err := p.state.DB.PutAnnouncement(ctx, announcement); err != nil {
+		err := gtserror.Newf("db error putting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.streamAnnouncement(ctx, announcement, false)
+
+	return p.apiAnnouncement(ctx, announcement)
+}
+
+// AnnouncementUpdate updates the announcement with the given ID
+// using the given form; only fields that are set are updated.
+// Users currently connected are streamed the updated announcement,
+// or its deletion if it's been unpublished.
+func (p *Processor) AnnouncementUpdate(ctx context.Context, id string, form *apimodel.AnnouncementRequest) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	wasShown := announcement.IsPublished() &&
+		!announcement.IsEnded(time.Now())
+
+	if errWithCode := p.applyAnnouncementForm(ctx, announcement, form); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.Published != nil {
+		switch {
+		case *form.Published && !announcement.IsPublished():
+			announcement.PublishedAt = time.Now()
+		case !*form.Published:
+			announcement.PublishedAt = time.Time{}
+		}
+	}
+
+	if err := p.state.DB.UpdateAnnouncement(ctx, announcement); err != nil {
+		err := gtserror.Newf("db error updating announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.streamAnnouncement(ctx, announcement, wasShown)
+
+	return p.apiAnnouncement(ctx, announcement)
+}
+
+// AnnouncementDelete deletes the announcement with the given ID,
+// along with all reactions to it, returning the deleted announcement.
+// Users currently connected are streamed the announcement's deletion.
+func (p *Processor) AnnouncementDelete(ctx context.Context, id string) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deleting,
+	// while reactions still exist.
+	apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteAnnouncementByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement.IsPublished() {
+		p.stream.AnnouncementDelete(ctx, id)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// applyAnnouncementForm sets the fields present in the
+// given form on the given (new or existing) announcement.
+func (p *Processor) applyAnnouncementForm(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+	form *apimodel.AnnouncementRequest,
+) gtserror.WithCode {
+	if form.Text != nil {
+		if *form.Text == "" {
+			const text = "text must not be empty"
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		instanceAcc, err := p.state.DB.GetInstanceAccount(ctx, "")
+		if err != nil {
+			err := gtserror.Newf("db error getting instance account: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		// Announcements are formatted
+		// like the instance description.
+		formatted := p.formatter.FromMarkdown(ctx,
+			p.parseMention,
+			instanceAcc.ID,
+			"",
+			*form.Text,
+		)
+
+		announcement.Text = *form.Text
+		announcement.Content = formatted.HTML
+		announcement.Emojis = formatted.Emojis
+		announcement.EmojiIDs = make([]string, len(formatted.Emojis))
+		for i, emoji := range formatted.Emojis {
+			announcement.EmojiIDs[i] = emoji.ID
+		}
+	}
+
+	if form.StartsAt != nil {
+		startsAt, errWithCode := parseAnnouncementTime("starts_at", *form.StartsAt)
+		if errWithCode != nil {
+			return errWithCode
+		}
+		announcement.StartsAt = startsAt
+	}
+
+	if form.EndsAt != nil {
+		endsAt, errWithCode := parseAnnouncementTime("ends_at", *form.EndsAt)
+		if errWithCode != nil {
+			return errWithCode
+		}
+		announcement.EndsAt = endsAt
+	}
+
+	if form.AllDay != nil {
+		announcement.AllDay = form.AllDay
+	}
+
+	if !announcement.StartsAt.IsZero() &&
+		!announcement.EndsAt.IsZero() &&
+		announcement.EndsAt.Before(announcement.StartsAt) {
+		const text = "ends_at must not be before starts_at"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// parseAnnouncementTime parses the given announcement
+// time field value, where empty means no time set.
+func parseAnnouncementTime(field string, value string) (time.Time, gtserror.WithCode) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		text := fmt.Sprintf("could not parse %s %q as ISO 8601 datetime", field, value)
+		return time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return t, nil
+}
+
+// streamAnnouncement streams the given announcement to
+// connected users if it's now shown to them, or else its
+// deletion if it was shown to them before it was changed.
+func (p *Processor) streamAnnouncement(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+	wasShown bool,
+) {
+	if !announcement.IsPublished() ||
+		announcement.IsEnded(time.Now()) {
+		if wasShown {
+			p.stream.AnnouncementDelete(ctx, announcement.ID)
+		}
+		return
+	}
+
+	apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, nil)
+	if err != nil {
+		log.Errorf(ctx, "error converting announcement %s: %v", announcement.ID, err)
+		return
+	}
+
+	p.stream.Announcement(ctx, apiAnnouncement)
+}
+
+// getAnnouncement gets the announcement with the given
+// ID, returning a not found error if there's no such one.
+func (p *Processor) getAnnouncement(ctx context.Context, id string) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement == nil {
+		err := gtserror.Newf("announcement %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return announcement, nil
+}
+
+// apiAnnouncement converts the given announcement
+// into its admin api representation.
+func (p *Processor) apiAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	apiAnnouncement, err := p.converter.AnnouncementToAdminAPIAnnouncement(ctx, announcement)
+	if err != nil {
+		err := gtserror.Newf("error converting announcement %s: %w", announcement.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAnnouncement, nil
+}
@@ -0,0 +1,198 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AnnouncementTestSuite struct {
+	AdminStandardTestSuite
+}
+
+// openStream opens a home timeline stream for local_account_1,
+// on which announcement events are expected to be streamed.
+func (suite *AnnouncementTestSuite) openStream() *stream.Stream {
+	wssStream, errWithCode := suite.processor.Stream().Open(
+		context.Background(),
+		suite.testAccounts["local_account_1"],
+		stream.TimelineHome,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	return wssStream
+}
+
+func (suite *AnnouncementTestSuite) recv(wssStream *stream.Stream) stream.Message {
+	ctx, cncl := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cncl()
+
+	msg, ok := wssStream.Recv(ctx)
+	if !ok {
+		suite.FailNow("timed out waiting for streamed message")
+	}
+	suite.Equal([]string{stream.TimelineHome}, msg.Stream)
+	return msg
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementCreate() {
+	var (
+		ctx       = context.Background()
+		wssStream = suite.openStream()
+	)
+	defer wssStream.Close()
+
+	apiAnnouncement, errWithCode := suite.adminProcessor.AnnouncementCreate(ctx, &apimodel.AnnouncementRequest{
+		Text:     util.Ptr("Back up now, thanks for waiting! :rainbow:"),
+		StartsAt: util.Ptr("2024-08-21T08:00:00+02:00"),
+		EndsAt:   util.Ptr("2124-08-22T08:00:00+02:00"),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("Back up now, thanks for waiting! :rainbow:", apiAnnouncement.Text)
+	suite.Equal("<p>Back up now, thanks for waiting! :rainbow:</p>", apiAnnouncement.Content)
+	suite.Equal("2024-08-21T06:00:00.000Z", apiAnnouncement.StartsAt)
+	suite.Equal("2124-08-22T06:00:00.000Z", apiAnnouncement.EndsAt)
+	suite.True(apiAnnouncement.Published)
+	suite.Len(apiAnnouncement.Emojis, 1)
+
+	// New announcement should have been streamed.
+	msg := suite.recv(wssStream)
+	suite.Equal(stream.EventTypeAnnouncement, msg.Event)
+
+	streamed := &apimodel.Announcement{}
+	if err := json.Unmarshal([]byte(msg.Payload), streamed); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(apiAnnouncement.ID, streamed.ID)
+	suite.Equal(apiAnnouncement.Content, streamed.Content)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementCreateInvalid() {
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		form *apimodel.AnnouncementRequest
+		err  string
+	}{
+		{
+			form: &apimodel.AnnouncementRequest{},
+			err:  "Bad Request: text must be set",
+		},
+		{
+			form: &apimodel.AnnouncementRequest{
+				Text:     util.Ptr("hello"),
+				StartsAt: util.Ptr("tomorrow"),
+			},
+			err: "Bad Request: could not parse starts_at \"tomorrow\" as ISO 8601 datetime",
+		},
+		{
+			form: &apimodel.AnnouncementRequest{
+				Text:     util.Ptr("hello"),
+				StartsAt: util.Ptr("2024-08-21T08:00:00Z"),
+				EndsAt:   util.Ptr("2024-08-20T08:00:00Z"),
+			},
+			err: "Bad Request: ends_at must not be before starts_at",
+		},
+	} {
+		_, errWithCode := suite.adminProcessor.AnnouncementCreate(ctx, test.form)
+		if suite.NotNil(errWithCode) {
+			suite.Equal(http.StatusBadRequest, errWithCode.Code())
+			suite.Equal(test.err, errWithCode.Safe())
+		}
+	}
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementPublishDraft() {
+	var (
+		ctx       = context.Background()
+		draft     = testrig.NewTestAnnouncements()["draft"]
+		wssStream = suite.openStream()
+	)
+	defer wssStream.Close()
+
+	apiAnnouncement, errWithCode := suite.adminProcessor.AnnouncementUpdate(ctx, draft.ID, &apimodel.AnnouncementRequest{
+		Published: util.Ptr(true),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.True(apiAnnouncement.Published)
+	suite.Equal(draft.Text, apiAnnouncement.Text)
+
+	msg := suite.recv(wssStream)
+	suite.Equal(stream.EventTypeAnnouncement, msg.Event)
+
+	// Unpublishing removes it again.
+	apiAnnouncement, errWithCode = suite.adminProcessor.AnnouncementUpdate(ctx, draft.ID, &apimodel.AnnouncementRequest{
+		Published: util.Ptr(false),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.False(apiAnnouncement.Published)
+
+	msg = suite.recv(wssStream)
+	suite.Equal(stream.EventTypeAnnouncementDelete, msg.Event)
+	suite.Equal(draft.ID, msg.Payload)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementDelete() {
+	var (
+		ctx       = context.Background()
+		published = testrig.NewTestAnnouncements()["published"]
+		wssStream = suite.openStream()
+	)
+	defer wssStream.Close()
+
+	apiAnnouncement, errWithCode := suite.adminProcessor.AnnouncementDelete(ctx, published.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Returned announcement still has its reactions.
+	suite.Len(apiAnnouncement.Reactions, 2)
+
+	msg := suite.recv(wssStream)
+	suite.Equal(stream.EventTypeAnnouncementDelete, msg.Event)
+	suite.Equal(published.ID, msg.Payload)
+
+	_, err := suite.db.GetAnnouncementByID(ctx, published.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
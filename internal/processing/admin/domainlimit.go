@@ -0,0 +1,213 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"codeberg.org/gruf/go-kv"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *Processor) createDomainLimit(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domain string,
+	obfuscate bool,
+	publicComment string,
+	privateComment string,
+	subscriptionID string,
+	limitOptions *apimodel.DomainLimitOptions,
+) (*apimodel.DomainPermission, string, gtserror.WithCode) {
+	if limitOptions == nil {
+		// No options provided,
+		// use the zero value.
+		limitOptions = new(apimodel.DomainLimitOptions)
+	}
+
+	// Check if a limit already exists for this domain.
+	domainLimit, err := p.state.DB.GetDomainLimit(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Something went wrong in the DB.
+		err = gtserror.Newf("db error getting domain limit %s: %w", domain, err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+
+	if domainLimit == nil {
+		// No limit exists yet, create it.
+		domainLimit = &gtsmodel.DomainLimit{
+			ID:                 id.NewULID(),
+			Domain:             domain,
+			CreatedByAccountID: adminAcct.ID,
+			PrivateComment:     text.SanitizeToPlaintext(privateComment),
+			PublicComment:      text.SanitizeToPlaintext(publicComment),
+			Obfuscate:          &obfuscate,
+			SubscriptionID:     subscriptionID,
+			ContentWarning:     text.SanitizeToPlaintext(limitOptions.ContentWarning),
+			MediaSensitive:     util.Ptr(limitOptions.MediaSensitive),
+			HidePublic:         util.Ptr(limitOptions.HidePublic),
+			RejectMedia:        util.Ptr(limitOptions.RejectMedia),
+		}
+
+		// Insert the new limit into the database.
+		if err := p.state.DB.CreateDomainLimit(ctx, domainLimit); err != nil {
+			err = gtserror.Newf("db error putting domain limit %s: %w", domain, err)
+			return nil, "", gtserror.NewErrorInternalError(err)
+		}
+	} else {
+		// Limit already exists, update
+		// it with the provided options.
+		domainLimit.ContentWarning = text.SanitizeToPlaintext(limitOptions.ContentWarning)
+		domainLimit.MediaSensitive = util.Ptr(limitOptions.MediaSensitive)
+		domainLimit.HidePublic = util.Ptr(limitOptions.HidePublic)
+		domainLimit.RejectMedia = util.Ptr(limitOptions.RejectMedia)
+
+		if err := p.state.DB.UpdateDomainLimit(
+			ctx,
+			domainLimit,
+			"content_warning",
+			"media_sensitive",
+			"hide_public",
+			"reject_media",
+		); err != nil {
+			err = gtserror.Newf("db error updating domain limit %s: %w", domain, err)
+			return nil, "", gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	actionID := id.NewULID()
+
+	// Process domain limit side
+	// effects asynchronously.
+	if errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryDomain,
+			TargetID:       domain,
+			Type:           gtsmodel.AdminActionSilence,
+			AccountID:      adminAcct.ID,
+			Text:           domainLimit.PrivateComment,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			// Log start + finish.
+			l := log.WithFields(kv.Fields{
+				{"domain", domain},
+				{"actionID", actionID},
+			}...).WithContext(ctx)
+
+			l.Info("processing domain limit side effects")
+			defer func() { l.Info("finished processing domain limit side effects") }()
+
+			return p.domainLimitSideEffects(ctx)
+		},
+	); errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	apiDomainLimit, errWithCode := p.apiDomainPerm(ctx, domainLimit, false)
+	if errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	return apiDomainLimit, actionID, nil
+}
+
+// domainLimitSideEffects processes the side effects
+// of creating, updating or removing a domain limit.
+func (p *Processor) domainLimitSideEffects(
+	ctx context.Context,
+) gtserror.MultiError {
+	// Statuses from the limited domain may
+	// have different visibility now, so just
+	// drop all cached visibility results.
+	p.state.Caches.Visibility.Clear()
+
+	return nil
+}
+
+func (p *Processor) deleteDomainLimit(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domainLimitID string,
+) (*apimodel.DomainPermission, string, gtserror.WithCode) {
+	domainLimit, err := p.state.DB.GetDomainLimitByID(ctx, domainLimitID)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// Real error.
+			err = gtserror.Newf("db error getting domain limit: %w", err)
+			return nil, "", gtserror.NewErrorInternalError(err)
+		}
+
+		// There are just no entries for this ID.
+		err = fmt.Errorf("no domain limit entry exists with ID %s", domainLimitID)
+		return nil, "", gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	// Prepare the domain limit to return, *before* the deletion goes through.
+	apiDomainLimit, errWithCode := p.apiDomainPerm(ctx, domainLimit, false)
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	// Delete the original domain limit.
+	if err := p.state.DB.DeleteDomainLimit(ctx, domainLimit.Domain); err != nil {
+		err = gtserror.Newf("db error deleting domain limit: %w", err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+
+	actionID := id.NewULID()
+
+	// Process domain unlimit side
+	// effects asynchronously.
+	if errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryDomain,
+			TargetID:       domainLimit.Domain,
+			Type:           gtsmodel.AdminActionUnsilence,
+			AccountID:      adminAcct.ID,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			// Log start + finish.
+			l := log.WithFields(kv.Fields{
+				{"domain", domainLimit.Domain},
+				{"actionID", actionID},
+			}...).WithContext(ctx)
+
+			l.Info("processing domain unlimit side effects")
+			defer func() { l.Info("finished processing domain unlimit side effects") }()
+
+			return p.domainLimitSideEffects(ctx)
+		},
+	); errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	return apiDomainLimit, actionID, nil
+}
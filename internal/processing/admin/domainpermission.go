@@ -33,7 +33,8 @@ import (
 
 // apiDomainPerm is a cheeky shortcut for returning
 // the API version of the given domain permission
-// (*gtsmodel.DomainBlock or *gtsmodel.DomainAllow),
+// (*gtsmodel.DomainBlock, *gtsmodel.DomainAllow
+// or *gtsmodel.DomainLimit),
 // or an appropriate error if something goes wrong.
 func (p *Processor) apiDomainPerm(
 	ctx context.Context,
@@ -56,6 +57,9 @@ func (p *Processor) apiDomainPerm(
 // If the same permission type already exists for the domain,
 // side effects will be retried.
 //
+// limitOptions are only used when creating a domain limit,
+// and may be nil for other permission types.
+//
 // Return values for this function are the new or existing
 // domain permission, the ID of the admin action resulting
 // from this call, and/or an error if something goes wrong.
@@ -68,6 +72,7 @@ func (p *Processor) DomainPermissionCreate(
 	publicComment string,
 	privateComment string,
 	subscriptionID string,
+	limitOptions *apimodel.DomainLimitOptions,
 ) (*apimodel.DomainPermission, string, gtserror.WithCode) {
 	switch permissionType {
 
@@ -95,6 +100,19 @@ func (p *Processor) DomainPermissionCreate(
 			subscriptionID,
 		)
 
+	// Limit (but don't block) a domain.
+	case gtsmodel.DomainPermissionLimit:
+		return p.createDomainLimit(
+			ctx,
+			adminAcct,
+			domain,
+			obfuscate,
+			publicComment,
+			privateComment,
+			subscriptionID,
+			limitOptions,
+		)
+
 	// Weeping, roaring, red-faced.
 	default:
 		err := gtserror.Newf("unrecognized permission type %d", permissionType)
@@ -131,6 +149,14 @@ func (p *Processor) DomainPermissionDelete(
 			domainBlockID,
 		)
 
+	// Delete domain limit.
+	case gtsmodel.DomainPermissionLimit:
+		return p.deleteDomainLimit(
+			ctx,
+			adminAcct,
+			domainBlockID,
+		)
+
 	// You do the hokey-cokey and you turn
 	// around, that's what it's all about.
 	default:
@@ -158,7 +184,8 @@ func (p *Processor) DomainPermissionsImport(
 ) (*apimodel.MultiStatus, gtserror.WithCode) {
 	// Ensure known permission type.
 	if permissionType != gtsmodel.DomainPermissionBlock &&
-		permissionType != gtsmodel.DomainPermissionAllow {
+		permissionType != gtsmodel.DomainPermissionAllow &&
+		permissionType != gtsmodel.DomainPermissionLimit {
 		err := gtserror.Newf("unrecognized permission type %d", permissionType)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -196,6 +223,7 @@ func (p *Processor) DomainPermissionsImport(
 			publicComment  = domainPerm.PublicComment
 			privateComment = domainPerm.PrivateComment
 			subscriptionID = "" // No sub ID for imports.
+			limitOptions   = domainPerm.Limit
 			errWithCode    gtserror.WithCode
 		)
 
@@ -208,6 +236,7 @@ func (p *Processor) DomainPermissionsImport(
 			publicComment,
 			privateComment,
 			subscriptionID,
+			limitOptions,
 		)
 
 		var entry *apimodel.MultiStatusEntry
@@ -274,6 +303,18 @@ func (p *Processor) DomainPermissionsGet(
 			domainPerms = append(domainPerms, allow)
 		}
 
+	case gtsmodel.DomainPermissionLimit:
+		var limits []*gtsmodel.DomainLimit
+
+		limits, err = p.state.DB.GetDomainLimits(ctx)
+		if err != nil {
+			break
+		}
+
+		for _, limit := range limits {
+			domainPerms = append(domainPerms, limit)
+		}
+
 	default:
 		err = errors.New("unrecognized permission type")
 	}
@@ -317,6 +358,8 @@ func (p *Processor) DomainPermissionGet(
 		domainPerm, err = p.state.DB.GetDomainBlockByID(ctx, id)
 	case gtsmodel.DomainPermissionAllow:
 		domainPerm, err = p.state.DB.GetDomainAllowByID(ctx, id)
+	case gtsmodel.DomainPermissionLimit:
+		domainPerm, err = p.state.DB.GetDomainLimitByID(ctx, id)
 	default:
 		err = gtserror.New("unrecognized permission type")
 	}
@@ -134,6 +134,7 @@ func (suite *DomainBlockTestSuite) createDomainPerm(
 		"",
 		"",
 		"",
+		nil,
 	)
 	suite.NoError(errWithCode)
 	suite.NotNil(apiPerm)
@@ -159,6 +160,8 @@ func (suite *DomainBlockTestSuite) deleteDomainPerm(
 		domainPermission, _ = suite.db.GetDomainBlock(ctx, domain)
 	case gtsmodel.DomainPermissionAllow:
 		domainPermission, _ = suite.db.GetDomainAllow(ctx, domain)
+	case gtsmodel.DomainPermissionLimit:
+		domainPermission, _ = suite.db.GetDomainLimit(ctx, domain)
 	default:
 		panic("unrecognized permission type")
 	}
@@ -526,6 +529,88 @@ func (suite *DomainBlockTestSuite) TestAllowAndBlockDomain() {
 	})
 }
 
+func (suite *DomainBlockTestSuite) TestLimitAndUnlimitDomain() {
+	var (
+		ctx    = context.Background()
+		domain = "fossbros-anonymous.io"
+	)
+
+	// Create a limit with some options set.
+	apiPerm, actionID, errWithCode := suite.adminProcessor.DomainPermissionCreate(
+		ctx,
+		gtsmodel.DomainPermissionLimit,
+		suite.testAccounts["admin_account"],
+		domain,
+		false,
+		"",
+		"",
+		"",
+		&apimodel.DomainLimitOptions{
+			ContentWarning: "fossbros",
+			MediaSensitive: true,
+		},
+	)
+	suite.NoError(errWithCode)
+	suite.awaitAction(actionID)
+
+	suite.Equal(domain, apiPerm.Domain.Domain)
+	suite.Equal(&apimodel.DomainLimitOptions{
+		ContentWarning: "fossbros",
+		MediaSensitive: true,
+	}, apiPerm.Limit)
+
+	// Limit should now apply to the domain.
+	limit, err := suite.db.MatchDomainLimit(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(apiPerm.ID, limit.ID)
+
+	// Creating the limit again should
+	// update the options on the existing one.
+	apiPerm, actionID, errWithCode = suite.adminProcessor.DomainPermissionCreate(
+		ctx,
+		gtsmodel.DomainPermissionLimit,
+		suite.testAccounts["admin_account"],
+		domain,
+		false,
+		"",
+		"",
+		"",
+		&apimodel.DomainLimitOptions{
+			HidePublic:  true,
+			RejectMedia: true,
+		},
+	)
+	suite.NoError(errWithCode)
+	suite.awaitAction(actionID)
+
+	suite.Equal(limit.ID, apiPerm.ID)
+	suite.Equal(&apimodel.DomainLimitOptions{
+		HidePublic:  true,
+		RejectMedia: true,
+	}, apiPerm.Limit)
+
+	limit, err = suite.db.MatchDomainLimit(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(limit.ContentWarning)
+	suite.False(*limit.MediaSensitive)
+	suite.True(*limit.HidePublic)
+	suite.True(*limit.RejectMedia)
+
+	// Remove the limit again.
+	_, actionID = suite.deleteDomainPerm(gtsmodel.DomainPermissionLimit, domain)
+	suite.awaitAction(actionID)
+
+	limit, err = suite.db.MatchDomainLimit(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Nil(limit)
+}
+
 func TestDomainBlockTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainBlockTestSuite))
 }
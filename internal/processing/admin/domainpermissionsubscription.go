@@ -0,0 +1,407 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// apiDomainPermSub is a cheeky shortcut for returning the
+// API version of the given domain permission subscription,
+// or an appropriate error if something goes wrong.
+func (p *Processor) apiDomainPermSub(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	apiPermSub, err := p.converter.DomainPermSubToAPIDomainPermSub(ctx, permSub)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting domain permission subscription to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPermSub, nil
+}
+
+// getDomainPermSub fetches the domain permission
+// subscription with the given ID, returning a
+// not found error if it doesn't exist.
+func (p *Processor) getDomainPermSub(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, err := p.state.DB.GetDomainPermissionSubscriptionByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			err = gtserror.Newf("db error getting domain permission subscription %s: %w", id, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		err = fmt.Errorf("no domain permission subscription exists with ID %s", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return permSub, nil
+}
+
+// validateDomainPermSubURI ensures the
+// given URI is an absolute http(s) URI.
+func validateDomainPermSubURI(uri string) gtserror.WithCode {
+	u, err := url.Parse(uri)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		const text = "uri must be an absolute http or https URI"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// validateDomainPermSubPriority ensures
+// the given priority fits in a uint8.
+func validateDomainPermSubPriority(priority int) gtserror.WithCode {
+	if priority < 0 || priority > 255 {
+		const text = "priority must be a number in the range 0 to 255"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// parseDomainPermSubContentType parses the given
+// content type, returning a bad request error
+// if it's not one we know how to handle.
+func parseDomainPermSubContentType(contentType string) (gtsmodel.DomainPermSubContentType, gtserror.WithCode) {
+	ct := gtsmodel.NewDomainPermSubContentType(contentType)
+	if ct == gtsmodel.DomainPermSubContentTypeUnknown {
+		text := fmt.Sprintf(
+			"content_type must be one of %s, %s, %s",
+			gtsmodel.DomainPermSubContentTypeCSV,
+			gtsmodel.DomainPermSubContentTypeJSON,
+			gtsmodel.DomainPermSubContentTypePlain,
+		)
+		return ct, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return ct, nil
+}
+
+// DomainPermissionSubscriptionCreate creates a new domain
+// permission subscription from the given form. Entries from
+// the subscription will be created on the next processing run.
+func (p *Processor) DomainPermissionSubscriptionCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.DomainPermissionSubscriptionRequest,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	// Permission type is required.
+	var permType gtsmodel.DomainPermissionType
+	if form.PermissionType != nil {
+		permType = gtsmodel.NewDomainPermissionType(*form.PermissionType)
+	}
+
+	if permType != gtsmodel.DomainPermissionBlock &&
+		permType != gtsmodel.DomainPermissionAllow {
+		const text = "permission_type must be one of block, allow"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// URI is required.
+	if form.URI == nil {
+		const text = "uri must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if errWithCode := validateDomainPermSubURI(*form.URI); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Content type is required.
+	if form.ContentType == nil {
+		const text = "content_type must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	contentType, errWithCode := parseDomainPermSubContentType(*form.ContentType)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	permSub := &gtsmodel.DomainPermissionSubscription{
+		ID:                 id.NewULID(),
+		PermissionType:     permType,
+		AdoptOrphans:       util.Ptr(false),
+		RemoveRetracted:    util.Ptr(true),
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+		URI:                *form.URI,
+		ContentType:        contentType,
+	}
+
+	if form.Priority != nil {
+		if errWithCode := validateDomainPermSubPriority(*form.Priority); errWithCode != nil {
+			return nil, errWithCode
+		}
+		permSub.Priority = uint8(*form.Priority)
+	}
+
+	if form.Title != nil {
+		permSub.Title = text.SanitizeToPlaintext(*form.Title)
+	}
+
+	if form.AdoptOrphans != nil {
+		permSub.AdoptOrphans = form.AdoptOrphans
+	}
+
+	if form.RemoveRetracted != nil {
+		permSub.RemoveRetracted = form.RemoveRetracted
+	}
+
+	if form.FetchUsername != nil {
+		permSub.FetchUsername = *form.FetchUsername
+	}
+
+	if form.FetchPassword != nil {
+		permSub.FetchPassword = *form.FetchPassword
+	}
+
+	if err := p.state.DB.PutDomainPermissionSubscription(ctx, permSub); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			text := fmt.Sprintf("a domain permission subscription with uri %s already exists", permSub.URI)
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		err = gtserror.Newf("db error putting domain permission subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermSub(ctx, permSub)
+}
+
+// DomainPermissionSubscriptionGet returns one
+// domain permission subscription with the given id.
+func (p *Processor) DomainPermissionSubscriptionGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, errWithCode := p.getDomainPermSub(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainPermSub(ctx, permSub)
+}
+
+// DomainPermissionSubscriptionsGet returns all domain permission
+// subscriptions of the given type, or all subscriptions if
+// permissionType is DomainPermissionUnknown, ordered by priority.
+func (p *Processor) DomainPermissionSubscriptionsGet(
+	ctx context.Context,
+	permissionType gtsmodel.DomainPermissionType,
+) ([]*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSubs, err := p.state.DB.GetDomainPermissionSubscriptions(ctx, permissionType)
+	if err != nil {
+		err = gtserror.Newf("db error getting domain permission subscriptions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiPermSubs := make([]*apimodel.DomainPermissionSubscription, len(permSubs))
+	for i, permSub := range permSubs {
+		apiPermSub, errWithCode := p.apiDomainPermSub(ctx, permSub)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiPermSubs[i] = apiPermSub
+	}
+
+	return apiPermSubs, nil
+}
+
+// DomainPermissionSubscriptionUpdate updates the domain
+// permission subscription with the given id using the
+// set fields of the given form. The permission type of
+// an existing subscription cannot be changed.
+func (p *Processor) DomainPermissionSubscriptionUpdate(
+	ctx context.Context,
+	id string,
+	form *apimodel.DomainPermissionSubscriptionRequest,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, errWithCode := p.getDomainPermSub(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.PermissionType != nil &&
+		gtsmodel.NewDomainPermissionType(*form.PermissionType) != permSub.PermissionType {
+		const text = "permission_type of a domain permission subscription cannot be changed"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	columns := make([]string, 0, 9)
+
+	if form.Priority != nil {
+		if errWithCode := validateDomainPermSubPriority(*form.Priority); errWithCode != nil {
+			return nil, errWithCode
+		}
+		permSub.Priority = uint8(*form.Priority)
+		columns = append(columns, "priority")
+	}
+
+	if form.Title != nil {
+		permSub.Title = text.SanitizeToPlaintext(*form.Title)
+		columns = append(columns, "title")
+	}
+
+	if form.AdoptOrphans != nil {
+		permSub.AdoptOrphans = form.AdoptOrphans
+		columns = append(columns, "adopt_orphans")
+	}
+
+	if form.RemoveRetracted != nil {
+		permSub.RemoveRetracted = form.RemoveRetracted
+		columns = append(columns, "remove_retracted")
+	}
+
+	if form.FetchUsername != nil {
+		permSub.FetchUsername = *form.FetchUsername
+		columns = append(columns, "fetch_username")
+	}
+
+	if form.FetchPassword != nil {
+		permSub.FetchPassword = *form.FetchPassword
+		columns = append(columns, "fetch_password")
+	}
+
+	// Changing where or how the list is fetched
+	// invalidates any cache validators we hold.
+	var refetch bool
+
+	if form.URI != nil && *form.URI != permSub.URI {
+		if errWithCode := validateDomainPermSubURI(*form.URI); errWithCode != nil {
+			return nil, errWithCode
+		}
+		permSub.URI = *form.URI
+		columns = append(columns, "uri")
+		refetch = true
+	}
+
+	if form.ContentType != nil {
+		contentType, errWithCode := parseDomainPermSubContentType(*form.ContentType)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if contentType != permSub.ContentType {
+			permSub.ContentType = contentType
+			columns = append(columns, "content_type")
+			refetch = true
+		}
+	}
+
+	if refetch {
+		permSub.ETag = ""
+		permSub.LastModified = time.Time{}
+		columns = append(columns, "etag", "last_modified")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to do.
+		return p.apiDomainPermSub(ctx, permSub)
+	}
+
+	if err := p.state.DB.UpdateDomainPermissionSubscription(ctx, permSub, columns...); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			text := fmt.Sprintf("a domain permission subscription with uri %s already exists", permSub.URI)
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		err = gtserror.Newf("db error updating domain permission subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermSub(ctx, permSub)
+}
+
+// DomainPermissionSubscriptionDelete deletes the domain permission
+// subscription with the given id. If removeChildren is true, all
+// domain permissions owned by the subscription are deleted (with
+// side effects); otherwise they are orphaned and left in place.
+//
+// Returns the deleted subscription.
+func (p *Processor) DomainPermissionSubscriptionDelete(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	removeChildren bool,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, errWithCode := p.getDomainPermSub(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Prepare the subscription to return,
+	// *before* the deletion goes through.
+	apiPermSub, errWithCode := p.apiDomainPermSub(ctx, permSub)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Get all domain permissions
+	// owned by this subscription.
+	perms, err := p.getDomainPermsBySubscription(ctx, permSub)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, perm := range perms {
+		if removeChildren {
+			_, _, errWithCode := p.DomainPermissionDelete(
+				ctx,
+				permSub.PermissionType,
+				adminAcct,
+				perm.GetID(),
+			)
+			if errWithCode != nil {
+				return nil, errWithCode
+			}
+			continue
+		}
+
+		if err := p.setDomainPermSubscriptionID(ctx, perm, ""); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if err := p.state.DB.DeleteDomainPermissionSubscription(ctx, permSub.ID); err != nil {
+		err = gtserror.Newf("db error deleting domain permission subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPermSub, nil
+}
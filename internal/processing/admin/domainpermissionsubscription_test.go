@@ -0,0 +1,226 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const testListURI = "https://lists.example.org/blocklist.csv"
+
+type DomainPermissionSubscriptionTestSuite struct {
+	AdminStandardTestSuite
+
+	// Body to serve at testListURI.
+	listBody string
+}
+
+// subscriptionsProcessor returns an admin processor
+// whose transport serves suite.listBody at testListURI.
+func (suite *DomainPermissionSubscriptionTestSuite) subscriptionsProcessor() *admin.Processor {
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != testListURI {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     http.StatusText(http.StatusNotFound),
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+				Request:    req,
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     http.StatusText(http.StatusOK),
+			Header:     http.Header{"Content-Type": {"text/csv"}},
+			Body:       io.NopCloser(bytes.NewReader([]byte(suite.listBody))),
+			Request:    req,
+		}, nil
+	}, "")
+
+	processor := admin.New(
+		&suite.state,
+		cleaner.New(&suite.state),
+		suite.tc,
+		suite.mediaManager,
+		testrig.NewTestTransportController(&suite.state, httpClient),
+		suite.emailSender,
+		nil,
+		nil,
+	)
+
+	return &processor
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) waitActions(processor *admin.Processor) {
+	if !testrig.WaitFor(func() bool {
+		return processor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) TestProcessCSVBlocklist() {
+	var (
+		ctx       = context.Background()
+		processor = suite.subscriptionsProcessor()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	apiPermSub, errWithCode := processor.DomainPermissionSubscriptionCreate(ctx, adminAcct, &apimodel.DomainPermissionSubscriptionRequest{
+		Priority:       util.Ptr(100),
+		Title:          util.Ptr("community blocklist"),
+		PermissionType: util.Ptr("block"),
+		AdoptOrphans:   util.Ptr(true),
+		URI:            util.Ptr(testListURI),
+		ContentType:    util.Ptr("text/csv"),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// List contains one domain that's already
+	// blocked (orphan), two new domains, one
+	// silenced domain which should be skipped,
+	// and a duplicate entry which should be ignored.
+	suite.listBody = `#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate
+replyguys.com,suspend,false,false,reply-guying to tech posts,false
+bad.example.org,suspend,false,false,bad vibes,false
+worse.example.org,suspend,false,false,,true
+quiet.example.org,silence,false,false,,false
+BAD.example.org,suspend,false,false,bad vibes,false
+`
+
+	processor.ProcessDomainPermissionSubscriptions(ctx)
+	suite.waitActions(processor)
+
+	for _, domain := range []string{
+		"replyguys.com",
+		"bad.example.org",
+		"worse.example.org",
+	} {
+		block, err := suite.db.GetDomainBlock(ctx, domain)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(apiPermSub.ID, block.SubscriptionID)
+	}
+
+	// Silenced domain should not be blocked.
+	blocked, err := suite.db.IsDomainBlocked(ctx, "quiet.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(blocked)
+
+	permSub, err := suite.db.GetDomainPermissionSubscriptionByID(ctx, apiPermSub.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(permSub.Error)
+	suite.NotZero(permSub.SuccessfullyFetchedAt)
+
+	// Retract one domain from the list;
+	// it should be removed on next run.
+	suite.listBody = `#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate
+replyguys.com,suspend,false,false,reply-guying to tech posts,false
+bad.example.org,suspend,false,false,bad vibes,false
+`
+
+	processor.ProcessDomainPermissionSubscriptions(ctx)
+	suite.waitActions(processor)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, "worse.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(blocked)
+
+	// Delete the subscription, orphaning its children.
+	if _, errWithCode := processor.DomainPermissionSubscriptionDelete(ctx, adminAcct, apiPermSub.ID, false); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	block, err := suite.db.GetDomainBlock(ctx, "bad.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(block.SubscriptionID)
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) TestProcessFetchError() {
+	var (
+		ctx       = context.Background()
+		processor = suite.subscriptionsProcessor()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	apiPermSub, errWithCode := processor.DomainPermissionSubscriptionCreate(ctx, adminAcct, &apimodel.DomainPermissionSubscriptionRequest{
+		PermissionType: util.Ptr("block"),
+		URI:            util.Ptr("https://lists.example.org/does-not-exist.txt"),
+		ContentType:    util.Ptr("text/plain"),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	processor.ProcessDomainPermissionSubscriptions(ctx)
+
+	permSub, err := suite.db.GetDomainPermissionSubscriptionByID(ctx, apiPermSub.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(permSub.Error)
+	suite.NotZero(permSub.FetchedAt)
+	suite.Zero(permSub.SuccessfullyFetchedAt)
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) TestCreateInvalid() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.adminProcessor.DomainPermissionSubscriptionCreate(ctx, adminAcct, &apimodel.DomainPermissionSubscriptionRequest{
+		PermissionType: util.Ptr("block"),
+		URI:            util.Ptr(testListURI),
+		ContentType:    util.Ptr("application/xml"),
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	_, errWithCode = suite.adminProcessor.DomainPermissionSubscriptionCreate(ctx, adminAcct, &apimodel.DomainPermissionSubscriptionRequest{
+		PermissionType: util.Ptr("block"),
+		URI:            util.Ptr("ftp://lists.example.org/list.csv"),
+		ContentType:    util.Ptr("text/csv"),
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestDomainPermissionSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainPermissionSubscriptionTestSuite))
+}
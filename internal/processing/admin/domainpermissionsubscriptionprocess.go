@@ -0,0 +1,658 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// ScheduleDomainPermissionSubscriptions schedules
+// processing of domain permission subscriptions
+// using configured parameters.
+//
+// Returns an error if `InstanceSubscriptionsProcessFrom`
+// is not a valid format (hh:mm).
+func (p *Processor) ScheduleDomainPermissionSubscriptions() error {
+	const hourMinute = "15:04"
+
+	var (
+		now            = time.Now()
+		processEvery   = config.GetInstanceSubscriptionsProcessEvery()
+		processFromStr = config.GetInstanceSubscriptionsProcessFrom()
+	)
+
+	// Parse processFromStr as hh:mm.
+	// Resulting time will be on 1 Jan year zero.
+	processFrom, err := time.Parse(hourMinute, processFromStr)
+	if err != nil {
+		return gtserror.Newf(
+			"error parsing '%s' in time format 'hh:mm': %w",
+			processFromStr, err,
+		)
+	}
+
+	firstProcessAt := time.Date(
+		now.Year(),
+		now.Month(),
+		now.Day(),
+		processFrom.Hour(),
+		processFrom.Minute(),
+		0,
+		0,
+		now.Location(),
+	)
+
+	// Ensure first processing is in the future.
+	for firstProcessAt.Before(now) {
+		firstProcessAt = firstProcessAt.Add(processEvery)
+	}
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Info(ctx, "starting domain permission subscriptions processing")
+		p.ProcessDomainPermissionSubscriptions(ctx)
+		log.Infof(ctx, "finished domain permission subscriptions processing after %s", time.Since(start))
+	}
+
+	log.Infof(nil,
+		"scheduling domain permission subscriptions to run every %s, starting from %s; next run will be at %s",
+		processEvery, processFromStr, firstProcessAt,
+	)
+
+	// Schedule processing to execute according to schedule.
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@domainpermsubs",
+		firstProcessAt,
+		processEvery,
+		fn,
+	) {
+		return gtserror.New("failed to schedule @domainpermsubs")
+	}
+
+	return nil
+}
+
+// ProcessDomainPermissionSubscriptions fetches every domain
+// permission subscription's list, and creates, adopts, takes
+// over, orphans or removes domain permissions accordingly.
+//
+// Subscriptions of each permission type are processed in
+// order of priority, highest first, and a subscription will
+// never take over a domain permission already claimed by a
+// subscription of higher priority during the same run.
+//
+// Errors are stored on the relevant subscription and logged.
+func (p *Processor) ProcessDomainPermissionSubscriptions(ctx context.Context) {
+	// Domain permissions created by subscriptions
+	// are attributed to the instance account.
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		log.Errorf(ctx, "db error getting instance account: %v", err)
+		return
+	}
+
+	// Lists are fetched as the instance account.
+	tsport, err := p.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		log.Errorf(ctx, "error getting instance transport: %v", err)
+		return
+	}
+
+	for _, permType := range []gtsmodel.DomainPermissionType{
+		gtsmodel.DomainPermissionBlock,
+		gtsmodel.DomainPermissionAllow,
+	} {
+		// Get subscriptions of this type, highest priority first.
+		permSubs, err := p.state.DB.GetDomainPermissionSubscriptions(ctx, permType)
+		if err != nil {
+			log.Errorf(ctx, "db error getting domain %s subscriptions: %v", permType, err)
+			continue
+		}
+
+		// Domains already claimed by a
+		// higher priority subscription.
+		claimed := make(map[string]struct{})
+
+		for _, permSub := range permSubs {
+			if err := p.processDomainPermSub(ctx,
+				tsport,
+				instanceAcct,
+				permSub,
+				claimed,
+			); err != nil {
+				log.Errorf(ctx, "error processing domain permission subscription %s: %v", permSub.ID, err)
+			}
+		}
+	}
+}
+
+// processDomainPermSub fetches the list of the given subscription
+// and applies its entries, recording the outcome on the subscription.
+func (p *Processor) processDomainPermSub(
+	ctx context.Context,
+	tsport transport.Transport,
+	instanceAcct *gtsmodel.Account,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	claimed map[string]struct{},
+) error {
+	permSub.FetchedAt = time.Now()
+
+	// Fetch and apply the list, only
+	// marking the fetch successful if
+	// nothing went wrong along the way.
+	err := p.fetchAndApplyDomainPermSub(ctx,
+		tsport,
+		instanceAcct,
+		permSub,
+		claimed,
+	)
+
+	columns := []string{"fetched_at", "error"}
+	if err != nil {
+		permSub.Error = err.Error()
+	} else {
+		permSub.Error = ""
+		permSub.SuccessfullyFetchedAt = permSub.FetchedAt
+		columns = append(columns, "successfully_fetched_at", "etag", "last_modified")
+	}
+
+	if dbErr := p.state.DB.UpdateDomainPermissionSubscription(ctx, permSub, columns...); dbErr != nil {
+		dbErr = gtserror.Newf("db error updating domain permission subscription: %w", dbErr)
+		return errors.Join(err, dbErr)
+	}
+
+	return err
+}
+
+func (p *Processor) fetchAndApplyDomainPermSub(
+	ctx context.Context,
+	tsport transport.Transport,
+	instanceAcct *gtsmodel.Account,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	claimed map[string]struct{},
+) error {
+	rsp, err := tsport.DereferenceDomainPermissions(ctx, permSub, false)
+	if err != nil {
+		return gtserror.Newf("error fetching %s: %w", permSub.URI, err)
+	}
+
+	if rsp.Unmodified {
+		// Nothing changed since last fetch,
+		// but still claim domains we own so
+		// that lower priority subscriptions
+		// don't try to take them over.
+		perms, err := p.getDomainPermsBySubscription(ctx, permSub)
+		if err != nil {
+			return err
+		}
+
+		for _, perm := range perms {
+			claimed[perm.GetDomain()] = struct{}{}
+		}
+
+		return nil
+	}
+
+	entries, err := parseDomainPermSubList(rsp.Body, permSub)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return gtserror.Newf("error parsing %s as %s: %w", permSub.URI, permSub.ContentType, err)
+	}
+
+	// Don't treat an empty list as every entry
+	// having been retracted, it's far more likely
+	// that something is wrong on the remote end.
+	if len(entries) == 0 {
+		return gtserror.Newf("list at %s contained no valid entries", permSub.URI)
+	}
+
+	if err := p.applyDomainPermSubEntries(ctx,
+		instanceAcct,
+		permSub,
+		entries,
+		claimed,
+	); err != nil {
+		return err
+	}
+
+	// Only store cache validators once entries
+	// have been applied, so that a failed run
+	// is retried in full next time around.
+	permSub.ETag = rsp.ETag
+	permSub.LastModified = rsp.LastModified
+
+	return nil
+}
+
+// applyDomainPermSubEntries creates, adopts or takes over a domain
+// permission for each of the given entries, and then removes or
+// orphans any permissions owned by the subscription that were
+// not present in entries.
+func (p *Processor) applyDomainPermSubEntries(
+	ctx context.Context,
+	instanceAcct *gtsmodel.Account,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	entries []*apimodel.DomainPermission,
+	claimed map[string]struct{},
+) error {
+	var (
+		permType = permSub.PermissionType
+		listed   = make(map[string]struct{}, len(entries))
+		errs     gtserror.MultiError
+	)
+
+	for _, entry := range entries {
+		domain := entry.Domain.Domain
+		listed[domain] = struct{}{}
+
+		if _, ok := claimed[domain]; ok {
+			// A higher priority subscription
+			// already owns this permission.
+			continue
+		}
+
+		existing, err := p.getDomainPerm(ctx, permType, domain)
+		if err != nil {
+			errs.Append(err)
+			continue
+		}
+
+		switch {
+
+		// No permission exists yet, create one.
+		case existing == nil:
+			_, _, errWithCode := p.DomainPermissionCreate(
+				ctx,
+				permType,
+				instanceAcct,
+				domain,
+				entry.Obfuscate,
+				entry.PublicComment,
+				entry.PrivateComment,
+				permSub.ID,
+				nil, // Subscriptions don't create limits.
+			)
+			if errWithCode != nil {
+				errs.Appendf("error creating domain %s %s: %w", permType, domain, errWithCode)
+				continue
+			}
+
+		// Already ours, nothing to do.
+		case existing.GetSubscriptionID() == permSub.ID:
+
+		// Orphaned permission, only adopt
+		// it if the subscription wants to.
+		case existing.GetSubscriptionID() == "":
+			if !*permSub.AdoptOrphans {
+				continue
+			}
+
+			if err := p.setDomainPermSubscriptionID(ctx, existing, permSub.ID); err != nil {
+				errs.Append(err)
+				continue
+			}
+
+		// Owned by a subscription of lower (or equal)
+		// priority which hasn't been processed yet, or
+		// by a subscription that no longer exists; either
+		// way, this subscription takes precedence.
+		default:
+			if err := p.setDomainPermSubscriptionID(ctx, existing, permSub.ID); err != nil {
+				errs.Append(err)
+				continue
+			}
+		}
+
+		claimed[domain] = struct{}{}
+	}
+
+	// Look for any permissions owned by
+	// this subscription that have been
+	// retracted from the list since.
+	perms, err := p.getDomainPermsBySubscription(ctx, permSub)
+	if err != nil {
+		errs.Append(err)
+		return errs.Combine()
+	}
+
+	for _, perm := range perms {
+		domain := perm.GetDomain()
+		if _, ok := listed[domain]; ok {
+			// Still listed.
+			continue
+		}
+
+		if !*permSub.RemoveRetracted {
+			// Leave permission in place,
+			// but release it from this sub.
+			if err := p.setDomainPermSubscriptionID(ctx, perm, ""); err != nil {
+				errs.Append(err)
+			}
+			continue
+		}
+
+		if _, _, errWithCode := p.DomainPermissionDelete(
+			ctx,
+			permType,
+			instanceAcct,
+			perm.GetID(),
+		); errWithCode != nil {
+			errs.Appendf("error deleting domain %s %s: %w", permType, domain, errWithCode)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// getDomainPerm returns the domain permission of the given
+// type for the given domain, or nil if none exists.
+func (p *Processor) getDomainPerm(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+	domain string,
+) (gtsmodel.DomainPermission, error) {
+	var (
+		perm gtsmodel.DomainPermission
+		err  error
+	)
+
+	switch permType {
+	case gtsmodel.DomainPermissionBlock:
+		var block *gtsmodel.DomainBlock
+		if block, err = p.state.DB.GetDomainBlock(ctx, domain); block != nil {
+			perm = block
+		}
+
+	case gtsmodel.DomainPermissionAllow:
+		var allow *gtsmodel.DomainAllow
+		if allow, err = p.state.DB.GetDomainAllow(ctx, domain); allow != nil {
+			perm = allow
+		}
+
+	default:
+		return nil, gtserror.Newf("unrecognized permission type %d", permType)
+	}
+
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting domain %s %s: %w", permType, domain, err)
+	}
+
+	return perm, nil
+}
+
+// getDomainPermsBySubscription returns all domain
+// permissions owned by the given subscription.
+func (p *Processor) getDomainPermsBySubscription(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+) ([]gtsmodel.DomainPermission, error) {
+	var perms []gtsmodel.DomainPermission
+
+	switch permSub.PermissionType {
+	case gtsmodel.DomainPermissionBlock:
+		blocks, err := p.state.DB.GetDomainBlocks(ctx)
+		if err != nil {
+			return nil, gtserror.Newf("db error getting domain blocks: %w", err)
+		}
+
+		for _, block := range blocks {
+			if block.SubscriptionID == permSub.ID {
+				perms = append(perms, block)
+			}
+		}
+
+	case gtsmodel.DomainPermissionAllow:
+		allows, err := p.state.DB.GetDomainAllows(ctx)
+		if err != nil {
+			return nil, gtserror.Newf("db error getting domain allows: %w", err)
+		}
+
+		for _, allow := range allows {
+			if allow.SubscriptionID == permSub.ID {
+				perms = append(perms, allow)
+			}
+		}
+
+	default:
+		return nil, gtserror.Newf("unrecognized permission type %d", permSub.PermissionType)
+	}
+
+	return perms, nil
+}
+
+// setDomainPermSubscriptionID sets the subscription ID
+// of the given domain permission and updates it in the db.
+func (p *Processor) setDomainPermSubscriptionID(
+	ctx context.Context,
+	perm gtsmodel.DomainPermission,
+	subscriptionID string,
+) error {
+	perm.SetSubscriptionID(subscriptionID)
+
+	var err error
+	switch perm := perm.(type) {
+	case *gtsmodel.DomainBlock:
+		err = p.state.DB.UpdateDomainBlock(ctx, perm, "subscription_id")
+	case *gtsmodel.DomainAllow:
+		err = p.state.DB.UpdateDomainAllow(ctx, perm, "subscription_id")
+	default:
+		err = gtserror.Newf("unrecognized domain permission %T", perm)
+	}
+
+	if err != nil {
+		return gtserror.Newf("db error updating domain %s %s: %w", perm.GetType(), perm.GetDomain(), err)
+	}
+
+	return nil
+}
+
+// parseDomainPermSubList parses the given list body according
+// to the content type of the given subscription, returning
+// entries with normalized (punycode) domains, deduplicated.
+func parseDomainPermSubList(
+	body io.Reader,
+	permSub *gtsmodel.DomainPermissionSubscription,
+) ([]*apimodel.DomainPermission, error) {
+	var (
+		entries []*apimodel.DomainPermission
+		err     error
+	)
+
+	switch permSub.ContentType {
+	case gtsmodel.DomainPermSubContentTypeCSV:
+		entries, err = parseDomainPermsCSV(body, permSub.PermissionType)
+	case gtsmodel.DomainPermSubContentTypeJSON:
+		err = json.NewDecoder(body).Decode(&entries)
+	case gtsmodel.DomainPermSubContentTypePlain:
+		entries, err = parseDomainPermsPlain(body)
+	default:
+		err = gtserror.Newf("unrecognized content type %s", permSub.ContentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Normalize + deduplicate domains,
+	// dropping any we can't make sense of.
+	seen := make(map[string]struct{}, len(entries))
+	valid := entries[:0]
+
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+
+		domain, ok := normalizeListedDomain(entry.Domain.Domain)
+		if !ok {
+			continue
+		}
+
+		if _, ok := seen[domain]; ok {
+			continue
+		}
+		seen[domain] = struct{}{}
+
+		entry.Domain.Domain = domain
+		valid = append(valid, entry)
+	}
+
+	return valid, nil
+}
+
+// parseDomainPermsCSV parses a Mastodon-style CSV list, eg:
+//
+//	#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate
+//	example.org,suspend,false,false,they smell,false
+//
+// If the first row isn't a header row, the first column
+// of each row is taken as the domain. When parsing for
+// a block subscription, rows with a severity other than
+// "suspend" are skipped, since we can't enforce them.
+func parseDomainPermsCSV(
+	body io.Reader,
+	permType gtsmodel.DomainPermissionType,
+) ([]*apimodel.DomainPermission, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1 // Allow ragged rows.
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	// Column indices, -1 if not present.
+	var (
+		domainI        = 0
+		severityI      = -1
+		publicCommentI = -1
+		obfuscateI     = -1
+	)
+
+	// Check for a header row.
+	if header := records[0]; len(header) > 0 &&
+		(strings.HasPrefix(header[0], "#") || header[0] == "domain") {
+		domainI = -1
+		for i, col := range header {
+			switch strings.TrimPrefix(strings.TrimSpace(col), "#") {
+			case "domain":
+				domainI = i
+			case "severity":
+				severityI = i
+			case "public_comment":
+				publicCommentI = i
+			case "obfuscate":
+				obfuscateI = i
+			}
+		}
+
+		if domainI == -1 {
+			return nil, errors.New("header row has no domain column")
+		}
+
+		records = records[1:]
+	}
+
+	// column returns value at index i
+	// of the record, or "" if not set.
+	column := func(record []string, i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	entries := make([]*apimodel.DomainPermission, 0, len(records))
+	for _, record := range records {
+		if permType == gtsmodel.DomainPermissionBlock {
+			if severity := column(record, severityI); severity != "" && severity != "suspend" {
+				continue
+			}
+		}
+
+		obfuscate, _ := strconv.ParseBool(column(record, obfuscateI))
+		entries = append(entries, &apimodel.DomainPermission{
+			Domain: apimodel.Domain{
+				Domain:        column(record, domainI),
+				PublicComment: column(record, publicCommentI),
+			},
+			Obfuscate: obfuscate,
+		})
+	}
+
+	return entries, nil
+}
+
+// parseDomainPermsPlain parses a newline-separated list
+// of domains, ignoring empty lines and # comments.
+func parseDomainPermsPlain(body io.Reader) ([]*apimodel.DomainPermission, error) {
+	var entries []*apimodel.DomainPermission
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		entries = append(entries, &apimodel.DomainPermission{
+			Domain: apimodel.Domain{Domain: line},
+		})
+	}
+
+	return entries, scanner.Err()
+}
+
+// normalizeListedDomain converts the given domain from a
+// list to punycode, returning false if it's not a usable
+// domain or if it refers to this instance.
+func normalizeListedDomain(domain string) (string, bool) {
+	domain, err := util.Punify(strings.TrimSpace(domain))
+	if err != nil || domain == "" ||
+		strings.ContainsAny(domain, " \t/@*:?#") {
+		return "", false
+	}
+
+	if domain == config.GetHost() ||
+		domain == config.GetAccountDomain() {
+		return "", false
+	}
+
+	return domain, true
+}
@@ -59,6 +59,7 @@ func (p *Processor) MediaPrune(ctx context.Context, mediaRemoteCacheDays int) gt
 		ctx := context.Background()
 		p.cleaner.Media().All(ctx, mediaRemoteCacheDays)
 		p.cleaner.Emoji().All(ctx, mediaRemoteCacheDays)
+		p.cleaner.Card().All(ctx, mediaRemoteCacheDays)
 	}()
 
 	return nil
@@ -0,0 +1,319 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// apiRelay is a cheeky shortcut for returning the
+// API version of the given relay subscription,
+// or an appropriate error if something goes wrong.
+func (p *Processor) apiRelay(
+	ctx context.Context,
+	relay *gtsmodel.Relay,
+) (*apimodel.Relay, gtserror.WithCode) {
+	apiRelay, err := p.converter.RelayToAPIRelay(ctx, relay)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting relay to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
+
+// getRelay fetches the relay subscription with the
+// given ID, returning a not found error if it doesn't exist.
+func (p *Processor) getRelay(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.Relay, gtserror.WithCode) {
+	relay, err := p.state.DB.GetRelayByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			err = gtserror.Newf("db error getting relay %s: %w", id, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		err = fmt.Errorf("no relay exists with ID %s", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return relay, nil
+}
+
+// deliverToRelay delivers the given activity from
+// our instance account to the inbox of the relay.
+func (p *Processor) deliverToRelay(
+	ctx context.Context,
+	relay *gtsmodel.Relay,
+	activity vocab.Type,
+) error {
+	inboxIRI, err := url.Parse(relay.InboxURI)
+	if err != nil {
+		return gtserror.Newf("error parsing relay inbox uri: %w", err)
+	}
+
+	data, err := ap.Serialize(activity)
+	if err != nil {
+		return gtserror.Newf("error serializing %T: %w", activity, err)
+	}
+
+	tsport, err := p.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting instance transport: %w", err)
+	}
+
+	if err := tsport.Deliver(ctx, data, inboxIRI); err != nil {
+		return gtserror.Newf("error delivering %T to %s: %w", activity, inboxIRI, err)
+	}
+
+	return nil
+}
+
+// resolveRelayInbox works out the inbox of the relay
+// at the given URI. If the URI is that of a (LitePub)
+// relay actor, this is the inbox of the actor. Mastodon
+// relays are instead given by their inbox URI directly.
+func (p *Processor) resolveRelayInbox(ctx context.Context, uri *url.URL) string {
+	tsport, err := p.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		log.Errorf(ctx, "error getting instance transport: %v", err)
+		return uri.String()
+	}
+
+	rsp, err := tsport.Dereference(ctx, uri)
+	if err != nil {
+		// Inboxes usually can't be
+		// fetched, so this is normal.
+		log.Debugf(ctx, "could not dereference %s, assuming it's an inbox: %v", uri, err)
+		return uri.String()
+	}
+
+	accountable, err := ap.ResolveAccountable(ctx, rsp.Body)
+	if err != nil {
+		log.Debugf(ctx, "could not resolve %s as an actor, assuming it's an inbox: %v", uri, err)
+		return uri.String()
+	}
+
+	inbox := ap.GetInbox(accountable)
+	if inbox == nil {
+		log.Debugf(ctx, "actor %s has no inbox, assuming it's an inbox itself", uri)
+		return uri.String()
+	}
+
+	return inbox.String()
+}
+
+// RelayCreate subscribes to the relay at the URI of the
+// given form, by sending a Follow from our instance account.
+// The subscription stays pending until the relay accepts it.
+func (p *Processor) RelayCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.RelayRequest,
+) (*apimodel.Relay, gtserror.WithCode) {
+	// URI is required.
+	if form.URI == nil || *form.URI == "" {
+		const text = "uri must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	uri, err := url.Parse(*form.URI)
+	if err != nil || (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" {
+		const text = "uri must be an absolute http or https URI"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	blocked, err := p.state.DB.IsDomainBlocked(ctx, uri.Hostname())
+	if err != nil {
+		err = gtserror.Newf("db error checking domain block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		text := fmt.Sprintf("domain %s is blocked", uri.Hostname())
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err = gtserror.Newf("db error getting instance account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	relayID := id.NewULID()
+	relay := &gtsmodel.Relay{
+		ID:                 relayID,
+		URI:                uri.String(),
+		InboxURI:           p.resolveRelayInbox(ctx, uri),
+		FollowURI:          uris.GenerateURIForFollow(instanceAcct.Username, relayID),
+		State:              gtsmodel.RelayStatePending,
+		Forward:            util.Ptr(util.PtrValueOr(form.Forward, false)),
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+	}
+
+	if relay.IsLitePub() {
+		// Relay actor is
+		// known already.
+		relay.ActorURI = relay.URI
+	}
+
+	if err := p.state.DB.PutRelay(ctx, relay); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			text := fmt.Sprintf("a relay with uri %s already exists", relay.URI)
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		err = gtserror.Newf("db error putting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	follow, err := p.converter.RelayToASFollow(ctx, relay)
+	if err != nil {
+		err = gtserror.Newf("error converting relay to follow: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.deliverToRelay(ctx, relay, follow); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelayGet returns one relay subscription with the given id.
+func (p *Processor) RelayGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.Relay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelaysGet returns all relay subscriptions, oldest first.
+func (p *Processor) RelaysGet(
+	ctx context.Context,
+) ([]*apimodel.Relay, gtserror.WithCode) {
+	relays, err := p.state.DB.GetRelays(ctx)
+	if err != nil {
+		err = gtserror.Newf("db error getting relays: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRelays := make([]*apimodel.Relay, len(relays))
+	for i, relay := range relays {
+		apiRelay, errWithCode := p.apiRelay(ctx, relay)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiRelays[i] = apiRelay
+	}
+
+	return apiRelays, nil
+}
+
+// RelayUpdate updates the relay subscription with the
+// given id using the set fields of the given form. The
+// URI of an existing subscription cannot be changed.
+func (p *Processor) RelayUpdate(
+	ctx context.Context,
+	id string,
+	form *apimodel.RelayRequest,
+) (*apimodel.Relay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.URI != nil && *form.URI != relay.URI {
+		const text = "uri of a relay cannot be changed"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if form.Forward == nil {
+		// Nothing to update.
+		return p.apiRelay(ctx, relay)
+	}
+
+	relay.Forward = form.Forward
+	if err := p.state.DB.UpdateRelay(ctx, relay, "forward"); err != nil {
+		err = gtserror.Newf("db error updating relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelayDelete unsubscribes from the relay with
+// the given id, by sending an Undo of the Follow.
+func (p *Processor) RelayDelete(
+	ctx context.Context,
+	id string,
+) (*apimodel.Relay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert to API model before
+	// deleting, for the response.
+	apiRelay, errWithCode := p.apiRelay(ctx, relay)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if relay.State != gtsmodel.RelayStateRejected {
+		// Tell the relay to stop sending us stuff,
+		// but remove the subscription even if this
+		// fails, as we ignore unsubscribed relays.
+		undo, err := p.converter.RelayToASUndoFollow(ctx, relay)
+		if err != nil {
+			log.Errorf(ctx, "error converting relay to undo: %v", err)
+		} else if err := p.deliverToRelay(ctx, relay, undo); err != nil {
+			log.Errorf(ctx, "error unsubscribing from relay: %v", err)
+		}
+	}
+
+	if err := p.state.DB.DeleteRelayByID(ctx, relay.ID); err != nil {
+		err = gtserror.Newf("db error deleting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
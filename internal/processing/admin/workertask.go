@@ -0,0 +1,397 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+// NOTE:
+// Having these functions in the processor, which is
+// usually the intermediary that performs *processing*
+// between the HTTP route handlers and the underlying
+// database / storage layers is a little odd, so this
+// may be subject to change!
+//
+// For now at least, this is a useful place that has
+// access to the underlying database, workers and
+// transport controller, and causes no dependency
+// cycles with this use case!
+
+// FillWorkerQueues recovers all serialized worker tasks from the database
+// (if any!), and pushes them to each of their relevant worker queues.
+func (p *Processor) FillWorkerQueues(ctx context.Context) error {
+	// Get all persisted worker tasks from db.
+	tasks, err := p.state.DB.GetWorkerTasks(ctx)
+	if err != nil {
+		return gtserror.Newf("error fetching worker tasks from db: %w", err)
+	}
+
+	var (
+		// Counts of each task type
+		// successfully recovered.
+		delivery  int
+		federator int
+		client    int
+
+		// Failed recoveries.
+		errors int
+	)
+
+	for _, task := range tasks {
+		var err error
+
+		// Switch on task type and push to appropriate queue.
+		switch task.WorkerType {
+		case gtsmodel.DeliveryWorker:
+			err = p.pushDelivery(ctx, task)
+			if err == nil {
+				delivery++
+			}
+		case gtsmodel.FederatorWorker:
+			err = p.pushFederator(ctx, task)
+			if err == nil {
+				federator++
+			}
+		case gtsmodel.ClientWorker:
+			err = p.pushClient(ctx, task)
+			if err == nil {
+				client++
+			}
+		default:
+			err = fmt.Errorf("invalid worker type %d", task.WorkerType)
+		}
+
+		if err != nil {
+			log.Errorf(ctx, "error pushing task %d: %v", task.ID, err)
+			errors++
+		}
+
+		// Whether recovered or not, this task has now been
+		// handled; drop it so it isn't attempted again on
+		// the next startup (a failed task won't ever succeed).
+		if err := p.state.DB.DeleteWorkerTaskByID(ctx, task.ID); err != nil {
+			log.Errorf(ctx, "error deleting task %d from db: %v", task.ID, err)
+		}
+	}
+
+	log.Infof(ctx, "recovered queued tasks: delivery=%d federator=%d client=%d errors=%d",
+		delivery,
+		federator,
+		client,
+		errors,
+	)
+
+	return nil
+}
+
+// PersistWorkerQueues pops all queued worker tasks (that are themselves persistable, i.e. not
+// dereference tasks which are just function ptrs), serializes and persists them to the database.
+//
+// This should only be called once worker pools have been stopped,
+// else tasks may be popped from queues while also being processed.
+func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
+	var (
+		// Counts of each task type
+		// successfully persisted.
+		delivery  int
+		federator int
+		client    int
+
+		// Failed persists.
+		errors int
+
+		// Serialized tasks to persist.
+		tasks []*gtsmodel.WorkerTask
+	)
+
+	for {
+		// Pop all queued deliveries.
+		task, err := p.popDelivery()
+		if err != nil {
+			log.Errorf(ctx, "error popping delivery: %v", err)
+			errors++ // incr error count.
+			continue
+		}
+
+		if task == nil {
+			// No more queue
+			// tasks to pop!
+			break
+		}
+
+		// Append serialized task.
+		tasks = append(tasks, task)
+		delivery++ // incr count
+	}
+
+	for {
+		// Pop queued federator msgs.
+		task, err := p.popFederator()
+		if err != nil {
+			log.Errorf(ctx, "error popping federator message: %v", err)
+			errors++ // incr count
+			continue
+		}
+
+		if task == nil {
+			// No more queue
+			// tasks to pop!
+			break
+		}
+
+		// Append serialized task.
+		tasks = append(tasks, task)
+		federator++ // incr count
+	}
+
+	for {
+		// Pop queued client msgs.
+		task, err := p.popClient()
+		if err != nil {
+			log.Errorf(ctx, "error popping client message: %v", err)
+			errors++ // incr count
+			continue
+		}
+
+		if task == nil {
+			// No more queue
+			// tasks to pop!
+			break
+		}
+
+		// Append serialized task.
+		tasks = append(tasks, task)
+		client++ // incr count
+	}
+
+	// Persist all serialized queued worker tasks to database.
+	if err := p.state.DB.PutWorkerTasks(ctx, tasks); err != nil {
+		return gtserror.Newf("error putting tasks in db: %w", err)
+	}
+
+	log.Infof(ctx, "persisted queued tasks: delivery=%d federator=%d client=%d errors=%d",
+		delivery,
+		federator,
+		client,
+		errors,
+	)
+
+	return nil
+}
+
+// pushDelivery parses a valid delivery.Delivery{} from serialized task data and pushes to queue.
+func (p *Processor) pushDelivery(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	dlv := new(delivery.Delivery)
+
+	// Deserialize the raw worker task data into delivery.
+	if err := dlv.Deserialize(task.TaskData); err != nil {
+		return gtserror.Newf("error deserializing delivery: %w", err)
+	}
+
+	if dlv.PubKeyID == "" {
+		// Deliveries without a signing key
+		// can't be re-signed, so can't be sent.
+		return gtserror.New("delivery has no signing public key id")
+	}
+
+	// Fetch the local account which owns the signing public key.
+	account, err := p.state.DB.GetAccountByPubkeyID(ctx, dlv.PubKeyID)
+	if err != nil {
+		return gtserror.Newf("error getting account for pubkey %s: %w", dlv.PubKeyID, err)
+	}
+
+	if !account.IsLocal() || account.PrivateKey == nil {
+		return gtserror.Newf("account for pubkey %s is not a local signing account", dlv.PubKeyID)
+	}
+
+	// Get a transport for the account with this public key.
+	tsport, err := p.transportController.NewTransport(
+		account.PublicKeyURI,
+		account.PrivateKey,
+	)
+	if err != nil {
+		return gtserror.Newf("error getting transport for pubkey %s: %w", dlv.PubKeyID, err)
+	}
+
+	// Sign the deserialized delivery again.
+	if err := tsport.SignDelivery(dlv); err != nil {
+		return gtserror.Newf("error signing delivery: %w", err)
+	}
+
+	// Push deserialized task to delivery queue.
+	p.state.Workers.Delivery.Queue.Push(dlv)
+
+	return nil
+}
+
+// popDelivery pops delivery.Delivery{} from queue and serializes as valid task data.
+func (p *Processor) popDelivery() (*gtsmodel.WorkerTask, error) {
+	// Pop waiting delivery from the delivery worker.
+	dlv, ok := p.state.Workers.Delivery.Queue.Pop()
+	if !ok {
+		return nil, nil
+	}
+
+	// Serialize the delivery task data.
+	data, err := dlv.Serialize()
+	if err != nil {
+		return nil, gtserror.Newf("error serializing delivery: %w", err)
+	}
+
+	return &gtsmodel.WorkerTask{
+		// ID is autoincrement
+		WorkerType: gtsmodel.DeliveryWorker,
+		TaskData:   data,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// pushFederator parses a valid messages.FromFediAPI{} from serialized task data and pushes to queue.
+func (p *Processor) pushFederator(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	var msg messages.FromFediAPI
+
+	// Deserialize the raw worker task data into message.
+	if err := msg.Deserialize(task.TaskData); err != nil {
+		return gtserror.Newf("error deserializing federator message: %w", err)
+	}
+
+	if rcv := msg.Receiving; rcv != nil {
+		// Only a placeholder receiving account will be populated,
+		// fetch the actual model from database by persisted ID.
+		account, err := p.state.DB.GetAccountByID(ctx, rcv.ID)
+		if err != nil {
+			return gtserror.Newf("error fetching receiving account %s from db: %w", rcv.ID, err)
+		}
+
+		// Set the now populated
+		// receiving account model.
+		msg.Receiving = account
+	}
+
+	if req := msg.Requesting; req != nil {
+		// Only a placeholder requesting account will be populated,
+		// fetch the actual model from database by persisted ID.
+		account, err := p.state.DB.GetAccountByID(ctx, req.ID)
+		if err != nil {
+			return gtserror.Newf("error fetching requesting account %s from db: %w", req.ID, err)
+		}
+
+		// Set the now populated
+		// requesting account model.
+		msg.Requesting = account
+	}
+
+	// Push populated task to the federator queue.
+	p.state.Workers.Federator.Queue.Push(&msg)
+
+	return nil
+}
+
+// popFederator pops messages.FromFediAPI{} from queue and serializes as valid task data.
+func (p *Processor) popFederator() (*gtsmodel.WorkerTask, error) {
+	// Pop waiting message from the federator worker.
+	msg, ok := p.state.Workers.Federator.Queue.Pop()
+	if !ok {
+		return nil, nil
+	}
+
+	// Serialize message task data.
+	data, err := msg.Serialize()
+	if err != nil {
+		return nil, gtserror.Newf("error serializing federator message: %w", err)
+	}
+
+	return &gtsmodel.WorkerTask{
+		// ID is autoincrement
+		WorkerType: gtsmodel.FederatorWorker,
+		TaskData:   data,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// pushClient parses a valid messages.FromClientAPI{} from serialized task data and pushes to queue.
+func (p *Processor) pushClient(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	var msg messages.FromClientAPI
+
+	// Deserialize the raw worker task data into message.
+	if err := msg.Deserialize(task.TaskData); err != nil {
+		return gtserror.Newf("error deserializing client message: %w", err)
+	}
+
+	if org := msg.Origin; org != nil {
+		// Only a placeholder origin account will be populated,
+		// fetch the actual model from database by persisted ID.
+		account, err := p.state.DB.GetAccountByID(ctx, org.ID)
+		if err != nil {
+			return gtserror.Newf("error fetching origin account %s from db: %w", org.ID, err)
+		}
+
+		// Set the now populated
+		// origin account model.
+		msg.Origin = account
+	}
+
+	if trg := msg.Target; trg != nil {
+		// Only a placeholder target account will be populated,
+		// fetch the actual model from database by persisted ID.
+		account, err := p.state.DB.GetAccountByID(ctx, trg.ID)
+		if err != nil {
+			return gtserror.Newf("error fetching target account %s from db: %w", trg.ID, err)
+		}
+
+		// Set the now populated
+		// target account model.
+		msg.Target = account
+	}
+
+	// Push populated task to the client queue.
+	p.state.Workers.Client.Queue.Push(&msg)
+
+	return nil
+}
+
+// popClient pops messages.FromClientAPI{} from queue and serializes as valid task data.
+func (p *Processor) popClient() (*gtsmodel.WorkerTask, error) {
+	// Pop waiting message from the client worker.
+	msg, ok := p.state.Workers.Client.Queue.Pop()
+	if !ok {
+		return nil, nil
+	}
+
+	// Serialize message task data.
+	data, err := msg.Serialize()
+	if err != nil {
+		return nil, gtserror.Newf("error serializing client message: %w", err)
+	}
+
+	return &gtsmodel.WorkerTask{
+		// ID is autoincrement
+		WorkerType: gtsmodel.ClientWorker,
+		TaskData:   data,
+		CreatedAt:  time.Now(),
+	}, nil
+}
@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type WorkerTaskTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *WorkerTaskTestSuite) TestPersistAndFillWorkerQueues() {
+	ctx := context.Background()
+
+	// Stop workers so that queued
+	// messages aren't processed.
+	testrig.StopWorkers(&suite.state)
+
+	var (
+		account1 = suite.testAccounts["local_account_1"]
+		account2 = suite.testAccounts["local_account_2"]
+		remote   = suite.testAccounts["remote_account_1"]
+		status   = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	suite.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       status,
+		Origin:         account1,
+		Target:         account2,
+	})
+
+	suite.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityUpdate,
+		Requesting:     remote,
+		Receiving:      account1,
+	})
+
+	// Persist the queued tasks, this should empty the queues.
+	if err := suite.adminProcessor.PersistWorkerQueues(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(suite.state.Workers.Client.Queue.Len())
+	suite.Zero(suite.state.Workers.Federator.Queue.Len())
+
+	tasks, err := suite.state.DB.GetWorkerTasks(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(tasks, 2)
+
+	// Refill the queues from persisted tasks.
+	if err := suite.adminProcessor.FillWorkerQueues(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, suite.state.Workers.Client.Queue.Len())
+	suite.Equal(1, suite.state.Workers.Federator.Queue.Len())
+
+	// Persisted tasks should now be gone.
+	tasks, err = suite.state.DB.GetWorkerTasks(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(tasks)
+
+	// Accounts on messages should be repopulated.
+	clientMsg, _ := suite.state.Workers.Client.Queue.Pop()
+	suite.Equal(account1.Username, clientMsg.Origin.Username)
+	suite.Equal(account2.Username, clientMsg.Target.Username)
+	suite.Equal(status.ID, clientMsg.GTSModel.(*gtsmodel.Status).ID)
+
+	fediMsg, _ := suite.state.Workers.Federator.Queue.Pop()
+	suite.Equal(remote.URI, fediMsg.Requesting.URI)
+	suite.Equal(account1.Username, fediMsg.Receiving.Username)
+}
+
+func TestWorkerTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkerTaskTestSuite))
+}
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Get returns the announcements currently shown to users, oldest
+// published first. If requester is set, whether they've read each
+// announcement and which reactions are theirs is included, and
+// unless withDismissed, the announcements they've read are left out.
+func (p *Processor) Get(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	withDismissed bool,
+) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetPublishedAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	now := time.Now()
+	apiAnnouncements := make([]*apimodel.Announcement, 0, len(announcements))
+
+	for _, announcement := range announcements {
+		if announcement.IsEnded(now) {
+			// No longer shown.
+			continue
+		}
+
+		apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, requester)
+		if err != nil {
+			err := gtserror.Newf("error converting announcement %s: %w", announcement.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if apiAnnouncement.Read && !withDismissed {
+			// Requester dismissed this one.
+			continue
+		}
+
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// Dismiss marks the announcement with the given ID as read by the
+// requester. Dismissing an already dismissed announcement is not
+// an error.
+func (p *Processor) Dismiss(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) gtserror.WithCode {
+	announcement, errWithCode := p.getShownAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.PutAnnouncementRead(ctx, announcement.ID, requester.ID); err != nil {
+		err := gtserror.Newf("db error marking announcement %s read: %w", announcement.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// getShownAnnouncement gets the announcement with the given ID,
+// returning a not found error if there's no such announcement
+// currently shown to users.
+func (p *Processor) getShownAnnouncement(ctx context.Context, id string) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement == nil ||
+		!announcement.IsPublished() ||
+		announcement.IsEnded(time.Now()) {
+		err := gtserror.Newf("announcement %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return announcement, nil
+}
@@ -0,0 +1,225 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+const (
+	// maxReactions is the most different
+	// reactions an announcement may have.
+	maxReactions = 8
+
+	// maxEmojiRunes is the most runes in a unicode
+	// emoji reaction; the longest emoji sequences,
+	// like families with skin tones, are about 10.
+	maxEmojiRunes = 16
+)
+
+// AddReaction adds a reaction with the given name by the requester
+// to the announcement with the given ID. The name is either a unicode
+// emoji, or the shortcode of one of this instance's custom emojis.
+// Adding a reaction the requester already added is not an error.
+func (p *Processor) AddReaction(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	name string,
+) gtserror.WithCode {
+	announcement, errWithCode := p.getShownAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	emojiID, errWithCode := p.reactionEmojiID(ctx, name)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement reactions: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Count reactions by
+	// each different name.
+	counts := countReactions(reactions)
+	if _, ok := counts[name]; !ok && len(counts) >= maxReactions {
+		text := fmt.Sprintf("announcements can't have more than %d different reactions", maxReactions)
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	err = p.state.DB.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AnnouncementID: announcement.ID,
+		AccountID:      requester.ID,
+		Name:           name,
+		EmojiID:        emojiID,
+	})
+	switch {
+	case errors.Is(err, db.ErrAlreadyExists):
+		// Already reacted,
+		// nothing changed.
+		return nil
+
+	case err != nil:
+		err := gtserror.Newf("db error putting announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.stream.AnnouncementReaction(ctx, &apimodel.AnnouncementReactionEvent{
+		Name:           name,
+		Count:          counts[name] + 1,
+		AnnouncementID: announcement.ID,
+	})
+
+	return nil
+}
+
+// RemoveReaction removes the reaction with the given name by the
+// requester from the announcement with the given ID. Removing a
+// reaction the requester hasn't added is not an error.
+func (p *Processor) RemoveReaction(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	name string,
+) gtserror.WithCode {
+	announcement, errWithCode := p.getShownAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement reactions: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	var reacted bool
+	for _, reaction := range reactions {
+		if reaction.AccountID == requester.ID &&
+			reaction.Name == name {
+			reacted = true
+			break
+		}
+	}
+
+	if !reacted {
+		// Nothing
+		// to remove.
+		return nil
+	}
+
+	if err := p.state.DB.DeleteAnnouncementReaction(ctx, announcement.ID, requester.ID, name); err != nil {
+		err := gtserror.Newf("db error deleting announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.stream.AnnouncementReaction(ctx, &apimodel.AnnouncementReactionEvent{
+		Name:           name,
+		Count:          countReactions(reactions)[name] - 1,
+		AnnouncementID: announcement.ID,
+	})
+
+	return nil
+}
+
+// reactionEmojiID checks that the given reaction name is
+// either the shortcode of an enabled local custom emoji, in
+// which case the emoji's ID is returned, or a unicode emoji.
+func (p *Processor) reactionEmojiID(ctx context.Context, name string) (string, gtserror.WithCode) {
+	emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, name, "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting emoji %s: %w", name, err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji != nil && !*emoji.Disabled {
+		return emoji.ID, nil
+	}
+
+	if !isUnicodeEmoji(name) {
+		const text = "reaction must be a unicode emoji, or the shortcode of a custom emoji on this instance"
+		return "", gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return "", nil
+}
+
+// countReactions returns the number
+// of the given reactions by name.
+func countReactions(reactions []*gtsmodel.AnnouncementReaction) map[string]int {
+	counts := make(map[string]int, len(reactions))
+	for _, reaction := range reactions {
+		counts[reaction.Name]++
+	}
+	return counts
+}
+
+// isUnicodeEmoji returns whether the given string looks like a
+// single unicode emoji, including any modifiers and sequences.
+// This is a loose check of the characters used, as the set of
+// emojis is large, and grows with every unicode release.
+func isUnicodeEmoji(s string) bool {
+	if utf8.RuneCountInString(s) > maxEmojiRunes {
+		return false
+	}
+
+	var symbol bool
+	for _, r := range s {
+		switch {
+		case r < utf8.RuneSelf:
+			// Keycap emojis start
+			// with an ascii char.
+			if !strings.ContainsRune("#*0123456789", r) {
+				return false
+			}
+
+		case unicode.In(r,
+			unicode.So, // emoji symbols themselves
+			unicode.Me, // enclosing keycap
+		):
+			symbol = true
+
+		case unicode.In(r,
+			unicode.Sk, // skin tone modifiers
+			unicode.Mn, // variation selectors
+			unicode.Cf, // zero width joiners, tags
+		):
+
+		default:
+			return false
+		}
+	}
+
+	return symbol
+}
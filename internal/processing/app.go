@@ -29,12 +29,14 @@ import (
 )
 
 func (p *Processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.ApplicationCreateRequest) (*apimodel.Application, gtserror.WithCode) {
+	// ignore any scopes we don't recognize (eg., ones only
+	// supported by other server software), rather than
+	// failing, so that such clients can still sign in
+	scopes := oauth.KnownScopes(form.Scopes)
+
 	// set default 'read' for scopes if it's not set
-	var scopes string
-	if form.Scopes == "" {
+	if scopes == "" {
 		scopes = "read"
-	} else {
-		scopes = form.Scopes
 	}
 
 	// generate new IDs for this application and its associated client
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package processing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type AppTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *AppTestSuite) TestAppCreateUnknownScopes() {
+	ctx := context.Background()
+
+	apiApp, errWithCode := suite.processor.AppCreate(ctx, nil, &apimodel.ApplicationCreateRequest{
+		ClientName:   "some app",
+		RedirectURIs: "urn:ietf:wg:oauth:2.0:oob",
+		Scopes:       "read profile write:statuses",
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Unrecognized "profile" scope should be dropped.
+	dbApp, err := suite.db.GetApplicationByClientID(ctx, apiApp.ClientID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("read write:statuses", dbApp.Scopes)
+}
+
+func (suite *AppTestSuite) TestAppCreateOnlyUnknownScopes() {
+	ctx := context.Background()
+
+	apiApp, errWithCode := suite.processor.AppCreate(ctx, nil, &apimodel.ApplicationCreateRequest{
+		ClientName:   "some app",
+		RedirectURIs: "urn:ietf:wg:oauth:2.0:oob",
+		Scopes:       "profile",
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Should fall back to default.
+	dbApp, err := suite.db.GetApplicationByClientID(ctx, apiApp.ClientID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("read", dbApp.Scopes)
+}
+
+func TestAppTestSuite(t *testing.T) {
+	suite.Run(t, new(AppTestSuite))
+}
@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Delete removes the given conversation from the requesting
+// account's conversation list. The statuses it contains are
+// not affected, and a new conversation will be created if a
+// further direct message arrives in the same thread.
+func (p *Processor) Delete(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	id string,
+) gtserror.WithCode {
+	// Get the conversation so that we can check its owning account ID.
+	if _, errWithCode := p.getConversationOwnedBy(ctx, id, requestingAccount); errWithCode != nil {
+		return errWithCode
+	}
+
+	// Delete the conversation.
+	if err := p.state.DB.DeleteConversationByID(ctx, id); err != nil {
+		return gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error deleting conversation %s: %w", id, err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// GetAll returns conversations owned by the given account.
+// The additional parameters can be used for paging.
+func (p *Processor) GetAll(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	conversations, err := p.state.DB.GetConversationsByOwnerAccountID(
+		ctx,
+		requestingAccount.ID,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf(
+				"DB error getting conversations for account %s: %w",
+				requestingAccount.ID,
+				err,
+			),
+		)
+	}
+
+	// Check for empty response.
+	count := len(conversations)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest last status ID
+	// values, used for paging. Conversations are
+	// ordered by their last status, not their ID.
+	lo := conversations[count-1].LastStatusID
+	hi := conversations[0].LastStatusID
+
+	filters, mutes, errWithCode := p.getFiltersAndMutes(ctx, requestingAccount)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, conversation := range conversations {
+		// Skip conversations whose last status
+		// is no longer visible to the requester.
+		visible, err := p.filter.StatusVisible(ctx, requestingAccount, conversation.LastStatus)
+		if err != nil {
+			log.Errorf(ctx, "error checking visibility of conversation %s: %v", conversation.ID, err)
+			continue
+		}
+		if !visible {
+			continue
+		}
+
+		// Convert conversation to frontend API model.
+		apiConversation, err := p.converter.ConversationToAPIConversation(
+			ctx,
+			conversation,
+			requestingAccount,
+			filters,
+			mutes,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error converting conversation %s to API representation: %v", conversation.ID, err)
+			continue
+		}
+
+		// Append conversation to return items.
+		items = append(items, apiConversation)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/conversations",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
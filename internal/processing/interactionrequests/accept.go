@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package interactionrequests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Accept approves the interaction request with the given ID,
+// which must target one of the requester's own statuses.
+func (p *Processor) Accept(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	reqID string,
+) gtserror.WithCode {
+	fave, status, errWithCode := p.getPending(ctx, requester, reqID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Generate a URI for the Accept,
+	// which the interaction will now
+	// be approved by from now on.
+	acceptURI := uris.GenerateURIForAccept(requester.Username, id.NewULID())
+
+	if fave != nil {
+		fave.PendingApproval = util.Ptr(false)
+		fave.ApprovedByURI = acceptURI
+		if err := p.state.DB.UpdateStatusFave(ctx,
+			fave,
+			"pending_approval",
+			"approved_by_uri",
+		); err != nil {
+			err := gtserror.Newf("db error updating status fave: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ActivityLike,
+			APActivityType: ap.ActivityAccept,
+			GTSModel:       fave,
+			Origin:         requester,
+			Target:         fave.Account,
+		})
+
+		return nil
+	}
+
+	status.PendingApproval = util.Ptr(false)
+	status.ApprovedByURI = acceptURI
+	if err := p.state.DB.UpdateStatus(ctx,
+		status,
+		"pending_approval",
+		"approved_by_uri",
+	); err != nil {
+		err := gtserror.Newf("db error updating status: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   statusObjectType(status),
+		APActivityType: ap.ActivityAccept,
+		GTSModel:       status,
+		Origin:         requester,
+		Target:         status.Account,
+	})
+
+	return nil
+}
+
+// getPending fetches the pending fave, or pending reply /
+// boost status, with the given ID, checking that it is
+// indeed pending the approval of the given requester.
+func (p *Processor) getPending(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	reqID string,
+) (*gtsmodel.StatusFave, *gtsmodel.Status, gtserror.WithCode) {
+	fave, err := p.state.DB.GetStatusFaveByID(ctx, reqID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status fave %s: %w", reqID, err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if fave != nil {
+		if !util.PtrValueOr(fave.PendingApproval, false) || fave.TargetAccountID != requester.ID {
+			const text = "interaction request not found"
+			return nil, nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+		return fave, nil, nil
+	}
+
+	status, err := p.state.DB.GetStatusByID(ctx, reqID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status %s: %w", reqID, err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil ||
+		!util.PtrValueOr(status.PendingApproval, false) ||
+		(status.InReplyToAccountID != requester.ID &&
+			status.BoostOfAccountID != requester.ID) {
+		const text = "interaction request not found"
+		return nil, nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return nil, status, nil
+}
+
+// statusObjectType returns the AP object type
+// to use for the given pending reply or boost.
+func statusObjectType(status *gtsmodel.Status) string {
+	if status.BoostOfID != "" {
+		return ap.ActivityAnnounce
+	}
+	return ap.ObjectNote
+}
@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package interactionrequests
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// GetPage returns a page of interactions with statuses
+// of the given account that are awaiting its approval.
+func (p *Processor) GetPage(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	faves, err := p.state.DB.GetPendingStatusFavesForAccount(ctx, requester.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting pending faves: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	statuses, err := p.state.DB.GetPendingStatusesForAccount(ctx, requester.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting pending statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Both lists are returned by the database
+	// in descending ID order; merge them into
+	// one list, keeping that order, and trim
+	// it back down to the requested page size.
+	type pending struct {
+		id     string
+		fave   *gtsmodel.StatusFave
+		status *gtsmodel.Status
+	}
+
+	merged := make([]pending, 0, len(faves)+len(statuses))
+	for _, fave := range faves {
+		merged = append(merged, pending{id: fave.ID, fave: fave})
+	}
+	for _, status := range statuses {
+		merged = append(merged, pending{id: status.ID, status: status})
+	}
+
+	slices.SortFunc(merged, func(a, b pending) int {
+		return strings.Compare(b.id, a.id)
+	})
+
+	if limit := page.GetLimit(); limit > 0 && len(merged) > limit {
+		if page.GetOrder().Ascending() {
+			// Paging up from min_id, so
+			// keep those closest to it.
+			merged = merged[len(merged)-limit:]
+		} else {
+			merged = merged[:limit]
+		}
+	}
+
+	// Check for empty response.
+	count := len(merged)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := merged[count-1].id
+	hi := merged[0].id
+
+	items := make([]interface{}, 0, count)
+	for _, pending := range merged {
+		var (
+			apiReq *apimodel.InteractionRequest
+			err    error
+		)
+
+		if pending.fave != nil {
+			apiReq, err = p.converter.PendingFaveToAPIInteractionRequest(ctx, pending.fave, requester)
+		} else {
+			apiReq, err = p.converter.PendingStatusToAPIInteractionRequest(ctx, pending.status, requester)
+		}
+
+		if err != nil {
+			log.Errorf(ctx, "error converting interaction request %s to api: %v", pending.id, err)
+			continue
+		}
+
+		items = append(items, apiReq)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/interaction_requests",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package interactionrequests
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// Reject rejects the interaction request with the given ID,
+// which must target one of the requester's own statuses.
+// The rejected interaction is removed from the database.
+func (p *Processor) Reject(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	reqID string,
+) gtserror.WithCode {
+	fave, status, errWithCode := p.getPending(ctx, requester, reqID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if fave != nil {
+		if err := p.state.DB.DeleteStatusFaveByID(ctx, fave.ID); err != nil {
+			err := gtserror.Newf("db error deleting status fave: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ActivityLike,
+			APActivityType: ap.ActivityReject,
+			GTSModel:       fave,
+			Origin:         requester,
+			Target:         fave.Account,
+		})
+
+		return nil
+	}
+
+	// Status deletion is
+	// handled by the worker.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   statusObjectType(status),
+		APActivityType: ap.ActivityReject,
+		GTSModel:       status,
+		Origin:         requester,
+		Target:         status.Account,
+	})
+
+	return nil
+}
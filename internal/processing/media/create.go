@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // Create creates a new media attachment belonging to the given account, using the request form.
@@ -35,7 +36,7 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		return f, form.File.Size, err
 	}
 
-	focusX, focusY, err := parseFocus(form.Focus)
+	focusX, focusY, err := util.ParseFocus(form.Focus)
 	if err != nil {
 		err := fmt.Errorf("could not parse focus value %s: %s", form.Focus, err)
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// Create creates a Web Push subscription for the given access token,
+// replacing any subscription the token already had, and returns an
+// API model for the new subscription.
+func (p *Processor) Create(
+	ctx context.Context,
+	accountID string,
+	accessToken string,
+	form *apimodel.WebPushSubscriptionCreateRequest,
+) (*apimodel.WebPushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	endpoint := form.SubscriptionEndpoint()
+	if err := validateEndpoint(endpoint); err != nil {
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	auth := form.SubscriptionKeysAuth()
+	p256dh := form.SubscriptionKeysP256dh()
+	if auth == "" || p256dh == "" {
+		const text = "subscription keys auth and p256dh must be provided"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	policy, errWithCode := parsePolicy(form.DataPolicy())
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Each token may only have one subscription,
+	// so drop any existing one before we create.
+	if err := p.state.DB.DeleteWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+		err := gtserror.Newf("db error deleting existing subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:        id.NewULID(),
+		AccountID: accountID,
+		TokenID:   token.ID,
+		Endpoint:  endpoint,
+		Auth:      auth,
+		P256dh:    p256dh,
+		Alerts:    alertsFromAPI(form.DataAlerts()),
+		Policy:    policy,
+	}
+
+	if err := p.state.DB.PutWebPushSubscription(ctx, subscription); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			const text = "subscription for this token already exists"
+			return nil, gtserror.NewErrorConflict(err, text)
+		}
+		err := gtserror.Newf("db error putting subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+// validateEndpoint checks that the given
+// push service endpoint is an absolute https URL.
+func validateEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errors.New("subscription endpoint must be provided")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("subscription endpoint %s could not be parsed: %w", endpoint, err)
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("subscription endpoint %s must be an absolute https URL", endpoint)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Update replaces the alerts, and the policy if set, of the Web Push
+// subscription of the given access token, and returns an API model.
+func (p *Processor) Update(
+	ctx context.Context,
+	accessToken string,
+	form *apimodel.WebPushSubscriptionUpdateRequest,
+) (*apimodel.WebPushSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getSubscription(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := []string{"alerts"}
+	subscription.Alerts = alertsFromAPI(form.DataAlerts())
+
+	if form.DataPolicy() != nil {
+		policy, errWithCode := parsePolicy(form.DataPolicy())
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		subscription.Policy = policy
+		columns = append(columns, "policy")
+	}
+
+	if err := p.state.DB.UpdateWebPushSubscription(ctx, subscription, columns...); err != nil {
+		err := gtserror.Newf("db error updating subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+// parsePolicy parses the given optional policy
+// string, defaulting to "all" when not set.
+func parsePolicy(policy *string) (gtsmodel.WebPushSubscriptionPolicy, gtserror.WithCode) {
+	if policy == nil || *policy == "" {
+		return gtsmodel.WebPushSubscriptionPolicyAll, nil
+	}
+
+	switch p := gtsmodel.WebPushSubscriptionPolicy(*policy); p {
+	case gtsmodel.WebPushSubscriptionPolicyAll,
+		gtsmodel.WebPushSubscriptionPolicyFollowed,
+		gtsmodel.WebPushSubscriptionPolicyFollower,
+		gtsmodel.WebPushSubscriptionPolicyNone:
+		return p, nil
+	default:
+		err := fmt.Errorf("policy %s not recognized, valid options are all, followed, follower, none", *policy)
+		return "", gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+}
+
+// alertsFromAPI converts API model alerts to gts model alerts.
+func alertsFromAPI(a apimodel.WebPushSubscriptionAlerts) gtsmodel.WebPushSubscriptionAlerts {
+	return gtsmodel.WebPushSubscriptionAlerts{
+		Follow:        a.Follow,
+		FollowRequest: a.FollowRequest,
+		Favourite:     a.Favourite,
+		Mention:       a.Mention,
+		Reblog:        a.Reblog,
+		Poll:          a.Poll,
+		Status:        a.Status,
+		Update:        a.Update,
+		AdminSignup:   a.AdminSignup,
+		PendingFave:   a.PendingFave,
+		PendingReply:  a.PendingReply,
+		PendingReblog: a.PendingReblog,
+	}
+}
@@ -27,7 +27,6 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
-	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -85,19 +84,6 @@ func (p *Processor) Accounts(
 		}...).
 		Debugf("beginning search")
 
-	// todo: Currently we don't support offset for paging;
-	// if caller supplied an offset greater than 0, return
-	// nothing as though there were no additional results.
-	if offset > 0 {
-		return p.packageAccounts(
-			ctx,
-			requestingAccount,
-			foundAccounts,
-			includeInstanceAccounts,
-			includeBlockedAccounts,
-		)
-	}
-
 	// See if we have something that looks like a namestring.
 	username, domain, err := util.ExtractNamestringParts(query)
 	if err == nil {
@@ -108,6 +94,18 @@ func (p *Processor) Accounts(
 			// and show it to them even if they
 			// have it blocked.
 			includeBlockedAccounts = true
+
+			if offset > 0 {
+				// An exact namestring gives at most
+				// one result, so no more pages.
+				return p.packageAccounts(
+					ctx,
+					requestingAccount,
+					foundAccounts,
+					includeInstanceAccounts,
+					includeBlockedAccounts,
+				)
+			}
 		}
 
 		// Get all accounts we can find
@@ -115,8 +113,8 @@ func (p *Processor) Accounts(
 		if err := p.accountsByUsernameDomain(
 			ctx,
 			requestingAccount,
-			id.Highest,
-			id.Lowest,
+			"", // No max ID.
+			"", // No min ID.
 			limit,
 			offset,
 			username,
@@ -134,8 +132,8 @@ func (p *Processor) Accounts(
 		if err := p.accountsByText(
 			ctx,
 			requestingAccount.ID,
-			id.Highest,
-			id.Lowest,
+			"", // No max ID.
+			"", // No min ID.
 			limit,
 			offset,
 			query,
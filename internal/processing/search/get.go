@@ -119,21 +119,6 @@ func (p *Processor) Get(
 		}...).
 		Debugf("beginning search")
 
-	// todo: Currently we don't support offset for paging;
-	// a caller can page using maxID or minID, but if they
-	// supply an offset greater than 0, return nothing as
-	// though there were no additional results.
-	if req.Offset > 0 {
-		return p.packageSearchResult(
-			ctx,
-			account,
-			nil, nil, nil, // No results.
-			req.APIv1,
-			includeInstanceAccounts,
-			includeBlockedAccounts,
-		)
-	}
-
 	var (
 		foundStatuses = make([]*gtsmodel.Status, 0, limit)
 		foundAccounts = make([]*gtsmodel.Account, 0, limit)
@@ -175,6 +160,19 @@ func (p *Processor) Get(
 			includeInstanceAccounts = domainSet
 			includeBlockedAccounts = domainSet
 
+			if domainSet && offset > 0 {
+				// A full namestring gives at most one
+				// result, so there are no more pages.
+				return p.packageSearchResult(
+					ctx,
+					account,
+					nil, nil, nil, // No results.
+					req.APIv1,
+					includeInstanceAccounts,
+					includeBlockedAccounts,
+				)
+			}
+
 			err = p.accountsByUsernameDomain(
 				ctx,
 				account,
@@ -221,6 +219,19 @@ func (p *Processor) Get(
 		// caller wants to include blocked accounts too.
 		includeBlockedAccounts = true
 
+		if offset > 0 {
+			// A URI gives at most one result,
+			// so there are no more pages.
+			return p.packageSearchResult(
+				ctx,
+				account,
+				nil, nil, nil, // No results.
+				req.APIv1,
+				includeInstanceAccounts,
+				includeBlockedAccounts,
+			)
+		}
+
 		if err := p.byURI(
 			ctx,
 			account,
@@ -315,6 +326,11 @@ func (p *Processor) Get(
 		appendAccount,
 		appendStatus,
 	); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		if gtserror.IsMalformed(err) {
+			// Bad search operator in the query.
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
 		err = gtserror.Newf("error searching by text: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -758,7 +774,21 @@ func (p *Processor) byText(
 		minID = ""
 	}
 
-	if includeAccounts(queryType) {
+	// Parse any search operators out of the query.
+	parsed, err := p.parseQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	// If the owning account for statuses was provided as the account_id query
+	// parameter, it takes precedence over any from: operator in the query string.
+	if fromAccountID != "" {
+		parsed.filter.FromAccountID = fromAccountID
+	}
+
+	// Search operators only apply to statuses,
+	// so don't try to match them against accounts.
+	if includeAccounts(queryType) && !parsed.hasOperators {
 		// Search for accounts using the given text.
 		if err := p.accountsByText(ctx,
 			requestingAccount.ID,
@@ -782,8 +812,8 @@ func (p *Processor) byText(
 			minID,
 			limit,
 			offset,
-			query,
-			fromAccountID,
+			parsed.text,
+			&parsed.filter,
 			appendStatus,
 		); err != nil {
 			return err
@@ -821,8 +851,8 @@ func (p *Processor) accountsByText(
 	return nil
 }
 
-// statusesByText searches in the database for limit
-// number of statuses using the given query text.
+// statusesByText searches in the database for limit number
+// of statuses using the given query text and filter.
 func (p *Processor) statusesByText(
 	ctx context.Context,
 	requestingAccountID string,
@@ -831,25 +861,14 @@ func (p *Processor) statusesByText(
 	limit int,
 	offset int,
 	query string,
-	fromAccountID string,
+	filter *db.StatusSearchFilter,
 	appendStatus func(*gtsmodel.Status),
 ) error {
-	parsed, err := p.parseQuery(ctx, query)
-	if err != nil {
-		return err
-	}
-	query = parsed.query
-	// If the owning account for statuses was not provided as the account_id query parameter,
-	// it may still have been provided as a search operator in the query string.
-	if fromAccountID == "" {
-		fromAccountID = parsed.fromAccountID
-	}
-
 	statuses, err := p.state.DB.SearchForStatuses(
 		ctx,
 		requestingAccountID,
 		query,
-		fromAccountID,
+		filter,
 		maxID,
 		minID,
 		limit,
@@ -865,60 +884,3 @@ func (p *Processor) statusesByText(
 
 	return nil
 }
-
-// parsedQuery represents the results of parsing the search operator terms within a query.
-type parsedQuery struct {
-	// query is the original search query text with operator terms removed.
-	query string
-	// fromAccountID is the account from a successfully resolved `from:` operator, if present.
-	fromAccountID string
-}
-
-// parseQuery parses query text and handles any search operator terms present.
-func (p *Processor) parseQuery(ctx context.Context, query string) (parsed parsedQuery, err error) {
-	queryPartSeparator := " "
-	queryParts := strings.Split(query, queryPartSeparator)
-	nonOperatorQueryParts := make([]string, 0, len(queryParts))
-	for _, queryPart := range queryParts {
-		if arg, hasPrefix := strings.CutPrefix(queryPart, "from:"); hasPrefix {
-			parsed.fromAccountID, err = p.parseFromOperatorArg(ctx, arg)
-			if err != nil {
-				return
-			}
-		} else {
-			nonOperatorQueryParts = append(nonOperatorQueryParts, queryPart)
-		}
-	}
-	parsed.query = strings.Join(nonOperatorQueryParts, queryPartSeparator)
-	return
-}
-
-// parseFromOperatorArg attempts to parse the from: operator's argument as an account name,
-// and returns the account ID if possible. Allows specifying an account name with or without a leading @.
-func (p *Processor) parseFromOperatorArg(ctx context.Context, namestring string) (string, error) {
-	if namestring == "" {
-		return "", gtserror.New(
-			"the 'from:' search operator requires an account name, but it wasn't provided",
-		)
-	}
-	if namestring[0] != '@' {
-		namestring = "@" + namestring
-	}
-
-	username, domain, err := util.ExtractNamestringParts(namestring)
-	if err != nil {
-		return "", gtserror.Newf(
-			"the 'from:' search operator couldn't parse its argument as an account name: %w",
-			err,
-		)
-	}
-	account, err := p.state.DB.GetAccountByUsernameDomain(gtscontext.SetBarebones(ctx), username, domain)
-	if err != nil {
-		return "", gtserror.Newf(
-			"the 'from:' search operator couldn't find the requested account name: %w",
-			err,
-		)
-	}
-
-	return account.ID, nil
-}
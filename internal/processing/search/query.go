@@ -0,0 +1,268 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// queryDateLayout is the layout of dates given
+// to the before:, after:, and during: operators.
+const queryDateLayout = "2006-01-02"
+
+// parsedQuery represents the results of parsing the search operator terms within a query.
+type parsedQuery struct {
+	// text is the original search query text with operator terms and quoted phrases removed.
+	text string
+	// filter restricts status search according to the operator terms and quoted phrases.
+	filter db.StatusSearchFilter
+	// hasOperators is true if the query contained any operator terms.
+	hasOperators bool
+}
+
+// parseQuery parses query text and handles any search operator terms present.
+// Errors caused by bad operator terms are marked with gtserror.SetMalformed.
+func (p *Processor) parseQuery(ctx context.Context, query string) (parsed parsedQuery, err error) {
+	queryParts := splitQuery(query)
+	textQueryParts := make([]string, 0, len(queryParts))
+	for _, queryPart := range queryParts {
+		if queryPart[0] == '"' {
+			// Quoted phrase.
+			phrase := strings.Trim(queryPart, `"`)
+			if phrase != "" {
+				parsed.filter.Phrases = append(parsed.filter.Phrases, phrase)
+			}
+			continue
+		}
+
+		operator, arg, ok := strings.Cut(queryPart, ":")
+		if !ok {
+			textQueryParts = append(textQueryParts, queryPart)
+			continue
+		}
+
+		switch operator = strings.ToLower(operator); operator {
+		case "from":
+			parsed.filter.FromAccountID, err = p.parseFromOperatorArg(ctx, arg)
+		case "has":
+			err = parseHasOperatorArg(&parsed.filter, arg)
+		case "is":
+			err = parseIsOperatorArg(&parsed.filter, arg)
+		case "in":
+			err = parseInOperatorArg(&parsed.filter, arg)
+		case "language":
+			parsed.filter.Language, err = parseLanguageOperatorArg(arg)
+		case "before", "after", "during":
+			err = parseDateOperatorArg(&parsed.filter, operator, arg)
+		default:
+			// Not an operator, just
+			// text with a colon in it.
+			textQueryParts = append(textQueryParts, queryPart)
+			continue
+		}
+
+		if err != nil {
+			return
+		}
+		parsed.hasOperators = true
+	}
+	parsed.text = strings.Join(textQueryParts, " ")
+	return
+}
+
+// splitQuery splits query text on whitespace, except
+// within double quotes, so that each quoted phrase
+// is returned as one part, including its quotes.
+// An unterminated quote runs to the end of the query.
+func splitQuery(query string) []string {
+	var (
+		parts  []string
+		part   strings.Builder
+		quoted bool
+	)
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			if !quoted && part.Len() > 0 {
+				// Quote starts a new part.
+				parts = append(parts, part.String())
+				part.Reset()
+			}
+			part.WriteRune(r)
+			quoted = !quoted
+
+		case unicode.IsSpace(r) && !quoted:
+			if part.Len() > 0 {
+				parts = append(parts, part.String())
+				part.Reset()
+			}
+
+		default:
+			part.WriteRune(r)
+		}
+	}
+
+	if part.Len() > 0 {
+		parts = append(parts, part.String())
+	}
+
+	return parts
+}
+
+// parseFromOperatorArg attempts to parse the from: operator's argument as an account name,
+// and returns the account ID if possible. Allows specifying an account name with or without a leading @.
+func (p *Processor) parseFromOperatorArg(ctx context.Context, namestring string) (string, error) {
+	if namestring == "" {
+		return "", gtserror.SetMalformed(errors.New(
+			"the 'from:' search operator requires an account name, but it wasn't provided",
+		))
+	}
+	if namestring[0] != '@' {
+		namestring = "@" + namestring
+	}
+
+	username, domain, err := util.ExtractNamestringParts(namestring)
+	if err != nil {
+		return "", gtserror.SetMalformed(fmt.Errorf(
+			"the 'from:' search operator couldn't parse its argument as an account name: %w",
+			err,
+		))
+	}
+	account, err := p.state.DB.GetAccountByUsernameDomain(gtscontext.SetBarebones(ctx), username, domain)
+	if errors.Is(err, db.ErrNoEntries) {
+		return "", gtserror.SetMalformed(fmt.Errorf(
+			"the 'from:' search operator couldn't find the requested account name %s",
+			namestring,
+		))
+	} else if err != nil {
+		return "", gtserror.Newf("db error getting account %s: %w", namestring, err)
+	}
+
+	return account.ID, nil
+}
+
+// parseHasOperatorArg sets the filter
+// field named by the has: operator's argument.
+func parseHasOperatorArg(filter *db.StatusSearchFilter, arg string) error {
+	switch strings.ToLower(arg) {
+	case "media":
+		filter.HasMedia = true
+	case "poll":
+		filter.HasPoll = true
+	default:
+		return gtserror.SetMalformed(fmt.Errorf(
+			"the 'has:' search operator argument %s was not recognized, valid options are ['media', 'poll']",
+			arg,
+		))
+	}
+	return nil
+}
+
+// parseIsOperatorArg sets the filter
+// field named by the is: operator's argument.
+func parseIsOperatorArg(filter *db.StatusSearchFilter, arg string) error {
+	switch strings.ToLower(arg) {
+	case "reply":
+		filter.IsReply = true
+	case "sensitive":
+		filter.IsSensitive = true
+	default:
+		return gtserror.SetMalformed(fmt.Errorf(
+			"the 'is:' search operator argument %s was not recognized, valid options are ['reply', 'sensitive']",
+			arg,
+		))
+	}
+	return nil
+}
+
+// parseInOperatorArg sets the filter
+// field named by the in: operator's argument.
+func parseInOperatorArg(filter *db.StatusSearchFilter, arg string) error {
+	switch strings.ToLower(arg) {
+	case "library":
+		filter.InLibrary = true
+	default:
+		return gtserror.SetMalformed(fmt.Errorf(
+			"the 'in:' search operator argument %s was not recognized, valid options are ['library']",
+			arg,
+		))
+	}
+	return nil
+}
+
+// parseLanguageOperatorArg parses the language: operator's
+// argument as a language tag, and returns it normalized
+// to the form in which status languages are stored.
+func parseLanguageOperatorArg(arg string) (string, error) {
+	lang, err := validate.Language(arg)
+	if err != nil {
+		return "", gtserror.SetMalformed(fmt.Errorf(
+			"the 'language:' search operator couldn't parse its argument as a language tag: %w",
+			err,
+		))
+	}
+	return lang, nil
+}
+
+// parseDateOperatorArg parses the argument of the given
+// before:, after:, or during: operator as a date in UTC,
+// and narrows the filter's time range to match. before:
+// and after: exclude the given date, and during: covers it.
+func parseDateOperatorArg(filter *db.StatusSearchFilter, operator string, arg string) error {
+	date, err := time.Parse(queryDateLayout, arg)
+	if err != nil {
+		return gtserror.SetMalformed(fmt.Errorf(
+			"the '%s:' search operator requires a date formatted as YYYY-MM-DD, but got %s",
+			operator, arg,
+		))
+	}
+
+	var since, until time.Time
+	switch operator {
+	case "before":
+		until = date
+	case "after":
+		since = date.AddDate(0, 0, 1)
+	case "during":
+		since = date
+		until = date.AddDate(0, 0, 1)
+	}
+
+	// Only ever narrow the range, so that
+	// multiple date operators all apply.
+	if !since.IsZero() && since.After(filter.Since) {
+		filter.Since = since
+	}
+	if !until.IsZero() && (filter.Until.IsZero() || until.Before(filter.Until)) {
+		filter.Until = until
+	}
+
+	return nil
+}
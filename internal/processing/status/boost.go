@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // BoostCreate processes the boost/reblog of target
@@ -75,9 +76,10 @@ func (p *Processor) BoostCreate(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	if !boostable {
-		err := gtserror.New("status is not boostable")
-		return nil, gtserror.NewErrorNotFound(err)
+	if boostable.Forbidden() {
+		const errText = "you do not have permission to boost this status"
+		err := gtserror.New(errText)
+		return nil, gtserror.NewErrorForbidden(err, errText)
 	}
 
 	// Status is visible and boostable.
@@ -90,6 +92,10 @@ func (p *Processor) BoostCreate(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// If the boost requires approval, it's
+	// pending until the status author accepts.
+	boost.PendingApproval = util.Ptr(boostable.PermittedWithApproval())
+
 	// Store the new boost.
 	if err := p.state.DB.PutStatus(ctx, boost); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -78,6 +78,7 @@ func (p *Processor) Create(
 		Sensitive:                &form.Sensitive,
 		CreatedWithApplicationID: application.ID,
 		Text:                     form.Status,
+		PendingApproval:          util.Ptr(false),
 	}
 
 	if form.Poll != nil {
@@ -117,10 +118,20 @@ func (p *Processor) Create(
 		return nil, errWithCode
 	}
 
+	if len(status.AttachmentIDs) != 0 && requester.IsSensitized() {
+		// Media of sensitized
+		// accounts is always sensitive.
+		status.Sensitive = util.Ptr(true)
+	}
+
 	if err := processVisibility(form, requester.Settings.Privacy, status); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if errWithCode := processInteractionPolicy(form, requester.Settings, status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	if err := processLanguage(form, requester.Settings.Language, status); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -185,11 +196,25 @@ func (p *Processor) processInReplyTo(ctx context.Context, requester *gtsmodel.Ac
 		return errWithCode
 	}
 
-	if !*inReplyTo.Replyable {
-		const text = "in-reply-to status marked as not replyable"
+	// Ensure valid reply target for requester.
+	replyable, err := p.filter.StatusReplyable(ctx,
+		requester,
+		inReplyTo,
+	)
+	if err != nil {
+		err := gtserror.Newf("error seeing if status %s is replyable: %w", inReplyTo.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if replyable.Forbidden() {
+		const text = "you do not have permission to reply to this status"
 		return gtserror.NewErrorForbidden(errors.New(text), text)
 	}
 
+	// If the reply requires approval, it's pending
+	// until the in-reply-to status author accepts.
+	status.PendingApproval = util.Ptr(replyable.PermittedWithApproval())
+
 	// Set status fields from inReplyTo.
 	status.InReplyToID = inReplyTo.ID
 	status.InReplyTo = inReplyTo
@@ -244,54 +269,64 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.Advanced
 		return nil
 	}
 
+	attachments, attachmentIDs, errWithCode := p.getAttachableMedia(ctx, form.MediaIDs, thisAccountID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	status.Attachments = attachments
+	status.AttachmentIDs = attachmentIDs
+	return nil
+}
+
+// getAttachableMedia fetches the media with the given
+// IDs, checking that each belongs to the given account,
+// is not yet attached to a status or scheduled status,
+// and has a long enough description.
+func (p *Processor) getAttachableMedia(ctx context.Context, mediaIDs []string, thisAccountID string) ([]*gtsmodel.MediaAttachment, []string, gtserror.WithCode) {
 	// Get minimum allowed char descriptions.
 	minChars := config.GetMediaDescriptionMinChars()
 
 	attachments := []*gtsmodel.MediaAttachment{}
 	attachmentIDs := []string{}
 
-	for _, mediaID := range form.MediaIDs {
+	for _, mediaID := range mediaIDs {
 		attachment, err := p.state.DB.GetAttachmentByID(ctx, mediaID)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
 			err := gtserror.Newf("error fetching media from db: %w", err)
-			return gtserror.NewErrorInternalError(err)
+			return nil, nil, gtserror.NewErrorInternalError(err)
 		}
 
 		if attachment == nil {
 			text := fmt.Sprintf("media %s not found", mediaID)
-			return gtserror.NewErrorBadRequest(errors.New(text), text)
+			return nil, nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
 		if attachment.AccountID != thisAccountID {
 			text := fmt.Sprintf("media %s does not belong to account", mediaID)
-			return gtserror.NewErrorBadRequest(errors.New(text), text)
+			return nil, nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
 		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
 			text := fmt.Sprintf("media %s already attached to status", mediaID)
-			return gtserror.NewErrorBadRequest(errors.New(text), text)
+			return nil, nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
 		if length := len([]rune(attachment.Description)); length < minChars {
 			text := fmt.Sprintf("media %s description too short, at least %d required", mediaID, minChars)
-			return gtserror.NewErrorBadRequest(errors.New(text), text)
+			return nil, nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
 		attachments = append(attachments, attachment)
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
 
-	status.Attachments = attachments
-	status.AttachmentIDs = attachmentIDs
-	return nil
+	return attachments, attachmentIDs, nil
 }
 
 func processVisibility(form *apimodel.AdvancedStatusCreateForm, accountDefaultVis gtsmodel.Visibility, status *gtsmodel.Status) error {
-	// by default all flags are set to true
+	// by default all statuses are federated
 	federated := true
-	boostable := true
-	replyable := true
-	likeable := true
 
 	// If visibility isn't set on the form, then just take the account default.
 	// If that's also not set, take the default for the whole instance.
@@ -306,56 +341,66 @@ func processVisibility(form *apimodel.AdvancedStatusCreateForm, accountDefaultVi
 	}
 
 	switch vis {
-	case gtsmodel.VisibilityPublic:
-		// for public, there's no need to change any of the advanced flags from true regardless of what the user filled out
-		break
-	case gtsmodel.VisibilityUnlocked:
-		// for unlocked the user can set any combination of flags they like so look at them all to see if they're set and then apply them
+	case gtsmodel.VisibilityUnlocked,
+		gtsmodel.VisibilityFollowersOnly,
+		gtsmodel.VisibilityMutualsOnly:
+		// for unlocked, followers or mutuals only, the user can choose not to federate
 		if form.Federated != nil {
 			federated = *form.Federated
 		}
+	}
 
-		if form.Boostable != nil {
-			boostable = *form.Boostable
-		}
+	status.Visibility = vis
+	status.Federated = &federated
+	return nil
+}
 
-		if form.Replyable != nil {
-			replyable = *form.Replyable
+func processInteractionPolicy(form *apimodel.AdvancedStatusCreateForm, settings *gtsmodel.AccountSettings, status *gtsmodel.Status) gtserror.WithCode {
+	if form.InteractionPolicy != nil {
+		// An explicit interaction policy was
+		// set on the form, so use that one.
+		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(form.InteractionPolicy)
+		if err != nil {
+			return gtserror.NewErrorBadRequest(err, err.Error())
 		}
 
-		if form.Likeable != nil {
-			likeable = *form.Likeable
-		}
+		status.InteractionPolicy = policy
+		return nil
+	}
 
-	case gtsmodel.VisibilityFollowersOnly, gtsmodel.VisibilityMutualsOnly:
-		// for followers or mutuals only, boostable will *always* be false, but the other fields can be set so check and apply them
-		boostable = false
+	// Start with the account's default policy for this visibility.
+	var policy gtsmodel.InteractionPolicy
+	if settings != nil {
+		policy = *settings.InteractionPolicyFor(status.Visibility)
+	} else {
+		policy = *gtsmodel.DefaultInteractionPolicyFor(status.Visibility)
+	}
 
-		if form.Federated != nil {
-			federated = *form.Federated
+	switch status.Visibility {
+	case gtsmodel.VisibilityUnlocked,
+		gtsmodel.VisibilityFollowersOnly,
+		gtsmodel.VisibilityMutualsOnly:
+		// For these visibilities, the deprecated boostable,
+		// replyable and likeable flags can still be used to
+		// restrict the corresponding interaction to the author.
+		authorOnly := gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
 		}
 
-		if form.Replyable != nil {
-			replyable = *form.Replyable
+		if form.Boostable != nil && !*form.Boostable {
+			policy.CanAnnounce = authorOnly
 		}
 
-		if form.Likeable != nil {
-			likeable = *form.Likeable
+		if form.Replyable != nil && !*form.Replyable {
+			policy.CanReply = authorOnly
 		}
 
-	case gtsmodel.VisibilityDirect:
-		// direct is pretty easy: there's only one possible setting so return it
-		federated = true
-		boostable = false
-		replyable = true
-		likeable = true
+		if form.Likeable != nil && !*form.Likeable {
+			policy.CanLike = authorOnly
+		}
 	}
 
-	status.Visibility = vis
-	status.Federated = &federated
-	status.Boostable = &boostable
-	status.Replyable = &replyable
-	status.Likeable = &likeable
+	status.InteractionPolicy = &policy
 	return nil
 }
 
@@ -378,6 +423,26 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 		form.ContentType = contentType
 	}
 
+	return p.formatContent(ctx,
+		parseMention,
+		form.ContentType,
+		form.Status,
+		form.SpoilerText,
+		status,
+	)
+}
+
+// formatContent formats the given status text and spoiler text (and any
+// poll options set on status) according to content-type, storing the
+// results along with gathered mentions, tags and emojis on the status.
+func (p *Processor) formatContent(
+	ctx context.Context,
+	parseMention gtsmodel.ParseMentionFunc,
+	contentType apimodel.StatusContentType,
+	statusText string,
+	spoilerText string,
+	status *gtsmodel.Status,
+) error {
 	// format is the currently set text formatting
 	// function, according to the provided content-type.
 	var format text.FormatFunc
@@ -388,7 +453,7 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 		return formatFunc(ctx, parseMention, status.AccountID, status.ID, input)
 	}
 
-	switch form.ContentType {
+	switch contentType {
 	// None given / set,
 	// use default (plain).
 	case "":
@@ -404,11 +469,11 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 
 	// Unknown.
 	default:
-		return fmt.Errorf("invalid status format: %q", form.ContentType)
+		return fmt.Errorf("invalid status format: %q", contentType)
 	}
 
 	// Sanitize status text and format.
-	contentRes := formatInput(format, form.Status)
+	contentRes := formatInput(format, statusText)
 
 	// Collect formatted results.
 	status.Content = contentRes.HTML
@@ -421,7 +486,7 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 	format = p.formatter.FromPlainEmojiOnly
 
 	// Sanitize content warning and format.
-	spoiler := text.SanitizeToPlaintext(form.SpoilerText)
+	spoiler := text.SanitizeToPlaintext(spoilerText)
 	warningRes := formatInput(format, spoiler)
 
 	// Collect formatted results.
@@ -0,0 +1,411 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Edit processes the given form to edit an existing status belonging
+// to requester, storing the previous revision of the status in its
+// edit history and returning the api model of the edited status.
+//
+// Precondition: the form's fields should have already been validated and normalized by the caller.
+func (p *Processor) Edit(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	statusID string,
+	form *apimodel.StatusEditRequest,
+) (
+	*apimodel.Status,
+	gtserror.WithCode,
+) {
+	// Fetch status to edit from the database.
+	status, err := p.state.DB.GetStatusByID(ctx, statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error fetching status %s: %w", statusID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil || status.AccountID != requester.ID {
+		// Don't leak existence of other accounts' statuses.
+		const text = "status not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if status.BoostOfID != "" {
+		const text = "boosts cannot be edited"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Ensure account populated; we'll need settings.
+	if err := p.state.DB.PopulateAccount(ctx, requester); err != nil {
+		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
+	}
+
+	// Get current time.
+	now := time.Now()
+
+	// Snapshot the current revision of the
+	// status BEFORE we start making changes.
+	edit := statusToEdit(status)
+
+	// Start with a shallow copy of the status
+	// with all of the to-be-reformatted fields reset.
+	edited := new(gtsmodel.Status)
+	*edited = *status
+	edited.Sensitive = &form.Sensitive
+	edited.Text = form.Status
+	edited.Mentions, edited.MentionIDs = nil, nil
+	edited.Tags, edited.TagIDs = nil, nil
+	edited.Emojis, edited.EmojiIDs = nil, nil
+
+	if form.Language != "" {
+		edited.Language = form.Language
+	}
+
+	// Check + attach media for edited status.
+	if errWithCode := p.processEditMedia(ctx,
+		form,
+		requester.ID,
+		edited,
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if len(edited.AttachmentIDs) != 0 && requester.IsSensitized() {
+		// Media of sensitized
+		// accounts is always sensitive.
+		edited.Sensitive = util.Ptr(true)
+	}
+
+	// Set any new poll on the edited status.
+	p.processEditPoll(form, edited, now)
+
+	contentType := form.ContentType
+	if contentType == "" {
+		// If content type wasn't specified, use the author's preferred content-type.
+		contentType = apimodel.StatusContentType(requester.Settings.StatusContentType)
+	}
+
+	if err := p.formatContent(ctx,
+		p.parseMention,
+		contentType,
+		form.Status,
+		form.SpoilerText,
+		edited,
+	); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check whether the existing poll (if any) needs
+	// replacing, and whether the new one needs inserting.
+	oldPoll, newPoll := checkEditPoll(status, edited)
+
+	if oldPoll != nil {
+		// The previous poll is being replaced,
+		// so store its final counts in history.
+		edit.PollVotes = slices.Clone(oldPoll.Votes)
+	}
+
+	// Insert the previous status revision in the database.
+	if err := p.state.DB.PutStatusEdit(ctx, edit); err != nil {
+		err := gtserror.Newf("error inserting status edit in db: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Append new revision to the status history.
+	edited.EditIDs = append(slices.Clone(status.EditIDs), edit.ID)
+	edited.Edits = append(slices.Clone(status.Edits), edit)
+	edited.EditedAt = now
+
+	if newPoll {
+		// Try to insert the new status poll in the database.
+		if err := p.state.DB.PutPoll(ctx, edited.Poll); err != nil {
+			err := gtserror.Newf("error inserting poll in db: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Update the edited status in the database.
+	if err := p.state.DB.UpdateStatus(ctx, edited,
+		"activity_streams_type",
+		"attachments",
+		"content",
+		"content_warning",
+		"edited_at",
+		"edits",
+		"emojis",
+		"language",
+		"mentions",
+		"poll_id",
+		"sensitive",
+		"tags",
+		"text",
+	); err != nil {
+		err := gtserror.Newf("error updating status in db: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Formatting the edited text will have created
+	// fresh mentions, so delete the previous ones.
+	for _, id := range status.MentionIDs {
+		if err := p.state.DB.DeleteMentionByID(ctx, id); err != nil {
+			log.Errorf(ctx, "error deleting old status mention: %v", err)
+		}
+	}
+
+	if oldPoll != nil {
+		// Delete the replaced poll by ID from the database.
+		if err := p.state.DB.DeletePollByID(ctx, oldPoll.ID); err != nil {
+			log.Errorf(ctx, "error deleting old status poll: %v", err)
+		}
+
+		// Delete any poll votes pointing to the replaced poll.
+		if err := p.state.DB.DeletePollVotes(ctx, oldPoll.ID); err != nil {
+			log.Errorf(ctx, "error deleting old status poll votes: %v", err)
+		}
+
+		// Cancel any scheduled expiry task for poll.
+		_ = p.state.Workers.Scheduler.Cancel(oldPoll.ID)
+	}
+
+	// Send it to the client API worker for async side-effects.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       edited,
+		Origin:         requester,
+	})
+
+	if newPoll {
+		// Now that the status is updated, and side effects queued,
+		// attempt to schedule an expiry handler for the status poll.
+		if err := p.polls.ScheduleExpiry(ctx, edited.Poll); err != nil {
+			log.Errorf(ctx, "error scheduling poll expiry: %v", err)
+		}
+	}
+
+	return p.c.GetAPIStatus(ctx, requester, edited)
+}
+
+// statusToEdit returns a new StatusEdit model
+// snapshotting the current revision of status.
+func statusToEdit(status *gtsmodel.Status) *gtsmodel.StatusEdit {
+	edit := &gtsmodel.StatusEdit{
+		ID:             id.NewULID(),
+		Content:        status.Content,
+		ContentWarning: status.ContentWarning,
+		Text:           status.Text,
+		Language:       status.Language,
+		Sensitive:      util.Ptr(*status.Sensitive),
+		AttachmentIDs:  slices.Clone(status.AttachmentIDs),
+		StatusID:       status.ID,
+	}
+
+	// The previous revision was created either
+	// at the last edit, or when status was created.
+	edit.CreatedAt = status.EditedAt
+	if edit.CreatedAt.IsZero() {
+		edit.CreatedAt = status.CreatedAt
+	}
+
+	// Store attachment descriptions at this revision,
+	// as these are editable alongside status itself.
+	if len(status.Attachments) > 0 {
+		edit.Attachments = status.Attachments
+		edit.AttachmentDescriptions = make([]string, len(status.Attachments))
+		for i, attachment := range status.Attachments {
+			edit.AttachmentDescriptions[i] = attachment.Description
+		}
+	}
+
+	if status.Poll != nil {
+		edit.PollOptions = slices.Clone(status.Poll.Options)
+	}
+
+	return edit
+}
+
+// processEditMedia checks and sets the media attachments given in form
+// on the edited status, applying any provided media attribute changes.
+func (p *Processor) processEditMedia(
+	ctx context.Context,
+	form *apimodel.StatusEditRequest,
+	thisAccountID string,
+	status *gtsmodel.Status,
+) gtserror.WithCode {
+	// Get minimum allowed char descriptions.
+	minChars := config.GetMediaDescriptionMinChars()
+
+	attachments := make([]*gtsmodel.MediaAttachment, 0, len(form.MediaIDs))
+	attachmentIDs := make([]string, 0, len(form.MediaIDs))
+
+	for _, mediaID := range form.MediaIDs {
+		attachment, err := p.state.DB.GetAttachmentByID(ctx, mediaID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error fetching media from db: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if attachment == nil {
+			text := fmt.Sprintf("media %s not found", mediaID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if attachment.AccountID != thisAccountID {
+			text := fmt.Sprintf("media %s does not belong to account", mediaID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if (attachment.StatusID != "" && attachment.StatusID != status.ID) ||
+			attachment.ScheduledStatusID != "" {
+			text := fmt.Sprintf("media %s already attached to status", mediaID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		// Apply any changed attributes for this media.
+		var columns []string
+		for _, attrs := range form.MediaAttributes {
+			if attrs.ID != mediaID {
+				continue
+			}
+
+			if attrs.Description != nil {
+				attachment.Description = *attrs.Description
+				columns = append(columns, "description")
+			}
+
+			if attrs.Focus != nil {
+				focusx, focusy, err := util.ParseFocus(*attrs.Focus)
+				if err != nil {
+					text := fmt.Sprintf("media %s: %v", mediaID, err)
+					return gtserror.NewErrorBadRequest(errors.New(text), text)
+				}
+				attachment.FileMeta.Focus.X = focusx
+				attachment.FileMeta.Focus.Y = focusy
+				columns = append(columns, "focus_x", "focus_y")
+			}
+		}
+
+		if length := len([]rune(attachment.Description)); length < minChars {
+			text := fmt.Sprintf("media %s description too short, at least %d required", mediaID, minChars)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if len(columns) > 0 {
+			// Store any media attribute changes.
+			if err := p.state.DB.UpdateAttachment(ctx,
+				attachment,
+				columns...,
+			); err != nil {
+				err := gtserror.Newf("error updating media: %w", err)
+				return gtserror.NewErrorInternalError(err)
+			}
+		}
+
+		attachments = append(attachments, attachment)
+		attachmentIDs = append(attachmentIDs, attachment.ID)
+	}
+
+	status.Attachments = attachments
+	status.AttachmentIDs = attachmentIDs
+	return nil
+}
+
+// processEditPoll sets a new (as-yet unformatted)
+// poll from form on the edited status, or unsets
+// the poll and its type if form contains none.
+func (p *Processor) processEditPoll(
+	form *apimodel.StatusEditRequest,
+	status *gtsmodel.Status,
+	now time.Time,
+) {
+	if form.Poll == nil {
+		// Poll was removed (or
+		// never existed at all).
+		status.ActivityStreamsType = ap.ObjectNote
+		status.Poll = nil
+		status.PollID = ""
+		return
+	}
+
+	// Update the status AS type to "Question".
+	status.ActivityStreamsType = ap.ActivityQuestion
+
+	// Create new poll for status from form.
+	secs := time.Duration(form.Poll.ExpiresIn)
+	status.Poll = &gtsmodel.Poll{
+		ID:         id.NewULID(),
+		Multiple:   &form.Poll.Multiple,
+		HideCounts: &form.Poll.HideTotals,
+		Options:    form.Poll.Options,
+		StatusID:   status.ID,
+		Status:     status,
+		ExpiresAt:  now.Add(secs * time.Second),
+	}
+
+	// Set poll ID on the status.
+	status.PollID = status.Poll.ID
+}
+
+// checkEditPoll compares the (formatted) poll on the edited status to
+// that on the original, returning the original poll if it is to be
+// replaced or removed, and whether the edited poll is new. If the poll
+// is unchanged, the original is kept on the edited status (with votes).
+func checkEditPoll(status, edited *gtsmodel.Status) (*gtsmodel.Poll, bool) {
+	switch {
+	case status.Poll == nil && edited.Poll == nil:
+		// Nothing to do.
+		return nil, false
+
+	case status.Poll == nil:
+		// Poll was added.
+		return nil, true
+
+	case edited.Poll == nil:
+		// Poll was removed.
+		return status.Poll, false
+
+	case slices.Equal(status.Poll.Options, edited.Poll.Options) &&
+		*status.Poll.Multiple == *edited.Poll.Multiple:
+		// Poll is unchanged, keep existing.
+		edited.Poll = status.Poll
+		edited.PollID = status.PollID
+		return nil, false
+
+	default:
+		// Poll was changed, replace it.
+		return status.Poll, true
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 func (p *Processor) getFaveableStatus(
@@ -62,11 +63,6 @@ func (p *Processor) getFaveableStatus(
 		return nil, nil, errWithCode
 	}
 
-	if !*target.Likeable {
-		err := errors.New("status is not faveable")
-		return nil, nil, gtserror.NewErrorForbidden(err, err.Error())
-	}
-
 	fave, err := p.state.DB.GetStatusFave(ctx, requester.ID, target.ID)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		err = fmt.Errorf("getFaveTarget: error checking existing fave: %w", err)
@@ -88,6 +84,22 @@ func (p *Processor) FaveCreate(ctx context.Context, requestingAccount *gtsmodel.
 		return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
 	}
 
+	// Ensure valid fave target for requester.
+	likeable, err := p.filter.StatusLikeable(ctx,
+		requestingAccount,
+		targetStatus,
+	)
+	if err != nil {
+		err := gtserror.Newf("error seeing if status %s is likeable: %w", targetStatus.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if likeable.Forbidden() {
+		const errText = "you do not have permission to fave this status"
+		err := gtserror.New(errText)
+		return nil, gtserror.NewErrorForbidden(err, errText)
+	}
+
 	// Create and store a new fave
 	faveID := id.NewULID()
 	gtsFave := &gtsmodel.StatusFave{
@@ -99,6 +111,10 @@ func (p *Processor) FaveCreate(ctx context.Context, requestingAccount *gtsmodel.
 		StatusID:        targetStatus.ID,
 		Status:          targetStatus,
 		URI:             uris.GenerateURIForLike(requestingAccount.Username, faveID),
+
+		// If the fave requires approval, it's
+		// pending until the status author accepts.
+		PendingApproval: util.Ptr(likeable.PermittedWithApproval()),
 	}
 
 	if err := p.state.DB.PutStatusFave(ctx, gtsFave); err != nil {
@@ -0,0 +1,542 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// scheduledStatusMinDelay is the minimum amount
+// of time in the future a status may be scheduled.
+const scheduledStatusMinDelay = 5 * time.Minute
+
+// ScheduledStatusCreate processes the given form to schedule a
+// new status for publishing at form.ScheduledAt, returning the
+// api model representation of the scheduled status if it's OK.
+//
+// Precondition: the form's fields should have already been validated and normalized by the caller.
+func (p *Processor) ScheduledStatusCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	form *apimodel.AdvancedStatusCreateForm,
+) (
+	*apimodel.ScheduledStatus,
+	gtserror.WithCode,
+) {
+	// Ensure account populated; we'll need settings.
+	if err := p.state.DB.PopulateAccount(ctx, requester); err != nil {
+		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
+	}
+
+	scheduledAt, errWithCode := p.parseScheduledAt(ctx, requester, form.ScheduledAt, nil)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.InReplyToID != "" {
+		// Make sure the status being replied to
+		// exists and is visible to the requester.
+		// Whether it can be replied to is checked
+		// properly when the status is published.
+		if _, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+			requester,
+			form.InReplyToID,
+			nil,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	var (
+		attachments   []*gtsmodel.MediaAttachment
+		attachmentIDs []string
+	)
+
+	if form.MediaIDs != nil {
+		attachments, attachmentIDs, errWithCode = p.getAttachableMedia(ctx, form.MediaIDs, requester.ID)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	// Resolve visibility, interaction policy and language
+	// now, using the account's current defaults for any
+	// not set on the form, so the published status will
+	// look exactly like the one that was requested.
+	resolved := new(gtsmodel.Status)
+
+	if err := processVisibility(form, requester.Settings.Privacy, resolved); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if errWithCode := processInteractionPolicy(form, requester.Settings, resolved); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := processLanguage(form, requester.Settings.Language, resolved); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	contentType := string(form.ContentType)
+	if contentType == "" {
+		contentType = requester.Settings.StatusContentType
+	}
+
+	now := time.Now()
+	scheduledStatus := &gtsmodel.ScheduledStatus{
+		ID:                id.NewULID(),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		AccountID:         requester.ID,
+		Account:           requester,
+		ScheduledAt:       scheduledAt,
+		Text:              form.Status,
+		MediaIDs:          attachmentIDs,
+		MediaAttachments:  attachments,
+		Sensitive:         &form.Sensitive,
+		SpoilerText:       form.SpoilerText,
+		Visibility:        resolved.Visibility,
+		Federated:         resolved.Federated,
+		InReplyToID:       form.InReplyToID,
+		Language:          resolved.Language,
+		ContentType:       contentType,
+		InteractionPolicy: resolved.InteractionPolicy,
+		ApplicationID:     application.ID,
+		Application:       application,
+	}
+
+	if form.Poll != nil {
+		scheduledStatus.Poll = &gtsmodel.ScheduledPoll{
+			Options:    form.Poll.Options,
+			ExpiresIn:  form.Poll.ExpiresIn,
+			Multiple:   form.Poll.Multiple,
+			HideTotals: form.Poll.HideTotals,
+		}
+	}
+
+	// Insert this new scheduled status in the database.
+	if err := p.state.DB.PutScheduledStatus(ctx, scheduledStatus); err != nil {
+		err := gtserror.Newf("db error inserting scheduled status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Mark attachments as belonging to the scheduled
+	// status, so they aren't attached elsewhere or
+	// cleaned up as unused before it's published.
+	for _, attachment := range attachments {
+		attachment.ScheduledStatusID = scheduledStatus.ID
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, "scheduled_status_id"); err != nil {
+			err := gtserror.Newf("db error updating attachment %s: %w", attachment.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if err := p.ScheduleStatusPublish(ctx, scheduledStatus); err != nil {
+		err := gtserror.Newf("error scheduling status publish: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+// ScheduledStatusesGet returns a page of scheduled statuses owned by requester.
+func (p *Processor) ScheduledStatusesGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	scheduledStatuses, err := p.state.DB.GetScheduledStatusesForAccount(ctx, requester.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting scheduled statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(scheduledStatuses)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := scheduledStatuses[count-1].ID
+	hi := scheduledStatuses[0].ID
+
+	items := make([]interface{}, 0, count)
+	for _, scheduledStatus := range scheduledStatuses {
+		apiScheduledStatus, err := p.converter.ScheduledStatusToAPIScheduledStatus(ctx, scheduledStatus)
+		if err != nil {
+			log.Errorf(ctx, "error converting scheduled status %s to api: %v", scheduledStatus.ID, err)
+			continue
+		}
+
+		items = append(items, apiScheduledStatus)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/scheduled_statuses",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// ScheduledStatusGet returns the scheduled status with the given ID, if owned by requester.
+func (p *Processor) ScheduledStatusGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, errWithCode := p.getOwnScheduledStatus(ctx, requester, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+// ScheduledStatusUpdate moves the publish time of the
+// scheduled status with the given ID, if owned by requester.
+func (p *Processor) ScheduledStatusUpdate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+	form *apimodel.ScheduledStatusUpdateRequest,
+) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, errWithCode := p.getOwnScheduledStatus(ctx, requester, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	scheduledAt, errWithCode := p.parseScheduledAt(ctx, requester, form.ScheduledAt, scheduledStatus)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	scheduledStatus.ScheduledAt = scheduledAt
+	if err := p.state.DB.UpdateScheduledStatus(ctx, scheduledStatus, "scheduled_at"); err != nil {
+		err := gtserror.Newf("db error updating scheduled status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Replace the existing publish job.
+	p.state.Workers.Scheduler.Cancel(scheduledStatus.ID)
+	if err := p.ScheduleStatusPublish(ctx, scheduledStatus); err != nil {
+		err := gtserror.Newf("error rescheduling status publish: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+// ScheduledStatusDelete cancels and deletes the scheduled
+// status with the given ID, if owned by requester.
+func (p *Processor) ScheduledStatusDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) gtserror.WithCode {
+	scheduledStatus, errWithCode := p.getOwnScheduledStatus(ctx, requester, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Stop the status being published.
+	p.state.Workers.Scheduler.Cancel(scheduledStatus.ID)
+
+	// Free up the scheduled status attachments, so they
+	// can be reattached, or cleaned up if left unused.
+	if err := p.releaseScheduledMedia(ctx, scheduledStatus); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+		err := gtserror.Newf("db error deleting scheduled status: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// ScheduleAllStatusPublishes schedules publishing of all scheduled
+// statuses in the database. Statuses whose scheduled time passed
+// while the instance was offline will be published immediately.
+func (p *Processor) ScheduleAllStatusPublishes(ctx context.Context) error {
+	// Fetch all scheduled statuses from the database (barebones models are enough).
+	scheduledStatuses, err := p.state.DB.GetAllScheduledStatuses(gtscontext.SetBarebones(ctx))
+	if err != nil {
+		return gtserror.Newf("error getting scheduled statuses from db: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, scheduledStatus := range scheduledStatuses {
+		// Schedule each of the statuses and catch any errors.
+		if err := p.ScheduleStatusPublish(ctx, scheduledStatus); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// ScheduleStatusPublish adds a job to the scheduler
+// to publish the given scheduled status at its time.
+func (p *Processor) ScheduleStatusPublish(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	if !p.state.Workers.Scheduler.Running() {
+		// Scheduling a job on a stopped (or
+		// stopping) scheduler panics, so bail.
+		return gtserror.Newf("scheduler not running, can't schedule status %s", scheduledStatus.ID)
+	}
+
+	ok := p.state.Workers.Scheduler.AddOnce(
+		scheduledStatus.ID,
+		scheduledStatus.ScheduledAt,
+		p.onPublish(scheduledStatus.ID),
+	)
+
+	if !ok {
+		// Failed to add the status to the scheduler,
+		// there already exists a task for it.
+		return gtserror.Newf("failed adding scheduled status %s to scheduler", scheduledStatus.ID)
+	}
+
+	atStr := scheduledStatus.ScheduledAt.Local().Format("Jan _2 2006 15:04:05")
+	log.Infof(ctx, "scheduled status publish for %s at '%s'", scheduledStatus.ID, atStr)
+	return nil
+}
+
+// onPublish returns a callback function to be used by the
+// scheduler when the given scheduled status is due to be published.
+func (p *Processor) onPublish(scheduledStatusID string) func(context.Context, time.Time) {
+	return func(ctx context.Context, now time.Time) {
+		// Get the latest version of scheduled status from database.
+		scheduledStatus, err := p.state.DB.GetScheduledStatusByID(ctx, scheduledStatusID)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "error getting scheduled status %s from db: %v", scheduledStatusID, err)
+			}
+			return
+		}
+
+		// Whatever happens from here on, this scheduled
+		// status is done with, so tidy it away on return.
+		defer func() {
+			if err := p.state.DB.DeleteScheduledStatusByID(ctx, scheduledStatusID); err != nil {
+				log.Errorf(ctx, "error deleting scheduled status %s: %v", scheduledStatusID, err)
+			}
+		}()
+
+		// Release the attachments from the scheduled status
+		// so they can be attached to the published status.
+		if err := p.releaseScheduledMedia(ctx, scheduledStatus); err != nil {
+			log.Errorf(ctx, "error releasing scheduled status %s media: %v", scheduledStatusID, err)
+			return
+		}
+
+		account := scheduledStatus.Account
+		if account == nil {
+			log.Errorf(ctx, "scheduled status %s account not found", scheduledStatusID)
+			return
+		}
+
+		if account.IsSuspended() || account.IsMoving() {
+			log.Infof(ctx, "not publishing scheduled status %s for inactive account", scheduledStatusID)
+			return
+		}
+
+		application := scheduledStatus.Application
+		if application == nil {
+			// Application was since
+			// removed, post without.
+			application = new(gtsmodel.Application)
+		}
+
+		form := &apimodel.AdvancedStatusCreateForm{
+			StatusCreateRequest: apimodel.StatusCreateRequest{
+				Status:      scheduledStatus.Text,
+				MediaIDs:    scheduledStatus.MediaIDs,
+				InReplyToID: scheduledStatus.InReplyToID,
+				Sensitive:   util.PtrValueOr(scheduledStatus.Sensitive, false),
+				SpoilerText: scheduledStatus.SpoilerText,
+				Visibility:  p.visToFormVis(ctx, scheduledStatus.Visibility),
+				Language:    scheduledStatus.Language,
+				ContentType: apimodel.StatusContentType(scheduledStatus.ContentType),
+			},
+			AdvancedVisibilityFlagsForm: apimodel.AdvancedVisibilityFlagsForm{
+				Federated: scheduledStatus.Federated,
+			},
+		}
+
+		if scheduledStatus.Poll != nil {
+			form.Poll = &apimodel.PollRequest{
+				Options:    scheduledStatus.Poll.Options,
+				ExpiresIn:  scheduledStatus.Poll.ExpiresIn,
+				Multiple:   scheduledStatus.Poll.Multiple,
+				HideTotals: scheduledStatus.Poll.HideTotals,
+			}
+		}
+
+		if scheduledStatus.InteractionPolicy != nil {
+			policy := p.converter.InteractionPolicyToAPIInteractionPolicy(scheduledStatus.InteractionPolicy)
+			form.InteractionPolicy = &policy
+		}
+
+		if _, errWithCode := p.Create(ctx, account, application, form); errWithCode != nil {
+			log.Errorf(ctx, "error publishing scheduled status %s: %v", scheduledStatusID, errWithCode)
+			return
+		}
+	}
+}
+
+// getOwnScheduledStatus fetches the scheduled status
+// with the given ID, checking it's owned by requester.
+func (p *Processor) getOwnScheduledStatus(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) (*gtsmodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, err := p.state.DB.GetScheduledStatusByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting scheduled status %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if scheduledStatus == nil || scheduledStatus.AccountID != requester.ID {
+		err := gtserror.Newf("scheduled status %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return scheduledStatus, nil
+}
+
+// parseScheduledAt parses the given scheduled_at value, and
+// checks it's far enough in the future and wouldn't take the
+// requester over their total or daily scheduled status limits.
+// current, if set, is the scheduled status being rescheduled.
+func (p *Processor) parseScheduledAt(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	value string,
+	current *gtsmodel.ScheduledStatus,
+) (time.Time, gtserror.WithCode) {
+	scheduledAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		text := fmt.Sprintf("could not parse scheduled_at %q as ISO 8601 datetime", value)
+		return time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if time.Until(scheduledAt) < scheduledStatusMinDelay {
+		const text = "scheduled_at must be at least 5 minutes in the future"
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// A scheduled status being rescheduled
+	// is already counted in the total limit.
+	if current == nil {
+		total, err := p.state.DB.CountScheduledStatusesForAccount(ctx, requester.ID, time.Time{}, time.Time{})
+		if err != nil {
+			err := gtserror.Newf("db error counting scheduled statuses: %w", err)
+			return time.Time{}, gtserror.NewErrorInternalError(err)
+		}
+
+		if maxTotal := config.GetStatusesScheduledMaxTotal(); total >= maxTotal {
+			text := fmt.Sprintf("you can't have more than %d scheduled statuses", maxTotal)
+			return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+	}
+
+	dayStart := scheduledAt.UTC().Truncate(24 * time.Hour)
+	dayEnd := dayStart.Add(24 * time.Hour)
+
+	daily, err := p.state.DB.CountScheduledStatusesForAccount(ctx, requester.ID, dayStart, dayEnd)
+	if err != nil {
+		err := gtserror.Newf("db error counting scheduled statuses: %w", err)
+		return time.Time{}, gtserror.NewErrorInternalError(err)
+	}
+
+	if current != nil &&
+		!current.ScheduledAt.Before(dayStart) &&
+		current.ScheduledAt.Before(dayEnd) {
+		// Being rescheduled within the
+		// same day, so already counted.
+		daily--
+	}
+
+	if maxDaily := config.GetStatusesScheduledMaxDaily(); daily >= maxDaily {
+		text := fmt.Sprintf("you can't schedule more than %d statuses on the same day", maxDaily)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return scheduledAt, nil
+}
+
+// releaseScheduledMedia unsets the scheduled
+// status ID on the scheduled status' attachments.
+func (p *Processor) releaseScheduledMedia(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	for _, attachment := range scheduledStatus.MediaAttachments {
+		if attachment.ScheduledStatusID != scheduledStatus.ID {
+			continue
+		}
+
+		attachment.ScheduledStatusID = ""
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, "scheduled_status_id"); err != nil {
+			return gtserror.Newf("db error updating attachment %s: %w", attachment.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) apiScheduledStatus(
+	ctx context.Context,
+	scheduledStatus *gtsmodel.ScheduledStatus,
+) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	apiScheduledStatus, err := p.converter.ScheduledStatusToAPIScheduledStatus(ctx, scheduledStatus)
+	if err != nil {
+		err := gtserror.Newf("error converting scheduled status to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiScheduledStatus, nil
+}
+
+// visToFormVis returns the API visibility to use on a status create
+// form for the given visibility. VisToAPIVis downgrades mutuals-only
+// to private, which the form would otherwise turn into followers-only.
+func (p *Processor) visToFormVis(ctx context.Context, vis gtsmodel.Visibility) apimodel.Visibility {
+	if vis == gtsmodel.VisibilityMutualsOnly {
+		return apimodel.VisibilityMutualsOnly
+	}
+	return p.converter.VisToAPIVis(ctx, vis)
+}
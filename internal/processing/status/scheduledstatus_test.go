@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type ScheduledStatusTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *ScheduledStatusTestSuite) scheduleForm(scheduledAt time.Time) *apimodel.AdvancedStatusCreateForm {
+	return &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this one's for later",
+			Visibility:  apimodel.VisibilityMutualsOnly,
+			ScheduledAt: util.FormatISO8601(scheduledAt),
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+}
+
+func (suite *ScheduledStatusTestSuite) TestScheduledStatusCreate() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+	attachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	form := suite.scheduleForm(time.Now().Add(time.Hour))
+	form.MediaIDs = []string{attachment.ID}
+
+	apiScheduledStatus, errWithCode := suite.status.ScheduledStatusCreate(ctx, creatingAccount, creatingApplication, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("this one's for later", apiScheduledStatus.Params.Text)
+	suite.Equal(apimodel.VisibilityMutualsOnly, apiScheduledStatus.Params.Visibility)
+	suite.Equal(creatingApplication.ID, apiScheduledStatus.Params.ApplicationID)
+	suite.Len(apiScheduledStatus.MediaAttachments, 1)
+
+	// Attachment should now belong to the scheduled status.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(apiScheduledStatus.ID, dbAttachment.ScheduledStatusID)
+
+	// Deleting the scheduled status should release the attachment again.
+	if errWithCode := suite.status.ScheduledStatusDelete(ctx, creatingAccount, apiScheduledStatus.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	dbAttachment, err = suite.db.GetAttachmentByID(ctx, attachment.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbAttachment.ScheduledStatusID)
+}
+
+func (suite *ScheduledStatusTestSuite) TestScheduledStatusCreateTooSoon() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	form := suite.scheduleForm(time.Now().Add(time.Minute))
+
+	apiScheduledStatus, errWithCode := suite.status.ScheduledStatusCreate(ctx, creatingAccount, creatingApplication, form)
+	suite.Nil(apiScheduledStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *ScheduledStatusTestSuite) TestScheduledStatusUpdateNotOwned() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	form := suite.scheduleForm(time.Now().Add(time.Hour))
+
+	apiScheduledStatus, errWithCode := suite.status.ScheduledStatusCreate(ctx, creatingAccount, creatingApplication, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Another account shouldn't be able to see or move it.
+	_, errWithCode = suite.status.ScheduledStatusUpdate(ctx,
+		suite.testAccounts["local_account_2"],
+		apiScheduledStatus.ID,
+		&apimodel.ScheduledStatusUpdateRequest{
+			ScheduledAt: util.FormatISO8601(time.Now().Add(2 * time.Hour)),
+		},
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *ScheduledStatusTestSuite) TestScheduledStatusCreateSchedulerStopped() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Stop the scheduler, eg.,
+	// as during shutdown.
+	suite.state.Workers.Scheduler.Stop()
+
+	// Scheduling should error, not panic.
+	form := suite.scheduleForm(time.Now().Add(time.Hour))
+	apiScheduledStatus, errWithCode := suite.status.ScheduledStatusCreate(ctx, creatingAccount, creatingApplication, form)
+	suite.Nil(apiScheduledStatus)
+	suite.Equal(http.StatusInternalServerError, errWithCode.Code())
+}
+
+func TestScheduledStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledStatusTestSuite))
+}
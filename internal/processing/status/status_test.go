@@ -41,7 +41,7 @@ type StatusStandardTestSuite struct {
 	typeConverter *typeutils.Converter
 	tc            transport.Controller
 	storage       *storage.Driver
-	state         state.State
+	state         *state.State
 	mediaManager  *media.Manager
 	federator     *federation.Federator
 
@@ -73,32 +73,36 @@ func (suite *StatusStandardTestSuite) SetupSuite() {
 }
 
 func (suite *StatusStandardTestSuite) SetupTest() {
+	// Use fresh state for each test, so a
+	// scheduler stopped by the last test's
+	// teardown never gets reused.
+	suite.state = new(state.State)
 	suite.state.Caches.Init()
-	testrig.StartNoopWorkers(&suite.state)
+	testrig.StartNoopWorkers(suite.state)
 
 	testrig.InitTestConfig()
 	testrig.InitTestLog()
 
-	suite.db = testrig.NewTestDB(&suite.state)
-	suite.typeConverter = typeutils.NewConverter(&suite.state)
+	suite.db = testrig.NewTestDB(suite.state)
+	suite.typeConverter = typeutils.NewConverter(suite.state)
 	suite.state.DB = suite.db
 
-	suite.tc = testrig.NewTestTransportController(&suite.state, testrig.NewMockHTTPClient(nil, "../../../testrig/media"))
+	suite.tc = testrig.NewTestTransportController(suite.state, testrig.NewMockHTTPClient(nil, "../../../testrig/media"))
 	suite.storage = testrig.NewInMemoryStorage()
 	suite.state.Storage = suite.storage
-	suite.mediaManager = testrig.NewTestMediaManager(&suite.state)
-	suite.federator = testrig.NewTestFederator(&suite.state, suite.tc, suite.mediaManager)
+	suite.mediaManager = testrig.NewTestMediaManager(suite.state)
+	suite.federator = testrig.NewTestFederator(suite.state, suite.tc, suite.mediaManager)
 
-	filter := visibility.NewFilter(&suite.state)
+	filter := visibility.NewFilter(suite.state)
 	testrig.StartTimelines(
-		&suite.state,
+		suite.state,
 		filter,
 		suite.typeConverter,
 	)
 
-	common := common.New(&suite.state, suite.typeConverter, suite.federator, filter)
-	polls := polls.New(&common, &suite.state, suite.typeConverter)
-	suite.status = status.New(&suite.state, &common, &polls, suite.federator, suite.typeConverter, filter, processing.GetParseMentionFunc(&suite.state, suite.federator))
+	common := common.New(suite.state, suite.typeConverter, suite.federator, filter)
+	polls := polls.New(&common, suite.state, suite.typeConverter)
+	suite.status = status.New(suite.state, &common, &polls, suite.federator, suite.typeConverter, filter, processing.GetParseMentionFunc(suite.state, suite.federator))
 
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
 	testrig.StandardStorageSetup(suite.storage, "../../../testrig/media")
@@ -107,5 +111,5 @@ func (suite *StatusStandardTestSuite) SetupTest() {
 func (suite *StatusStandardTestSuite) TearDownTest() {
 	testrig.StandardDBTeardown(suite.db)
 	testrig.StandardStorageTeardown(suite.storage)
-	testrig.StopWorkers(&suite.state)
+	testrig.StopWorkers(suite.state)
 }
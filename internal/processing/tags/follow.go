@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package tags
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Follow follows the hashtag with the given name on behalf of the
+// requesting account, creating the hashtag first if it's not yet
+// known to the instance. Following an already followed hashtag
+// just returns the hashtag.
+func (p *Processor) Follow(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	name string,
+) (*apimodel.Tag, gtserror.WithCode) {
+	name, ok := text.NormalizeHashtag(name)
+	if !ok {
+		const text = "name was not a valid hashtag"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag == nil {
+		// We didn't have a tag with
+		// this name, create one.
+		tag = &gtsmodel.Tag{
+			ID:   id.NewULID(),
+			Name: name,
+		}
+
+		if err := p.state.DB.PutTag(ctx, tag); err != nil {
+			err := gtserror.Newf("db error putting new tag %s: %w", name, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !*tag.Useable {
+		const text = "hashtag is not useable on this instance"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if err := p.state.DB.PutFollowedTag(ctx, requestingAccount.ID, tag.ID); err != nil {
+		err := gtserror.Newf("db error following tag %s: %w", tag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiTag(ctx, tag, util.Ptr(true))
+}
+
+// Unfollow unfollows the hashtag with the given name on behalf
+// of the requesting account. Unfollowing a hashtag that isn't
+// followed just returns the hashtag.
+func (p *Processor) Unfollow(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	name string,
+) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteFollowedTag(ctx, requestingAccount.ID, tag.ID); err != nil {
+		err := gtserror.Newf("db error unfollowing tag %s: %w", tag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiTag(ctx, tag, util.Ptr(false))
+}
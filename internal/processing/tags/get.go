@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package tags
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Get gets the hashtag with the given name, including
+// whether it's followed by the requesting account.
+func (p *Processor) Get(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	name string,
+) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	following, err := p.state.DB.IsAccountFollowingTag(ctx, requestingAccount.ID, tag.ID)
+	if err != nil {
+		err := gtserror.Newf("db error checking if tag %s is followed: %w", tag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiTag(ctx, tag, &following)
+}
+
+// Followed gets a page of the hashtags
+// followed by the requesting account.
+func (p *Processor) Followed(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	tags, err := p.state.DB.GetFollowedTags(ctx,
+		requestingAccount.ID,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting followed tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(tags)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := tags[count-1].ID
+	hi := tags[0].ID
+
+	items := make([]interface{}, 0, count)
+
+	for _, tag := range tags {
+		apiTag, err := p.converter.TagToAPITag(ctx, tag, true, util.Ptr(true))
+		if err != nil {
+			log.Errorf(ctx, "error converting tag %s to api tag: %v", tag.ID, err)
+			continue
+		}
+
+		items = append(items, apiTag)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/followed_tags",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// getTag gets the tag with the given name,
+// returning 404 if the tag isn't known.
+func (p *Processor) getTag(ctx context.Context, name string) (*gtsmodel.Tag, gtserror.WithCode) {
+	name, ok := text.NormalizeHashtag(name)
+	if !ok {
+		const text = "name was not a valid hashtag"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag == nil {
+		const text = "hashtag not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return tag, nil
+}
+
+func (p *Processor) apiTag(ctx context.Context, tag *gtsmodel.Tag, following *bool) (*apimodel.Tag, gtserror.WithCode) {
+	apiTag, err := p.converter.TagToAPITag(ctx, tag, true, following)
+	if err != nil {
+		err := gtserror.Newf("error converting tag %s to api tag: %w", tag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apiTag, nil
+}
@@ -0,0 +1,469 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	// trendDays is the number of days
+	// of uses that trends are aggregated
+	// from, and that history is kept for.
+	trendDays = 7
+
+	// trendHalfLife is the age at which
+	// a use contributes half as much to
+	// the score of a trend as a new one.
+	trendHalfLife = 24 * time.Hour
+
+	// trendMinAccounts is the number of distinct
+	// accounts that must use a tag, status or
+	// link before it can be considered trending.
+	trendMinAccounts = 2
+
+	// trendMaxTargets is the maximum
+	// number of trends kept per type.
+	trendMaxTargets = 100
+)
+
+// ScheduleAggregation schedules trends
+// to be aggregated every 15 minutes.
+func (p *Processor) ScheduleAggregation() error {
+	const every = 15 * time.Minute
+	start := time.Now().Truncate(every).Add(every)
+
+	fn := func(ctx context.Context, now time.Time) {
+		log.Info(ctx, "starting trends aggregation")
+		if err := p.Aggregate(ctx, now); err != nil {
+			log.Errorf(ctx, "error aggregating trends: %v", err)
+			return
+		}
+		log.Infof(ctx, "finished trends aggregation after %s", time.Since(now))
+	}
+
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@trends",
+		start,
+		every,
+		fn,
+	) {
+		return gtserror.New("failed to schedule @trends")
+	}
+
+	return nil
+}
+
+// Aggregate scores the tags, statuses and links used
+// in the days before the given time, and updates the
+// stored trends of each type accordingly.
+//
+// Uses from domains hidden from public timelines by a
+// domain limit are ignored, as are uses of tags which
+// are not both useable and listable.
+func (p *Processor) Aggregate(ctx context.Context, now time.Time) error {
+	var (
+		since = now.Add(-trendDays * 24 * time.Hour)
+		agg   = &aggregation{Processor: p}
+	)
+
+	tagUses, err := p.state.DB.GetTrendTagUses(ctx, since)
+	if err != nil {
+		return gtserror.Newf("db error getting tag uses: %w", err)
+	}
+
+	tagUses, err = agg.filterUses(ctx, tagUses, agg.tagTrendable)
+	if err != nil {
+		return err
+	}
+
+	if err := p.updateTrends(ctx,
+		gtsmodel.TrendTypeTag,
+		scoreUses(gtsmodel.TrendTypeTag, tagUses, now),
+		now,
+	); err != nil {
+		return err
+	}
+
+	statusUses, err := p.state.DB.GetTrendStatusUses(ctx, since)
+	if err != nil {
+		return gtserror.Newf("db error getting status uses: %w", err)
+	}
+
+	statusUses, err = agg.filterUses(ctx, statusUses, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := p.updateTrends(ctx,
+		gtsmodel.TrendTypeStatus,
+		scoreUses(gtsmodel.TrendTypeStatus, statusUses, now),
+		now,
+	); err != nil {
+		return err
+	}
+
+	linkUses, err := p.state.DB.GetTrendLinkUses(ctx, since)
+	if err != nil {
+		return gtserror.Newf("db error getting link uses: %w", err)
+	}
+
+	// Replace each status with one
+	// use per link that it contains.
+	linkUses = splitLinkUses(linkUses)
+
+	linkUses, err = agg.filterUses(ctx, linkUses, agg.linkTrendable)
+	if err != nil {
+		return err
+	}
+
+	return p.updateTrends(ctx,
+		gtsmodel.TrendTypeLink,
+		scoreUses(gtsmodel.TrendTypeLink, linkUses, now),
+		now,
+	)
+}
+
+// aggregation memoizes the checks
+// performed on uses during one run
+// of trends aggregation.
+type aggregation struct {
+	*Processor
+	hiddenDomains map[string]bool
+	trendableTags map[string]bool
+	blockedHosts  map[string]bool
+}
+
+// filterUses returns the given uses, minus those by
+// domains hidden from public timelines, and those for
+// which the given trendable function returns false.
+func (a *aggregation) filterUses(
+	ctx context.Context,
+	uses []*db.TrendUse,
+	trendable func(context.Context, string) (bool, error),
+) ([]*db.TrendUse, error) {
+	filtered := make([]*db.TrendUse, 0, len(uses))
+
+	for _, use := range uses {
+		hidden, err := a.domainHidden(ctx, use.Domain)
+		if err != nil {
+			return nil, err
+		}
+
+		if hidden {
+			continue
+		}
+
+		if trendable != nil {
+			ok, err := trendable(ctx, use.TargetID)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				continue
+			}
+		}
+
+		filtered = append(filtered, use)
+	}
+
+	return filtered, nil
+}
+
+// domainHidden returns whether the given domain
+// is limited from appearing on public timelines.
+func (a *aggregation) domainHidden(ctx context.Context, domain string) (bool, error) {
+	if domain == "" {
+		// Local account.
+		return false, nil
+	}
+
+	hidden, ok := a.hiddenDomains[domain]
+	if ok {
+		return hidden, nil
+	}
+
+	limit, err := a.state.DB.MatchDomainLimit(ctx, domain)
+	if err != nil {
+		return false, gtserror.Newf("db error checking domain limit for %s: %w", domain, err)
+	}
+
+	hidden = limit != nil && *limit.HidePublic
+
+	if a.hiddenDomains == nil {
+		a.hiddenDomains = make(map[string]bool)
+	}
+	a.hiddenDomains[domain] = hidden
+
+	return hidden, nil
+}
+
+// tagTrendable returns whether the tag with the
+// given ID may trend, ie., is useable and listable.
+func (a *aggregation) tagTrendable(ctx context.Context, tagID string) (bool, error) {
+	trendable, ok := a.trendableTags[tagID]
+	if ok {
+		return trendable, nil
+	}
+
+	tag, err := a.state.DB.GetTag(ctx, tagID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("db error getting tag %s: %w", tagID, err)
+	}
+
+	trendable = tag != nil && *tag.Useable && *tag.Listable
+
+	if a.trendableTags == nil {
+		a.trendableTags = make(map[string]bool)
+	}
+	a.trendableTags[tagID] = trendable
+
+	return trendable, nil
+}
+
+// linkTrendable returns whether the given
+// link may trend, ie., its host isn't blocked.
+func (a *aggregation) linkTrendable(ctx context.Context, link string) (bool, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		// Already parsed
+		// successfully once.
+		return false, nil
+	}
+
+	blocked, ok := a.blockedHosts[u.Host]
+	if ok {
+		return !blocked, nil
+	}
+
+	blocked, err = a.state.DB.IsDomainBlocked(ctx, u.Hostname())
+	if err != nil {
+		return false, gtserror.Newf("db error checking domain block for %s: %w", u.Host, err)
+	}
+
+	if a.blockedHosts == nil {
+		a.blockedHosts = make(map[string]bool)
+	}
+	a.blockedHosts[u.Host] = blocked
+
+	return !blocked, nil
+}
+
+// splitLinkUses replaces each of the given link uses, which
+// hold the content of a status, with one use per distinct
+// link in that content, targeting the link itself.
+func splitLinkUses(uses []*db.TrendUse) []*db.TrendUse {
+	split := make([]*db.TrendUse, 0, len(uses))
+
+	for _, use := range uses {
+		for _, link := range text.ExtractLinks(use.Content) {
+			split = append(split, &db.TrendUse{
+				TargetID:  link,
+				AccountID: use.AccountID,
+				Domain:    use.Domain,
+				CreatedAt: use.CreatedAt,
+			})
+		}
+	}
+
+	return split
+}
+
+// scoreUses groups the given uses by target, and returns
+// trends of the given type for the best scoring targets
+// used by enough distinct accounts, highest score first.
+//
+// Each account using a target adds to its score, with
+// uses decaying by half every trendHalfLife, so that
+// targets used by many accounts recently score highest.
+func scoreUses(trendType gtsmodel.TrendType, uses []*db.TrendUse, now time.Time) []*gtsmodel.Trend {
+	type target struct {
+		lastUses    map[string]time.Time
+		dayUses     []int
+		dayAccounts []map[string]struct{}
+	}
+
+	var (
+		today   = now.UTC().Truncate(24 * time.Hour)
+		targets = make(map[string]*target)
+	)
+
+	for _, use := range uses {
+		t, ok := targets[use.TargetID]
+		if !ok {
+			t = &target{
+				lastUses:    make(map[string]time.Time),
+				dayUses:     make([]int, trendDays),
+				dayAccounts: make([]map[string]struct{}, trendDays),
+			}
+			targets[use.TargetID] = t
+		}
+
+		// Only the most recent use by
+		// each account counts for score.
+		last, ok := t.lastUses[use.AccountID]
+		if !ok || use.CreatedAt.After(last) {
+			t.lastUses[use.AccountID] = use.CreatedAt
+		}
+
+		day := int(today.Sub(use.CreatedAt.UTC().Truncate(24*time.Hour)) / (24 * time.Hour))
+		if day < 0 || day >= trendDays {
+			continue
+		}
+
+		t.dayUses[day]++
+		if t.dayAccounts[day] == nil {
+			t.dayAccounts[day] = make(map[string]struct{})
+		}
+		t.dayAccounts[day][use.AccountID] = struct{}{}
+	}
+
+	trends := make([]*gtsmodel.Trend, 0, len(targets))
+	for targetID, t := range targets {
+		if len(t.lastUses) < trendMinAccounts {
+			continue
+		}
+
+		var score float64
+		for _, last := range t.lastUses {
+			age := max(now.Sub(last), 0)
+			score += math.Pow(0.5, float64(age)/float64(trendHalfLife))
+		}
+
+		dayAccounts := make([]int, trendDays)
+		for day, accounts := range t.dayAccounts {
+			dayAccounts[day] = len(accounts)
+		}
+
+		trends = append(trends, &gtsmodel.Trend{
+			Type:        trendType,
+			TargetID:    targetID,
+			Score:       score,
+			DayUses:     t.dayUses,
+			DayAccounts: dayAccounts,
+		})
+	}
+
+	// Sort highest score first, falling back
+	// to target for a deterministic order.
+	slices.SortFunc(trends, func(a, b *gtsmodel.Trend) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TargetID, b.TargetID)
+	})
+
+	if len(trends) > trendMaxTargets {
+		trends = trends[:trendMaxTargets]
+	}
+
+	return trends
+}
+
+// updateTrends stores the given freshly scored trends of the
+// given type, updating existing trends for the same targets
+// so that admin reviews are kept.
+//
+// Existing trends which are no longer trending are deleted if
+// they were never reviewed, otherwise their score is zeroed,
+// so that the review still applies should they trend again.
+func (p *Processor) updateTrends(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	trends []*gtsmodel.Trend,
+	now time.Time,
+) error {
+	existing, err := p.state.DB.GetAllTrends(ctx, trendType)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting %s trends: %w", trendType, err)
+	}
+
+	byTarget := make(map[string]*gtsmodel.Trend, len(existing))
+	for _, trend := range existing {
+		byTarget[trend.TargetID] = trend
+	}
+
+	for _, trend := range trends {
+		prev, ok := byTarget[trend.TargetID]
+		if !ok {
+			// Newly trending.
+			trend.ID = id.NewULID()
+			trend.CreatedAt = now
+			trend.UpdatedAt = now
+			trend.Review = gtsmodel.TrendReviewPending
+
+			if err := p.state.DB.PutTrend(ctx, trend); err != nil {
+				return gtserror.Newf("db error putting %s trend: %w", trendType, err)
+			}
+
+			continue
+		}
+
+		// Still trending.
+		delete(byTarget, trend.TargetID)
+		prev.Score = trend.Score
+		prev.DayUses = trend.DayUses
+		prev.DayAccounts = trend.DayAccounts
+
+		if err := p.state.DB.UpdateTrend(ctx, prev,
+			"score",
+			"day_uses",
+			"day_accounts",
+		); err != nil {
+			return gtserror.Newf("db error updating %s trend: %w", trendType, err)
+		}
+	}
+
+	// Remaining trends stopped trending.
+	for _, prev := range byTarget {
+		if prev.Review == gtsmodel.TrendReviewPending {
+			if err := p.state.DB.DeleteTrendByID(ctx, prev.ID); err != nil {
+				return gtserror.Newf("db error deleting %s trend: %w", trendType, err)
+			}
+
+			continue
+		}
+
+		if prev.Score == 0 {
+			// Already zeroed.
+			continue
+		}
+
+		prev.Score = 0
+		if err := p.state.DB.UpdateTrend(ctx, prev, "score"); err != nil {
+			return gtserror.Newf("db error updating %s trend: %w", trendType, err)
+		}
+	}
+
+	return nil
+}
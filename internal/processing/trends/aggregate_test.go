@@ -0,0 +1,243 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type AggregateTestSuite struct {
+	TrendsStandardTestSuite
+}
+
+// putStatus puts a new public status by the given
+// account, with the given content and tag IDs.
+func (suite *AggregateTestSuite) putStatus(account *gtsmodel.Account, content string, tagIDs ...string) *gtsmodel.Status {
+	statusID := id.NewULID()
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 account.URI + "/statuses/" + statusID,
+		URL:                 account.URL + "/statuses/" + statusID,
+		Content:             content,
+		TagIDs:              tagIDs,
+		Local:               util.Ptr(true),
+		AccountURI:          account.URI,
+		AccountID:           account.ID,
+		Visibility:          gtsmodel.VisibilityPublic,
+		Federated:           util.Ptr(true),
+		ActivityStreamsType: "Note",
+	}
+
+	if err := suite.db.PutStatus(context.Background(), status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return status
+}
+
+// approveTrend approves the only trend of the given
+// type, checking that it's pending review.
+func (suite *AggregateTestSuite) approveTrend(trendType gtsmodel.TrendType) {
+	ctx := context.Background()
+
+	trends, err := suite.db.GetTrends(ctx, trendType, gtsmodel.TrendReviewUnknown, 10, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(trends, 1) {
+		suite.FailNow("expected one trend of type " + trendType.String())
+	}
+
+	trend := trends[0]
+	suite.Equal(gtsmodel.TrendReviewPending, trend.Review)
+
+	trend.Review = gtsmodel.TrendReviewApproved
+	if err := suite.db.UpdateTrend(ctx, trend, "review"); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *AggregateTestSuite) TestAggregate() {
+	var (
+		ctx     = context.Background()
+		welcome = suite.testTags["welcome"]
+		content = `<p>check out <a href="https://example.org/article#comments" rel="nofollow noreferrer noopener" target="_blank">this article</a> ` +
+			`<a href="http://localhost:8080/tags/welcome" class="mention hashtag" rel="tag">#<span>welcome</span></a></p>`
+	)
+
+	// Two accounts share the
+	// same link using #welcome.
+	status := suite.putStatus(suite.testAccounts["local_account_1"], content, welcome.ID)
+	suite.putStatus(suite.testAccounts["local_account_2"], content, welcome.ID)
+
+	// Two other accounts fave
+	// local_account_1's status.
+	for _, name := range []string{"local_account_2", "admin_account"} {
+		faveID := id.NewULID()
+		if err := suite.db.PutStatusFave(ctx, &gtsmodel.StatusFave{
+			ID:              faveID,
+			AccountID:       suite.testAccounts[name].ID,
+			TargetAccountID: status.AccountID,
+			StatusID:        status.ID,
+			URI:             suite.testAccounts[name].URI + "/liked/" + faveID,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	now := time.Now()
+	if err := suite.trends.Aggregate(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Nothing is shown until reviewed.
+	tags, errWithCode := suite.trends.TagsGet(ctx, nil, 10, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(tags)
+
+	suite.approveTrend(gtsmodel.TrendTypeTag)
+	suite.approveTrend(gtsmodel.TrendTypeStatus)
+	suite.approveTrend(gtsmodel.TrendTypeLink)
+
+	tags, errWithCode = suite.trends.TagsGet(ctx, suite.testAccounts["local_account_1"], 10, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if suite.Len(tags, 1) {
+		suite.Equal("welcome", tags[0].Name)
+		suite.False(*tags[0].Following)
+		if suite.NotNil(tags[0].History) && suite.Len(*tags[0].History, 7) {
+			suite.Equal(apimodel.History{
+				Day:      strconv.FormatInt(now.UTC().Truncate(24*time.Hour).Unix(), 10),
+				Uses:     "2",
+				Accounts: "2",
+			}, (*tags[0].History)[0])
+		}
+	}
+
+	statuses, errWithCode := suite.trends.StatusesGet(ctx, nil, 10, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if suite.Len(statuses, 1) {
+		suite.Equal(status.ID, statuses[0].ID)
+	}
+
+	links, errWithCode := suite.trends.LinksGet(ctx, 10, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if suite.Len(links, 1) {
+		suite.Equal("https://example.org/article", links[0].URL)
+		suite.Equal("example.org", links[0].ProviderName)
+		suite.Equal("2", links[0].History[0].Accounts)
+	}
+
+	// A week later nothing is trending anymore,
+	// but reviewed trends are kept, unscored.
+	if err := suite.trends.Aggregate(ctx, now.Add(8*24*time.Hour)); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tags, errWithCode = suite.trends.TagsGet(ctx, nil, 10, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(tags)
+
+	trends, err := suite.db.GetAllTrends(ctx, gtsmodel.TrendTypeTag)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(trends, 1) {
+		suite.Zero(trends[0].Score)
+		suite.Equal(gtsmodel.TrendReviewApproved, trends[0].Review)
+	}
+}
+
+func (suite *AggregateTestSuite) TestAggregateOneAccount() {
+	ctx := context.Background()
+
+	// A tag used twice by the same
+	// account isn't trending.
+	for i := 0; i < 2; i++ {
+		suite.putStatus(suite.testAccounts["local_account_1"], "<p>hello</p>", suite.testTags["welcome"].ID)
+	}
+
+	if err := suite.trends.Aggregate(ctx, time.Now()); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	trends, err := suite.db.GetAllTrends(ctx, gtsmodel.TrendTypeTag)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(trends)
+}
+
+func (suite *AggregateTestSuite) TestAggregateDomainLimit() {
+	var (
+		ctx           = context.Background()
+		remoteAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	// A local and a remote account use the same tag,
+	// but the remote account's domain is hidden from
+	// public timelines, so it isn't trending.
+	suite.putStatus(suite.testAccounts["local_account_1"], "<p>hello</p>", suite.testTags["welcome"].ID)
+	suite.putStatus(remoteAccount, "<p>hello</p>", suite.testTags["welcome"].ID)
+
+	if err := suite.db.CreateDomainLimit(ctx, &gtsmodel.DomainLimit{
+		ID:                 id.NewULID(),
+		Domain:             remoteAccount.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		HidePublic:         util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.trends.Aggregate(ctx, time.Now()); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	trends, err := suite.db.GetAllTrends(ctx, gtsmodel.TrendTypeTag)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(trends)
+}
+
+func TestAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(AggregateTestSuite))
+}
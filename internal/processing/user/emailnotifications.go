@@ -0,0 +1,403 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// emailDigestMaxNotifications is the maximum
+// number of notifications included in a digest.
+const emailDigestMaxNotifications = 50
+
+// ScheduleEmailDigests schedules sending of
+// email notification digests to run every hour,
+// starting from the next full hour. Each user's
+// digest is only sent once it's due for them.
+func (p *Processor) ScheduleEmailDigests() error {
+	const every = time.Hour
+	start := time.Now().Truncate(every).Add(every)
+
+	fn := func(ctx context.Context, now time.Time) {
+		log.Info(ctx, "starting email digests")
+		p.SendEmailDigests(ctx, now)
+		log.Infof(ctx, "finished email digests after %s", time.Since(now))
+	}
+
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@emaildigests",
+		start,
+		every,
+		fn,
+	) {
+		return gtserror.New("failed to schedule @emaildigests")
+	}
+
+	return nil
+}
+
+// SendEmailDigests sends an email notification digest to
+// every user who opted in to digests, and whose daily or
+// weekly digest is due at the given time. Errors are logged.
+func (p *Processor) SendEmailDigests(ctx context.Context, now time.Time) {
+	users, err := p.state.DB.GetAllUsers(ctx)
+	if err != nil {
+		log.Errorf(ctx, "db error getting users: %v", err)
+		return
+	}
+
+	for _, user := range users {
+		if err := p.sendEmailDigest(ctx, user, now); err != nil {
+			log.Errorf(ctx, "error sending email digest to user %s: %v", user.ID, err)
+		}
+	}
+}
+
+func (p *Processor) sendEmailDigest(ctx context.Context, user *gtsmodel.User, now time.Time) error {
+	if user.ConfirmedAt.IsZero() ||
+		!*user.Approved ||
+		*user.Disabled ||
+		user.Email == "" {
+		// Only email users who:
+		// - are confirmed
+		// - are approved
+		// - are not disabled
+		// - have an email address
+		return nil
+	}
+
+	settings, err := p.state.DB.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		return gtserror.Newf("db error getting account settings: %w", err)
+	}
+
+	interval := settings.EmailDigest.Interval()
+	if interval == 0 || !settings.EmailNotifyAny() {
+		// Not opted in to digests.
+		return nil
+	}
+
+	// Allow the scheduler a little leeway,
+	// so digests don't drift by an hour.
+	const leeway = 5 * time.Minute
+	if !settings.EmailDigestAt.IsZero() &&
+		now.Before(settings.EmailDigestAt.Add(interval-leeway)) {
+		// Not due yet.
+		return nil
+	}
+
+	// Only include unread notifications past
+	// the notifications marker, which haven't
+	// already been included in a previous digest.
+	var sinceID string
+
+	marker, err := p.state.DB.GetMarker(ctx, user.AccountID, gtsmodel.MarkerNameNotifications)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting notifications marker: %w", err)
+	}
+
+	if marker != nil {
+		sinceID = marker.LastReadID
+	}
+
+	if !settings.EmailDigestAt.IsZero() {
+		digestID, err := id.NewULIDFromTime(settings.EmailDigestAt)
+		if err != nil {
+			return gtserror.Newf("error generating id: %w", err)
+		}
+
+		// The last digest included everything up to and
+		// including its millisecond, so use the highest
+		// ULID from that time (ULIDs have a 10 char time).
+		digestID = digestID[:10] + id.Highest[10:]
+
+		if digestID > sinceID {
+			sinceID = digestID
+		}
+	}
+
+	// Only include notifications up to and including now,
+	// since that's the time the digest is recorded as sent,
+	// so later ones are left for the next digest instead.
+	nowID, err := id.NewULIDFromTime(now)
+	if err != nil {
+		return gtserror.Newf("error generating id: %w", err)
+	}
+	maxID := nowID[:10] + id.Highest[10:]
+
+	var (
+		emailNotifs []email.Notification
+		moreNotifs  int
+	)
+
+	// Page down through all the notifications in range,
+	// so that any beyond the max that can be included
+	// in the email can at least be counted in it.
+	for {
+		notifs, err := p.state.DB.GetAccountNotifications(ctx,
+			user.AccountID,
+			maxID,   // maxID
+			sinceID, // sinceID
+			"",      // minID
+			emailDigestMaxNotifications,
+			[]string{
+				string(gtsmodel.NotificationMention),
+				string(gtsmodel.NotificationFollow),
+				string(gtsmodel.NotificationFollowRequest),
+			},
+			nil, // excludeTypes
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting notifications: %w", err)
+		}
+
+		if len(notifs) == 0 {
+			// Reached the end.
+			break
+		}
+
+		// Set next maxID from the oldest in this page.
+		maxID = notifs[len(notifs)-1].ID
+
+		pageNotifs, err := p.emailDigestNotifications(ctx, user.AccountID, settings, notifs)
+		if err != nil {
+			return err
+		}
+
+		for _, emailNotif := range pageNotifs {
+			if len(emailNotifs) < emailDigestMaxNotifications {
+				emailNotifs = append(emailNotifs, emailNotif)
+			} else {
+				moreNotifs++
+			}
+		}
+	}
+
+	if len(emailNotifs) != 0 {
+		instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+		if err != nil {
+			return gtserror.Newf("db error getting instance: %w", err)
+		}
+
+		unsubscribeURL, err := p.emailUnsubscribeURL(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := p.state.DB.PopulateUser(ctx, user); err != nil {
+			return gtserror.Newf("error populating user: %w", err)
+		}
+
+		digestData := email.NotificationDigestData{
+			Username:          user.Account.Username,
+			InstanceURL:       instance.URI,
+			InstanceName:      instance.Title,
+			Notifications:     emailNotifs,
+			MoreNotifications: moreNotifs,
+			UnsubscribeURL:    unsubscribeURL,
+		}
+
+		if err := p.emailSender.SendNotificationDigestEmail(user.Email, digestData); err != nil {
+			return gtserror.Newf("error sending digest email: %w", err)
+		}
+	}
+
+	// Digest done for this interval,
+	// even if there was nothing to send.
+	settings.EmailDigestAt = now
+	if err := p.state.DB.UpdateAccountSettings(ctx, settings, "email_digest_at"); err != nil {
+		return gtserror.Newf("db error updating account settings: %w", err)
+	}
+
+	return nil
+}
+
+// emailDigestNotifications converts the given notifications
+// to their email representation, dropping those of types the
+// account didn't opt in to, and those hidden by mutes / filters.
+func (p *Processor) emailDigestNotifications(
+	ctx context.Context,
+	accountID string,
+	settings *gtsmodel.AccountSettings,
+	notifs []*gtsmodel.Notification,
+) ([]email.Notification, error) {
+	if len(notifs) == 0 {
+		return nil, nil
+	}
+
+	filters, err := p.state.DB.GetFiltersForAccountID(ctx, accountID)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting filters: %w", err)
+	}
+
+	mutes, err := p.state.DB.GetAccountMutes(gtscontext.SetBarebones(ctx), accountID, nil)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting mutes: %w", err)
+	}
+	compiledMutes := usermute.NewCompiledUserMuteList(mutes)
+
+	emailNotifs := make([]email.Notification, 0, len(notifs))
+	for _, notif := range notifs {
+		if err := p.state.DB.PopulateNotification(ctx, notif); err != nil {
+			log.Debugf(ctx, "skipping notification %s: %v", notif.ID, err)
+			continue
+		}
+
+		direct := notif.Status != nil && notif.Status.Visibility == gtsmodel.VisibilityDirect
+		if !settings.EmailNotifyFor(notif.NotificationType, direct) {
+			// Not opted in to
+			// this type of email.
+			continue
+		}
+
+		// Skip notifications that wouldn't
+		// be shown to the user via the API.
+		if _, err := p.converter.NotificationToAPINotification(ctx,
+			notif,
+			filters,
+			compiledMutes,
+		); err != nil {
+			if !errors.Is(err, statusfilter.ErrHideStatus) {
+				log.Debugf(ctx, "skipping notification %s: %v", notif.ID, err)
+			}
+			continue
+		}
+
+		emailNotif, err := p.converter.NotificationToEmailNotification(ctx, notif)
+		if err != nil {
+			log.Debugf(ctx, "skipping notification %s: %v", notif.ID, err)
+			continue
+		}
+
+		emailNotifs = append(emailNotifs, *emailNotif)
+	}
+
+	return emailNotifs, nil
+}
+
+// emailUnsubscribeURL returns a signed link to
+// unsubscribe the given user from email notifications.
+func (p *Processor) emailUnsubscribeURL(ctx context.Context, userID string) (string, error) {
+	// Unsubscribe links are signed
+	// with the router session key.
+	session, err := p.state.DB.GetSession(ctx)
+	if err != nil {
+		return "", gtserror.Newf("db error getting router session: %w", err)
+	}
+
+	token := email.UnsubscribeToken(session.Auth, userID)
+	return uris.GenerateURIForEmailUnsubscribe(userID, token), nil
+}
+
+// EmailGetUserForUnsubscribeToken retrieves the user (with account)
+// with the given ID from the database, if the given token is a valid
+// signed "unsubscribe from email notifications" token for them.
+func (p *Processor) EmailGetUserForUnsubscribeToken(
+	ctx context.Context,
+	userID string,
+	token string,
+) (*gtsmodel.User, gtserror.WithCode) {
+	if userID == "" || token == "" {
+		err := errors.New("no user or token provided")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	session, err := p.state.DB.GetSession(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting router session: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !email.ValidUnsubscribeToken(session.Auth, userID, token) {
+		err := gtserror.Newf("invalid unsubscribe token for user %s", userID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	user, err := p.state.DB.GetUserByID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// Real error.
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// User has since been deleted.
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.PopulateUser(ctx, user); err != nil {
+		err := gtserror.Newf("error populating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return user, nil
+}
+
+// EmailUnsubscribe turns off all email notifications and
+// digests for the user with the given ID, usually initiated
+// by clicking on the unsubscribe link in a notification email.
+func (p *Processor) EmailUnsubscribe(
+	ctx context.Context,
+	userID string,
+	token string,
+) (*gtsmodel.User, gtserror.WithCode) {
+	user, errWithCode := p.EmailGetUserForUnsubscribeToken(ctx, userID, token)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	settings, err := p.state.DB.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	settings.EmailNotifyMention = util.Ptr(false)
+	settings.EmailNotifyDirect = util.Ptr(false)
+	settings.EmailNotifyFollow = util.Ptr(false)
+	settings.EmailNotifyFollowRequest = util.Ptr(false)
+	settings.EmailDigest = gtsmodel.EmailDigestNone
+
+	if err := p.state.DB.UpdateAccountSettings(ctx,
+		settings,
+		"email_notify_mention",
+		"email_notify_direct",
+		"email_notify_follow",
+		"email_notify_follow_request",
+		"email_digest",
+	); err != nil {
+		err := gtserror.Newf("db error updating account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return user, nil
+}
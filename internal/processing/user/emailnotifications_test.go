@@ -0,0 +1,233 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type EmailNotificationsTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *EmailNotificationsTestSuite) TestSendEmailDigests() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	// Opt in to daily digests of follows.
+	settings, err := suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EmailNotifyFollow = util.Ptr(true)
+	settings.EmailDigest = gtsmodel.EmailDigestDaily
+	if err := suite.db.UpdateAccountSettings(ctx, settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Add a follow notification
+	// from local_account_2.
+	if err := suite.db.PutNotification(ctx, &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationFollow,
+		TargetAccountID:  user.AccountID,
+		OriginAccountID:  "01F8MH5NBDF2MV7CTC4Q5128HF",
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	now := time.Now()
+
+	// The digest should include the follow,
+	// but not the unread fave from the fixtures.
+	suite.user.SendEmailDigests(ctx, now)
+	if !suite.Len(suite.sentEmails, 1) {
+		suite.FailNow("")
+	}
+	digest := suite.sentEmails[user.Email]
+	suite.Contains(digest, "Subject: GoToSocial Notification Digest\r\n")
+	suite.Contains(digest, "@1happyturtle@localhost:8080 followed you.\r\nhttp://localhost:8080/@1happyturtle\r\n")
+	suite.NotContains(digest, "favourite")
+	suite.Contains(digest, "http://localhost:8080/unsubscribe_email?user="+user.ID+"&token=")
+
+	// Not due again until a day later.
+	clear(suite.sentEmails)
+	suite.user.SendEmailDigests(ctx, now.Add(time.Hour))
+	suite.Empty(suite.sentEmails)
+
+	// A day later, the follow has already
+	// been sent, so there's nothing to send.
+	suite.user.SendEmailDigests(ctx, now.Add(24*time.Hour))
+	suite.Empty(suite.sentEmails)
+
+	settings, err = suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(now.Add(24*time.Hour), settings.EmailDigestAt, time.Second)
+}
+
+func (suite *EmailNotificationsTestSuite) TestSendEmailDigestsLaterNotification() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+		now  = time.Now()
+	)
+
+	// Opt in to daily digests of follows.
+	settings, err := suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EmailNotifyFollow = util.Ptr(true)
+	settings.EmailDigest = gtsmodel.EmailDigestDaily
+	if err := suite.db.UpdateAccountSettings(ctx, settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Add a follow notification created just
+	// after the digest run starts, eg., while
+	// digests for other users are being sent.
+	notifID, err := id.NewULIDFromTime(now.Add(time.Second))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := suite.db.PutNotification(ctx, &gtsmodel.Notification{
+		ID:               notifID,
+		NotificationType: gtsmodel.NotificationFollow,
+		TargetAccountID:  user.AccountID,
+		OriginAccountID:  "01F8MH5NBDF2MV7CTC4Q5128HF",
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// It shouldn't be in this digest...
+	suite.user.SendEmailDigests(ctx, now)
+	suite.Empty(suite.sentEmails)
+
+	// ...but it should be in the next one.
+	suite.user.SendEmailDigests(ctx, now.Add(24*time.Hour))
+	if !suite.Len(suite.sentEmails, 1) {
+		suite.FailNow("")
+	}
+	suite.Contains(suite.sentEmails[user.Email], "@1happyturtle@localhost:8080 followed you.")
+}
+
+func (suite *EmailNotificationsTestSuite) TestSendEmailDigestsTooMany() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+		now  = time.Now()
+	)
+
+	// Opt in to daily digests of follows.
+	settings, err := suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EmailNotifyFollow = util.Ptr(true)
+	settings.EmailDigest = gtsmodel.EmailDigestDaily
+	if err := suite.db.UpdateAccountSettings(ctx, settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Add more follow notifications
+	// than fit in one digest email.
+	for i := 0; i < 57; i++ {
+		notifID, err := id.NewULIDFromTime(now.Add(-time.Duration(i) * time.Second))
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := suite.db.PutNotification(ctx, &gtsmodel.Notification{
+			ID:               notifID,
+			NotificationType: gtsmodel.NotificationFollow,
+			TargetAccountID:  user.AccountID,
+			OriginAccountID:  "01F8MH5NBDF2MV7CTC4Q5128HF",
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	suite.user.SendEmailDigests(ctx, now)
+	if !suite.Len(suite.sentEmails, 1) {
+		suite.FailNow("")
+	}
+
+	digest := suite.sentEmails[user.Email]
+	suite.Equal(50, strings.Count(digest, "followed you."))
+	suite.Contains(digest, "...and 7 more! Sign in to http://localhost:8080 to see them all.")
+}
+
+func (suite *EmailNotificationsTestSuite) TestEmailUnsubscribe() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	settings, err := suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EmailNotifyMention = util.Ptr(true)
+	settings.EmailNotifyDirect = util.Ptr(true)
+	settings.EmailDigest = gtsmodel.EmailDigestWeekly
+	if err := suite.db.UpdateAccountSettings(ctx, settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	session, err := suite.db.GetSession(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// A token for another user shouldn't work.
+	otherToken := email.UnsubscribeToken(session.Auth, suite.testUsers["local_account_2"].ID)
+	_, errWithCode := suite.user.EmailUnsubscribe(ctx, user.ID, otherToken)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	token := email.UnsubscribeToken(session.Auth, user.ID)
+	unsubscribed, errWithCode := suite.user.EmailUnsubscribe(ctx, user.ID, token)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(user.ID, unsubscribed.ID)
+	suite.Equal("the_mighty_zork", unsubscribed.Account.Username)
+
+	settings, err = suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(settings.EmailNotifyAny())
+	suite.Equal(gtsmodel.EmailDigestNone, settings.EmailDigest)
+}
+
+func TestEmailNotificationsTestSuite(t *testing.T) {
+	suite.Run(t, new(EmailNotificationsTestSuite))
+}
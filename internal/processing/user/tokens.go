@@ -0,0 +1,279 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// TokensGet returns a page of OAuth access tokens
+// issued to the given user, newest first, along
+// with the application each token was issued to.
+func (p *Processor) TokensGet(
+	ctx context.Context,
+	user *gtsmodel.User,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	tokens, err := p.state.DB.GetAccessTokens(ctx, user.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tokens: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(tokens)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := tokens[count-1].ID
+	hi := tokens[0].ID
+
+	items := make([]interface{}, 0, count)
+	for _, token := range tokens {
+		apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+		if err != nil {
+			log.Errorf(ctx, "error converting token %s: %v", token.ID, err)
+			continue
+		}
+		items = append(items, apiToken)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/tokens",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// TokenGet returns the OAuth access token
+// with the given ID, if owned by the user.
+func (p *Processor) TokenGet(
+	ctx context.Context,
+	user *gtsmodel.User,
+	tokenID string,
+) (*apimodel.TokenInfo, gtserror.WithCode) {
+	token, errWithCode := p.getOwnToken(ctx, user, tokenID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+	if err != nil {
+		err := gtserror.Newf("error converting token: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiToken, nil
+}
+
+// TokenInvalidate revokes the OAuth access token with the
+// given ID, if owned by the user, so that it can no longer
+// be used to access the API. The revoked token is returned.
+func (p *Processor) TokenInvalidate(
+	ctx context.Context,
+	user *gtsmodel.User,
+	tokenID string,
+) (*apimodel.TokenInfo, gtserror.WithCode) {
+	token, errWithCode := p.getOwnToken(ctx, user, tokenID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deleting, since
+	// we return the token to the caller.
+	apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+	if err != nil {
+		err := gtserror.Newf("error converting token: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteTokenByID(ctx, token.ID); err != nil {
+		err := gtserror.Newf("db error deleting token: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiToken, nil
+}
+
+// AuthorizedAppsGet returns the applications that the
+// user has authorized, each with the access tokens issued
+// to it. Applications are ordered by their newest token.
+func (p *Processor) AuthorizedAppsGet(
+	ctx context.Context,
+	user *gtsmodel.User,
+) ([]*apimodel.AuthorizedApplication, gtserror.WithCode) {
+	// Get all tokens, newest first. There
+	// are few enough of these not to page.
+	tokens, err := p.state.DB.GetAccessTokens(ctx, user.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tokens: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var (
+		apps  = make([]*apimodel.AuthorizedApplication, 0)
+		byApp = make(map[string]*apimodel.AuthorizedApplication)
+	)
+
+	for _, token := range tokens {
+		apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+		if err != nil {
+			log.Errorf(ctx, "error converting token %s: %v", token.ID, err)
+			continue
+		}
+
+		app := byApp[token.ClientID]
+		if app == nil {
+			app, err = p.authorizedApp(ctx, token.ClientID)
+			if err != nil {
+				log.Errorf(ctx, "error converting application of token %s: %v", token.ID, err)
+				continue
+			}
+			byApp[token.ClientID] = app
+			apps = append(apps, app)
+		}
+
+		// Don't repeat the app for each token.
+		apiToken.Application = nil
+		app.Tokens = append(app.Tokens, apiToken)
+	}
+
+	return apps, nil
+}
+
+// AuthorizedAppInvalidate revokes all OAuth access tokens
+// issued to the application with the given ID for the user,
+// signing the application out entirely. The application
+// is returned, along with the now revoked tokens.
+func (p *Processor) AuthorizedAppInvalidate(
+	ctx context.Context,
+	user *gtsmodel.User,
+	appID string,
+) (*apimodel.AuthorizedApplication, gtserror.WithCode) {
+	app, err := p.state.DB.GetApplicationByID(ctx, appID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting application %s: %w", appID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var tokens []*gtsmodel.Token
+	if app != nil {
+		tokens, err = p.state.DB.GetAccessTokens(ctx, user.ID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting tokens: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Only tokens of this app.
+		tokens = slices.DeleteFunc(tokens, func(token *gtsmodel.Token) bool {
+			return token.ClientID != app.ClientID
+		})
+	}
+
+	if len(tokens) == 0 {
+		// Don't reveal existence of applications
+		// that the user hasn't authorized.
+		const text = "application not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	apiApp, err := p.authorizedApp(ctx, app.ClientID)
+	if err != nil {
+		err := gtserror.Newf("error converting application: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, token := range tokens {
+		// Convert before deleting, since
+		// we return tokens to the caller.
+		apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+		if err != nil {
+			err := gtserror.Newf("error converting token: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if err := p.state.DB.DeleteTokenByID(ctx, token.ID); err != nil {
+			err := gtserror.Newf("db error deleting token: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiToken.Application = nil
+		apiApp.Tokens = append(apiApp.Tokens, apiToken)
+	}
+
+	return apiApp, nil
+}
+
+// authorizedApp returns an empty authorized application
+// model for the application with the given client ID,
+// including the application ID used for revoking it.
+func (p *Processor) authorizedApp(
+	ctx context.Context,
+	clientID string,
+) (*apimodel.AuthorizedApplication, error) {
+	app, err := p.state.DB.GetApplicationByClientID(ctx, clientID)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting application with client id %s: %w", clientID, err)
+	}
+
+	apiApp, err := p.converter.AppToAPIAppPublic(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	apiApp.ID = app.ID
+
+	return &apimodel.AuthorizedApplication{
+		Application: apiApp,
+		Tokens:      make([]*apimodel.TokenInfo, 0),
+	}, nil
+}
+
+// getOwnToken fetches the access token with given
+// ID, returning 404 if it's not owned by the user.
+func (p *Processor) getOwnToken(
+	ctx context.Context,
+	user *gtsmodel.User,
+	tokenID string,
+) (*gtsmodel.Token, gtserror.WithCode) {
+	token, err := p.state.DB.GetTokenByID(ctx, tokenID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting token %s: %w", tokenID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if token == nil || token.UserID != user.ID || token.Access == "" {
+		// Don't reveal existence of other users' tokens.
+		const text = "token not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return token, nil
+}
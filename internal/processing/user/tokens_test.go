@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TokensTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TokensTestSuite) TestTokensGet() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	resp, errWithCode := suite.user.TokensGet(ctx, user, &paging.Page{Limit: 20})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Only the access token should be returned, not the
+	// client token or the pending authorization code.
+	if !suite.Len(resp.Items, 1) {
+		suite.FailNow("")
+	}
+
+	token := resp.Items[0].(*apimodel.TokenInfo)
+	suite.Equal("01F8MGTQW4DKTDF8SW5CT9HYGA", token.ID)
+	suite.Equal("read write follow push", token.Scope)
+	suite.Equal("2022-06-10T15:22:08.000Z", token.CreatedAt)
+	suite.Empty(token.LastUsed)
+	suite.Equal("really cool gts application", token.Application.Name)
+}
+
+func (suite *TokensTestSuite) TestTokenGetNotOwn() {
+	var (
+		ctx   = context.Background()
+		user  = suite.testUsers["local_account_1"]
+		token = testrig.NewTestTokens()["local_account_2"]
+	)
+
+	_, errWithCode := suite.user.TokenGet(ctx, user, token.ID)
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *TokensTestSuite) TestTokenInvalidate() {
+	var (
+		ctx   = context.Background()
+		user  = suite.testUsers["local_account_1"]
+		token = testrig.NewTestTokens()["local_account_1"]
+	)
+
+	// Ensure token is cached.
+	if _, err := suite.db.GetTokenByAccess(ctx, token.Access); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiToken, errWithCode := suite.user.TokenInvalidate(ctx, user, token.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(token.ID, apiToken.ID)
+
+	// Token should no longer be usable.
+	_, err := suite.db.GetTokenByAccess(ctx, token.Access)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *TokensTestSuite) TestAuthorizedAppsGet() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	apps, errWithCode := suite.user.AuthorizedAppsGet(ctx, user)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !suite.Len(apps, 1) {
+		suite.FailNow("")
+	}
+
+	app := apps[0]
+	suite.Equal("01F8MGY43H3N2C8EWPR2FPYEXG", app.Application.ID)
+	suite.Equal("really cool gts application", app.Application.Name)
+	if suite.Len(app.Tokens, 1) {
+		suite.Equal("01F8MGTQW4DKTDF8SW5CT9HYGA", app.Tokens[0].ID)
+		suite.Nil(app.Tokens[0].Application)
+	}
+}
+
+func (suite *TokensTestSuite) TestAuthorizedAppInvalidate() {
+	var (
+		ctx   = context.Background()
+		user  = suite.testUsers["local_account_1"]
+		token = testrig.NewTestTokens()["local_account_1"]
+	)
+
+	app, errWithCode := suite.user.AuthorizedAppInvalidate(ctx, user, "01F8MGY43H3N2C8EWPR2FPYEXG")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(app.Tokens, 1) {
+		suite.Equal(token.ID, app.Tokens[0].ID)
+	}
+
+	// Token should no longer be usable.
+	_, err := suite.db.GetTokenByAccess(ctx, token.Access)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	// And the app no longer authorized.
+	apps, errWithCode := suite.user.AuthorizedAppsGet(ctx, user)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apps)
+}
+
+func (suite *TokensTestSuite) TestAuthorizedAppInvalidateNotOwn() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	// Application of local_account_2,
+	// which local_account_1 has no tokens for.
+	_, errWithCode := suite.user.AuthorizedAppInvalidate(ctx, user, "01F8MGYG9E893WRHW0TAEXR8GJ")
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestTokensTestSuite(t *testing.T) {
+	suite.Run(t, new(TokensTestSuite))
+}
@@ -0,0 +1,329 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 -- TOTP apps only reliably support SHA-1
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// TOTP parameters, as described in RFC 6238. These
+	// are the defaults, and the only values that all
+	// common authenticator apps reliably support.
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+	totpSkew   = 1 // accept codes from 1 period either side of now
+
+	// totpSecretLen is the number of bytes
+	// of random data in a new TOTP secret,
+	// as recommended by RFC 4226.
+	totpSecretLen = 20
+
+	// recoveryCodesCount is the number
+	// of recovery codes generated on
+	// enrolment, and recoveryCodeLen the
+	// number of hex characters in each.
+	recoveryCodesCount = 10
+	recoveryCodeLen    = 10
+)
+
+// base32NoPadding is the encoding of TOTP secrets
+// used in otpauth URIs, as per the key URI format.
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactorQRCodeURIGet returns an otpauth:// URI for the given user,
+// to be rendered as a QR code and scanned by an authenticator app,
+// or entered by hand. The first call generates and stores a new TOTP
+// secret for the user; enrolment is completed with TwoFactorEnable.
+func (p *Processor) TwoFactorQRCodeURIGet(ctx context.Context, user *gtsmodel.User) (*apimodel.TwoFactorQRCodeURI, gtserror.WithCode) {
+	if user.TwoFactorEnabled() {
+		const text = "two-factor authentication is already enabled; disable it first to enrol again"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	if user.TwoFactorSecret == "" {
+		// Generate a new secret for
+		// the user to enrol with.
+		secret := make([]byte, totpSecretLen)
+		if _, err := rand.Read(secret); err != nil {
+			err := gtserror.Newf("error generating secret: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		user.TwoFactorSecret = base32NoPadding.EncodeToString(secret)
+		if err := p.state.DB.UpdateUser(ctx, user, "two_factor_secret"); err != nil {
+			err := gtserror.Newf("db error updating user: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	account := user.Account
+	if account == nil {
+		var err error
+		account, err = p.state.DB.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error getting account: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// See https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+	issuer := config.GetHost()
+	uri := &url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + issuer + ":" + account.Username,
+		RawQuery: url.Values{
+			"secret":    {user.TwoFactorSecret},
+			"issuer":    {issuer},
+			"algorithm": {"SHA1"},
+			"digits":    {fmt.Sprint(totpDigits)},
+			"period":    {fmt.Sprint(int(totpPeriod.Seconds()))},
+		}.Encode(),
+	}
+
+	return &apimodel.TwoFactorQRCodeURI{
+		URI:    uri.String(),
+		Secret: user.TwoFactorSecret,
+	}, nil
+}
+
+// TwoFactorEnable completes enrolment of the given user in two-factor
+// authentication, if the given code is valid for the secret generated
+// by TwoFactorQRCodeURIGet. It returns a set of one-time recovery codes,
+// which are shown to the user once and then only stored hashed.
+func (p *Processor) TwoFactorEnable(ctx context.Context, user *gtsmodel.User, code string) ([]string, gtserror.WithCode) {
+	if user.TwoFactorEnabled() {
+		const text = "two-factor authentication is already enabled"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	if user.TwoFactorSecret == "" {
+		const text = "no two-factor authentication secret generated yet; get a QR code URI first"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	counter, err := totpValidate(user.TwoFactorSecret, code, time.Now(), 0)
+	if err != nil {
+		err := gtserror.Newf("error validating code: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if counter == 0 {
+		const text = "invalid two-factor authentication code"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	recoveryCodes := make([]string, recoveryCodesCount)
+	hashes := make([]string, recoveryCodesCount)
+	for i := range recoveryCodes {
+		b := make([]byte, recoveryCodeLen/2)
+		if _, err := rand.Read(b); err != nil {
+			err := gtserror.Newf("error generating recovery code: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		code := hex.EncodeToString(b)
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			err := gtserror.Newf("error hashing recovery code: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Show codes split in two for legibility;
+		// the dash is ignored on sign in.
+		recoveryCodes[i] = code[:recoveryCodeLen/2] + "-" + code[recoveryCodeLen/2:]
+		hashes[i] = string(hash)
+	}
+
+	user.TwoFactorBackups = hashes
+	user.TwoFactorEnabledAt = time.Now()
+	user.TwoFactorLastCounter = counter
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_backups",
+		"two_factor_enabled_at",
+		"two_factor_last_counter",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return recoveryCodes, nil
+}
+
+// TwoFactorDisable disables two-factor authentication
+// for the given user, if the given password is correct.
+func (p *Processor) TwoFactorDisable(ctx context.Context, user *gtsmodel.User, password string) gtserror.WithCode {
+	if !user.TwoFactorEnabled() {
+		const text = "two-factor authentication is not enabled"
+		return gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(password)); err != nil {
+		err := gtserror.Newf("%w", err)
+		return gtserror.NewErrorForbidden(err, "password was incorrect")
+	}
+
+	user.TwoFactorSecret = ""
+	user.TwoFactorBackups = nil
+	user.TwoFactorEnabledAt = time.Time{}
+	user.TwoFactorLastCounter = 0
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+		"two_factor_backups",
+		"two_factor_enabled_at",
+		"two_factor_last_counter",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// TwoFactorCodeCheck checks the given code as the second factor when
+// the given user signs in. The code may be either a current TOTP code,
+// or one of the user's recovery codes, in which case it's used up.
+func (p *Processor) TwoFactorCodeCheck(ctx context.Context, user *gtsmodel.User, code string) gtserror.WithCode {
+	if !user.TwoFactorEnabled() {
+		const text = "two-factor authentication is not enabled"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Normalize for users
+	// typing codes by hand.
+	code = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-':
+			return -1
+		default:
+			return r
+		}
+	}, strings.ToLower(code))
+
+	if len(code) == totpDigits {
+		// Only accept codes newer than the last
+		// one accepted, so a code that's been seen
+		// (eg., over someone's shoulder) can't be
+		// replayed while it's still within skew.
+		counter, err := totpValidate(user.TwoFactorSecret, code, time.Now(), user.TwoFactorLastCounter)
+		if err != nil {
+			err := gtserror.Newf("error validating code: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if counter != 0 {
+			user.TwoFactorLastCounter = counter
+			if err := p.state.DB.UpdateUser(ctx, user, "two_factor_last_counter"); err != nil {
+				err := gtserror.Newf("db error updating user: %w", err)
+				return gtserror.NewErrorInternalError(err)
+			}
+
+			return nil
+		}
+	} else if len(code) == recoveryCodeLen {
+		for i, hash := range user.TwoFactorBackups {
+			if bcrypt.CompareHashAndPassword([]byte(hash), []byte(code)) != nil {
+				continue
+			}
+
+			// Recovery codes are single
+			// use, so drop this one now.
+			backups := make([]string, 0, len(user.TwoFactorBackups)-1)
+			backups = append(backups, user.TwoFactorBackups[:i]...)
+			backups = append(backups, user.TwoFactorBackups[i+1:]...)
+
+			user.TwoFactorBackups = backups
+			if err := p.state.DB.UpdateUser(ctx, user, "two_factor_backups"); err != nil {
+				err := gtserror.Newf("db error updating user: %w", err)
+				return gtserror.NewErrorInternalError(err)
+			}
+
+			return nil
+		}
+	}
+
+	err := fmt.Errorf("invalid two-factor authentication code for user %s", user.ID)
+	return gtserror.NewErrorUnauthorized(err, "two-factor authentication code was incorrect")
+}
+
+// totpValidate checks whether the given code is valid for
+// the given base32 encoded TOTP secret at the given time,
+// allowing for some clock skew. If so, the time step counter
+// the code was generated for is returned, else 0. Codes for
+// counters at or below the given last counter are rejected.
+func totpValidate(secret string, code string, now time.Time, last int64) (int64, error) {
+	key, err := base32NoPadding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, fmt.Errorf("error decoding secret: %w", err)
+	}
+
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for counter := current - totpSkew; counter <= current+totpSkew; counter++ {
+		if counter <= last {
+			// Already used.
+			continue
+		}
+
+		expect := totpCode(key, uint64(counter))
+		if subtle.ConstantTimeCompare([]byte(expect), []byte(code)) == 1 {
+			return counter, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// totpCode generates the HOTP code, as described in
+// RFC 4226, for the given key and counter value.
+func totpCode(key []byte, counter uint64) string {
+	mac := hmac.New(sha1.New, key)
+	_ = binary.Write(mac, binary.BigEndian, counter)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
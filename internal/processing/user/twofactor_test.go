@@ -0,0 +1,152 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TwoFactorTestSuite struct {
+	UserStandardTestSuite
+}
+
+// totpCode independently generates the current RFC 6238
+// code for the given base32 secret, like an app would.
+func totpCode(secret string, now time.Time) string {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		panic(err)
+	}
+
+	mac := hmac.New(sha1.New, key)
+	_ = binary.Write(mac, binary.BigEndian, uint64(now.Unix()/30))
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1000000)
+}
+
+func (suite *TwoFactorTestSuite) TestTOTPCodeRFCVector() {
+	// SHA-1 test vector from RFC 6238 appendix B,
+	// truncated to 6 digits, to check our helper.
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	suite.Equal("287082", totpCode(secret, time.Unix(59, 0)))
+	suite.Equal("081804", totpCode(secret, time.Unix(1111111109, 0)))
+}
+
+func (suite *TwoFactorTestSuite) TestEnrolSignInDisable() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+		now  = time.Now()
+	)
+
+	// Get the QR code URI to start enrolment.
+	qrCodeURI, errWithCode := suite.user.TwoFactorQRCodeURIGet(ctx, user)
+	suite.NoError(errWithCode)
+
+	uri, err := url.Parse(qrCodeURI.URI)
+	suite.NoError(err)
+	suite.Equal("otpauth", uri.Scheme)
+	suite.Equal("totp", uri.Host)
+	suite.Equal("/localhost:8080:the_mighty_zork", uri.Path)
+
+	secret := uri.Query().Get("secret")
+	suite.NotEmpty(secret)
+	suite.Equal(secret, qrCodeURI.Secret)
+	suite.Equal(secret, user.TwoFactorSecret)
+	suite.False(user.TwoFactorEnabled())
+
+	// Getting the URI again should
+	// give the same secret back.
+	qrCodeURI, errWithCode = suite.user.TwoFactorQRCodeURIGet(ctx, user)
+	suite.NoError(errWithCode)
+	suite.Equal(secret, qrCodeURI.Secret)
+
+	// A wrong code can't complete enrolment.
+	_, errWithCode = suite.user.TwoFactorEnable(ctx, user, "abcdef")
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+	suite.False(user.TwoFactorEnabled())
+
+	// The right code should.
+	recoveryCodes, errWithCode := suite.user.TwoFactorEnable(ctx, user, totpCode(secret, now))
+	suite.NoError(errWithCode)
+	suite.Len(recoveryCodes, 10)
+	suite.True(user.TwoFactorEnabled())
+
+	// Enrolment can't be started again now.
+	_, errWithCode = suite.user.TwoFactorQRCodeURIGet(ctx, user)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Check stored user was updated.
+	dbUser, err := suite.state.DB.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.True(dbUser.TwoFactorEnabled())
+	suite.Len(dbUser.TwoFactorBackups, 10)
+
+	// The code used to enrol can't be used again
+	// to sign in, but the next one should be fine.
+	errWithCode = suite.user.TwoFactorCodeCheck(ctx, dbUser, totpCode(secret, now))
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	nextCode := totpCode(secret, now.Add(30*time.Second))
+	suite.NoError(suite.user.TwoFactorCodeCheck(ctx, dbUser, nextCode))
+
+	// Replaying it shouldn't work.
+	errWithCode = suite.user.TwoFactorCodeCheck(ctx, dbUser, nextCode)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	dbUser, err = suite.state.DB.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.Equal(now.Add(30*time.Second).Unix()/30, dbUser.TwoFactorLastCounter)
+
+	// Garbage should not.
+	errWithCode = suite.user.TwoFactorCodeCheck(ctx, dbUser, "not a code")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Recovery code should be accepted once.
+	suite.NoError(suite.user.TwoFactorCodeCheck(ctx, dbUser, recoveryCodes[0]))
+	suite.Len(dbUser.TwoFactorBackups, 9)
+	errWithCode = suite.user.TwoFactorCodeCheck(ctx, dbUser, recoveryCodes[0])
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Disabling needs the right password.
+	errWithCode = suite.user.TwoFactorDisable(ctx, dbUser, "wrong password")
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+	suite.True(dbUser.TwoFactorEnabled())
+
+	suite.NoError(suite.user.TwoFactorDisable(ctx, dbUser, "password"))
+	suite.False(dbUser.TwoFactorEnabled())
+	suite.Empty(dbUser.TwoFactorSecret)
+	suite.Empty(dbUser.TwoFactorBackups)
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorTestSuite))
+}
@@ -23,12 +23,15 @@ import (
 
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // federate wraps functions for federating
@@ -170,6 +173,14 @@ func (f *federate) CreateStatus(ctx context.Context, status *gtsmodel.Status) er
 	if _, err := f.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
 		return gtserror.Newf("error sending Create activity via outbox %s: %w", outboxIRI, err)
 	}
+
+	if status.Visibility == gtsmodel.VisibilityPublic {
+		// Also share public statuses via relays.
+		if err := f.deliverToRelays(ctx, status.Account, create); err != nil {
+			log.Errorf(ctx, "error delivering Create activity to relays: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -244,9 +255,63 @@ func (f *federate) DeleteStatus(ctx context.Context, status *gtsmodel.Status) er
 		)
 	}
 
+	if status.Visibility == gtsmodel.VisibilityPublic {
+		// Public statuses may have been shared via
+		// relays, so make sure they're removed there.
+		if err := f.deliverToRelays(ctx, status.Account, delete); err != nil {
+			log.Errorf(ctx, "error delivering Delete activity to relays: %v", err)
+		}
+	}
+
 	return nil
 }
 
+// deliverToRelays delivers the given activity by the given
+// account to the inboxes of accepted relay subscriptions which
+// have forwarding enabled, so that the relays share it onwards.
+func (f *federate) deliverToRelays(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	activity vocab.Type,
+) error {
+	relays, err := f.state.DB.GetRelays(ctx)
+	if err != nil {
+		return gtserror.Newf("db error getting relays: %w", err)
+	}
+
+	var inboxes []*url.URL
+	for _, relay := range relays {
+		if relay.State != gtsmodel.RelayStateAccepted ||
+			!util.PtrValueOr(relay.Forward, false) {
+			continue
+		}
+
+		inbox, err := parseURI(relay.InboxURI)
+		if err != nil {
+			return err
+		}
+
+		inboxes = append(inboxes, inbox)
+	}
+
+	if len(inboxes) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	data, err := ap.Serialize(activity)
+	if err != nil {
+		return gtserror.Newf("error serializing %T: %w", activity, err)
+	}
+
+	tsport, err := f.TransportController().NewTransportForUsername(ctx, account.Username)
+	if err != nil {
+		return gtserror.Newf("error getting transport for %s: %w", account.Username, err)
+	}
+
+	return tsport.BatchDeliver(ctx, data, inboxes)
+}
+
 func (f *federate) UpdateStatus(ctx context.Context, status *gtsmodel.Status) error {
 	// Do nothing if the status
 	// shouldn't be federated.
@@ -672,6 +737,176 @@ func (f *federate) RejectFollow(ctx context.Context, follow *gtsmodel.Follow) er
 	return nil
 }
 
+// AcceptInteraction sends an Accept of the interaction (Like, reply or
+// Announce) with the given URI, from the approving (local) account to
+// the (remote) account that did the interaction, using the given URI
+// as the ID of the Accept.
+func (f *federate) AcceptInteraction(
+	ctx context.Context,
+	approver *gtsmodel.Account,
+	interactor *gtsmodel.Account,
+	interactionURI string,
+	acceptURI string,
+) error {
+	// Bail if interacting account is ours:
+	// we've already accepted internally and
+	// shouldn't send an Accept to ourselves.
+	if interactor.IsLocal() {
+		return nil
+	}
+
+	// Bail if approving account isn't ours:
+	// we can't Accept an interaction on
+	// another instance's behalf.
+	if approver.IsRemote() {
+		return nil
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(approver.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	acceptIRI, err := parseURI(acceptURI)
+	if err != nil {
+		return err
+	}
+
+	approverIRI, err := parseURI(approver.URI)
+	if err != nil {
+		return err
+	}
+
+	interactorIRI, err := parseURI(interactor.URI)
+	if err != nil {
+		return err
+	}
+
+	interactionIRI, err := parseURI(interactionURI)
+	if err != nil {
+		return err
+	}
+
+	// Create a new Accept.
+	accept := streams.NewActivityStreamsAccept()
+
+	// Set the Accept ID.
+	ap.SetJSONLDId(accept, acceptIRI)
+
+	// Set the approver as Actor of the Accept.
+	acceptActorProp := streams.NewActivityStreamsActorProperty()
+	acceptActorProp.AppendIRI(approverIRI)
+	accept.SetActivityStreamsActor(acceptActorProp)
+
+	// Set the interaction IRI as 'object' property.
+	acceptObject := streams.NewActivityStreamsObjectProperty()
+	acceptObject.AppendIRI(interactionIRI)
+	accept.SetActivityStreamsObject(acceptObject)
+
+	// Address the Accept To the interactor.
+	acceptTo := streams.NewActivityStreamsToProperty()
+	acceptTo.AppendIRI(interactorIRI)
+	accept.SetActivityStreamsTo(acceptTo)
+
+	// Send the Accept via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, accept,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			accept, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
+// RejectInteraction sends a Reject of the interaction (Like, reply or
+// Announce) with the given URI, from the rejecting (local) account to
+// the (remote) account that did the interaction, using the given URI
+// as the ID of the Reject.
+func (f *federate) RejectInteraction(
+	ctx context.Context,
+	rejecter *gtsmodel.Account,
+	interactor *gtsmodel.Account,
+	interactionURI string,
+	rejectURI string,
+) error {
+	// Bail if interacting account is ours:
+	// we've already rejected internally and
+	// shouldn't send a Reject to ourselves.
+	if interactor.IsLocal() {
+		return nil
+	}
+
+	// Bail if rejecting account isn't ours:
+	// we can't Reject an interaction on
+	// another instance's behalf.
+	if rejecter.IsRemote() {
+		return nil
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(rejecter.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	rejectIRI, err := parseURI(rejectURI)
+	if err != nil {
+		return err
+	}
+
+	rejecterIRI, err := parseURI(rejecter.URI)
+	if err != nil {
+		return err
+	}
+
+	interactorIRI, err := parseURI(interactor.URI)
+	if err != nil {
+		return err
+	}
+
+	interactionIRI, err := parseURI(interactionURI)
+	if err != nil {
+		return err
+	}
+
+	// Create a new Reject.
+	reject := streams.NewActivityStreamsReject()
+
+	// Set the Reject ID.
+	ap.SetJSONLDId(reject, rejectIRI)
+
+	// Set the rejecter as Actor of the Reject.
+	rejectActorProp := streams.NewActivityStreamsActorProperty()
+	rejectActorProp.AppendIRI(rejecterIRI)
+	reject.SetActivityStreamsActor(rejectActorProp)
+
+	// Set the interaction IRI as 'object' property.
+	rejectObject := streams.NewActivityStreamsObjectProperty()
+	rejectObject.AppendIRI(interactionIRI)
+	reject.SetActivityStreamsObject(rejectObject)
+
+	// Address the Reject To the interactor.
+	rejectTo := streams.NewActivityStreamsToProperty()
+	rejectTo.AppendIRI(interactorIRI)
+	reject.SetActivityStreamsTo(rejectTo)
+
+	// Send the Reject via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, reject,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			reject, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
 func (f *federate) Like(ctx context.Context, fave *gtsmodel.StatusFave) error {
 	// Populate model.
 	if err := f.state.DB.PopulateStatusFave(ctx, fave); err != nil {
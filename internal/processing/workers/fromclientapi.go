@@ -27,11 +27,13 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -101,6 +103,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// CREATE BLOCK
 		case ap.ActivityBlock:
 			return p.clientAPI.CreateBlock(ctx, cMsg)
+
+		// CREATE COLLECTION (ie., import of account data)
+		case ap.ObjectCollection:
+			return p.clientAPI.CreateImport(ctx, cMsg)
 		}
 
 	// UPDATE SOMETHING
@@ -126,7 +132,7 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 
 	// ACCEPT SOMETHING
 	case ap.ActivityAccept:
-		switch cMsg.APObjectType { //nolint:gocritic
+		switch cMsg.APObjectType {
 
 		// ACCEPT FOLLOW (request)
 		case ap.ActivityFollow:
@@ -135,11 +141,23 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// ACCEPT USER (ie., new user+account sign-up)
 		case ap.ObjectProfile:
 			return p.clientAPI.AcceptUser(ctx, cMsg)
+
+		// ACCEPT LIKE/FAVE (pending approval)
+		case ap.ActivityLike:
+			return p.clientAPI.AcceptLike(ctx, cMsg)
+
+		// ACCEPT NOTE/STATUS (ie., reply pending approval)
+		case ap.ObjectNote:
+			return p.clientAPI.AcceptReply(ctx, cMsg)
+
+		// ACCEPT ANNOUNCE/BOOST (pending approval)
+		case ap.ActivityAnnounce:
+			return p.clientAPI.AcceptAnnounce(ctx, cMsg)
 		}
 
 	// REJECT SOMETHING
 	case ap.ActivityReject:
-		switch cMsg.APObjectType { //nolint:gocritic
+		switch cMsg.APObjectType {
 
 		// REJECT FOLLOW (request)
 		case ap.ActivityFollow:
@@ -148,6 +166,18 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// REJECT USER (ie., new user+account sign-up)
 		case ap.ObjectProfile:
 			return p.clientAPI.RejectUser(ctx, cMsg)
+
+		// REJECT LIKE/FAVE (pending approval)
+		case ap.ActivityLike:
+			return p.clientAPI.RejectLike(ctx, cMsg)
+
+		// REJECT NOTE/STATUS (ie., reply pending approval)
+		case ap.ObjectNote:
+			return p.clientAPI.RejectReply(ctx, cMsg)
+
+		// REJECT ANNOUNCE/BOOST (pending approval)
+		case ap.ActivityAnnounce:
+			return p.clientAPI.RejectAnnounce(ctx, cMsg)
 		}
 
 	// UNDO SOMETHING
@@ -255,6 +285,9 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error federating status: %v", err)
 	}
 
+	// Fetch preview card for any link.
+	p.utils.fetchPreviewCard(status.ID)
+
 	return nil
 }
 
@@ -409,6 +442,19 @@ func (p *clientAPI) CreateAnnounce(ctx context.Context, cMsg *messages.FromClien
 	return nil
 }
 
+func (p *clientAPI) CreateImport(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	imp, ok := cMsg.GTSModel.(*gtsmodel.Import)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Import", cMsg.GTSModel)
+	}
+
+	if err := p.account.ImportProcess(ctx, imp); err != nil {
+		return gtserror.Newf("error processing import: %w", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) CreateBlock(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	block, ok := cMsg.GTSModel.(*gtsmodel.Block)
 	if !ok {
@@ -465,6 +511,13 @@ func (p *clientAPI) UpdateStatus(ctx context.Context, cMsg *messages.FromClientA
 		if err := p.surface.notifyPollClose(ctx, status); err != nil {
 			log.Errorf(ctx, "error notifying poll close: %v", err)
 		}
+	} else if !status.EditedAt.IsZero() {
+
+		// Status content was edited, notify
+		// any new mentions and boosters.
+		if err := p.surface.notifyStatusEdit(ctx, status); err != nil {
+			log.Errorf(ctx, "error notifying status edit: %v", err)
+		}
 	}
 
 	// Push message that the status has been edited to streams.
@@ -472,6 +525,12 @@ func (p *clientAPI) UpdateStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error streaming status edit: %v", err)
 	}
 
+	if !status.EditedAt.IsZero() {
+		// Content may have changed, refetch
+		// the preview card for any link.
+		p.utils.fetchPreviewCard(status.ID)
+	}
+
 	return nil
 }
 
@@ -874,3 +933,196 @@ func (p *clientAPI) RejectUser(ctx context.Context, cMsg *messages.FromClientAPI
 
 	return nil
 }
+
+func (p *clientAPI) AcceptLike(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	fave, ok := cMsg.GTSModel.(*gtsmodel.StatusFave)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusFave", cMsg.GTSModel)
+	}
+
+	// Interaction counts changed on the faved status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, fave.StatusID)
+
+	if err := p.federate.AcceptInteraction(ctx,
+		cMsg.Origin,
+		cMsg.Target,
+		fave.URI,
+		fave.ApprovedByURI,
+	); err != nil {
+		log.Errorf(ctx, "error federating like accept: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) AcceptReply(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	// Now the reply is approved,
+	// timeline and notify it.
+	if err := p.surface.timelineAndNotifyStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error timelining and notifying status: %v", err)
+	}
+
+	// Interaction counts changed on the replied status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, status.InReplyToID)
+
+	if err := p.federate.AcceptInteraction(ctx,
+		cMsg.Origin,
+		cMsg.Target,
+		status.URI,
+		status.ApprovedByURI,
+	); err != nil {
+		log.Errorf(ctx, "error federating reply accept: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) AcceptAnnounce(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	boost, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	// Now the boost is approved,
+	// timeline and notify it.
+	if err := p.surface.timelineAndNotifyStatus(ctx, boost); err != nil {
+		log.Errorf(ctx, "error timelining and notifying status: %v", err)
+	}
+
+	// Interaction counts changed on the boosted status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, boost.BoostOfID)
+
+	if err := p.federate.AcceptInteraction(ctx,
+		cMsg.Origin,
+		cMsg.Target,
+		boost.URI,
+		boost.ApprovedByURI,
+	); err != nil {
+		log.Errorf(ctx, "error federating announce accept: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) RejectLike(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	fave, ok := cMsg.GTSModel.(*gtsmodel.StatusFave)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusFave", cMsg.GTSModel)
+	}
+
+	// Interaction counts changed on the faved status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, fave.StatusID)
+
+	if err := p.federate.RejectInteraction(ctx,
+		cMsg.Origin,
+		cMsg.Target,
+		fave.URI,
+		uris.GenerateURIForReject(cMsg.Origin.Username, id.NewULID()),
+	); err != nil {
+		log.Errorf(ctx, "error federating like reject: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) RejectReply(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	// Rejected replies are gone for
+	// good, so delete any attachments.
+	const deleteAttachments = true
+
+	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	// Try to populate status structs if possible,
+	// in order to more thoroughly remove them.
+	if err := p.state.DB.PopulateStatus(
+		ctx, status,
+	); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error populating status: %w", err)
+	}
+
+	// Perform the actual status deletion.
+	if err := p.utils.wipeStatus(ctx, status, deleteAttachments); err != nil {
+		log.Errorf(ctx, "error wiping status: %v", err)
+	}
+
+	// Update stats for the reply author.
+	if err := p.utils.decrementStatusesCount(ctx, cMsg.Target); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	// Interaction counts changed on the replied status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, status.InReplyToID)
+
+	if cMsg.Target.IsLocal() {
+		// Reply by a local account will have
+		// already been federated, so delete it.
+		if err := p.federate.DeleteStatus(ctx, status); err != nil {
+			log.Errorf(ctx, "error federating status delete: %v", err)
+		}
+		return nil
+	}
+
+	if err := p.federate.RejectInteraction(ctx,
+		cMsg.Origin,
+		cMsg.Target,
+		status.URI,
+		uris.GenerateURIForReject(cMsg.Origin.Username, id.NewULID()),
+	); err != nil {
+		log.Errorf(ctx, "error federating reply reject: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) RejectAnnounce(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	boost, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	if err := p.state.DB.DeleteStatusByID(ctx, boost.ID); err != nil {
+		return gtserror.Newf("db error deleting status: %w", err)
+	}
+
+	// Update stats for the booster account.
+	if err := p.utils.decrementStatusesCount(ctx, cMsg.Target); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	// Interaction counts changed on the boosted status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, boost.BoostOfID)
+
+	if cMsg.Target.IsLocal() {
+		// Boost by a local account will have
+		// already been federated, so undo it.
+		if err := p.federate.UndoAnnounce(ctx, boost); err != nil {
+			log.Errorf(ctx, "error federating announce undo: %v", err)
+		}
+		return nil
+	}
+
+	if err := p.federate.RejectInteraction(ctx,
+		cMsg.Origin,
+		cMsg.Target,
+		boost.URI,
+		uris.GenerateURIForReject(cMsg.Origin.Username, id.NewULID()),
+	); err != nil {
+		log.Errorf(ctx, "error federating announce reject: %v", err)
+	}
+
+	return nil
+}
@@ -62,6 +62,7 @@ func (suite *FromClientAPITestSuite) newStatus(
 		ID:                  statusID,
 		URI:                 protocol + "://" + host + "/users/" + account.Username + "/statuses/" + statusID,
 		URL:                 protocol + "://" + host + "/@" + account.Username + "/statuses/" + statusID,
+		CreatedAt:           time.Now(),
 		Content:             "pee pee poo poo",
 		Local:               util.Ptr(true),
 		AccountURI:          account.URI,
@@ -69,9 +70,6 @@ func (suite *FromClientAPITestSuite) newStatus(
 		Visibility:          visibility,
 		ActivityStreamsType: ap.ObjectNote,
 		Federated:           util.Ptr(true),
-		Boostable:           util.Ptr(true),
-		Replyable:           util.Ptr(true),
-		Likeable:            util.Ptr(true),
 	}
 
 	if replyToStatus != nil {
@@ -810,6 +808,167 @@ func (suite *FromClientAPITestSuite) TestProcessCreateStatusBoostNoReblogs() {
 	)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusFollowedTag() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = context.Background()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_2"]
+		streams          = suite.openStreams(ctx, testStructs.Processor, receivingAccount, nil)
+		homeStream       = streams[stream.TimelineHome]
+		testTag          = suite.testTags["welcome"]
+
+		// Admin account posts a new top-level status.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			nil,
+			nil,
+		)
+	)
+
+	// Tag the status with #welcome.
+	status.TagIDs = []string{testTag.ID}
+	if err := testStructs.State.DB.UpdateStatus(ctx, status, "tags"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Receiving account doesn't follow the
+	// posting account, but follows #welcome.
+	if err := testStructs.State.DB.PutFollowedTag(ctx, receivingAccount.ID, testTag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the new status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusJSON := suite.statusJSON(
+		ctx,
+		testStructs.TypeConverter,
+		status,
+		receivingAccount,
+	)
+
+	// Check message in home stream.
+	suite.checkStreamed(
+		homeStream,
+		true,
+		statusJSON,
+		stream.EventTypeUpdate,
+	)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusDirectConversation() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = context.Background()
+		postingAccount   = suite.testAccounts["local_account_1"]
+		receivingAccount = suite.testAccounts["local_account_2"]
+		dmStatus         = suite.testStatuses["local_account_2_status_6"]
+	)
+
+	directStream, err := testStructs.Processor.Stream().Open(ctx, receivingAccount, stream.TimelineDirect)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// processDM has the posting account reply to the
+	// receiving account's DM, processes the reply, and
+	// returns it along with the receiving account's
+	// resulting conversation.
+	processDM := func() (*gtsmodel.Status, *gtsmodel.Conversation) {
+		status := suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityDirect,
+			dmStatus,
+			nil,
+		)
+
+		if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+			ctx,
+			&messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityCreate,
+				GTSModel:       status,
+				Origin:         postingAccount,
+			},
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		conversation, err := testStructs.State.DB.GetConversationByThreadAndAccountIDs(
+			ctx,
+			dmStatus.ThreadID,
+			receivingAccount.ID,
+			[]string{postingAccount.ID},
+		)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(status.ID, conversation.LastStatusID)
+		suite.False(*conversation.Read)
+
+		apiConversation, err := testStructs.TypeConverter.ConversationToAPIConversation(
+			ctx,
+			conversation,
+			receivingAccount,
+			nil,
+			nil,
+		)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		conversationJSON, err := json.Marshal(apiConversation)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		// Check message in direct stream.
+		suite.checkStreamed(
+			directStream,
+			true,
+			string(conversationJSON),
+			stream.EventTypeConversation,
+		)
+
+		return status, conversation
+	}
+
+	// First DM should create a conversation.
+	_, conversation1 := processDM()
+
+	// Mark it read, as though the
+	// receiving account had seen it.
+	conversation1.Read = util.Ptr(true)
+	if err := testStructs.State.DB.UpsertConversation(ctx, conversation1, "read"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Second DM in the same thread should update the
+	// same conversation, marking it unread again.
+	_, conversation2 := processDM()
+	suite.Equal(conversation1.ID, conversation2.ID)
+}
+
 func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	testStructs := suite.SetupTestStructs()
 	defer suite.TearDownTestStructs(testStructs)
@@ -27,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -107,6 +108,14 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 			return p.fediAPI.CreatePollVote(ctx, fMsg)
 		}
 
+	// ANNOUNCE SOMETHING
+	case ap.ActivityAnnounce:
+
+		// ANNOUNCE NOTE/STATUS (from a relay)
+		if fMsg.APObjectType == ap.ObjectNote {
+			return p.fediAPI.RelayStatus(ctx, fMsg)
+		}
+
 	// UPDATE SOMETHING
 	case ap.ActivityUpdate:
 		switch fMsg.APObjectType {
@@ -122,11 +131,40 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 
 	// ACCEPT SOMETHING
 	case ap.ActivityAccept:
-		switch fMsg.APObjectType { //nolint:gocritic
+		switch fMsg.APObjectType {
 
 		// ACCEPT FOLLOW
 		case ap.ActivityFollow:
 			return p.fediAPI.AcceptFollow(ctx, fMsg)
+
+		// ACCEPT LIKE/FAVE
+		case ap.ActivityLike:
+			return p.fediAPI.AcceptLike(ctx, fMsg)
+
+		// ACCEPT NOTE/STATUS (ie., reply)
+		case ap.ObjectNote:
+			return p.fediAPI.AcceptReply(ctx, fMsg)
+
+		// ACCEPT ANNOUNCE/BOOST
+		case ap.ActivityAnnounce:
+			return p.fediAPI.AcceptAnnounce(ctx, fMsg)
+		}
+
+	// REJECT SOMETHING
+	case ap.ActivityReject:
+		switch fMsg.APObjectType {
+
+		// REJECT LIKE/FAVE
+		case ap.ActivityLike:
+			return p.fediAPI.RejectLike(ctx, fMsg)
+
+		// REJECT NOTE/STATUS (ie., reply)
+		case ap.ObjectNote:
+			return p.fediAPI.RejectReply(ctx, fMsg)
+
+		// REJECT ANNOUNCE/BOOST
+		case ap.ActivityAnnounce:
+			return p.fediAPI.RejectAnnounce(ctx, fMsg)
 		}
 
 	// DELETE SOMETHING
@@ -232,6 +270,72 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		log.Errorf(ctx, "error timelining and notifying status: %v", err)
 	}
 
+	// Fetch preview card for any link.
+	p.utils.fetchPreviewCard(status.ID)
+
+	return nil
+}
+
+// RelayStatus handles a status Announced or forwarded by
+// a relay we're subscribed to, by dereferencing it from
+// its origin, as if it had been forwarded to us. The
+// relay itself doesn't get credited with a boost.
+func (p *fediAPI) RelayStatus(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	if fMsg.APIRI == nil {
+		return gtserror.New("APIRI not set")
+	}
+
+	// Relays send us lots of statuses
+	// we may already have, so check
+	// that before dereferencing.
+	uri := fMsg.APIRI.String()
+	existing, err := p.state.DB.GetStatusByURI(gtscontext.SetBarebones(ctx), uri)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting status %s: %w", uri, err)
+	}
+
+	if existing != nil {
+		// Already got it,
+		// nothing to do.
+		return nil
+	}
+
+	// This will also cause the status to be inserted into the db.
+	status, statusable, err := p.federate.GetStatusByURI(ctx,
+		fMsg.Receiving.Username,
+		fMsg.APIRI,
+	)
+	if err != nil {
+		if gtserror.IsUnretrievable(err) ||
+			gtserror.NotPermitted(err) {
+			// Relayed status domain blocked,
+			// or gone already, nothing to do.
+			log.Debugf(ctx, "skipping relayed status: %v", err)
+			return nil
+		}
+
+		return gtserror.Newf("error dereferencing relayed status %s: %w", fMsg.APIRI, err)
+	}
+
+	if statusable == nil {
+		// We already had this status,
+		// or another thread beat us to
+		// creating it; nothing to do.
+		return nil
+	}
+
+	// Update stats for the status author.
+	if err := p.utils.incrementStatusesCount(ctx, status.Account, status); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	if err := p.surface.timelineAndNotifyStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error timelining and notifying status: %v", err)
+	}
+
+	// Fetch preview card for any link.
+	p.utils.fetchPreviewCard(status.ID)
+
 	return nil
 }
 
@@ -376,8 +480,10 @@ func (p *fediAPI) CreateAnnounce(ctx context.Context, fMsg *messages.FromFediAPI
 		fMsg.Receiving.Username,
 	)
 	if err != nil {
-		if gtserror.IsUnretrievable(err) {
-			// Boosted status domain blocked, nothing to do.
+		if gtserror.IsUnretrievable(err) ||
+			gtserror.NotPermitted(err) {
+			// Boosted status domain blocked, or
+			// boost not permitted, nothing to do.
 			log.Debugf(ctx, "skipping announce: %v", err)
 			return nil
 		}
@@ -582,6 +688,17 @@ func (p *fediAPI) UpdateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		if err := p.surface.notifyPollClose(ctx, status); err != nil {
 			log.Errorf(ctx, "error sending poll notification: %v", err)
 		}
+	} else if len(status.EditIDs) > len(existing.EditIDs) {
+
+		// Status content was edited, notify
+		// any new mentions and boosters.
+		if err := p.surface.notifyStatusEdit(ctx, status); err != nil {
+			log.Errorf(ctx, "error notifying status edit: %v", err)
+		}
+
+		// Content may have changed, refetch
+		// the preview card for any link.
+		p.utils.fetchPreviewCard(status.ID)
 	}
 
 	// Push message that the status has been edited to streams.
@@ -671,3 +788,146 @@ func (p *fediAPI) DeleteAccount(ctx context.Context, fMsg *messages.FromFediAPI)
 
 	return nil
 }
+
+func (p *fediAPI) AcceptLike(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	fave, ok := fMsg.GTSModel.(*gtsmodel.StatusFave)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusFave", fMsg.GTSModel)
+	}
+
+	// Interaction counts changed on the faved status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, fave.StatusID)
+
+	return nil
+}
+
+func (p *fediAPI) AcceptReply(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	status, ok := fMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", fMsg.GTSModel)
+	}
+
+	// Now the reply is approved,
+	// timeline and notify it.
+	if err := p.surface.timelineAndNotifyStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error timelining and notifying status: %v", err)
+	}
+
+	// Interaction counts changed on the replied status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, status.InReplyToID)
+
+	return nil
+}
+
+func (p *fediAPI) AcceptAnnounce(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	boost, ok := fMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", fMsg.GTSModel)
+	}
+
+	// Now the boost is approved,
+	// timeline and notify it.
+	if err := p.surface.timelineAndNotifyStatus(ctx, boost); err != nil {
+		log.Errorf(ctx, "error timelining and notifying status: %v", err)
+	}
+
+	// Interaction counts changed on the boosted status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, boost.BoostOfID)
+
+	return nil
+}
+
+func (p *fediAPI) RejectLike(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	fave, ok := fMsg.GTSModel.(*gtsmodel.StatusFave)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusFave", fMsg.GTSModel)
+	}
+
+	// Rejected faves are simply removed.
+	if err := p.state.DB.DeleteStatusFaveByID(ctx, fave.ID); err != nil {
+		return gtserror.Newf("db error deleting status fave: %w", err)
+	}
+
+	// Interaction counts changed on the faved status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, fave.StatusID)
+
+	return nil
+}
+
+func (p *fediAPI) RejectReply(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	// Rejected replies are gone for
+	// good, so delete any attachments.
+	const deleteAttachments = true
+
+	status, ok := fMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", fMsg.GTSModel)
+	}
+
+	// Try to populate status structs if possible,
+	// in order to more thoroughly remove them.
+	if err := p.state.DB.PopulateStatus(
+		ctx, status,
+	); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error populating status: %w", err)
+	}
+
+	// Perform the actual status deletion.
+	if err := p.utils.wipeStatus(ctx, status, deleteAttachments); err != nil {
+		log.Errorf(ctx, "error wiping status: %v", err)
+	}
+
+	// Update stats for the local reply author.
+	if err := p.utils.decrementStatusesCount(ctx, fMsg.Receiving); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	// Interaction counts changed on the replied status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, status.InReplyToID)
+
+	// The reply will already have been federated
+	// to our followers, so send out a delete too.
+	if err := p.federate.DeleteStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error federating status delete: %v", err)
+	}
+
+	return nil
+}
+
+func (p *fediAPI) RejectAnnounce(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	boost, ok := fMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", fMsg.GTSModel)
+	}
+
+	if err := p.state.DB.DeleteStatusByID(ctx, boost.ID); err != nil {
+		return gtserror.Newf("db error deleting status: %w", err)
+	}
+
+	// Update stats for the local booster.
+	if err := p.utils.decrementStatusesCount(ctx, fMsg.Receiving); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	// Remove the boost from any timelines.
+	if err := p.surface.deleteStatusFromTimelines(ctx, boost.ID); err != nil {
+		log.Errorf(ctx, "error removing timelined status: %v", err)
+	}
+
+	// Interaction counts changed on the boosted status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, boost.BoostOfID)
+
+	// The boost will already have been federated
+	// to our followers, so send out an undo too.
+	if err := p.federate.UndoAnnounce(ctx, boost); err != nil {
+		log.Errorf(ctx, "error federating announce undo: %v", err)
+	}
+
+	return nil
+}
@@ -304,7 +304,11 @@ func (suite *FromFediAPITestSuite) TestProcessAccountDelete() {
 
 	ctx := context.Background()
 
-	deletedAccount := suite.testAccounts["remote_account_1"]
+	// Take a copy of the account to delete, as
+	// processing the delete modifies it, and the
+	// suite's test accounts are shared across tests.
+	deletedAccount := new(gtsmodel.Account)
+	*deletedAccount = *suite.testAccounts["remote_account_1"]
 	receivingAccount := suite.testAccounts["local_account_1"]
 
 	// before doing the delete....
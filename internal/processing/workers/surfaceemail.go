@@ -269,3 +269,91 @@ func (s *Surface) emailAdminNewSignup(ctx context.Context, newUser *gtsmodel.Use
 
 	return nil
 }
+
+// emailUserNotification emails the target of the given
+// notification about it, if they've opted in to emails
+// for this type of notification. Users who opted in to
+// digests are skipped, as digests are sent on a schedule.
+func (s *Surface) emailUserNotification(ctx context.Context, notif *gtsmodel.Notification) error {
+	switch notif.NotificationType {
+	case gtsmodel.NotificationMention,
+		gtsmodel.NotificationFollow,
+		gtsmodel.NotificationFollowRequest:
+		// Types which may be emailed.
+	default:
+		return nil
+	}
+
+	settings, err := s.State.DB.GetAccountSettings(ctx, notif.TargetAccountID)
+	if err != nil {
+		return gtserror.Newf("db error getting account settings: %w", err)
+	}
+
+	if settings.EmailDigest != gtsmodel.EmailDigestNone ||
+		!settings.EmailNotifyAny() {
+		// Nothing to send now.
+		return nil
+	}
+
+	if err := s.State.DB.PopulateNotification(ctx, notif); err != nil {
+		return gtserror.Newf("error populating notification: %w", err)
+	}
+
+	direct := notif.Status != nil && notif.Status.Visibility == gtsmodel.VisibilityDirect
+	if !settings.EmailNotifyFor(notif.NotificationType, direct) {
+		// Not opted in to
+		// this type of email.
+		return nil
+	}
+
+	user, err := s.State.DB.GetUserByAccountID(ctx, notif.TargetAccountID)
+	if err != nil {
+		return gtserror.Newf("db error getting user: %w", err)
+	}
+
+	if user.ConfirmedAt.IsZero() ||
+		!*user.Approved ||
+		*user.Disabled ||
+		user.Email == "" {
+		// Only email users who:
+		// - are confirmed
+		// - are approved
+		// - are not disabled
+		// - have an email address
+		return nil
+	}
+
+	instance, err := s.State.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	// Unsubscribe links are signed
+	// with the router session key.
+	session, err := s.State.DB.GetSession(ctx)
+	if err != nil {
+		return gtserror.Newf("db error getting router session: %w", err)
+	}
+
+	emailNotif, err := s.Converter.NotificationToEmailNotification(ctx, notif)
+	if err != nil {
+		return gtserror.Newf("error converting notification: %w", err)
+	}
+
+	notificationData := email.NotificationData{
+		Username:     notif.TargetAccount.Username,
+		InstanceURL:  instance.URI,
+		InstanceName: instance.Title,
+		Notification: *emailNotif,
+		UnsubscribeURL: uris.GenerateURIForEmailUnsubscribe(
+			user.ID,
+			email.UnsubscribeToken(session.Auth, user.ID),
+		),
+	}
+
+	if err := s.EmailSender.SendNotificationEmail(user.Email, notificationData); err != nil {
+		return gtserror.Newf("error sending notification email: %w", err)
+	}
+
+	return nil
+}
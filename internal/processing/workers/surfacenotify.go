@@ -197,27 +197,34 @@ func (s *Surface) notifyFave(
 		return nil
 	}
 
-	// Ensure favee hasn't
-	// muted the thread.
-	muted, err := s.State.DB.IsThreadMutedByAccount(
-		ctx,
-		fave.Status.ThreadID,
-		fave.TargetAccountID,
-	)
-	if err != nil {
-		return gtserror.Newf("error checking status thread mute %s: %w", fave.StatusID, err)
-	}
+	notifType := gtsmodel.NotificationFave
+	if util.PtrValueOr(fave.PendingApproval, false) {
+		// Favee needs to approve this fave,
+		// so always notify, even if muted.
+		notifType = gtsmodel.NotificationPendingFave
+	} else {
+		// Ensure favee hasn't
+		// muted the thread.
+		muted, err := s.State.DB.IsThreadMutedByAccount(
+			ctx,
+			fave.Status.ThreadID,
+			fave.TargetAccountID,
+		)
+		if err != nil {
+			return gtserror.Newf("error checking status thread mute %s: %w", fave.StatusID, err)
+		}
 
-	if muted {
-		// Favee doesn't want
-		// notifs for this thread.
-		return nil
+		if muted {
+			// Favee doesn't want
+			// notifs for this thread.
+			return nil
+		}
 	}
 
 	// notify status author
 	// of fave by account.
 	if err := s.Notify(ctx,
-		gtsmodel.NotificationFave,
+		notifType,
 		fave.TargetAccount,
 		fave.Account,
 		fave.StatusID,
@@ -255,28 +262,34 @@ func (s *Surface) notifyAnnounce(
 		return nil
 	}
 
-	// Ensure boostee hasn't
-	// muted the thread.
-	muted, err := s.State.DB.IsThreadMutedByAccount(
-		ctx,
-		status.BoostOf.ThreadID,
-		status.BoostOfAccountID,
-	)
-
-	if err != nil {
-		return gtserror.Newf("error checking status thread mute %s: %w", status.BoostOfID, err)
-	}
+	notifType := gtsmodel.NotificationReblog
+	if util.PtrValueOr(status.PendingApproval, false) {
+		// Boostee needs to approve this boost,
+		// so always notify, even if muted.
+		notifType = gtsmodel.NotificationPendingReblog
+	} else {
+		// Ensure boostee hasn't
+		// muted the thread.
+		muted, err := s.State.DB.IsThreadMutedByAccount(
+			ctx,
+			status.BoostOf.ThreadID,
+			status.BoostOfAccountID,
+		)
+		if err != nil {
+			return gtserror.Newf("error checking status thread mute %s: %w", status.BoostOfID, err)
+		}
 
-	if muted {
-		// Boostee doesn't want
-		// notifs for this thread.
-		return nil
+		if muted {
+			// Boostee doesn't want
+			// notifs for this thread.
+			return nil
+		}
 	}
 
 	// notify status author
 	// of boost by account.
 	if err := s.Notify(ctx,
-		gtsmodel.NotificationReblog,
+		notifType,
 		status.BoostOfAccount,
 		status.Account,
 		status.ID,
@@ -287,6 +300,45 @@ func (s *Surface) notifyAnnounce(
 	return nil
 }
 
+// notifyPendingReply notifies the account replied-to
+// by the given status that they have a new reply,
+// which is pending their approval.
+func (s *Surface) notifyPendingReply(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	// Beforehand, ensure the passed status is fully populated.
+	if err := s.State.DB.PopulateStatus(ctx, status); err != nil {
+		return gtserror.Newf("error populating status %s: %w", status.ID, err)
+	}
+
+	if status.InReplyToAccount == nil ||
+		status.InReplyToAccountID == status.AccountID {
+		// Not a reply, or a self-reply,
+		// either way nothing to do.
+		return nil
+	}
+
+	if status.InReplyToAccount.IsRemote() {
+		// no need to notify
+		// remote accounts.
+		return nil
+	}
+
+	// notify replied-to account
+	// of pending reply by account.
+	if err := s.Notify(ctx,
+		gtsmodel.NotificationPendingReply,
+		status.InReplyToAccount,
+		status.Account,
+		status.ID,
+	); err != nil {
+		return gtserror.Newf("error notifying replied-to account %s: %w", status.InReplyToAccountID, err)
+	}
+
+	return nil
+}
+
 func (s *Surface) notifyPollClose(ctx context.Context, status *gtsmodel.Status) error {
 	// Beforehand, ensure the passed status is fully populated.
 	if err := s.State.DB.PopulateStatus(ctx, status); err != nil {
@@ -337,6 +389,61 @@ func (s *Surface) notifyPollClose(ctx context.Context, status *gtsmodel.Status)
 	return errs.Combine()
 }
 
+// notifyStatusEdit notifies any new mentions in the
+// given edited status, and notifies local accounts that
+// boosted the status that it has been edited.
+func (s *Surface) notifyStatusEdit(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	// Beforehand, ensure the passed status is fully populated.
+	if err := s.State.DB.PopulateStatus(ctx, status); err != nil {
+		return gtserror.Newf("error populating status %s: %w", status.ID, err)
+	}
+
+	var errs gtserror.MultiError
+
+	// Notify mentions; those already
+	// notified won't be notified again.
+	if err := s.notifyMentions(ctx, status); err != nil {
+		errs.Appendf("error notifying status mentions: %w", err)
+	}
+
+	// Fetch all boosts of the edited status.
+	boosts, err := s.State.DB.GetStatusBoosts(ctx, status.ID)
+	if err != nil {
+		errs.Appendf("error getting status %s boosts: %w", status.ID, err)
+		return errs.Combine()
+	}
+
+	for _, boost := range boosts {
+		if boost.AccountID == status.AccountID {
+			// Self-boost, nothing to do.
+			continue
+		}
+
+		if boost.Account.IsRemote() {
+			// no need to notify
+			// remote accounts.
+			continue
+		}
+
+		// notify booster that
+		// status has been edited.
+		if err := s.Notify(ctx,
+			gtsmodel.NotificationUpdate,
+			boost.Account,
+			status.Account,
+			status.ID,
+		); err != nil {
+			errs.Appendf("error notifying booster %s: %w", boost.AccountID, err)
+			continue
+		}
+	}
+
+	return errs.Combine()
+}
+
 func (s *Surface) notifySignup(ctx context.Context, newUser *gtsmodel.User) error {
 	modAccounts, err := s.State.DB.GetInstanceModerators(ctx)
 	if err != nil {
@@ -489,5 +596,17 @@ func (s *Surface) Notify(
 	}
 	s.Stream.Notify(ctx, targetAccount, apiNotif)
 
+	// Push notification to the user's
+	// Web Push subscriptions, if any.
+	if err := s.WebPushSender.Send(ctx, notif, apiNotif); err != nil {
+		return gtserror.Newf("error sending web push for notification %s: %w", notif.ID, err)
+	}
+
+	// Email notification to the
+	// user, if they've opted in.
+	if err := s.emailUserNotification(ctx, notif); err != nil {
+		return gtserror.Newf("error emailing notification %s: %w", notif.ID, err)
+	}
+
 	return nil
 }
@@ -28,6 +28,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type SurfaceNotifyTestSuite struct {
@@ -39,11 +41,13 @@ func (suite *SurfaceNotifyTestSuite) TestSpamNotifs() {
 	defer suite.TearDownTestStructs(testStructs)
 
 	surface := &workers.Surface{
-		State:       testStructs.State,
-		Converter:   testStructs.TypeConverter,
-		Stream:      testStructs.Processor.Stream(),
-		Filter:      visibility.NewFilter(testStructs.State),
-		EmailSender: testStructs.EmailSender,
+		State:         testStructs.State,
+		Converter:     testStructs.TypeConverter,
+		Stream:        testStructs.Processor.Stream(),
+		Filter:        visibility.NewFilter(testStructs.State),
+		EmailSender:   testStructs.EmailSender,
+		WebPushSender: testrig.NewNoopWebPushSender(),
+		Conversations: testStructs.Processor.Conversations(),
 	}
 
 	var (
@@ -110,6 +114,109 @@ func (suite *SurfaceNotifyTestSuite) TestSpamNotifs() {
 	}
 }
 
+func (suite *SurfaceNotifyTestSuite) TestEmailNotification() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	sentEmails := make(map[string]string)
+	surface := &workers.Surface{
+		State:         testStructs.State,
+		Converter:     testStructs.TypeConverter,
+		Stream:        testStructs.Processor.Stream(),
+		Filter:        visibility.NewFilter(testStructs.State),
+		EmailSender:   testrig.NewEmailSender("../../../web/template/", sentEmails),
+		WebPushSender: testrig.NewNoopWebPushSender(),
+		Conversations: testStructs.Processor.Conversations(),
+	}
+
+	var (
+		ctx           = context.Background()
+		targetUser    = suite.testUsers["local_account_1"]
+		targetAccount = suite.testAccounts["local_account_1"]
+		originAccount = suite.testAccounts["local_account_2"]
+	)
+
+	// Opt in to follow emails only.
+	settings, err := testStructs.State.DB.GetAccountSettings(ctx, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EmailNotifyFollow = util.Ptr(true)
+	if err := testStructs.State.DB.UpdateAccountSettings(ctx, settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// A fave isn't emailed.
+	if err := surface.Notify(ctx,
+		gtsmodel.NotificationFave,
+		targetAccount,
+		originAccount,
+		suite.testStatuses["local_account_1_status_1"].ID,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(sentEmails)
+
+	// A follow is.
+	if err := surface.Notify(ctx,
+		gtsmodel.NotificationFollow,
+		targetAccount,
+		originAccount,
+		"",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(sentEmails, 1)
+	suite.Contains(sentEmails[targetUser.Email], "Subject: GoToSocial Notification\r\n")
+	suite.Contains(sentEmails[targetUser.Email], "@1happyturtle@localhost:8080 followed you.")
+	suite.Contains(sentEmails[targetUser.Email], "http://localhost:8080/unsubscribe_email?user="+targetUser.ID+"&token=")
+}
+
+func (suite *SurfaceNotifyTestSuite) TestEmailNotificationDigest() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	sentEmails := make(map[string]string)
+	surface := &workers.Surface{
+		State:         testStructs.State,
+		Converter:     testStructs.TypeConverter,
+		Stream:        testStructs.Processor.Stream(),
+		Filter:        visibility.NewFilter(testStructs.State),
+		EmailSender:   testrig.NewEmailSender("../../../web/template/", sentEmails),
+		WebPushSender: testrig.NewNoopWebPushSender(),
+		Conversations: testStructs.Processor.Conversations(),
+	}
+
+	var (
+		ctx           = context.Background()
+		targetAccount = suite.testAccounts["local_account_1"]
+		originAccount = suite.testAccounts["local_account_2"]
+	)
+
+	// Opt in to follow emails, but as a digest.
+	settings, err := testStructs.State.DB.GetAccountSettings(ctx, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EmailNotifyFollow = util.Ptr(true)
+	settings.EmailDigest = gtsmodel.EmailDigestDaily
+	if err := testStructs.State.DB.UpdateAccountSettings(ctx, settings); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := surface.Notify(ctx,
+		gtsmodel.NotificationFollow,
+		targetAccount,
+		originAccount,
+		"",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Nothing sent straight away.
+	suite.Empty(sentEmails)
+}
+
 func TestSurfaceNotifyTestSuite(t *testing.T) {
 	suite.Run(t, new(SurfaceNotifyTestSuite))
 }
@@ -20,6 +20,7 @@ package workers
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
@@ -30,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // timelineAndNotifyStatus inserts the given status into the HOME
@@ -44,6 +46,17 @@ func (s *Surface) timelineAndNotifyStatus(ctx context.Context, status *gtsmodel.
 		return gtserror.Newf("error populating status with id %s: %w", status.ID, err)
 	}
 
+	if util.PtrValueOr(status.PendingApproval, false) {
+		// Status is pending approval, so it mustn't be
+		// timelined yet. Just notify the account being
+		// replied to, if any, so they can approve it.
+		// (Pending boosts are notified separately.)
+		if err := s.notifyPendingReply(ctx, status); err != nil {
+			return gtserror.Newf("error notifying pending reply %s: %w", status.ID, err)
+		}
+		return nil
+	}
+
 	// Get all local followers of the account that posted the status.
 	follows, err := s.State.DB.GetAccountLocalFollowers(ctx, status.AccountID)
 	if err != nil {
@@ -68,11 +81,26 @@ func (s *Surface) timelineAndNotifyStatus(ctx context.Context, status *gtsmodel.
 		return gtserror.Newf("error timelining status %s for followers: %w", status.ID, err)
 	}
 
+	// Timeline the status for each local account following
+	// any of its tags, which wasn't already handled above.
+	if err := s.timelineStatusForTagFollowers(ctx, status, follows); err != nil {
+		return gtserror.Newf("error timelining status %s for tag followers: %w", status.ID, err)
+	}
+
 	// Notify each local account that's mentioned by this status.
 	if err := s.notifyMentions(ctx, status); err != nil {
 		return gtserror.Newf("error notifying status mentions for status %s: %w", status.ID, err)
 	}
 
+	// Update any conversations containing this status, and send conversation notifications.
+	notifications, err := s.Conversations.UpdateConversationsForStatus(ctx, status)
+	if err != nil {
+		return gtserror.Newf("error updating conversations for status %s: %w", status.ID, err)
+	}
+	for _, notification := range notifications {
+		s.Stream.Conversation(ctx, notification.AccountID, notification.Conversation)
+	}
+
 	return nil
 }
 
@@ -190,6 +218,88 @@ func (s *Surface) timelineAndNotifyStatusForFollowers(
 	return errs.Combine()
 }
 
+// timelineStatusForTagFollowers adds the given status to the
+// home timelines of local accounts that follow any of its tags,
+// skipping owners of the given follows, since they've already
+// had the status added to their timelines as followers.
+func (s *Surface) timelineStatusForTagFollowers(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	follows []*gtsmodel.Follow,
+) error {
+	if status.Visibility != gtsmodel.VisibilityPublic ||
+		status.BoostOfID != "" || len(status.TagIDs) == 0 {
+		// Only public, non-boost statuses
+		// are timelined for tag followers.
+		return nil
+	}
+
+	accountIDs, err := s.State.DB.GetAccountIDsFollowingTagIDs(ctx, status.TagIDs)
+	if err != nil {
+		return gtserror.Newf("error getting accounts following tags of status %s: %w", status.ID, err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, accountID := range accountIDs {
+		if slices.ContainsFunc(follows, func(follow *gtsmodel.Follow) bool {
+			return follow.AccountID == accountID
+		}) {
+			// Already handled as a follower.
+			continue
+		}
+
+		account, err := s.State.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			errs.Appendf("error getting tag follower account %s: %w", accountID, err)
+			continue
+		}
+
+		// Check to see if the status is timelineable for
+		// this account, taking account of its visibility,
+		// and whether the followed tags are still useable.
+		timelineable, err := s.Filter.StatusHomeTimelineable(ctx, account, status)
+		if err != nil {
+			errs.Appendf("error checking status %s hometimelineability: %w", status.ID, err)
+			continue
+		}
+
+		if !timelineable {
+			// Nothing to do.
+			continue
+		}
+
+		filters, err := s.State.DB.GetFiltersForAccountID(ctx, account.ID)
+		if err != nil {
+			errs.Appendf("couldn't retrieve filters for account %s: %w", account.ID, err)
+			continue
+		}
+
+		mutes, err := s.State.DB.GetAccountMutes(gtscontext.SetBarebones(ctx), account.ID, nil)
+		if err != nil {
+			errs.Appendf("couldn't retrieve mutes for account %s: %w", account.ID, err)
+			continue
+		}
+		compiledMutes := usermute.NewCompiledUserMuteList(mutes)
+
+		// Add status to home timeline for this tag follower.
+		if _, err := s.timelineStatus(
+			ctx,
+			s.State.Timelines.Home.IngestOne,
+			account.ID, // home timelines are keyed by account ID
+			account,
+			status,
+			stream.TimelineHome,
+			filters,
+			compiledMutes,
+		); err != nil {
+			errs.Appendf("error home timelining status for tag follower: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
 // listTimelineStatusForFollow puts the given status
 // in any eligible lists owned by the given follower.
 func (s *Surface) listTimelineStatusForFollow(
@@ -20,8 +20,10 @@ package workers
 import (
 	"context"
 	"errors"
+	"slices"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cards"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -39,6 +41,7 @@ type utils struct {
 	media   *media.Processor
 	account *account.Processor
 	surface *Surface
+	cards   *cards.Fetcher
 }
 
 // wipeStatus encapsulates common logic
@@ -52,6 +55,25 @@ func (u *utils) wipeStatus(
 ) error {
 	var errs gtserror.MultiError
 
+	// Gather the attachment IDs of this status,
+	// along with any only attached to previous
+	// revisions of the status (from edits).
+	attachmentIDs := slices.Clone(statusToDelete.AttachmentIDs)
+	edits, err := u.state.DB.GetStatusEditsByIDs(
+		gtscontext.SetBarebones(ctx),
+		statusToDelete.EditIDs,
+	)
+	if err != nil {
+		errs.Appendf("error fetching status edits: %w", err)
+	}
+	for _, edit := range edits {
+		for _, id := range edit.AttachmentIDs {
+			if !slices.Contains(attachmentIDs, id) {
+				attachmentIDs = append(attachmentIDs, id)
+			}
+		}
+	}
+
 	// Either delete all attachments for this status,
 	// or simply unattach + clean them separately later.
 	//
@@ -60,14 +82,14 @@ func (u *utils) wipeStatus(
 	// status immediately (in case of delete + redraft)
 	if deleteAttachments {
 		// todo:u.state.DB.DeleteAttachmentsForStatus
-		for _, id := range statusToDelete.AttachmentIDs {
+		for _, id := range attachmentIDs {
 			if err := u.media.Delete(ctx, id); err != nil {
 				errs.Appendf("error deleting media: %w", err)
 			}
 		}
 	} else {
 		// todo:u.state.DB.UnattachAttachmentsForStatus
-		for _, id := range statusToDelete.AttachmentIDs {
+		for _, id := range attachmentIDs {
 			if _, err := u.media.Unattach(ctx, statusToDelete.Account, id); err != nil {
 				errs.Appendf("error unattaching media: %w", err)
 			}
@@ -82,6 +104,16 @@ func (u *utils) wipeStatus(
 		}
 	}
 
+	// delete all previous revisions of this status
+	if err := u.state.DB.DeleteStatusEdits(ctx, statusToDelete.EditIDs); err != nil {
+		errs.Appendf("error deleting status edits: %w", err)
+	}
+
+	// delete this status from any conversations it's part of
+	if err := u.state.DB.DeleteStatusFromConversations(ctx, statusToDelete.ID); err != nil {
+		errs.Appendf("error deleting status from conversations: %w", err)
+	}
+
 	// delete all notification entries generated by this status
 	if err := u.state.DB.DeleteNotificationsForStatus(ctx, statusToDelete.ID); err != nil {
 		errs.Appendf("error deleting status notifications: %w", err)
@@ -493,3 +525,49 @@ func (u *utils) decrementFollowRequestsCount(
 
 	return nil
 }
+
+// fetchPreviewCard queues fetching of a preview card for
+// the first link in the status with given ID, on the
+// dereference worker pool, as this calls out to remote
+// pages and so shouldn't hold up other status side effects.
+// If the status' card changes, it's updated in timelines.
+func (u *utils) fetchPreviewCard(statusID string) {
+	u.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		// Get an up-to-date copy
+		// of status to work with.
+		status, err := u.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			statusID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "db error getting status %s: %v", statusID, err)
+			return
+		}
+
+		cardID := status.PreviewCardID
+		if err := u.cards.FetchForStatus(ctx, status); err != nil {
+			log.Errorf(ctx, "error fetching preview card for status %s: %v", statusID, err)
+			return
+		}
+
+		if status.PreviewCardID == cardID {
+			// Card unchanged,
+			// nothing to update.
+			return
+		}
+
+		// Status representation has changed, invalidate from timelines.
+		u.surface.invalidateStatusFromTimelines(ctx, statusID)
+
+		// Fully populate status for streaming.
+		if err := u.state.DB.PopulateStatus(ctx, status); err != nil {
+			log.Errorf(ctx, "db error populating status %s: %v", statusID, err)
+			return
+		}
+
+		// Push message that the status has been updated to streams.
+		if err := u.surface.timelineStatusUpdate(ctx, status); err != nil {
+			log.Errorf(ctx, "error streaming status card update: %v", err)
+		}
+	})
+}
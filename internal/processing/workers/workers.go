@@ -18,14 +18,17 @@
 package workers
 
 import (
+	"github.com/superseriousbusiness/gotosocial/internal/cards"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/gotosocial/internal/workers"
 )
 
@@ -41,9 +44,12 @@ func New(
 	converter *typeutils.Converter,
 	filter *visibility.Filter,
 	emailSender email.Sender,
+	webPushSender webpush.Sender,
+	cardFetcher *cards.Fetcher,
 	account *account.Processor,
 	media *media.Processor,
 	stream *stream.Processor,
+	conversations *conversations.Processor,
 ) Processor {
 	// Init federate logic
 	// wrapper struct.
@@ -56,11 +62,13 @@ func New(
 	// Init surface logic
 	// wrapper struct.
 	surface := &Surface{
-		State:       state,
-		Converter:   converter,
-		Stream:      stream,
-		Filter:      filter,
-		EmailSender: emailSender,
+		State:         state,
+		Converter:     converter,
+		Stream:        stream,
+		Filter:        filter,
+		EmailSender:   emailSender,
+		WebPushSender: webPushSender,
+		Conversations: conversations,
 	}
 
 	// Init shared util funcs.
@@ -69,6 +77,7 @@ func New(
 		media:   media,
 		account: account,
 		surface: surface,
+		cards:   cardFetcher,
 	}
 
 	return Processor{
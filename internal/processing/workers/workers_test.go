@@ -159,7 +159,7 @@ func (suite *WorkersTestSuite) SetupTestStructs() *TestStructs {
 	oauthServer := testrig.NewTestOauthServer(db)
 	emailSender := testrig.NewEmailSender("../../../web/template/", nil)
 
-	processor := processing.NewProcessor(cleaner.New(&state), typeconverter, federator, oauthServer, mediaManager, &state, emailSender)
+	processor := processing.NewProcessor(cleaner.New(&state), typeconverter, federator, oauthServer, mediaManager, &state, emailSender, testrig.NewNoopWebPushSender(), testrig.NewTestCardFetcher(&state, mediaManager))
 	testrig.StartWorkers(&state, processor.Workers())
 
 	testrig.StandardDBSetup(db, suite.testAccounts)
@@ -54,6 +54,11 @@ func (sch *Scheduler) Stop() bool {
 	return false
 }
 
+// Running returns whether the scheduler is running (i.e. NOT stopped / stopping).
+func (sch *Scheduler) Running() bool {
+	return sch.sch.Running()
+}
+
 // AddOnce schedules the given task to run at time, registered under the given ID. Returns false if task already exists for id.
 func (sch *Scheduler) AddOnce(id string, start time.Time, fn func(context.Context, time.Time)) bool {
 	return sch.schedule(id, fn, (*sched.Once)(&start))
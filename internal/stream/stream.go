@@ -46,6 +46,22 @@ const (
 	// EventTypeFiltersChanged -- the user's filters
 	// (including keywords and statuses) have changed.
 	EventTypeFiltersChanged = "filters_changed"
+
+	// EventTypeConversation -- a user
+	// should be shown an updated conversation.
+	EventTypeConversation = "conversation"
+
+	// EventTypeAnnouncement -- users should be shown
+	// a new or updated instance announcement.
+	EventTypeAnnouncement = "announcement"
+
+	// EventTypeAnnouncementReaction -- the count of
+	// a reaction to an announcement has changed.
+	EventTypeAnnouncementReaction = "announcement.reaction"
+
+	// EventTypeAnnouncementDelete -- an announcement
+	// should no longer be shown to users.
+	EventTypeAnnouncementDelete = "announcement.delete"
 )
 
 const (
@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// ExtractLinks returns the distinct http(s) links in the
+// given status HTML content, ignoring mentions and hashtags,
+// in the order they appear. Fragments are removed, so links
+// differing only by fragment are considered the same link.
+func ExtractLinks(content string) []string {
+	var (
+		links []string
+		z     = html.NewTokenizer(strings.NewReader(content))
+	)
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// End of content,
+			// or invalid HTML.
+			return links
+
+		case html.StartTagToken:
+			link := anchorLink(z.Token())
+			if link != "" && !slices.Contains(links, link) {
+				links = append(links, link)
+			}
+		}
+	}
+}
+
+// anchorLink returns the normalized link of the given token
+// if it's an anchor that isn't a mention or hashtag, else "".
+func anchorLink(t html.Token) string {
+	if t.Data != "a" {
+		return ""
+	}
+
+	var href string
+	for _, attr := range t.Attr {
+		switch attr.Key {
+		case "href":
+			href = attr.Val
+
+		case "class":
+			// Local and remote mentions and hashtags
+			// are all marked with a "mention" class.
+			if slices.Contains(strings.Fields(attr.Val), "mention") {
+				return ""
+			}
+
+		case "rel":
+			if slices.Contains(strings.Fields(attr.Val), "tag") {
+				return ""
+			}
+		}
+	}
+
+	u, err := url.Parse(href)
+	if err != nil || u.Host == "" ||
+		(u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String()
+}
@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type LinksTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LinksTestSuite) TestExtractLinks() {
+	content := `<p>hey <span class="h-card"><a href="https://example.org/@someone" class="u-url mention">@<span>someone</span></a></span> check out ` +
+		`<a href="https://example.org/article#comments" rel="nofollow noreferrer noopener" target="_blank">https://example.org/article</a> ` +
+		`and <a href="https://example.org/article" rel="nofollow noreferrer noopener" target="_blank">this again</a> ` +
+		`<a href="https://example.org/tags/news" class="mention hashtag" rel="tag nofollow noreferrer noopener" target="_blank">#<span>news</span></a> ` +
+		`<a href="mailto:someone@example.org">mail me</a> <a href="https://example.com/other">other</a></p>`
+
+	suite.Equal([]string{
+		"https://example.org/article",
+		"https://example.com/other",
+	}, text.ExtractLinks(content))
+}
+
+func (suite *LinksTestSuite) TestExtractLinksNone() {
+	suite.Empty(text.ExtractLinks(`<p>no links here, just <em>emphasis</em></p>`))
+}
+
+func TestLinksTestSuite(t *testing.T) {
+	suite.Run(t, new(LinksTestSuite))
+}
@@ -163,6 +163,18 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 // Source: https://github.com/microcosm-cc/bluemonday#usage
 var strict *bluemonday.Policy = bluemonday.StrictPolicy()
 
+// embed only allows through https iframes, along
+// with a few attributes needed to size and lay them
+// out, for sanitizing oEmbed html from remote sites.
+var embed *bluemonday.Policy = func() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements("iframe")
+	p.AllowAttrs("src", "width", "height", "allowfullscreen", "frameborder").OnElements("iframe")
+	p.AllowURLSchemes("https")
+	p.RequireParseableURLs(true)
+	return p
+}()
+
 // removeHTML strictly removes *all* recognized
 // HTML elements from the given string.
 func removeHTML(in string) string {
@@ -175,6 +187,12 @@ func SanitizeToHTML(in string) string {
 	return regular.Sanitize(in)
 }
 
+// SanitizeEmbed sanitizes the given oEmbed html,
+// removing everything except https iframes.
+func SanitizeEmbed(in string) string {
+	return strings.TrimSpace(embed.Sanitize(in))
+}
+
 // SanitizeToPlaintext runs text through basic sanitization.
 // This removes any html elements that were in the string,
 // and returns clean plaintext.
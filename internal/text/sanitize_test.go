@@ -128,6 +128,17 @@ func (suite *SanitizeTestSuite) TestSanitizeInlineImg() {
 	suite.Equal(`<p>Here&#39;s an inline image: </p>`, sanitized)
 }
 
+func (suite *SanitizeTestSuite) TestSanitizeEmbed() {
+	dodgyEmbed := `<script src="https://example.org/embed.js"></script><iframe src="https://example.org/embed/1" width="560" height="315" onload="alert('haha!')" allowfullscreen></iframe>`
+	sanitized := text.SanitizeEmbed(dodgyEmbed)
+	suite.Equal(`<iframe src="https://example.org/embed/1" width="560" height="315" allowfullscreen=""></iframe>`, sanitized)
+}
+
+func (suite *SanitizeTestSuite) TestSanitizeEmbedInsecure() {
+	sanitized := text.SanitizeEmbed(`<iframe src="javascript:alert('haha!')"></iframe>`)
+	suite.Empty(sanitized)
+}
+
 func TestSanitizeTestSuite(t *testing.T) {
 	suite.Run(t, new(SanitizeTestSuite))
 }
@@ -0,0 +1,393 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trans
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"slices"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// Names of the files written to
+	// the root of an account archive.
+	ArchiveActorFile     = "actor.json"
+	ArchiveOutboxFile    = "outbox.json"
+	ArchiveLikesFile     = "likes.json"
+	ArchiveBookmarksFile = "bookmarks.json"
+
+	// ArchiveMediaDir is the directory in an
+	// account archive containing original media
+	// files, stored under their storage path.
+	ArchiveMediaDir = "media/"
+
+	// archivePageSize is the number of
+	// statuses to fetch from the db at once.
+	archivePageSize = 200
+)
+
+// Archiver wraps functionality for exporting all of
+// one local account's data to a zip archive, in a
+// format similar to Mastodon's account archives.
+type Archiver interface {
+	// ExportArchive writes a zip archive of the given
+	// account's actor, outbox, likes, bookmarks and
+	// original media files to w.
+	ExportArchive(ctx context.Context, account *gtsmodel.Account, w io.Writer) error
+}
+
+type archiver struct {
+	state     *state.State
+	converter *typeutils.Converter
+}
+
+// NewArchiver returns a new Archiver that will
+// use the given state and type converter.
+func NewArchiver(state *state.State, converter *typeutils.Converter) Archiver {
+	return &archiver{
+		state:     state,
+		converter: converter,
+	}
+}
+
+func (a *archiver) ExportArchive(ctx context.Context, account *gtsmodel.Account, w io.Writer) error {
+	if !account.IsLocal() {
+		return gtserror.Newf("account %s is not local", account.ID)
+	}
+
+	// Make sure avatar + header are loaded.
+	if err := a.state.DB.PopulateAccount(ctx, account); err != nil {
+		log.Warnf(ctx, "error populating account: %v", err)
+	}
+
+	statuses, err := a.getStatuses(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+
+	if err := a.writeActor(ctx, zw, account); err != nil {
+		return err
+	}
+
+	if err := a.writeOutbox(ctx, zw, account, statuses); err != nil {
+		return err
+	}
+
+	if err := a.writeLikes(ctx, zw, account); err != nil {
+		return err
+	}
+
+	if err := a.writeBookmarks(ctx, zw, account); err != nil {
+		return err
+	}
+
+	// Gather all media owned by this account
+	// that's referenced by the actor or outbox.
+	media := make([]*gtsmodel.MediaAttachment, 0, 2)
+	if account.AvatarMediaAttachment != nil {
+		media = append(media, account.AvatarMediaAttachment)
+	}
+	if account.HeaderMediaAttachment != nil {
+		media = append(media, account.HeaderMediaAttachment)
+	}
+	for _, status := range statuses {
+		media = append(media, status.Attachments...)
+	}
+
+	if err := a.writeMedia(ctx, zw, account, media); err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return gtserror.Newf("error closing zip: %w", err)
+	}
+
+	return nil
+}
+
+// getStatuses returns all statuses created by
+// the given account, including boosts, oldest first.
+func (a *archiver) getStatuses(ctx context.Context, accountID string) ([]*gtsmodel.Status, error) {
+	var (
+		statuses []*gtsmodel.Status
+		maxID    string
+	)
+
+	for {
+		page, err := a.state.DB.GetAccountStatuses(ctx,
+			accountID,
+			archivePageSize,
+			false, // include replies
+			false, // include boosts
+			maxID,
+			"",
+			false, // include statuses without media
+			false, // include all visibilities
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("db error getting statuses: %w", err)
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		statuses = append(statuses, page...)
+		maxID = page[len(page)-1].ID
+	}
+
+	// Statuses are returned newest first.
+	slices.Reverse(statuses)
+
+	return statuses, nil
+}
+
+func (a *archiver) writeActor(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	person, err := a.converter.AccountToAS(ctx, account)
+	if err != nil {
+		return gtserror.Newf("error converting account: %w", err)
+	}
+
+	return writeArchiveJSON(zw, ArchiveActorFile, person)
+}
+
+func (a *archiver) writeOutbox(
+	ctx context.Context,
+	zw *zip.Writer,
+	account *gtsmodel.Account,
+	statuses []*gtsmodel.Status,
+) error {
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+
+	for _, status := range statuses {
+		if status.BoostOfID != "" {
+			if status.BoostOfAccount == nil {
+				// Boosted account
+				// has since gone.
+				continue
+			}
+
+			announce, err := a.converter.BoostToAS(ctx, status, account, status.BoostOfAccount)
+			if err != nil {
+				log.Warnf(ctx, "error converting boost %s: %v", status.ID, err)
+				continue
+			}
+			itemsProp.AppendActivityStreamsAnnounce(announce)
+			continue
+		}
+
+		statusable, err := a.converter.StatusToAS(ctx, status)
+		if err != nil {
+			log.Warnf(ctx, "error converting status %s: %v", status.ID, err)
+			continue
+		}
+		itemsProp.AppendActivityStreamsCreate(typeutils.WrapStatusableInCreate(statusable, false))
+	}
+
+	outbox, err := archiveCollection(account.OutboxURI, itemsProp)
+	if err != nil {
+		return err
+	}
+
+	return writeArchiveJSON(zw, ArchiveOutboxFile, outbox)
+}
+
+func (a *archiver) writeLikes(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	faves, err := a.state.DB.GetAccountFaves(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting faves: %w", err)
+	}
+
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, fave := range faves {
+		status, err := a.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			fave.StatusID,
+		)
+		if err != nil {
+			log.Warnf(ctx, "error getting faved status %s: %v", fave.StatusID, err)
+			continue
+		}
+
+		if err := appendArchiveIRI(itemsProp, status.URI); err != nil {
+			log.Warn(ctx, err)
+		}
+	}
+
+	likes, err := archiveCollection(ArchiveLikesFile, itemsProp)
+	if err != nil {
+		return err
+	}
+
+	return writeArchiveJSON(zw, ArchiveLikesFile, likes)
+}
+
+func (a *archiver) writeBookmarks(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	bookmarks, err := a.state.DB.GetStatusBookmarks(ctx, account.ID, 0, "", "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting bookmarks: %w", err)
+	}
+
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, bookmark := range bookmarks {
+		if bookmark.Status == nil {
+			continue
+		}
+
+		if err := appendArchiveIRI(itemsProp, bookmark.Status.URI); err != nil {
+			log.Warn(ctx, err)
+		}
+	}
+
+	collection, err := archiveCollection(ArchiveBookmarksFile, itemsProp)
+	if err != nil {
+		return err
+	}
+
+	return writeArchiveJSON(zw, ArchiveBookmarksFile, collection)
+}
+
+// writeMedia copies the original files of the given media
+// attachments from storage into the archive's media dir.
+func (a *archiver) writeMedia(
+	ctx context.Context,
+	zw *zip.Writer,
+	account *gtsmodel.Account,
+	media []*gtsmodel.MediaAttachment,
+) error {
+	written := make(map[string]struct{}, len(media))
+
+	for _, attachment := range media {
+		path := attachment.File.Path
+		if path == "" ||
+			attachment.AccountID != account.ID ||
+			!util.PtrValueOr(attachment.Cached, false) {
+			// Nothing stored for this attachment.
+			continue
+		}
+
+		if _, ok := written[path]; ok {
+			continue
+		}
+		written[path] = struct{}{}
+
+		if err := a.writeMediaFile(ctx, zw, path); err != nil {
+			// Don't let one missing file
+			// spoil the rest of the archive.
+			log.Warnf(ctx, "error archiving media %s: %v", attachment.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *archiver) writeMediaFile(ctx context.Context, zw *zip.Writer, path string) error {
+	rc, err := a.state.Storage.GetStream(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	fw, err := zw.CreateHeader(&zip.FileHeader{
+		Name: ArchiveMediaDir + path,
+
+		// Media files are already
+		// compressed, just store them.
+		Method: zip.Store,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, rc)
+	return err
+}
+
+// archiveCollection returns an OrderedCollection
+// with the given ID and items, for an account archive.
+func archiveCollection(
+	id string,
+	itemsProp vocab.ActivityStreamsOrderedItemsProperty,
+) (vocab.ActivityStreamsOrderedCollection, error) {
+	collection := streams.NewActivityStreamsOrderedCollection()
+
+	idURI, err := url.Parse(id)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing id %s: %w", id, err)
+	}
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(idURI)
+	collection.SetJSONLDId(idProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(itemsProp.Len())
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	collection.SetActivityStreamsOrderedItems(itemsProp)
+
+	return collection, nil
+}
+
+// appendArchiveIRI parses the given
+// uri and appends it to itemsProp.
+func appendArchiveIRI(itemsProp vocab.ActivityStreamsOrderedItemsProperty, uri string) error {
+	iri, err := url.Parse(uri)
+	if err != nil {
+		return gtserror.Newf("error parsing uri %s: %w", uri, err)
+	}
+	itemsProp.AppendIRI(iri)
+	return nil
+}
+
+// writeArchiveJSON serializes the given ActivityStreams
+// type and writes it to the archive under name.
+func writeArchiveJSON(zw *zip.Writer, name string, t vocab.Type) error {
+	m, err := ap.Serialize(t)
+	if err != nil {
+		return gtserror.Newf("error serializing %s: %w", name, err)
+	}
+
+	fw, err := zw.Create(name)
+	if err != nil {
+		return gtserror.Newf("error creating %s: %w", name, err)
+	}
+
+	if err := json.NewEncoder(fw).Encode(m); err != nil {
+		return gtserror.Newf("error writing %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trans_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/trans"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ArchiveTestSuite struct {
+	suite.Suite
+	state state.State
+
+	testAccounts    map[string]*gtsmodel.Account
+	testAttachments map[string]*gtsmodel.MediaAttachment
+}
+
+func (suite *ArchiveTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testAttachments = testrig.NewTestAttachments()
+
+	suite.state.DB = testrig.NewTestDB(&suite.state)
+	suite.state.Storage = testrig.NewInMemoryStorage()
+	testrig.StandardDBSetup(suite.state.DB, nil)
+	testrig.StandardStorageSetup(suite.state.Storage, "../../testrig/media")
+}
+
+func (suite *ArchiveTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.state.DB)
+	testrig.StandardStorageTeardown(suite.state.Storage)
+}
+
+func (suite *ArchiveTestSuite) TestExportArchive() {
+	var (
+		ctx      = context.Background()
+		account  = suite.testAccounts["local_account_1"]
+		archiver = trans.NewArchiver(&suite.state, typeutils.NewConverter(&suite.state))
+		buf      = new(bytes.Buffer)
+	)
+
+	if err := archiver.ExportArchive(ctx, account, buf); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Decode the json files
+	// from the archive by name.
+	files := make(map[string]bool, len(zr.File))
+	docs := make(map[string]map[string]any)
+	for _, f := range zr.File {
+		files[f.Name] = true
+
+		if f.Name == trans.ArchiveActorFile ||
+			f.Name == trans.ArchiveOutboxFile ||
+			f.Name == trans.ArchiveLikesFile ||
+			f.Name == trans.ArchiveBookmarksFile {
+			rc, err := f.Open()
+			if err != nil {
+				suite.FailNow(err.Error())
+			}
+
+			b, err := io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				suite.FailNow(err.Error())
+			}
+
+			var doc map[string]any
+			if err := json.Unmarshal(b, &doc); err != nil {
+				suite.FailNow(err.Error())
+			}
+			docs[f.Name] = doc
+		}
+	}
+
+	suite.Equal(account.URI, docs[trans.ArchiveActorFile]["id"])
+	suite.Equal(account.Username, docs[trans.ArchiveActorFile]["preferredUsername"])
+
+	// Every status by this
+	// account should be included.
+	var statusCount int
+	for _, status := range testrig.NewTestStatuses() {
+		if status.AccountID == account.ID {
+			statusCount++
+		}
+	}
+	outbox := docs[trans.ArchiveOutboxFile]
+	suite.Equal(account.OutboxURI, outbox["id"])
+	suite.Equal("OrderedCollection", outbox["type"])
+	suite.EqualValues(statusCount, outbox["totalItems"])
+	suite.Len(outbox["orderedItems"], statusCount)
+
+	var faveURIs []any
+	for _, fave := range testrig.NewTestFaves() {
+		if fave.AccountID == account.ID {
+			status, err := suite.state.DB.GetStatusByID(ctx, fave.StatusID)
+			if err != nil {
+				suite.FailNow(err.Error())
+			}
+			faveURIs = append(faveURIs, status.URI)
+		}
+	}
+	suite.ElementsMatch(faveURIs, docs[trans.ArchiveLikesFile]["orderedItems"])
+
+	var bookmarkURIs []any
+	for _, bookmark := range testrig.NewTestBookmarks() {
+		if bookmark.AccountID == account.ID {
+			status, err := suite.state.DB.GetStatusByID(ctx, bookmark.StatusID)
+			if err != nil {
+				suite.FailNow(err.Error())
+			}
+			bookmarkURIs = append(bookmarkURIs, status.URI)
+		}
+	}
+	suite.ElementsMatch(bookmarkURIs, docs[trans.ArchiveBookmarksFile]["orderedItems"])
+
+	// Avatar and status attachments
+	// should be included, but not
+	// unattached media.
+	for key, included := range map[string]bool{
+		"local_account_1_avatar":                true,
+		"local_account_1_status_4_attachment_1": true,
+		"local_account_1_unattached_1":          false,
+	} {
+		path := trans.ArchiveMediaDir + suite.testAttachments[key].File.Path
+		suite.Equal(included, files[path], key)
+	}
+}
+
+func TestArchiveTestSuite(t *testing.T) {
+	suite.Run(t, new(ArchiveTestSuite))
+}
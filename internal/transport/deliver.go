@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -162,6 +163,7 @@ func (t *transport) prepare(
 	}
 
 	return &delivery.Delivery{
+		PubKeyID: t.pubKeyID,
 		ActorID:  actorID,
 		ObjectID: objectID,
 		TargetID: targetID,
@@ -169,6 +171,44 @@ func (t *transport) prepare(
 	}, nil
 }
 
+func (t *transport) SignDelivery(dlv *delivery.Delivery) error {
+	if dlv.Request.GetBody == nil {
+		return gtserror.New("delivery request body not rewindable")
+	}
+
+	// Get a new copy of the request body.
+	body, err := dlv.Request.GetBody()
+	if err != nil {
+		return gtserror.Newf("error getting request body: %w", err)
+	}
+
+	// Read body data into memory.
+	data, err := io.ReadAll(body)
+
+	// Done with body.
+	_ = body.Close()
+
+	if err != nil {
+		return gtserror.Newf("error reading request body: %w", err)
+	}
+
+	// Get signing function for POST data.
+	// (note that delivery is ALWAYS POST).
+	sign := t.signPOST(data)
+
+	// Extract delivery context.
+	ctx := dlv.Request.Context()
+
+	// Update delivery request context with signing details.
+	ctx = gtscontext.SetOutgoingPublicKeyID(ctx, t.pubKeyID)
+	ctx = gtscontext.SetHTTPClientSignFunc(ctx, sign)
+
+	// Update delivery request context.
+	dlv.Request.Request = dlv.Request.Request.WithContext(ctx)
+
+	return nil
+}
+
 // getObjectID extracts an object ID from 'serialized' ActivityPub object map.
 func getObjectID(obj map[string]interface{}) string {
 	switch t := obj["object"].(type) {
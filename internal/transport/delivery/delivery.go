@@ -143,6 +143,11 @@ func (dlv *Delivery) Deserialize(data []byte) error {
 		return err
 	}
 
+	// Copy over any stored header values.
+	for key, values := range idlv.Header {
+		r.Header[key] = values
+	}
+
 	// Wrap request in httpclient type.
 	dlv.Request = httpclient.WrapRequest(r)
 
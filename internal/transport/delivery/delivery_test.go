@@ -63,6 +63,24 @@ var deliveryCases = []struct {
 			// "header":     map[string][]string{},
 		}),
 	},
+	{
+		msg: delivery.Delivery{
+			PubKeyID: "https://google.com/users/bigboy#pubkey",
+			Request: withHeader(
+				toRequest("POST", "https://askjeeves.com/users/smallboy/inbox", []byte("data!")),
+				"Content-Type", "application/activity+json",
+			),
+		},
+		data: toJSON(map[string]any{
+			"pub_key_id": "https://google.com/users/bigboy#pubkey",
+			"method":     "POST",
+			"url":        "https://askjeeves.com/users/smallboy/inbox",
+			"body":       []byte("data!"),
+			"header": map[string][]string{
+				"Content-Type": {"application/activity+json"},
+			},
+		}),
+	},
 }
 
 func TestSerializeDelivery(t *testing.T) {
@@ -95,6 +113,7 @@ func TestDeserializeDelivery(t *testing.T) {
 		assert.Equal(t, test.msg.TargetID, msg.TargetID)
 		assert.Equal(t, test.msg.Request.Method, msg.Request.Method)
 		assert.Equal(t, test.msg.Request.URL, msg.Request.URL)
+		assert.Equal(t, test.msg.Request.Header, msg.Request.Header)
 		assert.Equal(t, readBody(test.msg.Request.Body), readBody(msg.Request.Body))
 	}
 }
@@ -112,6 +131,12 @@ func toRequest(method string, url string, body []byte) httpclient.Request {
 	return httpclient.WrapRequest(req)
 }
 
+// withHeader sets the given header key-value pair on httpclient.Request.
+func withHeader(req httpclient.Request, key string, value string) httpclient.Request {
+	req.Header.Set(key, value)
+	return req
+}
+
 // readBody reads the content of body io.ReadCloser into memory as byte slice.
 func readBody(r io.ReadCloser) []byte {
 	if r == nil {
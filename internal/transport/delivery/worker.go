@@ -103,6 +103,12 @@ func (p *WorkerPool) Stop() {
 		// here, as true = stopped,
 		// false = never running.
 		_ = p.workers[i].Stop()
+
+		// Push any unfinished backlog
+		// deliveries back to global queue,
+		// so they may be persisted on stop.
+		p.Queue.Push(p.workers[i].backlog...)
+		p.workers[i].backlog = nil
 	}
 
 	// Unset workers slice.
@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// DereferenceDomainPermissionsResp wraps
+// the response to a successful GET of a
+// domain permission subscription's URI.
+type DereferenceDomainPermissionsResp struct {
+	// Body of the response. Nil if
+	// Unmodified is true; otherwise
+	// must be closed by the caller.
+	Body io.ReadCloser
+
+	// ETag header
+	// from the response.
+	ETag string
+
+	// Last-Modified header
+	// from the response.
+	LastModified time.Time
+
+	// True if the remote indicated that the
+	// list has not changed since the ETag or
+	// Last-Modified stored on the subscription.
+	Unmodified bool
+}
+
+func (t *transport) DereferenceDomainPermissions(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	skipCache bool,
+) (*DereferenceDomainPermissionsResp, error) {
+	// Prepare HTTP request to the list URI.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, permSub.URI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set Accept header to the content type
+	// we expect to get back from the remote.
+	req.Header.Set("Accept", string(permSub.ContentType)+",*/*")
+
+	// Set basic auth if configured on the subscription.
+	if permSub.FetchUsername != "" || permSub.FetchPassword != "" {
+		req.SetBasicAuth(permSub.FetchUsername, permSub.FetchPassword)
+	}
+
+	// Set cache validators from
+	// the last successful fetch.
+	if !skipCache {
+		if permSub.ETag != "" {
+			req.Header.Set("If-None-Match", permSub.ETag)
+		}
+
+		if !permSub.LastModified.IsZero() {
+			req.Header.Set("If-Modified-Since", permSub.LastModified.UTC().Format(http.TimeFormat))
+		}
+	}
+
+	// Perform the HTTP request
+	rsp, err := t.GET(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remote has nothing new for us.
+	if rsp.StatusCode == http.StatusNotModified {
+		_ = rsp.Body.Close()
+		return &DereferenceDomainPermissionsResp{
+			ETag:         permSub.ETag,
+			LastModified: permSub.LastModified,
+			Unmodified:   true,
+		}, nil
+	}
+
+	// Check for an expected status code
+	if rsp.StatusCode != http.StatusOK {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+
+	// Parse Last-Modified, ignoring any
+	// unparseable value the remote sent.
+	lastModified, _ := http.ParseTime(rsp.Header.Get("Last-Modified"))
+
+	return &DereferenceDomainPermissionsResp{
+		Body:         rsp.Body,
+		ETag:         rsp.Header.Get("ETag"),
+		LastModified: lastModified,
+	}, nil
+}
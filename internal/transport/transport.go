@@ -30,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
 	"github.com/superseriousbusiness/httpsig"
 )
 
@@ -56,6 +57,10 @@ type Transport interface {
 	// BatchDeliver sends an ActivityStreams object to multiple recipients.
 	BatchDeliver(ctx context.Context, obj map[string]interface{}, recipients []*url.URL) error
 
+	// SignDelivery adds HTTP request signing client "middleware"
+	// to the request context within given delivery.Delivery{}.
+	SignDelivery(dlv *delivery.Delivery) error
+
 	/*
 		GET functions
 	*/
@@ -70,6 +75,10 @@ type Transport interface {
 	// DereferenceMedia fetches the given media attachment IRI, returning the reader and filesize.
 	DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, error)
 
+	// DereferenceDomainPermissions fetches the domain permission list at the URI of the
+	// given subscription, using the subscription's cache validators unless skipCache is set.
+	DereferenceDomainPermissions(ctx context.Context, permSub *gtsmodel.DomainPermissionSubscription, skipCache bool) (*DereferenceDomainPermissionsResp, error)
+
 	// DereferenceInstance dereferences remote instance information, first by checking /api/v1/instance, and then by checking /.well-known/nodeinfo.
 	DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error)
 
@@ -21,8 +21,10 @@ import (
 	"context"
 	"errors"
 	"net/url"
+	"slices"
 
 	"github.com/miekg/dns"
+	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -331,6 +333,14 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		log.Warnf(ctx, "unusable published property on %s", uri)
 	}
 
+	// status.EditedAt
+	//
+	// Extract updated time for the status, if
+	// it was updated after it was published.
+	if upd := ap.GetUpdated(statusable); upd.After(status.CreatedAt) {
+		status.EditedAt = upd
+	}
+
 	// status.AccountURI
 	// status.AccountID
 	// status.Account
@@ -393,20 +403,52 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		return nil, gtserror.SetMalformed(err)
 	}
 
-	// Advanced visibility toggles for this status.
-	//
-	// TODO: a lot of work to be done here -- a new type
-	// needs to be created for this in go-fed/activity.
-	// Until this is implemented, assume all true.
+	// Remote statuses are always federated.
 	status.Federated = util.Ptr(true)
-	status.Boostable = util.Ptr(true)
-	status.Replyable = util.Ptr(true)
-	status.Likeable = util.Ptr(true)
+
+	if with, ok := statusable.(ap.WithUnknownProperties); ok {
+		// status.InteractionPolicy
+		//
+		// Interaction policy for this status, if
+		// set. Nil means default for visibility.
+		if policy := ap.GetInteractionPolicy(with); policy != nil {
+			status.InteractionPolicy = asInteractionPolicy(policy, &status)
+		}
+
+		// status.ApprovedByURI
+		//
+		// URI of an Accept approving this
+		// status as a reply, if applicable.
+		if approvedBy := ap.GetApprovedBy(with); approvedBy != nil {
+			status.ApprovedByURI = approvedBy.String()
+		}
+	}
 
 	// status.Sensitive
 	sensitive := ap.ExtractSensitive(statusable)
 	status.Sensitive = &sensitive
 
+	// Apply moderation measures from any
+	// domain limit on the author's domain.
+	limit, err := c.state.DB.MatchDomainLimit(ctx, status.Account.Domain)
+	if err != nil {
+		err := gtserror.Newf("error checking domain limit for %s: %w", status.Account.Domain, err)
+		return nil, err
+	}
+
+	if limit != nil {
+		// Force content warning,
+		// if one wasn't already set.
+		if status.ContentWarning == "" {
+			status.ContentWarning = limit.ContentWarning
+		}
+
+		// Force media to be marked as sensitive.
+		if *limit.MediaSensitive && len(status.Attachments) != 0 {
+			status.Sensitive = util.Ptr(true)
+		}
+	}
+
 	// ActivityStreamsType
 	status.ActivityStreamsType = statusable.GetTypeName()
 
@@ -494,7 +536,7 @@ func (c *Converter) ASLikeToFave(ctx context.Context, likeable ap.Likeable) (*gt
 		return nil, err
 	}
 
-	return &gtsmodel.StatusFave{
+	fave := &gtsmodel.StatusFave{
 		AccountID:       origin.ID,
 		Account:         origin,
 		TargetAccountID: target.AccountID,
@@ -502,7 +544,17 @@ func (c *Converter) ASLikeToFave(ctx context.Context, likeable ap.Likeable) (*gt
 		StatusID:        target.ID,
 		Status:          target,
 		URI:             uri,
-	}, nil
+	}
+
+	if with, ok := likeable.(ap.WithUnknownProperties); ok {
+		// URI of an Accept approving
+		// this Like, if applicable.
+		if approvedBy := ap.GetApprovedBy(with); approvedBy != nil {
+			fave.ApprovedByURI = approvedBy.String()
+		}
+	}
+
+	return fave, nil
 }
 
 // ASBlockToBlock converts a remote activity streams 'block' representation into a gts model block.
@@ -620,6 +672,14 @@ func (c *Converter) ASAnnounceToStatus(
 		return nil, isNew, gtserror.SetMalformed(err)
 	}
 
+	if with, ok := announceable.(ap.WithUnknownProperties); ok {
+		// URI of an Accept approving
+		// this boost, if applicable.
+		if approvedBy := ap.GetApprovedBy(with); approvedBy != nil {
+			boost.ApprovedByURI = approvedBy.String()
+		}
+	}
+
 	// Below IDs will all be included in the
 	// boosted status, so set them empty here.
 	boost.AttachmentIDs = make([]string, 0)
@@ -837,3 +897,58 @@ func (c *Converter) getASObjectStatus(ctx context.Context, id string, with ap.Wi
 
 	return status, nil
 }
+
+// asInteractionPolicy converts the given ActivityPub interaction policy
+// into a gtsmodel interaction policy, mapping actor and collection IRIs
+// onto policy values relative to the given (author-populated) status.
+func asInteractionPolicy(policy *ap.InteractionPolicy, status *gtsmodel.Status) *gtsmodel.InteractionPolicy {
+	return &gtsmodel.InteractionPolicy{
+		CanLike:     asPolicyRules(policy.CanLike, status),
+		CanReply:    asPolicyRules(policy.CanReply, status),
+		CanAnnounce: asPolicyRules(policy.CanAnnounce, status),
+	}
+}
+
+// asPolicyRules converts the given ActivityPub
+// interaction policy rules into gtsmodel policy rules.
+func asPolicyRules(rules ap.InteractionPolicyRules, status *gtsmodel.Status) gtsmodel.PolicyRules {
+	return gtsmodel.PolicyRules{
+		Always:       asPolicyValues(rules.Always, status),
+		WithApproval: asPolicyValues(rules.ApprovalRequired, status),
+	}
+}
+
+// asPolicyValues converts the given IRIs into policy values,
+// dropping any IRIs that don't map onto a known policy value.
+func asPolicyValues(iris []*url.URL, status *gtsmodel.Status) gtsmodel.PolicyValues {
+	values := make(gtsmodel.PolicyValues, 0, len(iris))
+
+	for _, iri := range iris {
+		var value gtsmodel.PolicyValue
+
+		switch str := iri.String(); {
+		case pub.IsPublic(str):
+			value = gtsmodel.PolicyValuePublic
+		case str == status.Account.URI:
+			value = gtsmodel.PolicyValueAuthor
+		case str == status.Account.FollowersURI:
+			value = gtsmodel.PolicyValueFollowers
+		case str == status.Account.FollowingURI:
+			value = gtsmodel.PolicyValueFollowing
+		case slices.ContainsFunc(status.Mentions, func(m *gtsmodel.Mention) bool {
+			return m.TargetAccountURI == str
+		}):
+			value = gtsmodel.PolicyValueMentioned
+		default:
+			// Individual actors we
+			// can't represent, skip.
+			continue
+		}
+
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
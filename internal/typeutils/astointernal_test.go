@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type ASToInternalTestSuite struct {
@@ -181,9 +182,7 @@ func (suite *ASToInternalTestSuite) TestParseReplyWithMention() {
 	suite.Equal(inReplyToStatus.ID, status.InReplyToID)
 	suite.Equal(inReplyToStatus.URI, status.InReplyToURI)
 	suite.True(*status.Federated)
-	suite.True(*status.Boostable)
-	suite.True(*status.Replyable)
-	suite.True(*status.Likeable)
+	suite.Nil(status.InteractionPolicy)
 	suite.Equal(`<p><span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span> nice there it is:</p><p><a href="http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY/activity" rel="nofollow noopener noreferrer" target="_blank"><span class="invisible">https://</span><span class="ellipsis">social.pixie.town/users/f0x/st</span><span class="invisible">atuses/106221628567855262/activity</span></a></p>`, status.Content)
 	suite.Len(status.Mentions, 1)
 	m1 := status.Mentions[0]
@@ -192,6 +191,41 @@ func (suite *ASToInternalTestSuite) TestParseReplyWithMention() {
 	suite.Equal(gtsmodel.VisibilityUnlocked, status.Visibility)
 }
 
+func (suite *ASToInternalTestSuite) TestParseReplyWithMentionDomainLimit() {
+	ctx := context.Background()
+
+	// Limit the domain of the status author.
+	if err := suite.db.CreateDomainLimit(ctx, &gtsmodel.DomainLimit{
+		ID:                 "01J4CQXT4Z0KNR6YK0X4GWXJ5B",
+		Domain:             "fossbros-anonymous.io",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		ContentWarning:     "from fossbros",
+		MediaSensitive:     util.Ptr(true),
+		HidePublic:         util.Ptr(false),
+		RejectMedia:        util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t := suite.jsonToType(statusWithMentionsActivityJson)
+	create, ok := t.(vocab.ActivityStreamsCreate)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	statusable := create.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	status, err := suite.typeconverter.ASStatusToStatus(ctx, statusable)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Content warning should be forced by the limit.
+	suite.Equal("from fossbros", status.ContentWarning)
+
+	// No media attached, so sensitive is left alone.
+	suite.False(*status.Sensitive)
+}
+
 func (suite *ASToInternalTestSuite) TestParseOwncastService() {
 	t := suite.jsonToType(owncastService)
 	rep, ok := t.(ap.Accountable)
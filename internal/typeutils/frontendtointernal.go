@@ -18,6 +18,8 @@
 package typeutils
 
 import (
+	"fmt"
+
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -57,3 +59,55 @@ func APIFilterActionToFilterAction(m apimodel.FilterAction) gtsmodel.FilterActio
 	}
 	return gtsmodel.FilterActionNone
 }
+
+func APIInteractionPolicyToInteractionPolicy(p *apimodel.InteractionPolicy) (*gtsmodel.InteractionPolicy, error) {
+	canLike, err := apiPolicyRulesToPolicyRules(p.CanFavourite)
+	if err != nil {
+		return nil, err
+	}
+
+	canReply, err := apiPolicyRulesToPolicyRules(p.CanReply)
+	if err != nil {
+		return nil, err
+	}
+
+	canAnnounce, err := apiPolicyRulesToPolicyRules(p.CanReblog)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gtsmodel.InteractionPolicy{
+		CanLike:     canLike,
+		CanReply:    canReply,
+		CanAnnounce: canAnnounce,
+	}, nil
+}
+
+func apiPolicyRulesToPolicyRules(r apimodel.PolicyRules) (gtsmodel.PolicyRules, error) {
+	always, err := apiPolicyValuesToPolicyValues(r.Always)
+	if err != nil {
+		return gtsmodel.PolicyRules{}, err
+	}
+
+	withApproval, err := apiPolicyValuesToPolicyValues(r.WithApproval)
+	if err != nil {
+		return gtsmodel.PolicyRules{}, err
+	}
+
+	return gtsmodel.PolicyRules{
+		Always:       always,
+		WithApproval: withApproval,
+	}, nil
+}
+
+func apiPolicyValuesToPolicyValues(vs []apimodel.PolicyValue) (gtsmodel.PolicyValues, error) {
+	values := make(gtsmodel.PolicyValues, 0, len(vs))
+	for _, v := range vs {
+		value := gtsmodel.PolicyValue(v)
+		if !value.Valid() {
+			return nil, fmt.Errorf("policy value %q not recognized", v)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
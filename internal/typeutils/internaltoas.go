@@ -118,7 +118,14 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	person.SetTootFeatured(featuredProp)
 
 	// featuredTags
-	// NOT IMPLEMENTED
+	// Featured hashtags, only stored for local accounts.
+	if a.IsLocal() {
+		featuredTagsURI, err := url.Parse(uris.GenerateURIsForAccount(a.Username).FeaturedTagsURI)
+		if err != nil {
+			return nil, err
+		}
+		ap.SetFeaturedTags(person, featuredTagsURI)
+	}
 
 	// preferredUsername
 	// Used for Webfinger lookup. Must be unique on the domain, and must correspond to a Webfinger acct: URI.
@@ -488,6 +495,13 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 	publishedProp.Set(s.CreatedAt)
 	status.SetActivityStreamsPublished(publishedProp)
 
+	// updated
+	if !s.EditedAt.IsZero() {
+		updatedProp := streams.NewActivityStreamsUpdatedProperty()
+		updatedProp.Set(s.EditedAt)
+		status.SetActivityStreamsUpdated(updatedProp)
+	}
+
 	// url
 	if s.URL != "" {
 		sURL, err := url.Parse(s.URL)
@@ -672,9 +686,92 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive)
 	status.SetActivityStreamsSensitive(sensitiveProp)
 
+	// interactionPolicy
+	if with, ok := status.(ap.WithUnknownProperties); ok {
+		policy := s.InteractionPolicy
+		if policy == nil {
+			// Make the default policy for
+			// this visibility level explicit.
+			policy = gtsmodel.DefaultInteractionPolicyFor(s.Visibility)
+		}
+
+		asPolicy, err := c.interactionPolicyToAS(policy, s, mentions)
+		if err != nil {
+			return nil, gtserror.Newf("error converting interaction policy: %w", err)
+		}
+		ap.SetInteractionPolicy(with, asPolicy)
+
+		// approvedBy
+		if s.ApprovedByURI != "" {
+			approvedBy, err := url.Parse(s.ApprovedByURI)
+			if err != nil {
+				return nil, gtserror.Newf("error parsing url %s: %w", s.ApprovedByURI, err)
+			}
+			ap.SetApprovedBy(with, approvedBy)
+		}
+	}
+
 	return status, nil
 }
 
+// interactionPolicyToAS converts the given interaction policy of status into
+// its ActivityPub form, mapping policy values onto actor and collection IRIs.
+func (c *Converter) interactionPolicyToAS(
+	policy *gtsmodel.InteractionPolicy,
+	s *gtsmodel.Status,
+	mentions []*gtsmodel.Mention,
+) (*ap.InteractionPolicy, error) {
+	var errs gtserror.MultiError
+
+	toIRIs := func(values gtsmodel.PolicyValues) []*url.URL {
+		var strs []string
+
+		for _, value := range values {
+			switch value {
+			case gtsmodel.PolicyValuePublic:
+				strs = append(strs, pub.PublicActivityPubIRI)
+			case gtsmodel.PolicyValueAuthor:
+				strs = append(strs, s.Account.URI)
+			case gtsmodel.PolicyValueFollowers:
+				strs = append(strs, s.Account.FollowersURI)
+			case gtsmodel.PolicyValueFollowing:
+				strs = append(strs, s.Account.FollowingURI)
+			case gtsmodel.PolicyValueMentioned:
+				for _, m := range mentions {
+					strs = append(strs, m.TargetAccount.URI)
+				}
+			}
+		}
+
+		iris := make([]*url.URL, 0, len(strs))
+		for _, str := range strs {
+			iri, err := url.Parse(str)
+			if err != nil {
+				errs.Appendf("error parsing url %s: %w", str, err)
+				continue
+			}
+			iris = append(iris, iri)
+		}
+
+		return iris
+	}
+
+	toRules := func(rules gtsmodel.PolicyRules) ap.InteractionPolicyRules {
+		return ap.InteractionPolicyRules{
+			Always:           toIRIs(rules.Always),
+			ApprovalRequired: toIRIs(rules.WithApproval),
+		}
+	}
+
+	asPolicy := &ap.InteractionPolicy{
+		CanLike:     toRules(policy.CanLike),
+		CanReply:    toRules(policy.CanReply),
+		CanAnnounce: toRules(policy.CanAnnounce),
+	}
+
+	return asPolicy, errs.Combine()
+}
+
 func (c *Converter) addPollToAS(ctx context.Context, poll *gtsmodel.Poll, dst ap.Pollable) error {
 	var optionsProp interface {
 		// the minimum interface for appending AS Notes
@@ -932,6 +1029,88 @@ func (c *Converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow) (vocab.A
 	return follow, nil
 }
 
+// RelayToASFollow converts a gts model relay subscription into an
+// activity streams Follow from our instance account, suitable for
+// delivery to the relay's inbox. LitePub relays are subscribed to by
+// following the relay actor, Mastodon relays by following Public.
+func (c *Converter) RelayToASFollow(ctx context.Context, r *gtsmodel.Relay) (vocab.ActivityStreamsFollow, error) {
+	instanceAcct, err := c.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return nil, gtserror.Newf("error getting instance account: %w", err)
+	}
+
+	actorURI, err := url.Parse(instanceAcct.URI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing url %s: %w", instanceAcct.URI, err)
+	}
+
+	followURI, err := url.Parse(r.FollowURI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing url %s: %w", r.FollowURI, err)
+	}
+
+	object := pub.PublicActivityPubIRI
+	if r.IsLitePub() {
+		object = r.URI
+	}
+
+	objectURI, err := url.Parse(object)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing url %s: %w", object, err)
+	}
+
+	follow := streams.NewActivityStreamsFollow()
+
+	followIDProp := streams.NewJSONLDIdProperty()
+	followIDProp.SetIRI(followURI)
+	follow.SetJSONLDId(followIDProp)
+
+	followActorProp := streams.NewActivityStreamsActorProperty()
+	followActorProp.AppendIRI(actorURI)
+	follow.SetActivityStreamsActor(followActorProp)
+
+	followObjectProp := streams.NewActivityStreamsObjectProperty()
+	followObjectProp.AppendIRI(objectURI)
+	follow.SetActivityStreamsObject(followObjectProp)
+
+	followToProp := streams.NewActivityStreamsToProperty()
+	followToProp.AppendIRI(objectURI)
+	follow.SetActivityStreamsTo(followToProp)
+
+	return follow, nil
+}
+
+// RelayToASUndoFollow converts a gts model relay subscription into
+// an activity streams Undo of the Follow that subscribed to the relay.
+func (c *Converter) RelayToASUndoFollow(ctx context.Context, r *gtsmodel.Relay) (vocab.ActivityStreamsUndo, error) {
+	follow, err := c.RelayToASFollow(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	undoURI, err := url.Parse(r.FollowURI + "/undo")
+	if err != nil {
+		return nil, gtserror.Newf("error parsing url %s: %w", r.FollowURI, err)
+	}
+
+	undo := streams.NewActivityStreamsUndo()
+
+	undoIDProp := streams.NewJSONLDIdProperty()
+	undoIDProp.SetIRI(undoURI)
+	undo.SetJSONLDId(undoIDProp)
+
+	// Same actor and addressing
+	// as the original Follow.
+	undo.SetActivityStreamsActor(follow.GetActivityStreamsActor())
+	undo.SetActivityStreamsTo(follow.GetActivityStreamsTo())
+
+	undoObjectProp := streams.NewActivityStreamsObjectProperty()
+	undoObjectProp.AppendActivityStreamsFollow(follow)
+	undo.SetActivityStreamsObject(undoObjectProp)
+
+	return undo, nil
+}
+
 // MentionToAS converts a gts model mention into an activity streams Mention, suitable for federation
 func (c *Converter) MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab.ActivityStreamsMention, error) {
 	if m.TargetAccount == nil {
@@ -1169,6 +1348,15 @@ func (c *Converter) FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab
 	toProp.AppendIRI(toIRI)
 	like.SetActivityStreamsTo(toProp)
 
+	// set the approvedBy property, if approved
+	if f.ApprovedByURI != "" {
+		approvedBy, err := url.Parse(f.ApprovedByURI)
+		if err != nil {
+			return nil, fmt.Errorf("FaveToAS: error parsing uri %s: %s", f.ApprovedByURI, err)
+		}
+		ap.SetApprovedBy(like, approvedBy)
+	}
+
 	return like, nil
 }
 
@@ -1247,6 +1435,15 @@ func (c *Converter) BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.
 
 	announce.SetActivityStreamsCc(ccProp)
 
+	// set the approvedBy property, if approved
+	if boostWrapperStatus.ApprovedByURI != "" {
+		approvedBy, err := url.Parse(boostWrapperStatus.ApprovedByURI)
+		if err != nil {
+			return nil, fmt.Errorf("BoostToAS: error parsing uri %s: %s", boostWrapperStatus.ApprovedByURI, err)
+		}
+		ap.SetApprovedBy(announce, approvedBy)
+	}
+
 	return announce, nil
 }
 
@@ -1590,6 +1787,42 @@ func (c *Converter) StatusesToASFeaturedCollection(ctx context.Context, featured
 	return collection, nil
 }
 
+// FeaturedTagsToASCollection converts a slice of featured tags into a collection
+// of Hashtags, suitable for serializing and serving via the activitypub API.
+func (c *Converter) FeaturedTagsToASCollection(ctx context.Context, featuredTagsID string, featuredTags []*gtsmodel.FeaturedTag) (vocab.ActivityStreamsCollection, error) {
+	collection := streams.NewActivityStreamsCollection()
+
+	featuredTagsIDURI, err := url.Parse(featuredTagsID)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing url %s: %w", featuredTagsID, err)
+	}
+	ap.SetJSONLDId(collection, featuredTagsIDURI)
+
+	itemsProp := streams.NewActivityStreamsItemsProperty()
+	for _, featuredTag := range featuredTags {
+		if featuredTag.Tag == nil {
+			// Ensure tag is populated.
+			featuredTag.Tag, err = c.state.DB.GetTag(ctx, featuredTag.TagID)
+			if err != nil {
+				return nil, gtserror.Newf("error getting tag %s: %w", featuredTag.TagID, err)
+			}
+		}
+
+		asHashtag, err := c.TagToAS(ctx, featuredTag.Tag)
+		if err != nil {
+			return nil, gtserror.Newf("error converting tag %s to hashtag: %w", featuredTag.TagID, err)
+		}
+		itemsProp.AppendTootHashtag(asHashtag)
+	}
+	collection.SetActivityStreamsItems(itemsProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(len(featuredTags))
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	return collection, nil
+}
+
 // ReportToASFlag converts a gts model report into an activitystreams FLAG, suitable for federation.
 func (c *Converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (vocab.ActivityStreamsFlag, error) {
 	flag := streams.NewActivityStreamsFlag()
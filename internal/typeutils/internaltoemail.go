@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// NotificationToEmailNotification converts the given notification
+// to its plaintext email representation, populating it if necessary.
+//
+// Mentions in statuses with direct visibility are given type "direct".
+// Content of statuses with a content warning is replaced by the warning.
+func (c *Converter) NotificationToEmailNotification(
+	ctx context.Context,
+	n *gtsmodel.Notification,
+) (*email.Notification, error) {
+	if err := c.state.DB.PopulateNotification(ctx, n); err != nil {
+		return nil, gtserror.Newf("error populating notification %s: %w", n.ID, err)
+	}
+
+	domain := n.OriginAccount.Domain
+	if domain == "" {
+		domain = config.GetAccountDomain()
+	}
+
+	emailNotif := &email.Notification{
+		Type:       string(n.NotificationType),
+		Account:    n.OriginAccount.Username + "@" + domain,
+		AccountURL: n.OriginAccount.URL,
+	}
+
+	if n.Status != nil {
+		if n.NotificationType == gtsmodel.NotificationMention &&
+			n.Status.Visibility == gtsmodel.VisibilityDirect {
+			emailNotif.Type = "direct"
+		}
+
+		if n.Status.ContentWarning != "" {
+			emailNotif.Text = "Content warning: " + n.Status.ContentWarning
+		} else {
+			emailNotif.Text = text.SanitizeToPlaintext(n.Status.Content)
+		}
+
+		emailNotif.StatusURL = n.Status.URL
+	}
+
+	return emailNotif, nil
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InternalToEmailTestSuite struct {
+	TypeUtilsTestSuite
+}
+
+func (suite *InternalToEmailTestSuite) TestMentionToEmailNotification() {
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["remote_account_1_status_1"]
+
+	notif := &gtsmodel.Notification{
+		ID:               "01J4FJ2W8M3QZ1X7KTP9B0N5RE",
+		NotificationType: gtsmodel.NotificationMention,
+		TargetAccountID:  suite.testAccounts["local_account_1"].ID,
+		OriginAccountID:  status.AccountID,
+		StatusID:         status.ID,
+		Status:           status,
+	}
+
+	emailNotif, err := suite.typeconverter.NotificationToEmailNotification(context.Background(), notif)
+	suite.NoError(err)
+	suite.Equal("mention", emailNotif.Type)
+	suite.Equal("foss_satan@fossbros-anonymous.io", emailNotif.Account)
+	suite.Equal("http://fossbros-anonymous.io/@foss_satan", emailNotif.AccountURL)
+	suite.Equal("dark souls status bot: \"thoughts of dog\"", emailNotif.Text)
+	suite.Equal("http://fossbros-anonymous.io/@foss_satan/statuses/01FVW7JHQFSFK166WWKR8CBA6M", emailNotif.StatusURL)
+
+	// Direct mentions are emailed as DMs,
+	// and content warnings hide content.
+	status.Visibility = gtsmodel.VisibilityDirect
+	status.ContentWarning = "dog thoughts"
+
+	emailNotif, err = suite.typeconverter.NotificationToEmailNotification(context.Background(), notif)
+	suite.NoError(err)
+	suite.Equal("direct", emailNotif.Type)
+	suite.Equal("Content warning: dog thoughts", emailNotif.Text)
+}
+
+func (suite *InternalToEmailTestSuite) TestFollowToEmailNotification() {
+	notif := &gtsmodel.Notification{
+		ID:               "01J4FJ2W8M3QZ1X7KTP9B0N5RE",
+		NotificationType: gtsmodel.NotificationFollow,
+		TargetAccountID:  suite.testAccounts["local_account_1"].ID,
+		OriginAccountID:  suite.testAccounts["local_account_2"].ID,
+	}
+
+	emailNotif, err := suite.typeconverter.NotificationToEmailNotification(context.Background(), notif)
+	suite.NoError(err)
+	suite.Equal("follow", emailNotif.Type)
+	suite.Equal("1happyturtle@localhost:8080", emailNotif.Account)
+	suite.Equal("http://localhost:8080/@1happyturtle", emailNotif.AccountURL)
+	suite.Empty(emailNotif.Text)
+	suite.Empty(emailNotif.StatusURL)
+}
+
+func TestInternalToEmailTestSuite(t *testing.T) {
+	suite.Run(t, new(InternalToEmailTestSuite))
+}
@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -99,6 +101,10 @@ func (c *Converter) UserToAPIUser(ctx context.Context, u *gtsmodel.User) *apimod
 		user.ResetPasswordSentAt = util.FormatISO8601(u.ResetPasswordSentAt)
 	}
 
+	if !u.TwoFactorEnabledAt.IsZero() {
+		user.TwoFactorEnabledAt = util.FormatISO8601(u.TwoFactorEnabledAt)
+	}
+
 	return user
 }
 
@@ -138,6 +144,8 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		Fields:              c.fieldsToAPIFields(a.FieldsRaw),
 		FollowRequestsCount: *a.Stats.FollowRequestsCount,
 		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
+		EmailNotifications:  emailNotificationsToAPI(a.Settings),
+		EmailDigest:         string(a.Settings.EmailDigest),
 	}
 
 	return apiAccount, nil
@@ -497,8 +505,9 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Confirmed:              confirmed,
 		Approved:               approved,
 		Disabled:               disabled,
-		Silenced:               !a.SilencedAt.IsZero(),
-		Suspended:              !a.SuspendedAt.IsZero(),
+		Silenced:               a.IsSilenced(),
+		Sensitized:             a.IsSensitized(),
+		Suspended:              a.IsSuspended(),
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
 		InvitedByAccountID:     "", // not implemented (yet)
@@ -526,11 +535,74 @@ func (c *Converter) AppToAPIAppPublic(ctx context.Context, a *gtsmodel.Applicati
 	}, nil
 }
 
+// TokenToAPITokenInfo converts a gts model token into an apimodel
+// token info, suitable for showing to the user who owns the token.
+// The access token string itself is never included.
+func (c *Converter) TokenToAPITokenInfo(ctx context.Context, t *gtsmodel.Token) (*apimodel.TokenInfo, error) {
+	app, err := c.state.DB.GetApplicationByClientID(ctx, t.ClientID)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting application with client id %s: %w", t.ClientID, err)
+	}
+
+	apiApp, err := c.AppToAPIAppPublic(ctx, app)
+	if err != nil {
+		return nil, gtserror.Newf("error converting application: %w", err)
+	}
+
+	// Prefer time access
+	// token was issued.
+	createdAt := t.AccessCreateAt
+	if createdAt.IsZero() {
+		createdAt = t.CreatedAt
+	}
+
+	var lastUsed string
+	if !t.LastUsed.IsZero() {
+		lastUsed = util.FormatISO8601(t.LastUsed)
+	}
+
+	return &apimodel.TokenInfo{
+		ID:          t.ID,
+		CreatedAt:   util.FormatISO8601(createdAt),
+		LastUsed:    lastUsed,
+		Scope:       t.Scope,
+		Application: apiApp,
+	}, nil
+}
+
+// PreviewCardToAPICard converts a gts model preview card into its api representation for serialization on the API.
+func (c *Converter) PreviewCardToAPICard(card *gtsmodel.PreviewCard) *apimodel.Card {
+	apiCard := &apimodel.Card{
+		URL:          card.URL,
+		Title:        card.Title,
+		Description:  card.Description,
+		Type:         string(card.Type),
+		AuthorName:   card.AuthorName,
+		AuthorURL:    card.AuthorURL,
+		ProviderName: card.ProviderName,
+		ProviderURL:  card.ProviderURL,
+		HTML:         card.HTML,
+		Width:        card.Width,
+		Height:       card.Height,
+		EmbedURL:     card.EmbedURL,
+	}
+
+	if card.Image != nil && *card.Image.Cached {
+		// Serve the thumbnailed image,
+		// it's only shown as a preview.
+		apiCard.Image = card.Image.Thumbnail.URL
+		apiCard.Blurhash = card.Image.Blurhash
+	}
+
+	return apiCard
+}
+
 // AttachmentToAPIAttachment converts a gts model media attacahment into its api representation for serialization on the API.
 func (c *Converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.MediaAttachment) (apimodel.Attachment, error) {
 	apiAttachment := apimodel.Attachment{
-		ID:   a.ID,
-		Type: strings.ToLower(string(a.Type)),
+		ID:       a.ID,
+		Type:     strings.ToLower(string(a.Type)),
+		MIMEType: a.File.ContentType,
 	}
 
 	// Don't try to serialize meta for
@@ -599,6 +671,15 @@ func (c *Converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.M
 			apiAttachment.Meta.Original.FrameRate = fr + "/1"
 		}
 
+		if i := a.FileMeta.Original.Bitrate; i != nil {
+			apiAttachment.Meta.Original.Bitrate = int(*i)
+		}
+
+	case gtsmodel.FileTypeAudio:
+		if i := a.FileMeta.Original.Duration; i != nil {
+			apiAttachment.Meta.Original.Duration = *i
+		}
+
 		if i := a.FileMeta.Original.Bitrate; i != nil {
 			apiAttachment.Meta.Original.Bitrate = int(*i)
 		}
@@ -703,7 +784,8 @@ func (c *Converter) EmojiCategoryToAPIEmojiCategory(ctx context.Context, categor
 
 // TagToAPITag converts a gts model tag into its api (frontend) representation for serialization on the API.
 // If stubHistory is set to 'true', then the 'history' field of the tag will be populated with a pointer to an empty slice, for API compatibility reasons.
-func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistory bool) (apimodel.Tag, error) {
+// If following is set, then the 'following' field of the tag will be set to it.
+func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistory bool, following *bool) (apimodel.Tag, error) {
 	return apimodel.Tag{
 		Name: strings.ToLower(t.Name),
 		URL:  uris.URIForTag(t.Name),
@@ -715,9 +797,42 @@ func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistor
 			h := make([]any, 0)
 			return &h
 		}(),
+		Following: following,
 	}, nil
 }
 
+// FeaturedTagToAPIFeaturedTag converts a gts model featured tag
+// into its api (frontend) representation, including stats about
+// the featuring account's usage of the tag.
+func (c *Converter) FeaturedTagToAPIFeaturedTag(ctx context.Context, ft *gtsmodel.FeaturedTag) (*apimodel.FeaturedTag, error) {
+	if ft.Tag == nil {
+		// Ensure tag is populated.
+		var err error
+		ft.Tag, err = c.state.DB.GetTag(ctx, ft.TagID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting tag %s: %w", ft.TagID, err)
+		}
+	}
+
+	statusesCount, lastStatusAt, err := c.state.DB.GetFeaturedTagStats(ctx, ft)
+	if err != nil {
+		return nil, gtserror.Newf("error getting stats for featured tag %s: %w", ft.ID, err)
+	}
+
+	apiFeaturedTag := &apimodel.FeaturedTag{
+		ID:            ft.ID,
+		Name:          strings.ToLower(ft.Tag.Name),
+		URL:           uris.URIForTag(ft.Tag.Name),
+		StatusesCount: statusesCount,
+	}
+
+	if !lastStatusAt.IsZero() {
+		apiFeaturedTag.LastStatusAt = util.Ptr(util.FormatISO8601(lastStatusAt))
+	}
+
+	return apiFeaturedTag, nil
+}
+
 // StatusToAPIStatus converts a gts model status into its api
 // (frontend) representation for serialization on the API.
 //
@@ -1029,17 +1144,129 @@ func (c *Converter) StatusToWebStatus(
 // Callers should check beforehand whether a requester has permission to view the
 // source of the status, and ensure they're passing only a local status into this function.
 func (c *Converter) StatusToAPIStatusSource(ctx context.Context, s *gtsmodel.Status) (*apimodel.StatusSource, error) {
-	// TODO: remove this when edit support is added.
-	text := "**STATUS EDITS ARE NOT CURRENTLY SUPPORTED IN GOTOSOCIAL (coming in 2024)**\n" +
-		"You can review the original text of your status below, but you will not be able to submit this edit.\n\n---\n\n" + s.Text
-
 	return &apimodel.StatusSource{
 		ID:          s.ID,
-		Text:        text,
+		Text:        s.Text,
 		SpoilerText: s.ContentWarning,
 	}, nil
 }
 
+// StatusToAPIEdits converts a status into its edit history, as a
+// slice of frontend API model revisions ordered oldest to newest,
+// with the final entry being the current revision of the status.
+func (c *Converter) StatusToAPIEdits(ctx context.Context, s *gtsmodel.Status) ([]*apimodel.StatusEdit, error) {
+	// Try to populate status struct pointer fields.
+	// We can continue in many cases of partial failure,
+	// but the author account and edits are required.
+	if err := c.state.DB.PopulateStatus(ctx, s); err != nil {
+		if s.Account == nil || !s.EditsPopulated() {
+			return nil, gtserror.Newf("error(s) populating status, required fields not set: %w", err)
+		}
+		log.Errorf(ctx, "error(s) populating status, will continue: %v", err)
+	}
+
+	apiAuthorAccount, err := c.AccountToAPIAccountPublic(ctx, s.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting status author: %w", err)
+	}
+
+	// Emojis are not stored per revision, so we
+	// just use those of current status for all.
+	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, s.Emojis, s.EmojiIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting status emojis: %v", err)
+	}
+
+	apiEdits := make([]*apimodel.StatusEdit, 0, len(s.Edits)+1)
+
+	for _, edit := range s.Edits {
+		if err := c.state.DB.PopulateStatusEdit(ctx, edit); err != nil {
+			log.Errorf(ctx, "error(s) populating status edit, will continue: %v", err)
+		}
+
+		// Convert attachments with descriptions as they were at this revision.
+		apiAttachments := make([]*apimodel.Attachment, 0, len(edit.Attachments))
+		for _, attachment := range edit.Attachments {
+			apiAttachment, err := c.AttachmentToAPIAttachment(ctx, attachment)
+			if err != nil {
+				log.Errorf(ctx, "error converting attachment %s: %v", attachment.ID, err)
+				continue
+			}
+			i := slices.Index(edit.AttachmentIDs, attachment.ID)
+			if i >= 0 && i < len(edit.AttachmentDescriptions) {
+				apiAttachment.Description = util.Ptr(edit.AttachmentDescriptions[i])
+			}
+			apiAttachments = append(apiAttachments, &apiAttachment)
+		}
+
+		var apiPoll *apimodel.Poll
+		if len(edit.PollOptions) > 0 {
+			// Only titles (and final counts, if the
+			// poll was since replaced) are stored.
+			apiPoll = &apimodel.Poll{
+				Options: make([]apimodel.PollOption, len(edit.PollOptions)),
+				Emojis:  apiEmojis,
+			}
+			for i, title := range edit.PollOptions {
+				apiPoll.Options[i].Title = title
+				if i < len(edit.PollVotes) {
+					apiPoll.Options[i].VotesCount = util.Ptr(edit.PollVotes[i])
+					apiPoll.VotesCount += edit.PollVotes[i]
+				}
+			}
+		}
+
+		apiEdits = append(apiEdits, &apimodel.StatusEdit{
+			Content:          edit.Content,
+			SpoilerText:      edit.ContentWarning,
+			Sensitive:        util.PtrValueOr(edit.Sensitive, false),
+			CreatedAt:        util.FormatISO8601(edit.CreatedAt),
+			Account:          apiAuthorAccount,
+			Poll:             apiPoll,
+			MediaAttachments: apiAttachments,
+			Emojis:           apiEmojis,
+		})
+	}
+
+	// Finally, add the current revision of the status.
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, s.Attachments, s.AttachmentIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting status attachments: %v", err)
+	}
+
+	var apiPoll *apimodel.Poll
+	if s.Poll != nil {
+		// Set originating
+		// status on the poll.
+		poll := s.Poll
+		poll.Status = s
+
+		// No requester, so that per-account vote info is left out.
+		apiPoll, err = c.PollToAPIPoll(ctx, nil, poll)
+		if err != nil {
+			return nil, gtserror.Newf("error converting poll: %w", err)
+		}
+	}
+
+	createdAt := s.EditedAt
+	if createdAt.IsZero() {
+		createdAt = s.CreatedAt
+	}
+
+	apiEdits = append(apiEdits, &apimodel.StatusEdit{
+		Content:          s.Content,
+		SpoilerText:      s.ContentWarning,
+		Sensitive:        util.PtrValueOr(s.Sensitive, false),
+		CreatedAt:        util.FormatISO8601(createdAt),
+		Account:          apiAuthorAccount,
+		Poll:             apiPoll,
+		MediaAttachments: apiAttachments,
+		Emojis:           apiEmojis,
+	})
+
+	return apiEdits, nil
+}
+
 // statusToFrontend is a package internal function for
 // parsing a status into its initial frontend representation.
 //
@@ -1185,10 +1412,21 @@ func (c *Converter) baseStatusToFrontend(
 		Mentions:           apiMentions,
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
-		Card:               nil, // TODO: implement cards
+		Card:               nil, // Set below.
 		Text:               s.Text,
 	}
 
+	if s.PreviewCard != nil {
+		apiStatus.Card = c.PreviewCardToAPICard(s.PreviewCard)
+	}
+
+	if len(apiAttachments) != 0 && s.Account.IsSensitized() &&
+		(requestingAccount == nil || requestingAccount.ID != s.AccountID) {
+		// Media of sensitized accounts is always
+		// shown as sensitive, except to the author.
+		apiStatus.Sensitive = true
+	}
+
 	// Nullable fields.
 	if s.InReplyToID != "" {
 		apiStatus.InReplyToID = util.Ptr(s.InReplyToID)
@@ -1202,6 +1440,10 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.Language = util.Ptr(s.Language)
 	}
 
+	if !s.EditedAt.IsZero() {
+		apiStatus.EditedAt = util.Ptr(util.FormatISO8601(s.EditedAt))
+	}
+
 	if app := s.CreatedWithApplication; app != nil {
 		apiStatus.Application, err = c.AppToAPIAppPublic(ctx, app)
 		if err != nil {
@@ -1247,6 +1489,15 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.Pinned = interacts.Pinned
 	}
 
+	// Interaction policy of the status,
+	// falling back to the default for the
+	// visibility level if not explicitly set.
+	policy := s.InteractionPolicy
+	if policy == nil {
+		policy = gtsmodel.DefaultInteractionPolicyFor(s.Visibility)
+	}
+	apiStatus.InteractionPolicy = c.InteractionPolicyToAPIInteractionPolicy(policy)
+
 	// If web URL is empty for whatever
 	// reason, provide AP URI as fallback.
 	if s.URL == "" {
@@ -1311,6 +1562,124 @@ func (c *Converter) InstanceRuleToAdminAPIRule(r *gtsmodel.Rule) *apimodel.Admin
 	}
 }
 
+// AnnouncementToAPIAnnouncement converts a gts model announcement into its api representation.
+// If requester is set, whether they have read the announcement and which reactions are
+// theirs is included; otherwise the announcement is shown as unread, with no own reactions.
+func (c *Converter) AnnouncementToAPIAnnouncement(
+	ctx context.Context,
+	a *gtsmodel.Announcement,
+	requester *gtsmodel.Account,
+) (*apimodel.Announcement, error) {
+	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, a.Emojis, a.EmojiIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting announcement emojis: %v", err)
+	}
+
+	reactions, err := c.state.DB.GetAnnouncementReactions(ctx, a.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting announcement reactions: %w", err)
+	}
+
+	var read bool
+	if requester != nil {
+		read, err = c.state.DB.IsAnnouncementRead(ctx, a.ID, requester.ID)
+		if err != nil {
+			return nil, gtserror.Newf("db error checking announcement read: %w", err)
+		}
+	}
+
+	// Announcements that were never published
+	// show when they were created, instead.
+	publishedAt := a.PublishedAt
+	if publishedAt.IsZero() {
+		publishedAt = a.CreatedAt
+	}
+
+	apiAnnouncement := &apimodel.Announcement{
+		ID:          a.ID,
+		Content:     a.Content,
+		AllDay:      *a.AllDay,
+		PublishedAt: util.FormatISO8601(publishedAt),
+		UpdatedAt:   util.FormatISO8601(a.UpdatedAt),
+		Published:   a.IsPublished(),
+		Read:        read,
+		Mentions:    []apimodel.Mention{},
+		Statuses:    []apimodel.Status{},
+		Tags:        []apimodel.Tag{},
+		Emojis:      apiEmojis,
+		Reactions:   c.announcementReactionsToAPIReactions(ctx, reactions, requester),
+	}
+
+	if !a.StartsAt.IsZero() {
+		apiAnnouncement.StartsAt = util.FormatISO8601(a.StartsAt)
+	}
+
+	if !a.EndsAt.IsZero() {
+		apiAnnouncement.EndsAt = util.FormatISO8601(a.EndsAt)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// AnnouncementToAdminAPIAnnouncement converts a gts model announcement
+// into its api representation, with extra admin information.
+func (c *Converter) AnnouncementToAdminAPIAnnouncement(
+	ctx context.Context,
+	a *gtsmodel.Announcement,
+) (*apimodel.AdminAnnouncement, error) {
+	apiAnnouncement, err := c.AnnouncementToAPIAnnouncement(ctx, a, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apimodel.AdminAnnouncement{
+		Announcement: apiAnnouncement,
+		Text:         a.Text,
+	}, nil
+}
+
+// announcementReactionsToAPIReactions totals up the given reactions
+// to an announcement by name, in the order each name was first used.
+func (c *Converter) announcementReactionsToAPIReactions(
+	ctx context.Context,
+	reactions []*gtsmodel.AnnouncementReaction,
+	requester *gtsmodel.Account,
+) []apimodel.AnnouncementReaction {
+	apiReactions := make([]apimodel.AnnouncementReaction, 0, len(reactions))
+	indices := make(map[string]int, len(reactions))
+
+	for _, reaction := range reactions {
+		i, ok := indices[reaction.Name]
+		if !ok {
+			apiReaction := apimodel.AnnouncementReaction{
+				Name: reaction.Name,
+			}
+
+			if reaction.EmojiID != "" {
+				// Custom emoji reaction, include its images.
+				emoji, err := c.state.DB.GetEmojiByID(ctx, reaction.EmojiID)
+				if err != nil {
+					log.Errorf(ctx, "error getting reaction emoji %s: %v", reaction.EmojiID, err)
+				} else {
+					apiReaction.URL = emoji.ImageURL
+					apiReaction.StaticURL = emoji.ImageStaticURL
+				}
+			}
+
+			i = len(apiReactions)
+			indices[reaction.Name] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReactions[i].Count++
+		if requester != nil && reaction.AccountID == requester.ID {
+			apiReactions[i].Me = true
+		}
+	}
+
+	return apiReactions
+}
+
 // InstanceToAPIV1Instance converts a gts instance into its api equivalent for serving at /api/v1/instance
 func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceV1, error) {
 	instance := &apimodel.InstanceV1{
@@ -1500,6 +1869,7 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
 	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize())
+	instance.Configuration.VAPID.PublicKey = i.VAPIDPublicKey
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
 
 	// registrations
@@ -1618,6 +1988,65 @@ func (c *Converter) NotificationToAPINotification(
 	}, nil
 }
 
+// ConversationToAPIConversation converts a conversation into its API representation.
+// The conversation status will be filtered using the thread filter context,
+// and may be nil if the status was hidden.
+func (c *Converter) ConversationToAPIConversation(
+	ctx context.Context,
+	conversation *gtsmodel.Conversation,
+	requestingAccount *gtsmodel.Account,
+	filters []*gtsmodel.Filter,
+	mutes *usermute.CompiledUserMuteList,
+) (*apimodel.Conversation, error) {
+	apiConversation := &apimodel.Conversation{
+		ID:     conversation.ID,
+		Unread: !*conversation.Read,
+	}
+
+	// Populate most recent status in convo;
+	// can be nil if this status is filtered.
+	if conversation.LastStatus != nil {
+		var err error
+		apiConversation.LastStatus, err = c.StatusToAPIStatus(
+			ctx,
+			conversation.LastStatus,
+			requestingAccount,
+			statusfilter.FilterContextThread,
+			filters,
+			mutes,
+		)
+		if err != nil && !errors.Is(err, statusfilter.ErrHideStatus) {
+			return nil, gtserror.Newf(
+				"error converting status %s to API representation: %w",
+				conversation.LastStatus.ID,
+				err,
+			)
+		}
+	}
+
+	// If no other accounts are involved in this convo
+	// (ie., a DM to yourself), include the requester.
+	accounts := conversation.OtherAccounts
+	if len(accounts) == 0 {
+		accounts = []*gtsmodel.Account{requestingAccount}
+	}
+
+	apiConversation.Accounts = make([]apimodel.Account, 0, len(accounts))
+	for _, account := range accounts {
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			return nil, gtserror.Newf(
+				"error converting account %s to API representation: %w",
+				account.ID,
+				err,
+			)
+		}
+		apiConversation.Accounts = append(apiConversation.Accounts, *apiAccount)
+	}
+
+	return apiConversation, nil
+}
+
 // DomainPermToAPIDomainPerm converts a gts model domin block or allow into an api domain permission.
 func (c *Converter) DomainPermToAPIDomainPerm(
 	ctx context.Context,
@@ -1638,6 +2067,18 @@ func (c *Converter) DomainPermToAPIDomainPerm(
 		},
 	}
 
+	// Include moderation options
+	// for domain limits, so that
+	// they survive an export too.
+	if limit, ok := d.(*gtsmodel.DomainLimit); ok {
+		domainPerm.Limit = &apimodel.DomainLimitOptions{
+			ContentWarning: limit.ContentWarning,
+			MediaSensitive: *limit.MediaSensitive,
+			HidePublic:     *limit.HidePublic,
+			RejectMedia:    *limit.RejectMedia,
+		}
+	}
+
 	// If we're exporting, provide
 	// only bare minimum detail.
 	if export {
@@ -1654,6 +2095,69 @@ func (c *Converter) DomainPermToAPIDomainPerm(
 	return domainPerm, nil
 }
 
+// DomainPermSubToAPIDomainPermSub converts the given
+// domain permission subscription to its API model.
+func (c *Converter) DomainPermSubToAPIDomainPermSub(
+	ctx context.Context,
+	d *gtsmodel.DomainPermissionSubscription,
+) (*apimodel.DomainPermissionSubscription, error) {
+	// Count the permissions this subscription owns.
+	count, err := c.state.DB.CountDomainPermissionSubscriptionPerms(ctx, d)
+	if err != nil {
+		return nil, gtserror.Newf("error counting perms for subscription %s: %w", d.ID, err)
+	}
+
+	var (
+		fetchedAt             string
+		successfullyFetchedAt string
+	)
+
+	if !d.FetchedAt.IsZero() {
+		fetchedAt = util.FormatISO8601(d.FetchedAt)
+	}
+
+	if !d.SuccessfullyFetchedAt.IsZero() {
+		successfullyFetchedAt = util.FormatISO8601(d.SuccessfullyFetchedAt)
+	}
+
+	return &apimodel.DomainPermissionSubscription{
+		ID:                    d.ID,
+		Priority:              d.Priority,
+		Title:                 d.Title,
+		PermissionType:        d.PermissionType.String(),
+		AdoptOrphans:          *d.AdoptOrphans,
+		RemoveRetracted:       *d.RemoveRetracted,
+		CreatedAt:             util.FormatISO8601(d.CreatedAt),
+		CreatedBy:             d.CreatedByAccountID,
+		URI:                   d.URI,
+		ContentType:           string(d.ContentType),
+		FetchUsername:         d.FetchUsername,
+		FetchPassword:         d.FetchPassword,
+		FetchedAt:             fetchedAt,
+		SuccessfullyFetchedAt: successfullyFetchedAt,
+		Error:                 d.Error,
+		Count:                 uint64(count),
+	}, nil
+}
+
+// RelayToAPIRelay converts a gts model relay
+// subscription into an api model relay subscription.
+func (c *Converter) RelayToAPIRelay(
+	ctx context.Context,
+	r *gtsmodel.Relay,
+) (*apimodel.Relay, error) {
+	return &apimodel.Relay{
+		ID:        r.ID,
+		CreatedAt: util.FormatISO8601(r.CreatedAt),
+		CreatedBy: r.CreatedByAccountID,
+		URI:       r.URI,
+		InboxURI:  r.InboxURI,
+		ActorURI:  r.ActorURI,
+		State:     r.State.String(),
+		Forward:   util.PtrValueOr(r.Forward, false),
+	}, nil
+}
+
 // ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
 func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
@@ -1795,6 +2299,140 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 	}, nil
 }
 
+// TrendToAPITag converts a gts model tag trend into its api
+// (frontend) representation, including the daily usage history
+// of the tag, for serving at /api/v1/trends/tags.
+func (c *Converter) TrendToAPITag(ctx context.Context, t *gtsmodel.Trend, following *bool) (apimodel.Tag, error) {
+	if t.Tag == nil {
+		// Ensure tag is populated.
+		var err error
+		t.Tag, err = c.state.DB.GetTag(ctx, t.TargetID)
+		if err != nil {
+			return apimodel.Tag{}, gtserror.Newf("error getting tag %s: %w", t.TargetID, err)
+		}
+	}
+
+	apiTag, err := c.TagToAPITag(ctx, t.Tag, false, following)
+	if err != nil {
+		return apimodel.Tag{}, err
+	}
+
+	history := make([]any, 0, len(t.DayUses))
+	for _, h := range trendHistory(t) {
+		history = append(history, h)
+	}
+	apiTag.History = &history
+
+	return apiTag, nil
+}
+
+// TrendToAPILink converts a gts model link trend into its api
+// (frontend) representation, a preview card of the link along
+// with its daily usage history, for serving at /api/v1/trends/links.
+func (c *Converter) TrendToAPILink(ctx context.Context, t *gtsmodel.Trend) (*apimodel.TrendsLink, error) {
+	card, err := c.state.DB.GetPreviewCardByURL(ctx, t.TargetID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting preview card for %s: %w", t.TargetID, err)
+	}
+
+	if card != nil {
+		// Prefer the stored preview
+		// card of the link, if any.
+		return &apimodel.TrendsLink{
+			Card:    *c.PreviewCardToAPICard(card),
+			History: trendHistory(t),
+		}, nil
+	}
+
+	u, err := url.Parse(t.TargetID)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing link %s: %w", t.TargetID, err)
+	}
+
+	return &apimodel.TrendsLink{
+		Card: apimodel.Card{
+			URL:          t.TargetID,
+			Title:        t.TargetID,
+			Type:         "link",
+			ProviderName: u.Host,
+			ProviderURL:  u.Scheme + "://" + u.Host,
+		},
+		History: trendHistory(t),
+	}, nil
+}
+
+// TrendToAdminAPITrend converts a gts model trend into an admin view trend, for serving at /api/v1/admin/trends
+func (c *Converter) TrendToAdminAPITrend(ctx context.Context, t *gtsmodel.Trend, requestingAccount *gtsmodel.Account) (*apimodel.AdminTrend, error) {
+	apiTrend := &apimodel.AdminTrend{
+		ID:     t.ID,
+		Type:   t.Type.String(),
+		Score:  t.Score,
+		Review: t.Review.String(),
+	}
+
+	if !t.ReviewedAt.IsZero() {
+		ra := util.FormatISO8601(t.ReviewedAt)
+		apiTrend.ReviewedAt = &ra
+	}
+
+	switch t.Type {
+	case gtsmodel.TrendTypeTag:
+		apiTag, err := c.TrendToAPITag(ctx, t, nil)
+		if err != nil {
+			return nil, gtserror.Newf("error converting trend %s to api tag: %w", t.ID, err)
+		}
+		apiTrend.Tag = &apiTag
+
+	case gtsmodel.TrendTypeStatus:
+		if t.Status == nil {
+			// Ensure status is populated.
+			var err error
+			t.Status, err = c.state.DB.GetStatusByID(ctx, t.TargetID)
+			if err != nil {
+				return nil, gtserror.Newf("error getting status %s: %w", t.TargetID, err)
+			}
+		}
+
+		apiStatus, err := c.StatusToAPIStatus(ctx, t.Status, requestingAccount, statusfilter.FilterContextNone, nil, nil)
+		if err != nil {
+			return nil, gtserror.Newf("error converting trend %s to api status: %w", t.ID, err)
+		}
+		apiTrend.Status = apiStatus
+
+	case gtsmodel.TrendTypeLink:
+		apiLink, err := c.TrendToAPILink(ctx, t)
+		if err != nil {
+			return nil, gtserror.Newf("error converting trend %s to api link: %w", t.ID, err)
+		}
+		apiTrend.Link = apiLink
+	}
+
+	return apiTrend, nil
+}
+
+// trendHistory converts the daily counts of the given trend
+// into api history entries, starting from the day the trend
+// was last aggregated.
+func trendHistory(t *gtsmodel.Trend) []apimodel.History {
+	day := t.UpdatedAt.UTC().Truncate(24 * time.Hour)
+	history := make([]apimodel.History, len(t.DayUses))
+
+	for i, uses := range t.DayUses {
+		var accounts int
+		if i < len(t.DayAccounts) {
+			accounts = t.DayAccounts[i]
+		}
+
+		history[i] = apimodel.History{
+			Day:      strconv.FormatInt(day.AddDate(0, 0, -i).Unix(), 10),
+			Uses:     strconv.Itoa(uses),
+			Accounts: strconv.Itoa(accounts),
+		}
+	}
+
+	return history
+}
+
 // ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
 func (c *Converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error) {
 	return &apimodel.List{
@@ -2162,7 +2800,7 @@ func (c *Converter) convertTagsToAPITags(ctx context.Context, tags []*gtsmodel.T
 
 	// Convert GTS models to frontend models
 	for _, tag := range tags {
-		apiTag, err := c.TagToAPITag(ctx, tag, false)
+		apiTag, err := c.TagToAPITag(ctx, tag, false, nil)
 		if err != nil {
 			errs.Appendf("error converting tag %s to api tag: %w", tag.ID, err)
 			continue
@@ -2185,3 +2823,247 @@ func (c *Converter) ThemesToAPIThemes(themes []*gtsmodel.Theme) []apimodel.Theme
 	}
 	return apiThemes
 }
+
+// InteractionPolicyToAPIInteractionPolicy converts the
+// given interaction policy into its API model form.
+func (c *Converter) InteractionPolicyToAPIInteractionPolicy(p *gtsmodel.InteractionPolicy) apimodel.InteractionPolicy {
+	return apimodel.InteractionPolicy{
+		CanFavourite: policyRulesToAPIPolicyRules(p.CanLike),
+		CanReply:     policyRulesToAPIPolicyRules(p.CanReply),
+		CanReblog:    policyRulesToAPIPolicyRules(p.CanAnnounce),
+	}
+}
+
+func policyRulesToAPIPolicyRules(r gtsmodel.PolicyRules) apimodel.PolicyRules {
+	return apimodel.PolicyRules{
+		Always:       policyValuesToAPIPolicyValues(r.Always),
+		WithApproval: policyValuesToAPIPolicyValues(r.WithApproval),
+	}
+}
+
+func policyValuesToAPIPolicyValues(vs gtsmodel.PolicyValues) []apimodel.PolicyValue {
+	values := make([]apimodel.PolicyValue, len(vs))
+	for i, v := range vs {
+		values[i] = apimodel.PolicyValue(v)
+	}
+	return values
+}
+
+// DefaultPoliciesToAPIDefaultPolicies converts the default
+// interaction policies of the given account settings
+// (falling back to instance defaults) to API model form.
+func (c *Converter) DefaultPoliciesToAPIDefaultPolicies(s *gtsmodel.AccountSettings) *apimodel.DefaultPolicies {
+	return &apimodel.DefaultPolicies{
+		Direct:      c.InteractionPolicyToAPIInteractionPolicy(s.InteractionPolicyFor(gtsmodel.VisibilityDirect)),
+		MutualsOnly: c.InteractionPolicyToAPIInteractionPolicy(s.InteractionPolicyFor(gtsmodel.VisibilityMutualsOnly)),
+		Private:     c.InteractionPolicyToAPIInteractionPolicy(s.InteractionPolicyFor(gtsmodel.VisibilityFollowersOnly)),
+		Unlisted:    c.InteractionPolicyToAPIInteractionPolicy(s.InteractionPolicyFor(gtsmodel.VisibilityUnlocked)),
+		Public:      c.InteractionPolicyToAPIInteractionPolicy(s.InteractionPolicyFor(gtsmodel.VisibilityPublic)),
+	}
+}
+
+// PendingFaveToAPIInteractionRequest converts the given pending
+// status fave, targeting one of requester's statuses, to an API
+// model interaction request.
+func (c *Converter) PendingFaveToAPIInteractionRequest(
+	ctx context.Context,
+	fave *gtsmodel.StatusFave,
+	requester *gtsmodel.Account,
+) (*apimodel.InteractionRequest, error) {
+	if err := c.state.DB.PopulateStatusFave(ctx, fave); err != nil {
+		return nil, gtserror.Newf("error populating fave: %w", err)
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, fave.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting account to api: %w", err)
+	}
+
+	apiStatus, err := c.StatusToAPIStatus(ctx, fave.Status, requester, statusfilter.FilterContextNone, nil, nil)
+	if err != nil {
+		return nil, gtserror.Newf("error converting status to api: %w", err)
+	}
+
+	return &apimodel.InteractionRequest{
+		ID:        fave.ID,
+		Type:      "favourite",
+		CreatedAt: util.FormatISO8601(fave.CreatedAt),
+		Account:   apiAccount,
+		Status:    apiStatus,
+	}, nil
+}
+
+// PendingStatusToAPIInteractionRequest converts the given pending
+// reply or boost, targeting one of requester's statuses, to an API
+// model interaction request.
+func (c *Converter) PendingStatusToAPIInteractionRequest(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	requester *gtsmodel.Account,
+) (*apimodel.InteractionRequest, error) {
+	if err := c.state.DB.PopulateStatus(ctx, status); err != nil {
+		return nil, gtserror.Newf("error populating status: %w", err)
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, status.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting account to api: %w", err)
+	}
+
+	var (
+		reqType  string
+		targeted *gtsmodel.Status
+		apiReply *apimodel.Status
+	)
+
+	if status.BoostOfID != "" {
+		reqType = "reblog"
+		targeted = status.BoostOf
+	} else {
+		reqType = "reply"
+		targeted = status.InReplyTo
+
+		apiReply, err = c.StatusToAPIStatus(ctx, status, requester, statusfilter.FilterContextNone, nil, nil)
+		if err != nil {
+			return nil, gtserror.Newf("error converting reply to api: %w", err)
+		}
+	}
+
+	apiStatus, err := c.StatusToAPIStatus(ctx, targeted, requester, statusfilter.FilterContextNone, nil, nil)
+	if err != nil {
+		return nil, gtserror.Newf("error converting status to api: %w", err)
+	}
+
+	return &apimodel.InteractionRequest{
+		ID:        status.ID,
+		Type:      reqType,
+		CreatedAt: util.FormatISO8601(status.CreatedAt),
+		Account:   apiAccount,
+		Status:    apiStatus,
+		Reply:     apiReply,
+	}, nil
+}
+
+// ScheduledStatusToAPIScheduledStatus converts the given
+// scheduled status to its API model representation.
+func (c *Converter) ScheduledStatusToAPIScheduledStatus(
+	ctx context.Context,
+	s *gtsmodel.ScheduledStatus,
+) (*apimodel.ScheduledStatus, error) {
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(
+		ctx,
+		s.MediaAttachments,
+		s.MediaIDs,
+	)
+	if err != nil {
+		log.Errorf(ctx, "error converting scheduled status attachments: %v", err)
+	}
+
+	// Mutuals-only isn't a Mastodon visibility, but
+	// it's what the status will be published with, so
+	// return it as-is rather than downgrading it.
+	var visibility apimodel.Visibility
+	if s.Visibility == gtsmodel.VisibilityMutualsOnly {
+		visibility = apimodel.VisibilityMutualsOnly
+	} else {
+		visibility = c.VisToAPIVis(ctx, s.Visibility)
+	}
+
+	params := &apimodel.StatusParams{
+		Text:          s.Text,
+		MediaIDs:      s.MediaIDs,
+		Sensitive:     util.PtrValueOr(s.Sensitive, false),
+		SpoilerText:   s.SpoilerText,
+		Visibility:    visibility,
+		InReplyToID:   s.InReplyToID,
+		Language:      s.Language,
+		ApplicationID: s.ApplicationID,
+	}
+
+	if params.MediaIDs == nil {
+		// Serialize as empty array.
+		params.MediaIDs = []string{}
+	}
+
+	if s.Poll != nil {
+		params.Poll = &apimodel.StatusParamsPoll{
+			Options:    s.Poll.Options,
+			ExpiresIn:  s.Poll.ExpiresIn,
+			Multiple:   s.Poll.Multiple,
+			HideTotals: s.Poll.HideTotals,
+		}
+	}
+
+	return &apimodel.ScheduledStatus{
+		ID:               s.ID,
+		ScheduledAt:      util.FormatISO8601(s.ScheduledAt),
+		Params:           params,
+		MediaAttachments: apiAttachments,
+	}, nil
+}
+
+// ImportToAPIImport converts the given
+// account import to its API model representation.
+func (c *Converter) ImportToAPIImport(
+	ctx context.Context,
+	i *gtsmodel.Import,
+) (*apimodel.Import, error) {
+	mode := "merge"
+	if util.PtrValueOr(i.Overwrite, false) {
+		mode = "overwrite"
+	}
+
+	var finishedAt *string
+	if !i.FinishedAt.IsZero() {
+		finishedAt = util.Ptr(util.FormatISO8601(i.FinishedAt))
+	}
+
+	return &apimodel.Import{
+		ID:             i.ID,
+		Type:           i.Type.String(),
+		Mode:           mode,
+		DryRun:         util.PtrValueOr(i.DryRun, false),
+		Federate:       util.PtrValueOr(i.Federate, false),
+		State:          i.State.String(),
+		TotalItems:     i.TotalItems,
+		ProcessedItems: i.ProcessedItems,
+		FailedItems:    i.FailedItems,
+		CreatedAt:      util.FormatISO8601(i.CreatedAt),
+		FinishedAt:     finishedAt,
+	}, nil
+}
+
+// WebPushSubscriptionToAPIWebPushSubscription converts
+// a gts model web push subscription into an API model,
+// including the local instance's VAPID public key.
+func (c *Converter) WebPushSubscriptionToAPIWebPushSubscription(
+	ctx context.Context,
+	subscription *gtsmodel.WebPushSubscription,
+) (*apimodel.WebPushSubscription, error) {
+	instance, err := c.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return nil, gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	a := subscription.Alerts
+	return &apimodel.WebPushSubscription{
+		ID:       subscription.ID,
+		Endpoint: subscription.Endpoint,
+		Alerts: apimodel.WebPushSubscriptionAlerts{
+			Follow:        a.Follow,
+			FollowRequest: a.FollowRequest,
+			Favourite:     a.Favourite,
+			Mention:       a.Mention,
+			Reblog:        a.Reblog,
+			Poll:          a.Poll,
+			Status:        a.Status,
+			Update:        a.Update,
+			AdminSignup:   a.AdminSignup,
+			PendingFave:   a.PendingFave,
+			PendingReply:  a.PendingReply,
+			PendingReblog: a.PendingReblog,
+		},
+		ServerKey: instance.VAPIDPublicKey,
+		Policy:    string(subscription.Policy),
+	}, nil
+}
@@ -439,6 +439,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
 	suite.Equal(`{
   "id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "created_at": "2021-10-20T11:36:45.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -536,7 +537,27 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
   ],
   "card": null,
   "poll": null,
-  "text": "hello world! #welcome ! first post on the instance :rainbow: !"
+  "text": "hello world! #welcome ! first post on the instance :rainbow: !",
+  "interaction_policy": {
+    "can_favourite": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reply": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reblog": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    }
+  }
 }`, string(b))
 }
 
@@ -568,6 +589,7 @@ func (suite *InternalToFrontendTestSuite) TestWarnFilteredStatusToFrontend() {
 	suite.Equal(`{
   "id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "created_at": "2021-10-20T11:36:45.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -691,7 +713,27 @@ func (suite *InternalToFrontendTestSuite) TestWarnFilteredStatusToFrontend() {
       ],
       "status_matches": []
     }
-  ]
+  ],
+  "interaction_policy": {
+    "can_favourite": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reply": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reblog": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    }
+  }
 }`, string(b))
 }
 
@@ -785,6 +827,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownAttachments
 	suite.Equal(`{
   "id": "01HE7XJ1CG84TBKH5V9XKBVGF5",
   "created_at": "2023-11-02T10:44:25.000Z",
+  "edited_at": null,
   "in_reply_to_id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "in_reply_to_account_id": "01F8MH17FWEB39HZJ76B6VXSKF",
   "sensitive": true,
@@ -867,7 +910,27 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownAttachments
   "tags": [],
   "emojis": [],
   "card": null,
-  "poll": null
+  "poll": null,
+  "interaction_policy": {
+    "can_favourite": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reply": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reblog": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    }
+  }
 }`, string(b))
 }
 
@@ -894,6 +957,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
 	suite.Equal(`{
   "id": "01HE7XJ1CG84TBKH5V9XKBVGF5",
   "created_at": "2023-11-02T10:44:25.000Z",
+  "edited_at": null,
   "in_reply_to_id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "in_reply_to_account_id": "01F8MH17FWEB39HZJ76B6VXSKF",
   "sensitive": true,
@@ -1000,7 +1064,27 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
   "tags": [],
   "emojis": [],
   "card": null,
-  "poll": null
+  "poll": null,
+  "interaction_policy": {
+    "can_favourite": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reply": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reblog": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    }
+  }
 }`, string(b))
 }
 
@@ -1018,6 +1102,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
 	suite.Equal(`{
   "id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "created_at": "2021-10-20T11:36:45.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -1115,7 +1200,27 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
   ],
   "card": null,
   "poll": null,
-  "text": "hello world! #welcome ! first post on the instance :rainbow: !"
+  "text": "hello world! #welcome ! first post on the instance :rainbow: !",
+  "interaction_policy": {
+    "can_favourite": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reply": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    },
+    "can_reblog": {
+      "always": [
+        "public"
+      ],
+      "with_approval": []
+    }
+  }
 }`, string(b))
 }
 
@@ -1205,7 +1310,13 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -1330,7 +1441,13 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/m4a",
+        "audio/x-flac",
+        "audio/x-wav"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -1349,6 +1466,9 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "vapid": {
+      "public_key": "BPss3fr6EJnwie7yrTRQahBeKZXDzQXml47vZkfmEa7JnIU2JxpqYGodt09SvE857Bw_Oj9tTZwNM3MByCePx4A"
     }
   },
   "registrations": {
@@ -1591,6 +1711,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -1632,6 +1753,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -1689,6 +1811,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -1735,6 +1858,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -1804,6 +1928,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -1861,6 +1986,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -1891,6 +2017,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     {
       "id": "01FVW7JHQFSFK166WWKR8CBA6M",
       "created_at": "2021-09-20T10:40:37.000Z",
+      "edited_at": null,
       "in_reply_to_id": null,
       "in_reply_to_account_id": null,
       "sensitive": false,
@@ -1966,7 +2093,27 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "tags": [],
       "emojis": [],
       "card": null,
-      "poll": null
+      "poll": null,
+      "interaction_policy": {
+        "can_favourite": {
+          "always": [
+            "public"
+          ],
+          "with_approval": []
+        },
+        "can_reply": {
+          "always": [
+            "public"
+          ],
+          "with_approval": []
+        },
+        "can_reblog": {
+          "always": [
+            "public"
+          ],
+          "with_approval": []
+        }
+      }
     }
   ],
   "rules": [
@@ -2039,6 +2186,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -2080,6 +2228,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": true,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -2126,6 +2275,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -2172,6 +2322,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
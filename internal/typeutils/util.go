@@ -264,3 +264,23 @@ func ContentToContentLanguage(
 
 	return contentStr, langTagStr
 }
+
+// emailNotificationsToAPI returns the notification
+// types the given account settings have opted in to
+// receiving emails for, or nil if none.
+func emailNotificationsToAPI(s *gtsmodel.AccountSettings) []string {
+	var types []string
+	if *s.EmailNotifyMention {
+		types = append(types, "mention")
+	}
+	if *s.EmailNotifyDirect {
+		types = append(types, "direct")
+	}
+	if *s.EmailNotifyFollow {
+		types = append(types, "follow")
+	}
+	if *s.EmailNotifyFollowRequest {
+		types = append(types, "follow_request")
+	}
+	return types
+}
@@ -27,25 +27,29 @@ import (
 )
 
 const (
-	UsersPath        = "users"         // UsersPath is for serving users info
-	StatusesPath     = "statuses"      // StatusesPath is for serving statuses
-	InboxPath        = "inbox"         // InboxPath represents the activitypub inbox location
-	OutboxPath       = "outbox"        // OutboxPath represents the activitypub outbox location
-	FollowersPath    = "followers"     // FollowersPath represents the activitypub followers location
-	FollowingPath    = "following"     // FollowingPath represents the activitypub following location
-	LikedPath        = "liked"         // LikedPath represents the activitypub liked location
-	CollectionsPath  = "collections"   // CollectionsPath represents the activitypub collections location
-	FeaturedPath     = "featured"      // FeaturedPath represents the activitypub featured location
-	PublicKeyPath    = "main-key"      // PublicKeyPath is for serving an account's public key
-	FollowPath       = "follow"        // FollowPath used to generate the URI for an individual follow or follow request
-	UpdatePath       = "updates"       // UpdatePath is used to generate the URI for an account update
-	BlocksPath       = "blocks"        // BlocksPath is used to generate the URI for a block
-	MovesPath        = "moves"         // MovesPath is used to generate the URI for a move
-	ReportsPath      = "reports"       // ReportsPath is used to generate the URI for a report/flag
-	ConfirmEmailPath = "confirm_email" // ConfirmEmailPath is used to generate the URI for an email confirmation link
-	FileserverPath   = "fileserver"    // FileserverPath is a path component for serving attachments + media
-	EmojiPath        = "emoji"         // EmojiPath represents the activitypub emoji location
-	TagsPath         = "tags"          // TagsPath represents the activitypub tags location
+	UsersPath            = "users"             // UsersPath is for serving users info
+	StatusesPath         = "statuses"          // StatusesPath is for serving statuses
+	InboxPath            = "inbox"             // InboxPath represents the activitypub inbox location
+	OutboxPath           = "outbox"            // OutboxPath represents the activitypub outbox location
+	FollowersPath        = "followers"         // FollowersPath represents the activitypub followers location
+	FollowingPath        = "following"         // FollowingPath represents the activitypub following location
+	LikedPath            = "liked"             // LikedPath represents the activitypub liked location
+	CollectionsPath      = "collections"       // CollectionsPath represents the activitypub collections location
+	FeaturedPath         = "featured"          // FeaturedPath represents the activitypub featured location
+	FeaturedTagsPath     = "tags"              // FeaturedTagsPath represents the activitypub featured tags location
+	PublicKeyPath        = "main-key"          // PublicKeyPath is for serving an account's public key
+	FollowPath           = "follow"            // FollowPath used to generate the URI for an individual follow or follow request
+	UpdatePath           = "updates"           // UpdatePath is used to generate the URI for an account update
+	BlocksPath           = "blocks"            // BlocksPath is used to generate the URI for a block
+	MovesPath            = "moves"             // MovesPath is used to generate the URI for a move
+	AcceptsPath          = "accepts"           // AcceptsPath is used to generate the URI for an Accept of an interaction
+	RejectsPath          = "rejects"           // RejectsPath is used to generate the URI for a Reject of an interaction
+	ReportsPath          = "reports"           // ReportsPath is used to generate the URI for a report/flag
+	ConfirmEmailPath     = "confirm_email"     // ConfirmEmailPath is used to generate the URI for an email confirmation link
+	UnsubscribeEmailPath = "unsubscribe_email" // UnsubscribeEmailPath is used to generate the URI for an email notifications unsubscribe link
+	FileserverPath       = "fileserver"        // FileserverPath is a path component for serving attachments + media
+	EmojiPath            = "emoji"             // EmojiPath represents the activitypub emoji location
+	TagsPath             = "tags"              // TagsPath represents the activitypub tags location
 )
 
 // UserURIs contains a bunch of UserURIs and URLs for a user, host, account, etc.
@@ -73,6 +77,8 @@ type UserURIs struct {
 	LikedURI string
 	// The activitypub URI for this user's featured collections, eg., https://example.org/users/example_user/collections/featured
 	FeaturedCollectionURI string
+	// The activitypub URI for this user's featured tags collection, eg., https://example.org/users/example_user/collections/tags
+	FeaturedTagsURI string
 	// The URI for this user's public key, eg., https://example.org/users/example_user/publickey
 	PublicKeyURI string
 }
@@ -117,6 +123,22 @@ func GenerateURIForMove(username string, thisMoveID string) string {
 	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, username, MovesPath, thisMoveID)
 }
 
+// GenerateURIForAccept returns the AP URI for a new Accept activity -- something like:
+// https://example.org/users/whatever_user/accepts/01F7XTH1QGBAPMGF49WJZ91XGC
+func GenerateURIForAccept(username string, thisAcceptID string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, username, AcceptsPath, thisAcceptID)
+}
+
+// GenerateURIForReject returns the AP URI for a new Reject activity -- something like:
+// https://example.org/users/whatever_user/rejects/01F7XTH1QGBAPMGF49WJZ91XGC
+func GenerateURIForReject(username string, thisRejectID string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, username, RejectsPath, thisRejectID)
+}
+
 // GenerateURIForReport returns the API URI for a new Flag activity -- something like:
 // https://example.org/reports/01GP3AWY4CRDVRNZKW0TEAMB5R
 //
@@ -136,6 +158,14 @@ func GenerateURIForEmailConfirm(token string) string {
 	return fmt.Sprintf("%s://%s/%s?token=%s", protocol, host, ConfirmEmailPath, token)
 }
 
+// GenerateURIForEmailUnsubscribe returns a link for unsubscribing from email notifications -- something like:
+// https://example.org/unsubscribe_email?user=01F8MGVGPHQ2D3P3X0454H54Z5&token=pTsLY1hzEmBsdDfImhy0yhxM7ZW8R9sFTJHwiBGWAj8
+func GenerateURIForEmailUnsubscribe(userID string, token string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s?user=%s&token=%s", protocol, host, UnsubscribeEmailPath, userID, token)
+}
+
 // GenerateURIsForAccount throws together a bunch of URIs for the given username, with the given protocol and host.
 func GenerateURIsForAccount(username string) *UserURIs {
 	protocol := config.GetProtocol()
@@ -155,6 +185,7 @@ func GenerateURIsForAccount(username string) *UserURIs {
 	followingURI := fmt.Sprintf("%s/%s", userURI, FollowingPath)
 	likedURI := fmt.Sprintf("%s/%s", userURI, LikedPath)
 	collectionURI := fmt.Sprintf("%s/%s/%s", userURI, CollectionsPath, FeaturedPath)
+	featuredTagsURI := fmt.Sprintf("%s/%s/%s", userURI, CollectionsPath, FeaturedTagsPath)
 	publicKeyURI := fmt.Sprintf("%s/%s", userURI, PublicKeyPath)
 
 	return &UserURIs{
@@ -170,6 +201,7 @@ func GenerateURIsForAccount(username string) *UserURIs {
 		FollowingURI:          followingURI,
 		LikedURI:              likedURI,
 		FeaturedCollectionURI: collectionURI,
+		FeaturedTagsURI:       featuredTagsURI,
 		PublicKeyURI:          publicKeyURI,
 	}
 }
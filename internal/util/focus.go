@@ -15,7 +15,7 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-package media
+package util
 
 import (
 	"fmt"
@@ -23,7 +23,9 @@ import (
 	"strings"
 )
 
-func parseFocus(focus string) (focusx, focusy float32, err error) {
+// ParseFocus parses the given "x,y" focus string, as
+// provided in media attachment requests, into its parts.
+func ParseFocus(focus string) (focusx, focusy float32, err error) {
 	if focus == "" {
 		return
 	}
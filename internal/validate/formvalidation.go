@@ -176,6 +176,25 @@ func StatusContentType(statusContentType string) error {
 	return fmt.Errorf("status content type '%s' was not recognized, valid options are 'text/plain', 'text/markdown'", statusContentType)
 }
 
+// EmailNotificationType checks that the given type of
+// notification is one that can be sent by email.
+func EmailNotificationType(notificationType string) error {
+	switch notificationType {
+	case "mention", "direct", "follow", "follow_request":
+		return nil
+	}
+	return fmt.Errorf("email notification type '%s' was not recognized, valid options are 'mention', 'direct', 'follow', 'follow_request'", notificationType)
+}
+
+// EmailDigest checks that the desired email digest setting is valid.
+func EmailDigest(digest string) error {
+	switch gtsmodel.EmailDigest(digest) {
+	case gtsmodel.EmailDigestNone, gtsmodel.EmailDigestDaily, gtsmodel.EmailDigestWeekly:
+		return nil
+	}
+	return fmt.Errorf("email digest '%s' was not recognized, valid options are '', 'daily', 'weekly'", digest)
+}
+
 func CustomCSS(customCSS string) error {
 	if !config.GetAccountsAllowCustomCSS() {
 		return errors.New("accounts-allow-custom-css is not enabled for this instance")
@@ -50,6 +50,14 @@ func (m *Module) aboutGETHandler(c *gin.Context) {
 		return
 	}
 
+	// Get announcements currently shown to
+	// users, to show them to visitors too.
+	announcements, errWithCode := m.processor.Announcements().Get(c.Request.Context(), nil, false)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
 	page := apiutil.WebPage{
 		Template:    "about.tmpl",
 		Instance:    instance,
@@ -59,6 +67,7 @@ func (m *Module) aboutGETHandler(c *gin.Context) {
 			"showStrap":        true,
 			"blocklistExposed": config.GetInstanceExposeSuspendedWeb(),
 			"languages":        config.GetInstanceLanguages().DisplayStrs(),
+			"announcements":    announcements,
 		},
 	}
 
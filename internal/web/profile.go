@@ -133,6 +133,13 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		}
 	}
 
+	// Load featured tags to show alongside the account stats.
+	featuredTags, errWithCode := m.processor.Account().FeaturedTagsGet(ctx, nil, targetAccount.ID)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
 	// Get statuses from maxStatusID onwards (or from top if empty string).
 	statusResp, errWithCode := m.processor.Account().WebStatusesGet(ctx, targetAccount.ID, maxStatusID)
 	if errWithCode != nil {
@@ -181,6 +188,7 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 			"statuses":         statusResp.Items,
 			"statuses_next":    statusResp.NextLink,
 			"pinned_statuses":  pinnedStatuses,
+			"featured_tags":    featuredTags,
 			"show_back_to_top": paging,
 		},
 	}
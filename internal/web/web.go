@@ -36,20 +36,21 @@ import (
 )
 
 const (
-	confirmEmailPath   = "/" + uris.ConfirmEmailPath
-	profileGroupPath   = "/@:username"
-	statusPath         = "/statuses/:" + apiutil.WebStatusIDKey // leave out the '/@:username' prefix as this will be served within the profile group
-	tagsPath           = "/tags/:" + apiutil.TagNameKey
-	customCSSPath      = profileGroupPath + "/custom.css"
-	rssFeedPath        = profileGroupPath + "/feed.rss"
-	assetsPathPrefix   = "/assets"
-	distPathPrefix     = assetsPathPrefix + "/dist"
-	themesPathPrefix   = assetsPathPrefix + "/themes"
-	settingsPathPrefix = "/settings"
-	settingsPanelGlob  = settingsPathPrefix + "/*panel"
-	userPanelPath      = settingsPathPrefix + "/user"
-	adminPanelPath     = settingsPathPrefix + "/admin"
-	signupPath         = "/signup"
+	confirmEmailPath     = "/" + uris.ConfirmEmailPath
+	unsubscribeEmailPath = "/" + uris.UnsubscribeEmailPath
+	profileGroupPath     = "/@:username"
+	statusPath           = "/statuses/:" + apiutil.WebStatusIDKey // leave out the '/@:username' prefix as this will be served within the profile group
+	tagsPath             = "/tags/:" + apiutil.TagNameKey
+	customCSSPath        = profileGroupPath + "/custom.css"
+	rssFeedPath          = profileGroupPath + "/feed.rss"
+	assetsPathPrefix     = "/assets"
+	distPathPrefix       = assetsPathPrefix + "/dist"
+	themesPathPrefix     = assetsPathPrefix + "/themes"
+	settingsPathPrefix   = "/settings"
+	settingsPanelGlob    = settingsPathPrefix + "/*panel"
+	userPanelPath        = settingsPathPrefix + "/user"
+	adminPanelPath       = settingsPathPrefix + "/admin"
+	signupPath           = "/signup"
 
 	cacheControlHeader    = "Cache-Control"     // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control
 	cacheControlNoCache   = "no-cache"          // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control#response_directives
@@ -117,6 +118,8 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	r.AttachHandler(http.MethodGet, rssFeedPath, m.rssFeedGETHandler)
 	r.AttachHandler(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
 	r.AttachHandler(http.MethodPost, confirmEmailPath, m.confirmEmailPOSTHandler)
+	r.AttachHandler(http.MethodGet, unsubscribeEmailPath, m.unsubscribeEmailGETHandler)
+	r.AttachHandler(http.MethodPost, unsubscribeEmailPath, m.unsubscribeEmailPOSTHandler)
 	r.AttachHandler(http.MethodGet, robotsPath, m.robotsGETHandler)
 	r.AttachHandler(http.MethodGet, aboutPath, m.aboutGETHandler)
 	r.AttachHandler(http.MethodGet, domainBlockListPath, m.domainBlockListGETHandler)
@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// recordSize is the record size declared in the aes128gcm
+// content coding header. Payloads are always sent in a single
+// record, so this only needs to exceed the encrypted payload.
+const recordSize = 4096
+
+// maxPayloadSize is the maximum size of plaintext that can be
+// encrypted into a single record: the record size, minus the
+// AEAD tag and the padding delimiter octet.
+const maxPayloadSize = recordSize - 16 - 1
+
+// encrypt encrypts the given payload for a push subscription with
+// the given base64url encoded P-256 public key and auth secret, using
+// the "aes128gcm" content coding as described in RFC 8188 and RFC 8291.
+func encrypt(payload []byte, p256dh string, auth string) ([]byte, error) {
+	if len(payload) > maxPayloadSize {
+		return nil, fmt.Errorf("payload too large: %d bytes", len(payload))
+	}
+
+	uaPublicBytes, err := decodeBase64(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh: %w", err)
+	}
+
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh: %w", err)
+	}
+
+	authSecret, err := decodeBase64(auth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth: %w", err)
+	}
+
+	if len(authSecret) != 16 {
+		return nil, errors.New("invalid auth: must be 16 bytes")
+	}
+
+	// Generate an ephemeral application
+	// server key pair for this message.
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+
+	ecdhSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate a random salt.
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	cek, nonce := deriveKeys(ecdhSecret, authSecret, uaPublicBytes, asPublicBytes, salt)
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Single record, so append the
+	// final record padding delimiter.
+	plaintext := make([]byte, 0, len(payload)+1)
+	plaintext = append(plaintext, payload...)
+	plaintext = append(plaintext, 0x02)
+
+	// Write the header: salt, record
+	// size, key ID length, and key ID,
+	// which is the ephemeral public key.
+	out := make([]byte, 0, 16+4+1+len(asPublicBytes)+len(plaintext)+gcm.Overhead())
+	out = append(out, salt...)
+	out = binary.BigEndian.AppendUint32(out, recordSize)
+	out = append(out, byte(len(asPublicBytes)))
+	out = append(out, asPublicBytes...)
+
+	return gcm.Seal(out, nonce, plaintext, nil), nil
+}
+
+// deriveKeys derives the content encryption key and nonce
+// for a message, from the ECDH shared secret, the subscription
+// auth secret and the public keys, and the message salt.
+func deriveKeys(
+	ecdhSecret []byte,
+	authSecret []byte,
+	uaPublic []byte,
+	asPublic []byte,
+	salt []byte,
+) (cek []byte, nonce []byte) {
+	// key_info = "WebPush: info" || 0x00 || ua_public || as_public
+	keyInfo := make([]byte, 0, 14+len(uaPublic)+len(asPublic))
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+
+	// IKM = HKDF(auth_secret, ecdh_secret, key_info, 32)
+	ikm := hkdf(authSecret, ecdhSecret, keyInfo, 32)
+
+	// CEK = HKDF(salt, IKM, "Content-Encoding: aes128gcm" || 0x00, 16)
+	cek = hkdf(salt, ikm, []byte("Content-Encoding: aes128gcm\x00"), 16)
+
+	// NONCE = HKDF(salt, IKM, "Content-Encoding: nonce" || 0x00, 12)
+	nonce = hkdf(salt, ikm, []byte("Content-Encoding: nonce\x00"), 12)
+
+	return cek, nonce
+}
+
+// hkdf implements HKDF with SHA-256 as described in RFC 5869,
+// for output lengths up to the hash length (single expansion).
+func hkdf(salt []byte, ikm []byte, info []byte, length int) []byte {
+	// Extract.
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(ikm)
+	prk := mac.Sum(nil)
+
+	// Expand (T(1) only).
+	mac = hmac.New(sha256.New, prk)
+	mac.Write(info)
+	mac.Write([]byte{0x01})
+	return mac.Sum(nil)[:length]
+}
+
+// decodeBase64 decodes base64url, with or without padding,
+// falling back to standard encoding, as clients vary in
+// which encoding they use for subscription keys.
+func decodeBase64(s string) ([]byte, error) {
+	for _, enc := range []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.URLEncoding,
+		base64.RawStdEncoding,
+		base64.StdEncoding,
+	} {
+		if b, err := enc.DecodeString(s); err == nil {
+			return b, nil
+		}
+	}
+	return nil, errors.New("not valid base64")
+}
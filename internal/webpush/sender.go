@@ -0,0 +1,349 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// pushTTL is how long push services should
+// hold on to a notification for an offline client.
+const pushTTL = 48 * time.Hour
+
+// maxBodyChars is the maximum length
+// of the notification body text pushed.
+const maxBodyChars = 140
+
+// Sender can push notifications to
+// an account's Web Push subscriptions.
+type Sender interface {
+	// Send encrypts and delivers the given notification, along with its
+	// API model representation, to each of the target account's Web Push
+	// subscriptions whose alerts and policy allow the notification.
+	Send(ctx context.Context, notification *gtsmodel.Notification, apiNotif *apimodel.Notification) error
+}
+
+// NewSender returns a Sender which delivers
+// notifications to push services via client.
+func NewSender(client *httpclient.Client, state *state.State) Sender {
+	return &sender{
+		client: client,
+		state:  state,
+	}
+}
+
+type sender struct {
+	client *httpclient.Client
+	state  *state.State
+}
+
+func (s *sender) Send(
+	ctx context.Context,
+	notification *gtsmodel.Notification,
+	apiNotif *apimodel.Notification,
+) error {
+	subscriptions, err := s.state.DB.GetWebPushSubscriptionsByAccountID(ctx, notification.TargetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting web push subscriptions: %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	// Get the local instance, for its VAPID keys.
+	instance, err := s.state.DB.GetInstance(gtscontext.SetBarebones(ctx), config.GetHost())
+	if err != nil {
+		return gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	if instance.VAPIDPublicKey == "" || instance.VAPIDPrivateKey == "" {
+		return gtserror.New("instance has no VAPID key pair")
+	}
+
+	// Push services may use the subject to contact us.
+	subject := config.GetProtocol() + "://" + config.GetHost()
+	if instance.ContactEmail != "" {
+		subject = "mailto:" + instance.ContactEmail
+	}
+
+	var errs gtserror.MultiError
+
+	for _, subscription := range subscriptions {
+		if !subscription.Alerts.Enabled(notification.NotificationType) {
+			continue
+		}
+
+		allowed, err := s.policyAllows(ctx, subscription, notification)
+		if err != nil {
+			errs.Appendf("error checking subscription %s policy: %w", subscription.ID, err)
+			continue
+		}
+
+		if !allowed {
+			continue
+		}
+
+		if err := s.send(ctx,
+			subscription,
+			notification,
+			apiNotif,
+			instance,
+			subject,
+		); err != nil {
+			errs.Appendf("error pushing to subscription %s: %w", subscription.ID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// policyAllows returns whether the policy of the subscription
+// allows pushing a notification from the origin account.
+func (s *sender) policyAllows(
+	ctx context.Context,
+	subscription *gtsmodel.WebPushSubscription,
+	notification *gtsmodel.Notification,
+) (bool, error) {
+	switch subscription.Policy {
+	case gtsmodel.WebPushSubscriptionPolicyNone:
+		return false, nil
+
+	case gtsmodel.WebPushSubscriptionPolicyFollowed:
+		return s.state.DB.IsFollowing(ctx,
+			notification.TargetAccountID,
+			notification.OriginAccountID,
+		)
+
+	case gtsmodel.WebPushSubscriptionPolicyFollower:
+		return s.state.DB.IsFollowing(ctx,
+			notification.OriginAccountID,
+			notification.TargetAccountID,
+		)
+
+	default:
+		return true, nil
+	}
+}
+
+// send encrypts and POSTs one notification to one subscription.
+func (s *sender) send(
+	ctx context.Context,
+	subscription *gtsmodel.WebPushSubscription,
+	notification *gtsmodel.Notification,
+	apiNotif *apimodel.Notification,
+	instance *gtsmodel.Instance,
+	subject string,
+) error {
+	// The client uses the access token that
+	// created the subscription to fetch any
+	// further details of the notification.
+	token, err := s.state.DB.GetTokenByID(ctx, subscription.TokenID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting token: %w", err)
+	}
+
+	if token == nil || token.Access == "" {
+		// Token was revoked; the
+		// subscription went with it.
+		return s.deleteSubscription(ctx, subscription)
+	}
+
+	payload, err := json.Marshal(newPayload(notification, apiNotif, token.Access))
+	if err != nil {
+		return gtserror.Newf("error marshaling payload: %w", err)
+	}
+
+	body, err := encrypt(payload, subscription.P256dh, subscription.Auth)
+	if err != nil {
+		return gtserror.Newf("error encrypting payload: %w", err)
+	}
+
+	authorization, err := vapidAuthorization(
+		subscription.Endpoint,
+		subject,
+		instance.VAPIDPublicKey,
+		instance.VAPIDPrivateKey,
+		time.Now(),
+	)
+	if err != nil {
+		return gtserror.Newf("error signing request: %w", err)
+	}
+
+	// Push services are expected to be fast, so don't
+	// hold up the worker retrying ones that aren't.
+	req, err := http.NewRequestWithContext(
+		gtscontext.SetFastFail(ctx),
+		http.MethodPost,
+		subscription.Endpoint,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return gtserror.Newf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(pushTTL.Seconds())))
+	req.Header.Set("Urgency", "normal")
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return gtserror.Newf("error doing request: %w", err)
+	}
+
+	// Drain and close body so
+	// connection can be reused.
+	_, _ = io.Copy(io.Discard, rsp.Body)
+	_ = rsp.Body.Close()
+
+	switch code := rsp.StatusCode; {
+	case code == http.StatusNotFound || code == http.StatusGone:
+		// Subscription has expired or been
+		// unsubscribed at the push service.
+		return s.deleteSubscription(ctx, subscription)
+
+	case code < 200 || code > 299:
+		return gtserror.Newf("push service responded %s", rsp.Status)
+	}
+
+	return nil
+}
+
+func (s *sender) deleteSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) error {
+	log.Debugf(ctx, "deleting expired web push subscription %s", subscription.ID)
+	if err := s.state.DB.DeleteWebPushSubscriptionByTokenID(ctx, subscription.TokenID); err != nil {
+		return gtserror.Newf("db error deleting subscription: %w", err)
+	}
+	return nil
+}
+
+// payload is the Mastodon-compatible
+// JSON body of a pushed notification.
+type payload struct {
+	AccessToken      string `json:"access_token"`
+	PreferredLocale  string `json:"preferred_locale"`
+	NotificationID   string `json:"notification_id"`
+	NotificationType string `json:"notification_type"`
+	Icon             string `json:"icon"`
+	Title            string `json:"title"`
+	Body             string `json:"body"`
+}
+
+func newPayload(
+	notification *gtsmodel.Notification,
+	apiNotif *apimodel.Notification,
+	accessToken string,
+) *payload {
+	p := &payload{
+		AccessToken:      accessToken,
+		PreferredLocale:  "en",
+		NotificationID:   apiNotif.ID,
+		NotificationType: apiNotif.Type,
+	}
+
+	if target := notification.TargetAccount; target != nil &&
+		target.Settings != nil && target.Settings.Language != "" {
+		p.PreferredLocale = target.Settings.Language
+	}
+
+	name := ""
+	if account := apiNotif.Account; account != nil {
+		p.Icon = account.Avatar
+		name = account.DisplayName
+		if name == "" {
+			name = account.Username
+		}
+	}
+
+	p.Title = notificationTitle(notification.NotificationType, name)
+
+	if status := apiNotif.Status; status != nil {
+		// Prefer the content warning,
+		// so we don't spoil anything.
+		body := status.SpoilerText
+		if body == "" {
+			body = text.SanitizeToPlaintext(status.Content)
+		}
+		p.Body = truncate(body, maxBodyChars)
+	} else if account := apiNotif.Account; account != nil {
+		p.Body = "@" + account.Acct
+	}
+
+	return p
+}
+
+func notificationTitle(t gtsmodel.NotificationType, name string) string {
+	switch t {
+	case gtsmodel.NotificationFollow:
+		return name + " followed you"
+	case gtsmodel.NotificationFollowRequest:
+		return name + " requested to follow you"
+	case gtsmodel.NotificationMention:
+		return name + " mentioned you"
+	case gtsmodel.NotificationReblog:
+		return name + " boosted your post"
+	case gtsmodel.NotificationFave:
+		return name + " favourited your post"
+	case gtsmodel.NotificationPoll:
+		return "A poll has ended"
+	case gtsmodel.NotificationStatus:
+		return name + " just posted"
+	case gtsmodel.NotificationSignup:
+		return name + " signed up"
+	case gtsmodel.NotificationUpdate:
+		return name + " edited a post"
+	case gtsmodel.NotificationPendingFave:
+		return name + " favourited your post, pending your approval"
+	case gtsmodel.NotificationPendingReply:
+		return name + " replied to your post, pending your approval"
+	case gtsmodel.NotificationPendingReblog:
+		return name + " boosted your post, pending your approval"
+	default:
+		return fmt.Sprintf("New %s notification", t)
+	}
+}
+
+func truncate(s string, maxChars int) string {
+	runes := []rune(s)
+	if len(runes) <= maxChars {
+		return s
+	}
+	return string(runes[:maxChars-1]) + "…"
+}
@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+)
+
+// vapidTokenTTL is how long a VAPID JWT remains valid
+// for. RFC 8292 says this must be no more than 24h.
+const vapidTokenTTL = 12 * time.Hour
+
+// GenerateVAPIDKeyPair generates a new P-256 key pair for
+// signing Web Push requests, as described in RFC 8292. The
+// public key is returned as a base64url encoded uncompressed
+// point, as expected by clients, and the private key as a
+// base64url encoded scalar.
+func GenerateVAPIDKeyPair() (publicKey string, privateKey string, err error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	publicKey = base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes())
+	privateKey = base64.RawURLEncoding.EncodeToString(key.Bytes())
+	return publicKey, privateKey, nil
+}
+
+// vapidAuthorization returns the value of the Authorization header
+// for a Web Push request to the given push service endpoint, signed
+// with the given VAPID key pair and identifying the given subject.
+func vapidAuthorization(
+	endpoint string,
+	subject string,
+	publicKey string,
+	privateKey string,
+	now time.Time,
+) (string, error) {
+	key, err := parseVAPIDPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	// Prepare JWT header and claims; the
+	// audience is the push service origin.
+	header, err := json.Marshal(map[string]string{
+		"typ": "JWT",
+		"alg": "ES256",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]any{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": now.Add(vapidTokenTTL).Unix(),
+		"sub": subject,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) +
+		"." + base64.RawURLEncoding.EncodeToString(claims)
+
+	// Sign with ES256, encoding the
+	// signature as the concatenated
+	// 32-byte big endian r and s values.
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	token := unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+	return "vapid t=" + token + ", k=" + publicKey, nil
+}
+
+// parseVAPIDPrivateKey parses the given base64url encoded
+// P-256 private key scalar into an ECDSA private key.
+func parseVAPIDPrivateKey(privateKey string) (*ecdsa.PrivateKey, error) {
+	b, err := base64.RawURLEncoding.DecodeString(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+
+	// Let ecdh validate the scalar,
+	// and derive the public point.
+	ecdhKey, err := ecdh.P256().NewPrivateKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+
+	point := ecdhKey.PublicKey().Bytes()
+	if len(point) != 65 || point[0] != 4 {
+		return nil, errors.New("invalid VAPID public point")
+	}
+
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(point[1:33]),
+			Y:     new(big.Int).SetBytes(point[33:]),
+		},
+		D: new(big.Int).SetBytes(b),
+	}, nil
+}
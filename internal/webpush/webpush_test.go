@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WebPushTestSuite struct {
+	suite.Suite
+}
+
+func (suite *WebPushTestSuite) TestEncryptDecrypt() {
+	// Generate a user agent key pair and auth secret,
+	// like a browser would when creating a subscription.
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	suite.NoError(err)
+
+	p256dh := base64.RawURLEncoding.EncodeToString(uaPrivate.PublicKey().Bytes())
+	auth := base64.RawURLEncoding.EncodeToString(authSecret)
+
+	payload := []byte(`{"title":"the_mighty_zork mentioned you"}`)
+
+	encrypted, err := encrypt(payload, p256dh, auth)
+	suite.NoError(err)
+
+	// Parse the aes128gcm header.
+	suite.Greater(len(encrypted), 86)
+	salt := encrypted[:16]
+	rs := binary.BigEndian.Uint32(encrypted[16:20])
+	idLen := int(encrypted[20])
+	suite.EqualValues(recordSize, rs)
+	suite.Equal(65, idLen)
+	asPublicBytes := encrypted[21 : 21+idLen]
+	ciphertext := encrypted[21+idLen:]
+
+	// Decrypt as the user agent would.
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicBytes)
+	suite.NoError(err)
+
+	ecdhSecret, err := uaPrivate.ECDH(asPublic)
+	suite.NoError(err)
+
+	cek, nonce := deriveKeys(ecdhSecret, authSecret, uaPrivate.PublicKey().Bytes(), asPublicBytes, salt)
+
+	block, err := aes.NewCipher(cek)
+	suite.NoError(err)
+
+	gcm, err := cipher.NewGCM(block)
+	suite.NoError(err)
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	suite.NoError(err)
+
+	// Plaintext should be the payload
+	// followed by the last record delimiter.
+	suite.Equal(append(bytes.Clone(payload), 0x02), plaintext)
+}
+
+func (suite *WebPushTestSuite) TestEncryptInvalidKeys() {
+	_, err := encrypt([]byte("hello"), "not a key", "AAAAAAAAAAAAAAAAAAAAAA")
+	suite.EqualError(err, "invalid p256dh: not valid base64")
+
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	suite.NoError(err)
+	p256dh := base64.RawURLEncoding.EncodeToString(uaPrivate.PublicKey().Bytes())
+
+	_, err = encrypt([]byte("hello"), p256dh, "AAAA")
+	suite.EqualError(err, "invalid auth: must be 16 bytes")
+}
+
+func (suite *WebPushTestSuite) TestVAPIDAuthorization() {
+	publicKey, privateKey, err := GenerateVAPIDKeyPair()
+	suite.NoError(err)
+
+	now := time.Date(2024, 7, 12, 15, 8, 22, 0, time.UTC)
+	authorization, err := vapidAuthorization(
+		"https://push.example.org/send/some-subscription-id",
+		"mailto:admin@localhost:8080",
+		publicKey,
+		privateKey,
+		now,
+	)
+	suite.NoError(err)
+
+	// Split "vapid t=<jwt>, k=<key>".
+	suite.True(strings.HasPrefix(authorization, "vapid t="))
+	token, key, ok := strings.Cut(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+	suite.True(ok)
+	suite.Equal(publicKey, key)
+
+	parts := strings.Split(token, ".")
+	suite.Len(parts, 3)
+
+	claimsBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	suite.NoError(err)
+
+	var claims struct {
+		Aud string `json:"aud"`
+		Exp int64  `json:"exp"`
+		Sub string `json:"sub"`
+	}
+	suite.NoError(json.Unmarshal(claimsBytes, &claims))
+	suite.Equal("https://push.example.org", claims.Aud)
+	suite.Equal(now.Add(12*time.Hour).Unix(), claims.Exp)
+	suite.Equal("mailto:admin@localhost:8080", claims.Sub)
+
+	// Verify the signature with the public key.
+	point, err := base64.RawURLEncoding.DecodeString(publicKey)
+	suite.NoError(err)
+	suite.Len(point, 65)
+
+	pub := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(point[1:33]),
+		Y:     new(big.Int).SetBytes(point[33:]),
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	suite.NoError(err)
+	suite.Len(sig, 64)
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	suite.True(ecdsa.Verify(pub, digest[:],
+		new(big.Int).SetBytes(sig[:32]),
+		new(big.Int).SetBytes(sig[32:]),
+	))
+}
+
+func TestWebPushTestSuite(t *testing.T) {
+	suite.Run(t, new(WebPushTestSuite))
+}
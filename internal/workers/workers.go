@@ -18,6 +18,7 @@
 package workers
 
 import (
+	"context"
 	"runtime"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -53,10 +54,31 @@ type Workers struct {
 	// asynchronous media processing jobs.
 	Media FnWorkerPool
 
+	// Persister, if set, refills the worker
+	// queues with tasks persisted on last
+	// shutdown when workers are started, and
+	// persists remaining queued tasks once
+	// workers have been stopped.
+	Persister QueuePersister
+
 	// prevent pass-by-value.
 	_ nocopy
 }
 
+// QueuePersister persists the queued tasks
+// of worker pools to the database, so they
+// can survive a restart of the instance.
+type QueuePersister interface {
+	// FillWorkerQueues pushes all tasks persisted
+	// in the database to their worker queues.
+	FillWorkerQueues(ctx context.Context) error
+
+	// PersistWorkerQueues pops all persistable
+	// tasks from the worker queues, and stores
+	// them in the database.
+	PersistWorkerQueues(ctx context.Context) error
+}
+
 // StartScheduler starts the job scheduler.
 func (w *Workers) StartScheduler() {
 	_ = w.Scheduler.Start() // false = already running
@@ -88,6 +110,14 @@ func (w *Workers) Start() {
 	n = 8 * maxprocs
 	w.Media.Start(n)
 	log.Infof(nil, "started %d media workers", n)
+
+	if w.Persister != nil {
+		// Refill worker queues from any
+		// tasks persisted on last shutdown.
+		if err := w.Persister.FillWorkerQueues(context.Background()); err != nil {
+			log.Errorf(nil, "error filling worker queues: %v", err)
+		}
+	}
 }
 
 // Stop will stop all of the contained worker pools (and global scheduler).
@@ -108,6 +138,15 @@ func (w *Workers) Stop() {
 
 	w.Media.Stop()
 	log.Info(nil, "stopped media workers")
+
+	if w.Persister != nil {
+		// Now workers are stopped, persist any
+		// remaining queued tasks to the database
+		// so they can be picked up on next start.
+		if err := w.Persister.PersistWorkerQueues(context.Background()); err != nil {
+			log.Errorf(nil, "error persisting worker queues: %v", err)
+		}
+	}
 }
 
 // nocopy when embedded will signal linter to
@@ -32,8 +32,10 @@ EXPECT=$(cat << "EOF"
         "block-mem-ratio": 2,
         "boost-of-ids-mem-ratio": 3,
         "client-mem-ratio": 0.1,
+        "conversation-mem-ratio": 1,
         "emoji-category-mem-ratio": 0.1,
         "emoji-mem-ratio": 3,
+        "featured-tag-mem-ratio": 0.5,
         "filter-keyword-mem-ratio": 0.5,
         "filter-mem-ratio": 0.5,
         "filter-status-mem-ratio": 0.5,
@@ -41,6 +43,7 @@ EXPECT=$(cat << "EOF"
         "follow-mem-ratio": 2,
         "follow-request-ids-mem-ratio": 2,
         "follow-request-mem-ratio": 2,
+        "followed-tag-ids-mem-ratio": 1,
         "in-reply-to-ids-mem-ratio": 3,
         "instance-mem-ratio": 1,
         "list-entry-mem-ratio": 2,
@@ -54,9 +57,12 @@ EXPECT=$(cat << "EOF"
         "poll-mem-ratio": 1,
         "poll-vote-ids-mem-ratio": 2,
         "poll-vote-mem-ratio": 2,
+        "preview-card-mem-ratio": 0.5,
         "report-mem-ratio": 1,
+        "scheduled-status-mem-ratio": 0.5,
         "status-bookmark-ids-mem-ratio": 2,
         "status-bookmark-mem-ratio": 0.5,
+        "status-edit-mem-ratio": 2,
         "status-fave-ids-mem-ratio": 3,
         "status-fave-mem-ratio": 2,
         "status-mem-ratio": 5,
@@ -68,6 +74,7 @@ EXPECT=$(cat << "EOF"
         "user-mute-ids-mem-ratio": 3,
         "user-mute-mem-ratio": 2,
         "visibility-mem-ratio": 2,
+        "web-push-subscription-mem-ratio": 0.5,
         "webfinger-mem-ratio": 0.1
     },
     "config-path": "internal/config/testdata/test.yaml",
@@ -105,6 +112,8 @@ EXPECT=$(cat << "EOF"
         "nl",
         "en-GB"
     ],
+    "instance-subscriptions-process-every": 86400000000000,
+    "instance-subscriptions-process-from": "23:00",
     "landing-page-user": "admin",
     "letsencrypt-cert-dir": "/gotosocial/storage/certs",
     "letsencrypt-email-address": "",
@@ -162,6 +171,8 @@ EXPECT=$(cat << "EOF"
     "statuses-media-max-files": 1,
     "statuses-poll-max-options": 1,
     "statuses-poll-option-max-chars": 50,
+    "statuses-scheduled-max-daily": 25,
+    "statuses-scheduled-max-total": 300,
     "storage-backend": "local",
     "storage-local-base-path": "/root/store",
     "storage-s3-access-key": "minio",
@@ -0,0 +1,42 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package testrig
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cards"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// NewTestCardFetcher returns a preview card fetcher
+// suitable for testing, which makes no remote calls,
+// as every page it requests is simply not found.
+func NewTestCardFetcher(state *state.State, mediaManager *media.Manager) *cards.Fetcher {
+	return cards.New(state, NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Status:     "404 Not Found",
+			StatusCode: http.StatusNotFound,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader("not found")),
+		}, nil
+	}, ""), mediaManager)
+}
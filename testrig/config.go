@@ -106,6 +106,8 @@ func testDefaults() config.Configuration {
 		StatusesPollMaxOptions:     6,
 		StatusesPollOptionMaxChars: 50,
 		StatusesMediaMaxFiles:      6,
+		StatusesScheduledMaxTotal:  300,
+		StatusesScheduledMaxDaily:  25,
 
 		LetsEncryptEnabled:      false,
 		LetsEncryptPort:         0,
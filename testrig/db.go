@@ -32,13 +32,19 @@ var testModels = []interface{}{
 	&gtsmodel.AccountToEmoji{},
 	&gtsmodel.Application{},
 	&gtsmodel.Block{},
+	&gtsmodel.Conversation{},
+	&gtsmodel.ConversationToStatus{},
 	&gtsmodel.DomainBlock{},
+	&gtsmodel.DomainLimit{},
+	&gtsmodel.DomainPermissionSubscription{},
 	&gtsmodel.EmailDomainBlock{},
+	&gtsmodel.FeaturedTag{},
 	&gtsmodel.Filter{},
 	&gtsmodel.FilterKeyword{},
 	&gtsmodel.FilterStatus{},
 	&gtsmodel.Follow{},
 	&gtsmodel.FollowRequest{},
+	&gtsmodel.FollowedTag{},
 	&gtsmodel.List{},
 	&gtsmodel.ListEntry{},
 	&gtsmodel.Marker{},
@@ -51,10 +57,16 @@ var testModels = []interface{}{
 	&gtsmodel.StatusToTag{},
 	&gtsmodel.StatusFave{},
 	&gtsmodel.StatusBookmark{},
+	&gtsmodel.StatusEdit{},
 	&gtsmodel.Tag{},
 	&gtsmodel.Thread{},
 	&gtsmodel.ThreadMute{},
 	&gtsmodel.ThreadToStatus{},
+	&gtsmodel.Trend{},
+	&gtsmodel.PreviewCard{},
+	&gtsmodel.Announcement{},
+	&gtsmodel.AnnouncementReaction{},
+	&gtsmodel.AnnouncementRead{},
 	&gtsmodel.User{},
 	&gtsmodel.UserMute{},
 	&gtsmodel.Emoji{},
@@ -65,7 +77,13 @@ var testModels = []interface{}{
 	&gtsmodel.Client{},
 	&gtsmodel.EmojiCategory{},
 	&gtsmodel.Tombstone{},
+	&gtsmodel.WebPushSubscription{},
+	&gtsmodel.WorkerTask{},
 	&gtsmodel.Report{},
+	&gtsmodel.ScheduledStatus{},
+	&gtsmodel.Import{},
+	&gtsmodel.ImportStatus{},
+	&gtsmodel.Relay{},
 	&gtsmodel.Rule{},
 	&gtsmodel.AccountNote{},
 	&gtsmodel.AccountSettings{},
@@ -157,6 +175,18 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestAnnouncements() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
+	for _, v := range NewTestAnnouncementReactions() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
 	for _, v := range NewTestDomainBlocks() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(nil, err)
@@ -176,16 +206,16 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 	}
 
 	if accounts == nil {
-		for _, v := range NewTestAccounts() {
-			if err := db.Put(ctx, v); err != nil {
-				log.Panic(nil, err)
-			}
+		accounts = NewTestAccounts()
+	}
+
+	for _, v := range accounts {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
 		}
-	} else {
-		for _, v := range accounts {
-			if err := db.Put(ctx, v); err != nil {
-				log.Panic(nil, err)
-			}
+
+		if err := db.IndexAccount(ctx, v); err != nil {
+			log.Panic(nil, err)
 		}
 	}
 
@@ -205,6 +235,10 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(nil, err)
 		}
+
+		if err := db.IndexStatus(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
 	}
 
 	for _, v := range NewTestEmojis() {
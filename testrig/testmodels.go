@@ -650,44 +650,60 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 func NewTestAccountSettings() map[string]*gtsmodel.AccountSettings {
 	return map[string]*gtsmodel.AccountSettings{
 		"unconfirmed_account": {
-			AccountID:       "01F8MH0BBE4FHXPH513MBVFHB0",
-			CreatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(false),
-			HideCollections: util.Ptr(false),
+			AccountID:                "01F8MH0BBE4FHXPH513MBVFHB0",
+			CreatedAt:                TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:                TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:                  gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			EnableRSS:                util.Ptr(false),
+			HideCollections:          util.Ptr(false),
+			EmailNotifyMention:       util.Ptr(false),
+			EmailNotifyDirect:        util.Ptr(false),
+			EmailNotifyFollow:        util.Ptr(false),
+			EmailNotifyFollowRequest: util.Ptr(false),
 		},
 		"admin_account": {
-			AccountID:       "01F8MH17FWEB39HZJ76B6VXSKF",
-			CreatedAt:       TimeMustParse("2022-05-17T13:10:59Z"),
-			UpdatedAt:       TimeMustParse("2022-05-17T13:10:59Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(true),
-			HideCollections: util.Ptr(false),
+			AccountID:                "01F8MH17FWEB39HZJ76B6VXSKF",
+			CreatedAt:                TimeMustParse("2022-05-17T13:10:59Z"),
+			UpdatedAt:                TimeMustParse("2022-05-17T13:10:59Z"),
+			Privacy:                  gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			EnableRSS:                util.Ptr(true),
+			HideCollections:          util.Ptr(false),
+			EmailNotifyMention:       util.Ptr(false),
+			EmailNotifyDirect:        util.Ptr(false),
+			EmailNotifyFollow:        util.Ptr(false),
+			EmailNotifyFollowRequest: util.Ptr(false),
 		},
 		"local_account_1": {
-			AccountID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
-			CreatedAt:       TimeMustParse("2022-05-20T11:09:18Z"),
-			UpdatedAt:       TimeMustParse("2022-05-20T11:09:18Z"),
-			Privacy:         gtsmodel.VisibilityPublic,
-			Sensitive:       util.Ptr(false),
-			Language:        "en",
-			EnableRSS:       util.Ptr(true),
-			HideCollections: util.Ptr(false),
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			CreatedAt:                TimeMustParse("2022-05-20T11:09:18Z"),
+			UpdatedAt:                TimeMustParse("2022-05-20T11:09:18Z"),
+			Privacy:                  gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			EnableRSS:                util.Ptr(true),
+			HideCollections:          util.Ptr(false),
+			EmailNotifyMention:       util.Ptr(false),
+			EmailNotifyDirect:        util.Ptr(false),
+			EmailNotifyFollow:        util.Ptr(false),
+			EmailNotifyFollowRequest: util.Ptr(false),
 		},
 		"local_account_2": {
-			AccountID:       "01F8MH5NBDF2MV7CTC4Q5128HF",
-			CreatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:         gtsmodel.VisibilityFollowersOnly,
-			Sensitive:       util.Ptr(true),
-			Language:        "fr",
-			EnableRSS:       util.Ptr(false),
-			HideCollections: util.Ptr(true),
+			AccountID:                "01F8MH5NBDF2MV7CTC4Q5128HF",
+			CreatedAt:                TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:                TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:                  gtsmodel.VisibilityFollowersOnly,
+			Sensitive:                util.Ptr(true),
+			Language:                 "fr",
+			EnableRSS:                util.Ptr(false),
+			HideCollections:          util.Ptr(true),
+			EmailNotifyMention:       util.Ptr(false),
+			EmailNotifyDirect:        util.Ptr(false),
+			EmailNotifyFollow:        util.Ptr(false),
+			EmailNotifyFollowRequest: util.Ptr(false),
 		},
 	}
 }
@@ -1305,6 +1321,8 @@ func NewTestInstances() map[string]*gtsmodel.Instance {
 			ContactEmail:           "admin@example.org",
 			ContactAccountUsername: "admin",
 			ContactAccountID:       "01F8MH17FWEB39HZJ76B6VXSKF",
+			VAPIDPublicKey:         "BPss3fr6EJnwie7yrTRQahBeKZXDzQXml47vZkfmEa7JnIU2JxpqYGodt09SvE857Bw_Oj9tTZwNM3MByCePx4A",
+			VAPIDPrivateKey:        "Mqrzn7JSzwY3G5a83BS2rYuZmUeTl47sTdBLFmFmRYs",
 		},
 		"fossbros-anonymous.io": {
 			ID:        "01G5H6YMJQKR86QZKXXQ2S95FZ",
@@ -1425,9 +1443,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"admin_account_status_2": {
@@ -1451,9 +1466,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"admin_account_status_3": {
@@ -1478,9 +1490,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"admin_account_status_4": {
@@ -1506,9 +1515,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_1_status_1": {
@@ -1531,9 +1537,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_1_status_2": {
@@ -1556,9 +1559,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(false),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_1_status_3": {
@@ -1581,10 +1581,18 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(false),
-			Replyable:                util.Ptr(false),
-			Likeable:                 util.Ptr(false),
-			ActivityStreamsType:      ap.ObjectNote,
+			InteractionPolicy: &gtsmodel.InteractionPolicy{
+				CanLike: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				},
+				CanReply: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				},
+				CanAnnounce: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				},
+			},
+			ActivityStreamsType: ap.ObjectNote,
 		},
 		"local_account_1_status_4": {
 			ID:                       "01F8MH82FYRXD2RC6108DAJ5HB",
@@ -1607,9 +1615,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_1_status_5": {
@@ -1633,9 +1638,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_1_status_6": {
@@ -1659,9 +1661,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ActivityQuestion,
 			PollID:                   "01HEN2RKT1YTEZ80SA8HGP105F",
 		},
@@ -1685,9 +1684,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_2_status_1": {
@@ -1710,9 +1706,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_2_status_2": {
@@ -1735,10 +1728,18 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(false),
-			Likeable:                 util.Ptr(true),
-			ActivityStreamsType:      ap.ObjectNote,
+			InteractionPolicy: &gtsmodel.InteractionPolicy{
+				CanLike: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+				},
+				CanReply: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				},
+				CanAnnounce: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+				},
+			},
+			ActivityStreamsType: ap.ObjectNote,
 		},
 		"local_account_2_status_3": {
 			ID:                       "01F8MHC8VWDRBQR0N1BATDDEM5",
@@ -1760,10 +1761,18 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(false),
-			Likeable:                 util.Ptr(false),
-			ActivityStreamsType:      ap.ObjectNote,
+			InteractionPolicy: &gtsmodel.InteractionPolicy{
+				CanLike: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				},
+				CanReply: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				},
+				CanAnnounce: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+				},
+			},
+			ActivityStreamsType: ap.ObjectNote,
 		},
 		"local_account_2_status_4": {
 			ID:                       "01F8MHCP5P2NWYQ416SBA0XSEV",
@@ -1785,9 +1794,17 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(false),
-			Boostable:                util.Ptr(false),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
+			InteractionPolicy: &gtsmodel.InteractionPolicy{
+				CanLike: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+				},
+				CanReply: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+				},
+				CanAnnounce: gtsmodel.PolicyRules{
+					Always: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				},
+			},
 
 			ActivityStreamsType: ap.ObjectNote,
 		},
@@ -1814,9 +1831,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_2_status_6": {
@@ -1842,9 +1856,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_2_status_7": {
@@ -1869,9 +1880,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_2_status_8": {
@@ -1895,9 +1903,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ActivityQuestion,
 			PollID:                   "01HEN2QB5NR4NCEHGYC3HN84K6",
 		},
@@ -1923,9 +1928,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"remote_account_1_status_2": {
@@ -1949,9 +1951,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ActivityQuestion,
 			PollID:                   "01HEN2R65468ZG657C4ZPHJ4EX",
 		},
@@ -1976,9 +1975,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ActivityQuestion,
 			PollID:                   "01HEWV1GW2D49R919NPEDXPTZ5",
 		},
@@ -2004,9 +2000,6 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "",
 			Federated:                util.Ptr(true),
-			Boostable:                util.Ptr(true),
-			Replyable:                util.Ptr(true),
-			Likeable:                 util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 	}
@@ -2596,6 +2589,50 @@ func NewTestRules() map[string]*gtsmodel.Rule {
 	}
 }
 
+func NewTestAnnouncements() map[string]*gtsmodel.Announcement {
+	return map[string]*gtsmodel.Announcement{
+		"published": {
+			ID:          "01J5QVB9VC76NPPRQ207GG4DRZ",
+			CreatedAt:   TimeMustParse("2024-08-20T10:12:24+02:00"),
+			UpdatedAt:   TimeMustParse("2024-08-20T10:12:24+02:00"),
+			Text:        "The instance will be down for maintenance tonight, sorry! :rainbow:",
+			Content:     "<p>The instance will be down for maintenance tonight, sorry! :rainbow:</p>",
+			StartsAt:    TimeMustParse("2024-08-20T22:00:00+02:00"),
+			AllDay:      util.Ptr(false),
+			PublishedAt: TimeMustParse("2024-08-20T10:12:24+02:00"),
+			EmojiIDs:    []string{"01F8MH9H8E4VG3KDYJR9EGPXCQ"},
+		},
+		"draft": {
+			ID:        "01J5QVXJ2SXCWH2YF9FD0WYQCP",
+			CreatedAt: TimeMustParse("2024-08-20T10:22:24+02:00"),
+			UpdatedAt: TimeMustParse("2024-08-20T10:22:24+02:00"),
+			Text:      "We're moving to a bigger server next month!",
+			Content:   "<p>We're moving to a bigger server next month!</p>",
+			AllDay:    util.Ptr(false),
+		},
+	}
+}
+
+func NewTestAnnouncementReactions() map[string]*gtsmodel.AnnouncementReaction {
+	return map[string]*gtsmodel.AnnouncementReaction{
+		"local_account_2_sloth": {
+			ID:             "01J5QW5J0BRHBS2JG5Z5S5QG1F",
+			CreatedAt:      TimeMustParse("2024-08-20T10:30:01+02:00"),
+			AnnouncementID: "01J5QVB9VC76NPPRQ207GG4DRZ",
+			AccountID:      "01F8MH5NBDF2MV7CTC4Q5128HF",
+			Name:           "🦥",
+		},
+		"local_account_1_rainbow": {
+			ID:             "01J5QW6FB3EW5VJ7QWZ4BS3N9H",
+			CreatedAt:      TimeMustParse("2024-08-20T10:31:12+02:00"),
+			AnnouncementID: "01J5QVB9VC76NPPRQ207GG4DRZ",
+			AccountID:      "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Name:           "rainbow",
+			EmojiID:        "01F8MH9H8E4VG3KDYJR9EGPXCQ",
+		},
+	}
+}
+
 // ActivityWithSignature wraps a pub.Activity along with its signature headers, for testing.
 type ActivityWithSignature struct {
 	Activity        pub.Activity
@@ -117,15 +117,15 @@ dynamicSpoiler("text-spoiler", (spoiler) => {
 
 dynamicSpoiler("media-spoiler", (spoiler) => {
 	const eye = spoiler.querySelector(".eye.button");
-	const video = spoiler.querySelector(".plyr-video");
+	const media = spoiler.querySelector(".plyr-video, audio");
 
 	return () => {
 		if (spoiler.open) {
 			eye.setAttribute("aria-label", "Hide media");
 		} else {
 			eye.setAttribute("aria-label", "Show media");
-			if (video) {
-				video.pause();
+			if (media) {
+				media.pause();
 			}
 		}
 	};
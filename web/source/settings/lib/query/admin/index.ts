@@ -91,9 +91,23 @@ const extended = gtsApi.injectEndpoints({
 			async onQueryStarted({ id, action }, { dispatch, queryFulfilled }) {
 				const patchResult = dispatch(
 					extended.util.updateQueryData("getAccount", id, (draft) => {
-						if (action === "suspend") {
-							draft.suspended = true;
-							draft.account.suspended = true;
+						switch (action) {
+							case "suspend":
+								draft.suspended = true;
+								draft.account.suspended = true;
+								break;
+							case "silence":
+							case "unsilence":
+								draft.silenced = action === "silence";
+								break;
+							case "sensitive":
+							case "unsensitive":
+								draft.sensitized = action === "sensitive";
+								break;
+							case "disable":
+							case "reenable":
+								draft.disabled = action === "disable";
+								break;
 						}
 					})
 				);
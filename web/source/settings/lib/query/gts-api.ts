@@ -141,6 +141,7 @@ export const gtsApi = createApi({
 		"InstanceRules",
 		"HTTPHeaderAllows",
 		"HTTPHeaderBlocks",
+		"AuthorizedApps",
 	],
 	endpoints: (build) => ({
 		instanceV1: build.query<InstanceV1, void>({
@@ -25,6 +25,7 @@ import type {
 } from "../../types/migration";
 import type { Theme } from "../../types/theme";
 import { User } from "../../types/user";
+import type { AuthorizedApp } from "../../types/token";
 
 const extended = gtsApi.injectEndpoints({
 	endpoints: (build) => ({
@@ -84,6 +85,19 @@ const extended = gtsApi.injectEndpoints({
 			query: () => ({
 				url: `/api/v1/accounts/themes`
 			})
+		}),
+		authorizedApps: build.query<AuthorizedApp[], void>({
+			query: () => ({
+				url: `/api/v1/tokens/applications`
+			}),
+			providesTags: ["AuthorizedApps"]
+		}),
+		revokeAuthorizedApp: build.mutation<AuthorizedApp, string>({
+			query: (id) => ({
+				method: "POST",
+				url: `/api/v1/tokens/applications/${id}/invalidate`
+			}),
+			invalidatesTags: ["AuthorizedApps"]
 		})
 	})
 });
@@ -96,4 +110,6 @@ export const {
 	useAliasAccountMutation,
 	useMoveAccountMutation,
 	useAccountThemesQuery,
+	useAuthorizedAppsQuery,
+	useRevokeAuthorizedAppMutation,
 } = extended;
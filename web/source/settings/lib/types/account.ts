@@ -35,6 +35,7 @@ export interface AdminAccount {
 	approved: boolean,
 	disabled: boolean,
 	silenced: boolean,
+	sensitized: boolean,
 	suspended: boolean,
 	created_by_application_id: string,
 	account: Account,
@@ -96,6 +97,10 @@ export interface HandleSignupParams {
 
 export interface ActionAccountParams {
 	id: string;
-	action: "suspend";
+	action: "none"
+		| "sensitive" | "unsensitive"
+		| "disable" | "reenable"
+		| "silence" | "unsilence"
+		| "suspend";
 	reason: string;
 }
@@ -0,0 +1,59 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+export interface TokenInfo {
+	/**
+	 * ID of this token.
+	 */
+	id: string;
+
+	/**
+	 * ISO8601 timestamp when
+	 * this token was created.
+	 */
+	created_at: string;
+
+	/**
+	 * Approximate ISO8601 timestamp when this
+	 * token was last used, if ever used.
+	 */
+	last_used?: string;
+
+	/**
+	 * OAuth scopes granted by
+	 * the token, space-separated.
+	 */
+	scope: string;
+}
+
+export interface AuthorizedApp {
+	/**
+	 * The authorized application.
+	 */
+	application: {
+		id: string;
+		name: string;
+		website?: string;
+	};
+
+	/**
+	 * Access tokens issued to
+	 * the application, newest first.
+	 */
+	tokens: TokenInfo[];
+}
@@ -63,6 +63,7 @@ export function AccountActions({ account, backLocation }: AccountActionsProps) {
 }
 
 function ModerateAccount({ account }: { account: AdminAccount }) {
+	const local = !account.domain;
 	const form = {
 		id: useValue("id", account.id),
 		reason: useTextInput("text")
@@ -78,15 +79,47 @@ function ModerateAccount({ account }: { account: AdminAccount }) {
 		>
 			<h3 id="account-moderation-actions">Account Moderation Actions</h3>
 			<div>
-				Currently only the "suspend" action is implemented.<br/>
-				Suspending an account will delete it from your server, and remove all of its media, posts, relationships, etc.<br/>
-				If the suspended account is local, suspending will also send out a "delete" message to other servers, requesting them to remove its data from their instance as well.<br/>
-				<b>Account suspension cannot be reversed.</b>
+				Silencing an account hides its posts from everyone except its followers and accounts it mentions.<br/>
+				Marking an account sensitive forces all of its media to be shown as sensitive.<br/>
+				Disabling a local account prevents it from logging in, and hides it and its posts.<br/>
+				Taking no action records a warning against the account, with the given reason.
 			</div>
 			<TextInput
 				field={form.reason}
 				placeholder="Reason for this action"
 			/>
+			<div className="action-buttons">
+				<MutationButton
+					disabled={false}
+					label="Warn (no action)"
+					name="none"
+					result={result}
+				/>
+				<MutationButton
+					disabled={false}
+					label={account.silenced ? "Unsilence" : "Silence"}
+					name={account.silenced ? "unsilence" : "silence"}
+					result={result}
+				/>
+				<MutationButton
+					disabled={false}
+					label={account.sensitized ? "Unmark sensitive" : "Mark sensitive"}
+					name={account.sensitized ? "unsensitive" : "sensitive"}
+					result={result}
+				/>
+				{ local &&
+				<MutationButton
+					disabled={false}
+					label={account.disabled ? "Reenable" : "Disable"}
+					name={account.disabled ? "reenable" : "disable"}
+					result={result}
+				/> }
+			</div>
+			<div>
+				Suspending an account will delete it from your server, and remove all of its media, posts, relationships, etc.<br/>
+				If the suspended account is local, suspending will also send out a "delete" message to other servers, requesting them to remove its data from their instance as well.<br/>
+				<b>Account suspension cannot be reversed.</b>
+			</div>
 			<div className="action-buttons">
 				<MutationButton
 					disabled={account.suspended || reallySuspend.value === undefined || reallySuspend.value === false}
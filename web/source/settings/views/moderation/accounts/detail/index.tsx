@@ -141,6 +141,10 @@ function GeneralAccountDetails({ adminAcct } : { adminAcct: AdminAccount }) {
 					<dt>Silenced</dt>
 					<dd>{yesOrNo(adminAcct.silenced)}</dd>
 				</div>
+				<div className="info-list-entry">
+					<dt>Media sensitive</dt>
+					<dd>{yesOrNo(adminAcct.sensitized)}</dd>
+				</div>
 				<div className="info-list-entry">
 					<dt>Statuses</dt>
 					<dd>{adminAcct.account.statuses_count}</dd>
@@ -0,0 +1,105 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+import React from "react";
+import { useAuthorizedAppsQuery, useRevokeAuthorizedAppMutation } from "../../lib/query/user";
+import { AuthorizedApp } from "../../lib/types/token";
+import Loading from "../../components/loading";
+import { Error } from "../../components/error";
+import MutationButton from "../../components/form/mutation-button";
+
+export default function UserApps() {
+	const { data: apps, isLoading, isFetching, isError, error } = useAuthorizedAppsQuery();
+
+	let content: React.ReactNode;
+	if (isLoading || isFetching) {
+		content = <Loading />;
+	} else if (isError) {
+		content = <Error error={error} />;
+	} else if (apps === undefined || apps.length === 0) {
+		content = <b>You haven't authorized any apps.</b>;
+	} else {
+		content = apps.map((app) => (
+			<AuthorizedAppEntry key={app.application.id} app={app} />
+		));
+	}
+
+	return (
+		<div className="authorized-apps">
+			<div className="form-section-docs">
+				<h1>Authorized Apps</h1>
+				<p>
+					These are the apps you've signed in to with your account, each with
+					the access tokens issued to it, one per sign in. If you lost a device
+					that's signed in, or no longer use or trust an app, revoke its access.
+				</p>
+				<p>
+					Revoking access signs the app out everywhere it's signed in, including
+					this settings panel if you revoke the "GoToSocial Settings" app.
+				</p>
+				<a
+					href="https://docs.gotosocial.org/en/latest/user_guide/password_management/#authorized-apps"
+					target="_blank"
+					className="docslink"
+					rel="noreferrer"
+				>
+					Learn more about authorized apps (opens in a new tab)
+				</a>
+			</div>
+			{content}
+		</div>
+	);
+}
+
+function AuthorizedAppEntry({ app }: { app: AuthorizedApp }) {
+	const [ revokeTrigger, revokeResult ] = useRevokeAuthorizedAppMutation();
+	const { application, tokens } = app;
+
+	return (
+		<div className="authorized-app">
+			<h2>
+				{application.website
+					? <a href={application.website} target="_blank" rel="noreferrer">{application.name}</a>
+					: application.name
+				}
+			</h2>
+			<dl className="info-list">
+				{tokens.map((token) => (
+					<div key={token.id} className="info-list-entry">
+						<dt>Signed in <time dateTime={token.created_at}>{new Date(token.created_at).toLocaleString()}</time></dt>
+						<dd>
+							{token.last_used
+								? <>Last used <time dateTime={token.last_used}>{new Date(token.last_used).toLocaleString()}</time></>
+								: "Never used"
+							}
+							{" with scopes "}<span className="monospace">{token.scope}</span>
+						</dd>
+					</div>
+				))}
+			</dl>
+			<MutationButton
+				type="button"
+				onClick={() => revokeTrigger(application.id)}
+				label="Revoke access"
+				result={revokeResult}
+				className="button danger"
+				disabled={false}
+			/>
+		</div>
+	);
+}